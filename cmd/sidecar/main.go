@@ -19,6 +19,7 @@ import (
 	_ "github.com/marcus/sidecar/internal/adapter/codex"
 	_ "github.com/marcus/sidecar/internal/adapter/cursor"
 	_ "github.com/marcus/sidecar/internal/adapter/geminicli"
+	_ "github.com/marcus/sidecar/internal/adapter/jsonl"
 	_ "github.com/marcus/sidecar/internal/adapter/kiro"
 	_ "github.com/marcus/sidecar/internal/adapter/opencode"
 	_ "github.com/marcus/sidecar/internal/adapter/pi"
@@ -38,6 +39,7 @@ import (
 	"github.com/marcus/sidecar/internal/state"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/theme"
+	"github.com/marcus/sidecar/internal/version"
 	"golang.org/x/term"
 )
 
@@ -144,6 +146,9 @@ func main() {
 	// Apply UI settings (Nerd Font features)
 	styles.PillTabsEnabled = cfg.UI.NerdFontsEnabled
 
+	// Apply update check settings
+	version.CacheTTL = cfg.Updates.CheckInterval
+
 	// Create keymap registry first (plugins may register bindings during Init)
 	km := keymap.NewRegistry()
 	keymap.RegisterDefaults(km)
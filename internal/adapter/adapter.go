@@ -62,6 +62,15 @@ type WatchScopeProvider interface {
 	WatchScope() WatchScope
 }
 
+// SessionEndDetector is an optional interface for file-based adapters that
+// can tell from a session file that the agent has finished, so watchers can
+// emit EventSessionEnded instead of a plain update.
+type SessionEndDetector interface {
+	// SessionEnded reports whether the session file at path ends with the
+	// agent finishing its turn.
+	SessionEnded(path string) bool
+}
+
 // Capability represents a feature supported by an adapter.
 type Capability string
 
@@ -75,6 +84,15 @@ const (
 // CapabilitySet tracks which features an adapter supports.
 type CapabilitySet map[Capability]bool
 
+// Supports reports whether the set includes cap. A nil set means the adapter
+// does not declare its capabilities, so every feature is assumed supported.
+func (c CapabilitySet) Supports(cap Capability) bool {
+	if c == nil {
+		return true
+	}
+	return c[cap]
+}
+
 // Session file size thresholds for performance warnings
 const (
 	LargeSessionThreshold = 100 * 1024 * 1024 // 100MB - show warning
@@ -186,11 +204,56 @@ type ToolUse struct {
 
 // UsageStats provides aggregate usage statistics.
 type UsageStats struct {
-	TotalInputTokens  int
-	TotalOutputTokens int
-	TotalCacheRead    int
-	TotalCacheWrite   int
-	MessageCount      int
+	TotalInputTokens    int
+	TotalOutputTokens   int
+	TotalCacheRead      int
+	TotalCacheWrite     int
+	TotalThinkingTokens int
+	MessageCount        int
+	Daily               []DailyUsage // Per-day totals, oldest first (optional)
+}
+
+// DailyUsage holds the token and message totals for one local calendar day.
+type DailyUsage struct {
+	Date         time.Time // Local midnight
+	InputTokens  int
+	OutputTokens int
+	MessageCount int
+}
+
+// AddMessage adds a message's tokens to the totals and to the per-day
+// breakdown for its timestamp. Messages without a timestamp only count
+// toward the totals.
+func (s *UsageStats) AddMessage(m Message) {
+	s.TotalInputTokens += m.InputTokens
+	s.TotalOutputTokens += m.OutputTokens
+	s.TotalCacheRead += m.CacheRead
+	s.TotalCacheWrite += m.CacheWrite
+	for _, tb := range m.ThinkingBlocks {
+		s.TotalThinkingTokens += tb.TokenCount
+	}
+	s.MessageCount++
+
+	if m.Timestamp.IsZero() {
+		return
+	}
+	t := m.Timestamp.Local()
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	// Messages are usually in order, so search from the newest day
+	i := len(s.Daily)
+	for i > 0 && s.Daily[i-1].Date.After(date) {
+		i--
+	}
+	if i == 0 || !s.Daily[i-1].Date.Equal(date) {
+		s.Daily = append(s.Daily, DailyUsage{})
+		copy(s.Daily[i+1:], s.Daily[i:])
+		s.Daily[i] = DailyUsage{Date: date}
+		i++
+	}
+	day := &s.Daily[i-1]
+	day.InputTokens += m.InputTokens
+	day.OutputTokens += m.OutputTokens
+	day.MessageCount++
 }
 
 // Event represents a change in session data.
@@ -207,4 +270,9 @@ const (
 	EventSessionCreated EventType = "session_created"
 	EventSessionUpdated EventType = "session_updated"
 	EventMessageAdded   EventType = "message_added"
+
+	// EventSessionEnded reports that an agent finished working on a session,
+	// such as completing its turn (see SessionEndDetector); watchers flush
+	// pending updates instead of waiting out their debounce.
+	EventSessionEnded EventType = "session_ended"
 )
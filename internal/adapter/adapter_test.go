@@ -0,0 +1,21 @@
+package adapter
+
+import "testing"
+
+func TestCapabilitySetSupports(t *testing.T) {
+	caps := CapabilitySet{CapSessions: true, CapUsage: false}
+	if !caps.Supports(CapSessions) {
+		t.Error("expected declared capability to be supported")
+	}
+	if caps.Supports(CapUsage) {
+		t.Error("expected capability declared false to be unsupported")
+	}
+	if caps.Supports(CapWatch) {
+		t.Error("expected capability missing from a declared set to be unsupported")
+	}
+
+	var undeclared CapabilitySet
+	if !undeclared.Supports(CapWatch) {
+		t.Error("expected a nil set to support every capability")
+	}
+}
@@ -2,6 +2,7 @@ package claudecode
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/marcus/sidecar/internal/adapter"
@@ -1160,3 +1161,28 @@ func TestSessionMetadataCacheInvalidation(t *testing.T) {
 		t.Errorf("expected 3 msgs after invalidation, got %d", meta2.MsgCount)
 	}
 }
+
+func TestSessionEnded(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want bool
+	}{
+		{"end of turn", `{"type":"assistant","message":{"role":"assistant","stop_reason":"end_turn"}}`, true},
+		{"tool call", `{"type":"assistant","message":{"role":"assistant","stop_reason":"tool_use"}}`, false},
+		{"still streaming", `{"type":"assistant","message":{"role":"assistant","stop_reason":null}}`, false},
+		{"tool result", `{"type":"user","message":{"role":"user"}}`, false},
+		{"no message", `{"type":"summary"}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "s.jsonl")
+			if err := os.WriteFile(path, []byte(tt.line+"\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if got := New().SessionEnded(path); got != tt.want {
+				t.Errorf("SessionEnded = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package claudecode
 
 import (
+	"encoding/json"
 	"io"
 	"path/filepath"
 	"strings"
@@ -78,6 +79,8 @@ func NewWatcher(projectDir string) (<-chan adapter.Event, io.Closer, error) {
 					switch {
 					case lastEvent.Op&fsnotify.Create != 0:
 						eventType = adapter.EventSessionCreated
+					case lastEvent.Op&fsnotify.Write != 0 && sessionEnded(lastEvent.Name):
+						eventType = adapter.EventSessionEnded
 					case lastEvent.Op&fsnotify.Write != 0:
 						eventType = adapter.EventMessageAdded
 					case lastEvent.Op&fsnotify.Remove != 0:
@@ -109,3 +112,30 @@ func NewWatcher(projectDir string) (<-chan adapter.Event, io.Closer, error) {
 
 	return events, watcher, nil
 }
+
+// turnEnd is the part of a transcript line that shows the agent finished.
+type turnEnd struct {
+	Type    string `json:"type"`
+	Message *struct {
+		StopReason string `json:"stop_reason"`
+	} `json:"message"`
+}
+
+// sessionEnded reports whether the session file at path ends with an assistant
+// message that stopped at the end of its turn, rather than for a tool call.
+func sessionEnded(path string) bool {
+	line, err := adapter.LastLine(path)
+	if err != nil || line == nil {
+		return false
+	}
+	var rec turnEnd
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return false
+	}
+	return rec.Type == "assistant" && rec.Message != nil && rec.Message.StopReason == "end_turn"
+}
+
+// SessionEnded implements adapter.SessionEndDetector.
+func (a *Adapter) SessionEnded(path string) bool {
+	return sessionEnded(path)
+}
@@ -579,11 +579,7 @@ func (a *Adapter) Usage(sessionID string) (*adapter.UsageStats, error) {
 
 	stats := &adapter.UsageStats{}
 	for _, msg := range messages {
-		stats.TotalInputTokens += msg.InputTokens
-		stats.TotalOutputTokens += msg.OutputTokens
-		stats.TotalCacheRead += msg.CacheRead
-		stats.TotalCacheWrite += msg.CacheWrite
-		stats.MessageCount++
+		stats.AddMessage(msg)
 	}
 
 	// If per-message stats are zero, use cached total from Messages() scan
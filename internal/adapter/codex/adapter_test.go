@@ -504,3 +504,27 @@ func TestMessagesCaching_ToolCallAcrossBoundary(t *testing.T) {
 		t.Error("tool use output should be linked, got empty")
 	}
 }
+
+func TestSessionEnded(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines string
+		want  bool
+	}{
+		{"task complete", `{"type":"event_msg","payload":{"type":"task_complete"}}`, true},
+		{"token count last", `{"type":"event_msg","payload":{"type":"task_complete"}}` + "\n" + `{"type":"event_msg","payload":{"type":"token_count"}}`, false},
+		{"response item", `{"type":"response_item","payload":{"type":"message","role":"assistant"}}`, false},
+		{"partial line", `{"type":"event_msg","payload":{"type":"task_compl`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "s.jsonl")
+			if err := os.WriteFile(path, []byte(tt.lines+"\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if got := New().SessionEnded(path); got != tt.want {
+				t.Errorf("SessionEnded = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
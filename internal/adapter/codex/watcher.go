@@ -1,6 +1,7 @@
 package codex
 
 import (
+	"encoding/json"
 	"io"
 	"os"
 	"path/filepath"
@@ -98,6 +99,8 @@ func NewWatcher(root string) (<-chan adapter.Event, io.Closer, error) {
 					switch {
 					case lastEvent.Op&fsnotify.Create != 0:
 						eventType = adapter.EventSessionCreated
+					case lastEvent.Op&fsnotify.Write != 0 && sessionEnded(lastEvent.Name):
+						eventType = adapter.EventSessionEnded
 					case lastEvent.Op&fsnotify.Write != 0:
 						eventType = adapter.EventMessageAdded
 					case lastEvent.Op&fsnotify.Remove != 0:
@@ -124,6 +127,24 @@ func NewWatcher(root string) (<-chan adapter.Event, io.Closer, error) {
 	return events, watcher, nil
 }
 
+// sessionEnded reports whether the session file at path ends with a
+// task_complete event, written when Codex finishes its turn.
+func sessionEnded(path string) bool {
+	line, err := adapter.LastLine(path)
+	if err != nil || line == nil {
+		return false
+	}
+	var record RawRecord
+	if err := json.Unmarshal(line, &record); err != nil || record.Type != "event_msg" {
+		return false
+	}
+	var event EventMsgPayload
+	if err := json.Unmarshal(record.Payload, &event); err != nil {
+		return false
+	}
+	return event.Type == "task_complete"
+}
+
 // recentSessionDirs returns directories for current and previous months (td-ae05cd6a).
 // Codex organizes sessions by date: sessions/YYYY/MM/DD/session.jsonl
 func recentSessionDirs(root string) []string {
@@ -159,3 +180,8 @@ func scanNewDirForSessions(dir string, events chan<- adapter.Event) {
 		return nil
 	})
 }
+
+// SessionEnded implements adapter.SessionEndDetector.
+func (a *Adapter) SessionEnded(path string) bool {
+	return sessionEnded(path)
+}
@@ -257,11 +257,7 @@ func (a *Adapter) Usage(sessionID string) (*adapter.UsageStats, error) {
 
 	stats := &adapter.UsageStats{}
 	for _, m := range messages {
-		stats.TotalInputTokens += m.InputTokens
-		stats.TotalOutputTokens += m.OutputTokens
-		stats.TotalCacheRead += m.CacheRead
-		stats.TotalCacheWrite += m.CacheWrite
-		stats.MessageCount++
+		stats.AddMessage(m)
 	}
 
 	return stats, nil
@@ -0,0 +1,477 @@
+package jsonl
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+const (
+	adapterID    = "jsonl"
+	adapterName  = "JSONL"
+	adapterIcon  = "≡"
+	fileExt      = ".jsonl"
+	maxLineSize  = 10 * 1024 * 1024 // Longest transcript line read
+	pollInterval = 2 * time.Second
+)
+
+// Adapter implements the adapter.Adapter interface for generic JSONL
+// transcripts.
+type Adapter struct {
+	dir          string
+	sessionIndex map[string]string // sessionID -> file path
+	mu           sync.RWMutex      // guards sessionIndex
+	metaCache    map[string]metaCacheEntry
+	metaMu       sync.Mutex // guards metaCache
+}
+
+// New creates a new JSONL transcript adapter.
+func New() *Adapter {
+	home, _ := os.UserHomeDir()
+	return &Adapter{
+		dir:          transcriptsDir(home),
+		sessionIndex: make(map[string]string),
+		metaCache:    make(map[string]metaCacheEntry),
+	}
+}
+
+// transcriptsDir returns the directory transcripts are read from:
+// SIDECAR_TRANSCRIPTS_DIR > XDG_DATA_HOME (Linux) > ~/.local/share.
+func transcriptsDir(home string) string {
+	if dir := os.Getenv("SIDECAR_TRANSCRIPTS_DIR"); dir != "" {
+		return dir
+	}
+	if runtime.GOOS == "linux" {
+		if xdgData := os.Getenv("XDG_DATA_HOME"); xdgData != "" {
+			return filepath.Join(xdgData, "sidecar", "transcripts")
+		}
+	}
+	return filepath.Join(home, ".local", "share", "sidecar", "transcripts")
+}
+
+// ID returns the adapter identifier.
+func (a *Adapter) ID() string { return adapterID }
+
+// Name returns the human-readable adapter name.
+func (a *Adapter) Name() string { return adapterName }
+
+// Icon returns the adapter icon for badge display.
+func (a *Adapter) Icon() string { return adapterIcon }
+
+// Capabilities returns the supported features.
+func (a *Adapter) Capabilities() adapter.CapabilitySet {
+	return adapter.CapabilitySet{
+		adapter.CapSessions: true,
+		adapter.CapMessages: true,
+		adapter.CapUsage:    true,
+		adapter.CapWatch:    true,
+	}
+}
+
+// WatchScope returns Global because all projects share one transcripts
+// directory.
+func (a *Adapter) WatchScope() adapter.WatchScope {
+	return adapter.WatchScopeGlobal
+}
+
+// Detect checks if any transcript belongs to the given project.
+func (a *Adapter) Detect(projectRoot string) (bool, error) {
+	project := newResolvedProjectPath(projectRoot)
+	if project == nil {
+		return false, nil
+	}
+	paths, err := a.transcriptFiles()
+	if err != nil {
+		return false, err
+	}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		meta, err := a.sessionMetadata(path, info)
+		if err != nil {
+			continue
+		}
+		if meta.messageCount > 0 && project.matchesCWD(meta.cwd) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Sessions returns all sessions for the given project, sorted by update time.
+func (a *Adapter) Sessions(projectRoot string) ([]adapter.Session, error) {
+	paths, err := a.transcriptFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	project := newResolvedProjectPath(projectRoot)
+	sessions := make([]adapter.Session, 0, len(paths))
+	newIndex := make(map[string]string, len(paths))
+	seenPaths := make(map[string]struct{}, len(paths))
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		seenPaths[path] = struct{}{}
+		meta, err := a.sessionMetadata(path, info)
+		if err != nil || meta.messageCount == 0 {
+			continue
+		}
+		if project != nil && !project.matchesCWD(meta.cwd) {
+			continue
+		}
+
+		id := sessionIDFromPath(path)
+		newIndex[id] = path
+		sessions = append(sessions, meta.session(id, path, info))
+	}
+
+	// Atomically swap in the new index
+	a.mu.Lock()
+	a.sessionIndex = newIndex
+	a.mu.Unlock()
+	a.pruneMetaCache(seenPaths)
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt)
+	})
+	return sessions, nil
+}
+
+// Messages returns all messages for the given session.
+func (a *Adapter) Messages(sessionID string) ([]adapter.Message, error) {
+	messages, err := readMessages(a.sessionFilePath(sessionID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// Usage returns aggregate usage stats for the given session.
+func (a *Adapter) Usage(sessionID string) (*adapter.UsageStats, error) {
+	messages, err := a.Messages(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	stats := &adapter.UsageStats{}
+	for _, m := range messages {
+		stats.AddMessage(m)
+	}
+	return stats, nil
+}
+
+// Watch returns a channel that emits events when transcripts are created
+// or changed. The directory is polled rather than watched with fsnotify.
+func (a *Adapter) Watch(projectRoot string) (<-chan adapter.Event, io.Closer, error) {
+	return NewWatcher(a.dir, pollInterval)
+}
+
+// transcriptFiles returns the transcript paths in the directory. A missing
+// directory has no transcripts.
+func (a *Adapter) transcriptFiles() ([]string, error) {
+	entries, err := os.ReadDir(a.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != fileExt {
+			continue
+		}
+		paths = append(paths, filepath.Join(a.dir, e.Name()))
+	}
+	return paths, nil
+}
+
+// sessionFilePath returns the transcript path for a session, falling back
+// to the file named after it when Sessions hasn't indexed it.
+func (a *Adapter) sessionFilePath(sessionID string) string {
+	a.mu.RLock()
+	path, ok := a.sessionIndex[sessionID]
+	a.mu.RUnlock()
+	if ok {
+		return path
+	}
+	return filepath.Join(a.dir, filepath.Base(sessionID)+fileExt)
+}
+
+// sessionIDFromPath returns the session ID for a transcript file: its name
+// without the extension.
+func sessionIDFromPath(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), fileExt)
+}
+
+// readMessages parses the messages in a transcript file.
+func readMessages(path string) ([]adapter.Message, error) {
+	var messages []adapter.Message
+	err := scanTranscript(path, func(_ Line, msg adapter.Message, ok bool) {
+		if ok {
+			messages = append(messages, msg)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// scanTranscript calls fn for each line of a transcript, along with the
+// message it holds, if any. Lines that aren't valid JSON (such as a line
+// still being written) are skipped.
+func scanTranscript(path string, fn func(line Line, msg adapter.Message, ok bool)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	sessionID := sessionIDFromPath(path)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		var line Line
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+		msg, ok := line.message(sessionID, lineNo)
+		fn(line, msg, ok)
+	}
+	return scanner.Err()
+}
+
+// sessionMeta is the summary of a transcript needed to list it, without
+// its messages.
+type sessionMeta struct {
+	cwd          string
+	title        string
+	firstUser    string
+	first, last  time.Time
+	totalTokens  int
+	messageCount int
+}
+
+// metaCacheEntry is cached metadata, valid while the file's size and
+// modification time are unchanged.
+type metaCacheEntry struct {
+	meta    *sessionMeta
+	modTime time.Time
+	size    int64
+}
+
+// sessionMetadata returns cached metadata for a transcript if the file is
+// unchanged, otherwise it parses the file and caches the result. This keeps
+// the repeated Detect and Sessions calls from the 2s poll from re-reading
+// every transcript.
+func (a *Adapter) sessionMetadata(path string, info os.FileInfo) (*sessionMeta, error) {
+	a.metaMu.Lock()
+	entry, ok := a.metaCache[path]
+	a.metaMu.Unlock()
+	if ok && entry.size == info.Size() && entry.modTime.Equal(info.ModTime()) {
+		return entry.meta, nil
+	}
+
+	meta, err := readSessionMeta(path)
+	if err != nil {
+		return nil, err
+	}
+	a.metaMu.Lock()
+	a.metaCache[path] = metaCacheEntry{meta: meta, modTime: info.ModTime(), size: info.Size()}
+	a.metaMu.Unlock()
+	return meta, nil
+}
+
+// pruneMetaCache drops cached metadata for transcripts that no longer exist.
+func (a *Adapter) pruneMetaCache(seenPaths map[string]struct{}) {
+	a.metaMu.Lock()
+	for path := range a.metaCache {
+		if _, ok := seenPaths[path]; !ok {
+			delete(a.metaCache, path)
+		}
+	}
+	a.metaMu.Unlock()
+}
+
+// readSessionMeta parses a transcript's metadata without keeping its
+// messages.
+func readSessionMeta(path string) (*sessionMeta, error) {
+	meta := &sessionMeta{}
+	err := scanTranscript(path, func(line Line, msg adapter.Message, ok bool) {
+		if meta.cwd == "" {
+			meta.cwd = line.Cwd
+		}
+		if meta.title == "" {
+			meta.title = strings.TrimSpace(line.Title)
+		}
+		if ok {
+			meta.add(msg)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// add folds a message into the metadata.
+func (m *sessionMeta) add(msg adapter.Message) {
+	m.messageCount++
+	if !msg.Timestamp.IsZero() {
+		if m.first.IsZero() || msg.Timestamp.Before(m.first) {
+			m.first = msg.Timestamp
+		}
+		if msg.Timestamp.After(m.last) {
+			m.last = msg.Timestamp
+		}
+	}
+	m.totalTokens += msg.InputTokens + msg.OutputTokens
+	if m.firstUser == "" && msg.Role == "user" {
+		m.firstUser = msg.Content
+	}
+}
+
+// message converts a line to a message, if it has a role.
+func (l Line) message(sessionID string, lineNo int) (adapter.Message, bool) {
+	role := strings.ToLower(strings.TrimSpace(l.Role))
+	if role == "" {
+		return adapter.Message{}, false
+	}
+	id := l.ID
+	if id == "" {
+		id = sessionID + "-" + strconv.Itoa(lineNo)
+	}
+	return adapter.Message{
+		ID:        id,
+		Role:      role,
+		Content:   l.Content,
+		Timestamp: parseTimestamp(l.Timestamp),
+		Model:     l.Model,
+		TokenUsage: adapter.TokenUsage{
+			InputTokens:  l.InputTokens,
+			OutputTokens: l.OutputTokens,
+			CacheRead:    l.CacheReadTokens,
+			CacheWrite:   l.CacheWriteTokens,
+		},
+	}, true
+}
+
+// session builds the session summary for a transcript. Sessions without
+// timestamps use the file's modification time.
+func (m *sessionMeta) session(id, path string, info os.FileInfo) adapter.Session {
+	first, last := m.first, m.last
+	if last.IsZero() {
+		first, last = info.ModTime(), info.ModTime()
+	}
+
+	name := m.title
+	if name == "" {
+		name = truncateTitle(m.firstUser, 120)
+	}
+	if name == "" {
+		name = shortID(id)
+	}
+
+	return adapter.Session{
+		ID:           id,
+		Name:         name,
+		Slug:         shortID(id),
+		AdapterID:    adapterID,
+		AdapterName:  adapterName,
+		AdapterIcon:  adapterIcon,
+		CreatedAt:    first,
+		UpdatedAt:    last,
+		Duration:     last.Sub(first),
+		IsActive:     time.Since(info.ModTime()) < 5*time.Minute,
+		TotalTokens:  m.totalTokens,
+		MessageCount: m.messageCount,
+		FileSize:     info.Size(),
+		Path:         path,
+	}
+}
+
+// parseTimestamp parses an RFC3339 timestamp, returning the zero time when
+// it is missing or invalid.
+func parseTimestamp(s string) time.Time {
+	if s == "" {
+		return time.Time{}
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t
+	}
+	return time.Time{}
+}
+
+// resolvedProjectPath is a project root resolved once for matching many
+// session directories.
+type resolvedProjectPath struct {
+	abs string
+}
+
+func newResolvedProjectPath(projectRoot string) *resolvedProjectPath {
+	if projectRoot == "" {
+		return nil
+	}
+	return &resolvedProjectPath{abs: resolvePath(projectRoot)}
+}
+
+// matchesCWD reports whether cwd is the project root or inside it.
+func (r *resolvedProjectPath) matchesCWD(cwd string) bool {
+	if r == nil || cwd == "" {
+		return false
+	}
+	rel, err := filepath.Rel(r.abs, resolvePath(cwd))
+	if err != nil {
+		return false
+	}
+	return rel == "." || !strings.HasPrefix(rel, "..")
+}
+
+// resolvePath returns the absolute, symlink-resolved form of path.
+func resolvePath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.Clean(path)
+	}
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		abs = resolved
+	}
+	return filepath.Clean(abs)
+}
+
+func shortID(id string) string {
+	if len(id) >= 8 {
+		return id[:8]
+	}
+	return id
+}
+
+// truncateTitle flattens s to one line and truncates it to maxLen.
+func truncateTitle(s string, maxLen int) string {
+	runes := []rune(strings.Join(strings.Fields(s), " "))
+	if len(runes) <= maxLen {
+		return string(runes)
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
@@ -0,0 +1,343 @@
+package jsonl
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+// newTestAdapter creates an Adapter whose transcripts directory is a temp dir
+// containing copies of the named testdata fixtures.
+func newTestAdapter(t *testing.T, fixtures ...string) *Adapter {
+	t.Helper()
+	dir := t.TempDir()
+	a := New()
+	a.dir = dir
+	for _, f := range fixtures {
+		data, err := os.ReadFile(filepath.Join("testdata", f))
+		if err != nil {
+			t.Fatalf("read fixture %s: %v", f, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, f), data, 0o644); err != nil {
+			t.Fatalf("write fixture %s: %v", f, err)
+		}
+	}
+	return a
+}
+
+func TestDetect(t *testing.T) {
+	a := newTestAdapter(t, "basic-session.jsonl", "other-project.jsonl")
+
+	tests := []struct {
+		project string
+		want    bool
+	}{
+		{"/test/project", true},
+		{"/other/project", true},
+		{"/unrelated", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		got, err := a.Detect(tt.project)
+		if err != nil {
+			t.Fatalf("Detect(%q): %v", tt.project, err)
+		}
+		if got != tt.want {
+			t.Errorf("Detect(%q) = %v, want %v", tt.project, got, tt.want)
+		}
+	}
+}
+
+func TestDetect_MissingDirectory(t *testing.T) {
+	a := New()
+	a.dir = filepath.Join(t.TempDir(), "missing")
+	if found, err := a.Detect("/test/project"); err != nil || found {
+		t.Errorf("Detect = %v, %v; want false, nil", found, err)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	a := newTestAdapter(t, "basic-session.jsonl", "minimal-session.jsonl",
+		"other-project.jsonl", "no-messages.jsonl", "notes.txt")
+
+	sessions, err := a.Sessions("/test/project")
+	if err != nil {
+		t.Fatalf("Sessions: %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions for /test/project, got %d: %+v", len(sessions), sessions)
+	}
+
+	byID := make(map[string]adapter.Session)
+	for _, s := range sessions {
+		byID[s.ID] = s
+	}
+
+	basic, ok := byID["basic-session"]
+	if !ok {
+		t.Fatal("missing basic-session")
+	}
+	if basic.Name != "Fix the login bug" {
+		t.Errorf("Name = %q, want title", basic.Name)
+	}
+	if basic.AdapterID != adapterID || basic.AdapterName != adapterName || basic.AdapterIcon != adapterIcon {
+		t.Errorf("adapter fields = %q/%q/%q", basic.AdapterID, basic.AdapterName, basic.AdapterIcon)
+	}
+	if basic.MessageCount != 4 {
+		t.Errorf("MessageCount = %d, want 4", basic.MessageCount)
+	}
+	if basic.TotalTokens != 165 {
+		t.Errorf("TotalTokens = %d, want 165", basic.TotalTokens)
+	}
+	wantCreated := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	wantUpdated := time.Date(2026, 3, 1, 10, 2, 0, 0, time.UTC)
+	if !basic.CreatedAt.Equal(wantCreated) || !basic.UpdatedAt.Equal(wantUpdated) {
+		t.Errorf("times = %v..%v, want %v..%v", basic.CreatedAt, basic.UpdatedAt, wantCreated, wantUpdated)
+	}
+	if basic.Duration != 2*time.Minute {
+		t.Errorf("Duration = %v, want 2m", basic.Duration)
+	}
+
+	// No title and no timestamps: named by the first user message and
+	// timed by the file
+	minimal, ok := byID["minimal-session"]
+	if !ok {
+		t.Fatal("missing minimal-session (cwd in a subdirectory should match)")
+	}
+	if minimal.Name != "List the files here" {
+		t.Errorf("Name = %q, want first user message", minimal.Name)
+	}
+	if minimal.UpdatedAt.IsZero() || minimal.CreatedAt != minimal.UpdatedAt {
+		t.Errorf("expected file time for both ends, got %v..%v", minimal.CreatedAt, minimal.UpdatedAt)
+	}
+
+	// Sorted newest first
+	if sessions[0].UpdatedAt.Before(sessions[1].UpdatedAt) {
+		t.Error("sessions not sorted by update time")
+	}
+}
+
+func TestSessions_CachesMetadata(t *testing.T) {
+	a := newTestAdapter(t, "basic-session.jsonl", "minimal-session.jsonl")
+	path := filepath.Join(a.dir, "basic-session.jsonl")
+
+	messageCount := func() int {
+		t.Helper()
+		sessions, err := a.Sessions("/test/project")
+		if err != nil {
+			t.Fatalf("Sessions: %v", err)
+		}
+		for _, s := range sessions {
+			if s.ID == "basic-session" {
+				return s.MessageCount
+			}
+		}
+		return -1
+	}
+	if n := messageCount(); n != 4 {
+		t.Fatalf("MessageCount = %d, want 4", n)
+	}
+
+	// Same size and modification time: the cached metadata is reused
+	// rather than re-reading the file.
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blank := []byte(strings.Repeat(" ", int(info.Size())-1) + "\n")
+	if err := os.WriteFile(path, blank, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	if n := messageCount(); n != 4 {
+		t.Errorf("MessageCount = %d after same-size rewrite, want cached 4", n)
+	}
+
+	// Growing the file invalidates the entry.
+	line := `{"cwd": "/test/project", "role": "user", "content": "more"}` + "\n"
+	if err := os.WriteFile(path, append(blank, line...), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if n := messageCount(); n != 1 {
+		t.Errorf("MessageCount = %d after append, want 1", n)
+	}
+
+	// Removed transcripts are pruned from the cache.
+	if err := os.Remove(filepath.Join(a.dir, "minimal-session.jsonl")); err != nil {
+		t.Fatal(err)
+	}
+	messageCount()
+	if _, ok := a.metaCache[filepath.Join(a.dir, "minimal-session.jsonl")]; ok {
+		t.Error("metadata for removed transcript was not pruned")
+	}
+}
+
+func TestMessages(t *testing.T) {
+	a := newTestAdapter(t, "basic-session.jsonl")
+	if _, err := a.Sessions("/test/project"); err != nil {
+		t.Fatalf("Sessions: %v", err)
+	}
+
+	msgs, err := a.Messages("basic-session")
+	if err != nil {
+		t.Fatalf("Messages: %v", err)
+	}
+	if len(msgs) != 4 {
+		t.Fatalf("expected 4 messages (bad lines skipped), got %d", len(msgs))
+	}
+
+	first := msgs[0]
+	if first.ID != "u1" || first.Role != "user" || first.Content != "Why does login fail?" {
+		t.Errorf("first message = %+v", first)
+	}
+
+	reply := msgs[1]
+	if reply.Model != "my-model" {
+		t.Errorf("Model = %q", reply.Model)
+	}
+	wantUsage := adapter.TokenUsage{InputTokens: 120, OutputTokens: 40, CacheRead: 100, CacheWrite: 20}
+	if reply.TokenUsage != wantUsage {
+		t.Errorf("TokenUsage = %+v, want %+v", reply.TokenUsage, wantUsage)
+	}
+
+	// Missing id falls back to the line number; role is normalized
+	if msgs[2].ID != "basic-session-5" || msgs[2].Role != "user" {
+		t.Errorf("third message id/role = %q/%q", msgs[2].ID, msgs[2].Role)
+	}
+	// Invalid timestamp is tolerated as zero
+	if !msgs[3].Timestamp.IsZero() {
+		t.Errorf("expected zero timestamp, got %v", msgs[3].Timestamp)
+	}
+}
+
+func TestMessages_UnindexedAndMissing(t *testing.T) {
+	a := newTestAdapter(t, "minimal-session.jsonl")
+
+	// Falls back to the file named after the session without Sessions()
+	msgs, err := a.Messages("minimal-session")
+	if err != nil || len(msgs) != 2 {
+		t.Fatalf("Messages = %d, %v; want 2 messages", len(msgs), err)
+	}
+	if msgs[1].Content != "" {
+		t.Errorf("expected empty content for a line without it, got %q", msgs[1].Content)
+	}
+
+	msgs, err = a.Messages("nope")
+	if err != nil || msgs != nil {
+		t.Errorf("Messages(missing) = %v, %v; want nil, nil", msgs, err)
+	}
+}
+
+func TestUsage(t *testing.T) {
+	a := newTestAdapter(t, "basic-session.jsonl")
+	stats, err := a.Usage("basic-session")
+	if err != nil {
+		t.Fatalf("Usage: %v", err)
+	}
+	if stats.TotalInputTokens != 120 || stats.TotalOutputTokens != 45 {
+		t.Errorf("tokens = in:%d out:%d, want in:120 out:45", stats.TotalInputTokens, stats.TotalOutputTokens)
+	}
+	if stats.TotalCacheRead != 100 || stats.TotalCacheWrite != 20 {
+		t.Errorf("cache = read:%d write:%d", stats.TotalCacheRead, stats.TotalCacheWrite)
+	}
+	if stats.MessageCount != 4 {
+		t.Errorf("MessageCount = %d, want 4", stats.MessageCount)
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	prev := map[string]fileState{
+		"same":    {modTime: t0, size: 10},
+		"grown":   {modTime: t0, size: 10},
+		"removed": {modTime: t0, size: 10},
+	}
+	current := map[string]fileState{
+		"same":  {modTime: t0, size: 10},
+		"grown": {modTime: t0.Add(time.Second), size: 20},
+		"new":   {modTime: t0, size: 5},
+	}
+
+	got := diffSnapshots(prev, current)
+	want := []adapter.Event{
+		{Type: adapter.EventSessionUpdated, SessionID: "grown"},
+		{Type: adapter.EventSessionCreated, SessionID: "new"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("events = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i].Type != want[i].Type || got[i].SessionID != want[i].SessionID {
+			t.Errorf("event %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestWatcherPollsForChanges(t *testing.T) {
+	dir := t.TempDir()
+	events, closer, err := NewWatcher(dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+
+	path := filepath.Join(dir, "live.jsonl")
+	if err := os.WriteFile(path, []byte(`{"role":"user","content":"hi"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case evt := <-events:
+		if evt.Type != adapter.EventSessionCreated || evt.SessionID != "live" {
+			t.Errorf("event = %+v, want created for live", evt)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event for new transcript")
+	}
+
+	_ = closer.Close()
+	_ = closer.Close() // safe to close twice
+	for range events {
+		// drain until the watcher closes the channel
+	}
+}
+
+func TestNewWatcher_MissingDirectory(t *testing.T) {
+	if _, _, err := NewWatcher(filepath.Join(t.TempDir(), "missing"), time.Second); err == nil {
+		t.Error("expected error for missing directory")
+	}
+}
+
+func TestWatcherReportsSessionEnded(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "live.jsonl")
+	if err := os.WriteFile(path, []byte(`{"role":"user","content":"hi"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	events, closer, err := NewWatcher(dir, 10*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewWatcher: %v", err)
+	}
+	defer func() { _ = closer.Close() }()
+
+	data := `{"role":"user","content":"hi"}` + "\n" + `{"role":"assistant","content":"done"}` + "\n" + `{"event":"end"}` + "\n"
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case evt := <-events:
+		if evt.Type != adapter.EventSessionEnded || evt.SessionID != "live" {
+			t.Errorf("event = %+v, want ended for live", evt)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event for ended transcript")
+	}
+	if !New().SessionEnded(path) {
+		t.Error("SessionEnded = false for a transcript ending with an end event")
+	}
+}
@@ -0,0 +1,19 @@
+// Package jsonl provides an adapter for transcripts in a generic JSONL format,
+// so tools without a native adapter can be viewed in the conversations plugin.
+//
+// Each *.jsonl file in the transcripts directory is one session, named by its
+// file name. The directory is $SIDECAR_TRANSCRIPTS_DIR, or
+// ~/.local/share/sidecar/transcripts ($XDG_DATA_HOME/sidecar/transcripts on
+// Linux). Each line is one JSON object; see Line for the fields. Only role is
+// needed for a message, and lines that fail to parse are skipped. A session
+// belongs to the project containing its cwd, taken from the first line that
+// sets one.
+//
+//	{"cwd": "/home/me/project", "title": "Fix the login bug"}
+//	{"role": "user", "content": "Why does login fail?", "timestamp": "2026-03-01T10:00:00Z"}
+//	{"role": "assistant", "content": "The token expired.", "model": "my-model", "input_tokens": 120, "output_tokens": 40}
+//	{"event": "end"}
+//
+// The end line is optional; it lets the conversations plugin refresh as soon
+// as the agent is done instead of waiting for writes to settle.
+package jsonl
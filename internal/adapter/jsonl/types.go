@@ -0,0 +1,20 @@
+package jsonl
+
+// Line is one line of a transcript file. Lines with a role are messages;
+// cwd and title may appear on any line, and the first non-empty value wins.
+// A last line with event "end" marks the agent finished. Every field is
+// optional.
+type Line struct {
+	ID               string `json:"id"`                 // Message ID; defaults to <session>-<line number>
+	Role             string `json:"role"`               // "user", "assistant", ...; lines without one are not messages
+	Content          string `json:"content"`            // Message text
+	Timestamp        string `json:"timestamp"`          // RFC3339
+	Model            string `json:"model"`              // Model ID
+	Cwd              string `json:"cwd"`                // Project directory the session ran in
+	Title            string `json:"title"`              // Session title; defaults to the first user message
+	InputTokens      int    `json:"input_tokens"`       // Prompt tokens
+	OutputTokens     int    `json:"output_tokens"`      // Completion tokens
+	CacheReadTokens  int    `json:"cache_read_tokens"`  // Prompt tokens served from cache
+	CacheWriteTokens int    `json:"cache_write_tokens"` // Prompt tokens written to cache
+	Event            string `json:"event"`              // "end" when the agent finished
+}
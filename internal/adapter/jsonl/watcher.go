@@ -0,0 +1,127 @@
+package jsonl
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+// fileState is what the watcher compares between polls of a transcript.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// pollCloser stops a polling watcher.
+type pollCloser struct {
+	done chan struct{}
+	once sync.Once
+}
+
+// Close stops the watcher; it is safe to call more than once.
+func (c *pollCloser) Close() error {
+	c.once.Do(func() { close(c.done) })
+	return nil
+}
+
+// NewWatcher polls dir every interval and emits an event for each transcript
+// that appears or changes. Polling keeps the adapter working for directories
+// on network or synced filesystems where fsnotify is unreliable.
+func NewWatcher(dir string, interval time.Duration) (<-chan adapter.Event, io.Closer, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan adapter.Event, 32)
+	closer := &pollCloser{done: make(chan struct{})}
+	seen := snapshotDir(dir)
+
+	go func() {
+		defer close(events)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-closer.done:
+				return
+			case <-ticker.C:
+				current := snapshotDir(dir)
+				for _, evt := range diffSnapshots(seen, current) {
+					if evt.Type == adapter.EventSessionUpdated && sessionEnded(filepath.Join(dir, evt.SessionID+fileExt)) {
+						evt.Type = adapter.EventSessionEnded
+					}
+					select {
+					case events <- evt:
+					default:
+					}
+				}
+				seen = current
+			}
+		}
+	}()
+
+	return events, closer, nil
+}
+
+// snapshotDir records the state of each transcript in dir by session ID.
+func snapshotDir(dir string) map[string]fileState {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	states := make(map[string]fileState, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != fileExt {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		states[sessionIDFromPath(e.Name())] = fileState{modTime: info.ModTime(), size: info.Size()}
+	}
+	return states
+}
+
+// diffSnapshots returns events for the transcripts created or changed
+// between two snapshots, ordered by session ID.
+func diffSnapshots(prev, current map[string]fileState) []adapter.Event {
+	var events []adapter.Event
+	for id, state := range current {
+		old, ok := prev[id]
+		switch {
+		case !ok:
+			events = append(events, adapter.Event{Type: adapter.EventSessionCreated, SessionID: id})
+		case old != state:
+			events = append(events, adapter.Event{Type: adapter.EventSessionUpdated, SessionID: id})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].SessionID < events[j].SessionID
+	})
+	return events
+}
+
+// sessionEnded reports whether the transcript at path ends with an end event.
+func sessionEnded(path string) bool {
+	data, err := adapter.LastLine(path)
+	if err != nil || data == nil {
+		return false
+	}
+	var line Line
+	if err := json.Unmarshal(data, &line); err != nil {
+		return false
+	}
+	return line.Event == "end"
+}
+
+// SessionEnded implements adapter.SessionEndDetector.
+func (a *Adapter) SessionEnded(path string) bool {
+	return sessionEnded(path)
+}
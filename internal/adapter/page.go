@@ -0,0 +1,53 @@
+package adapter
+
+// MessagePager is an optional interface for adapters that can load a page of
+// a session's messages without reading them all. Adapters without it are
+// paged by MessagesPage, which slices the full Messages result.
+type MessagePager interface {
+	// MessagesPage returns the page of up to limit messages that ends offset
+	// messages before the newest one.
+	MessagesPage(sessionID string, offset, limit int) (MessagePage, error)
+}
+
+// MessagePage is a window of a session's messages.
+type MessagePage struct {
+	Messages []Message // Oldest first
+	Offset   int       // Newer messages after the page (0 = ends at the newest)
+	Total    int       // Messages in the whole session
+}
+
+// HasOlder reports whether the session has messages before the page.
+func (p MessagePage) HasOlder() bool {
+	return p.Offset+len(p.Messages) < p.Total
+}
+
+// MessagesPage loads the page of up to limit messages that ends offset
+// messages before the newest one, so offset 0 is the most recent page.
+// Offsets past the start of the session are clamped to its oldest page, and
+// a limit of 0 or less loads every message.
+func MessagesPage(a Adapter, sessionID string, offset, limit int) (MessagePage, error) {
+	if pager, ok := a.(MessagePager); ok {
+		return pager.MessagesPage(sessionID, offset, limit)
+	}
+	messages, err := a.Messages(sessionID)
+	if err != nil {
+		return MessagePage{}, err
+	}
+	return PageMessages(messages, offset, limit), nil
+}
+
+// PageMessages slices the page described by offset and limit from a
+// session's full message list; see MessagesPage.
+func PageMessages(messages []Message, offset, limit int) MessagePage {
+	total := len(messages)
+	if limit <= 0 || limit > total {
+		limit = total
+	}
+	offset = max(0, min(offset, total-limit))
+	end := total - offset
+	return MessagePage{
+		Messages: messages[end-limit : end],
+		Offset:   offset,
+		Total:    total,
+	}
+}
@@ -0,0 +1,104 @@
+package adapter
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+)
+
+// fullMessageAdapter only implements Messages, returning every message at once.
+type fullMessageAdapter struct {
+	messages []Message
+	err      error
+}
+
+func (a *fullMessageAdapter) ID() string                                    { return "full" }
+func (a *fullMessageAdapter) Name() string                                  { return "Full" }
+func (a *fullMessageAdapter) Icon() string                                  { return "" }
+func (a *fullMessageAdapter) Detect(string) (bool, error)                   { return true, nil }
+func (a *fullMessageAdapter) Capabilities() CapabilitySet                   { return nil }
+func (a *fullMessageAdapter) Sessions(string) ([]Session, error)            { return nil, nil }
+func (a *fullMessageAdapter) Usage(string) (*UsageStats, error)             { return nil, nil }
+func (a *fullMessageAdapter) Messages(string) ([]Message, error)            { return a.messages, a.err }
+func (a *fullMessageAdapter) Watch(string) (<-chan Event, io.Closer, error) { return nil, nil, nil }
+
+// pagingAdapter also implements MessagePager.
+type pagingAdapter struct {
+	fullMessageAdapter
+	calls int
+}
+
+func (a *pagingAdapter) MessagesPage(sessionID string, offset, limit int) (MessagePage, error) {
+	a.calls++
+	return MessagePage{Offset: offset, Total: 99}, nil
+}
+
+func numberedMessages(n int) []Message {
+	msgs := make([]Message, n)
+	for i := range msgs {
+		msgs[i] = Message{ID: strconv.Itoa(i)}
+	}
+	return msgs
+}
+
+func TestMessagesPage_SlicesFullResult(t *testing.T) {
+	a := &fullMessageAdapter{messages: numberedMessages(10)}
+
+	tests := []struct {
+		name             string
+		offset, limit    int
+		wantFirst, wantN int
+		wantOffset       int
+		wantOlder        bool
+	}{
+		{"newest page", 0, 4, 6, 4, 0, true},
+		{"middle page", 3, 4, 3, 4, 3, true},
+		{"oldest page", 6, 4, 0, 4, 6, false},
+		{"offset past start clamps", 50, 4, 0, 4, 6, false},
+		{"negative offset clamps", -2, 4, 6, 4, 0, true},
+		{"limit larger than total", 0, 25, 0, 10, 0, false},
+		{"limit zero loads all", 3, 0, 0, 10, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := MessagesPage(a, "s", tt.offset, tt.limit)
+			if err != nil {
+				t.Fatalf("MessagesPage: %v", err)
+			}
+			if len(page.Messages) != tt.wantN || page.Messages[0].ID != strconv.Itoa(tt.wantFirst) {
+				t.Errorf("page = %d messages from %q, want %d from %d",
+					len(page.Messages), page.Messages[0].ID, tt.wantN, tt.wantFirst)
+			}
+			if page.Offset != tt.wantOffset || page.Total != 10 {
+				t.Errorf("offset/total = %d/%d, want %d/10", page.Offset, page.Total, tt.wantOffset)
+			}
+			if page.HasOlder() != tt.wantOlder {
+				t.Errorf("HasOlder() = %v, want %v", page.HasOlder(), tt.wantOlder)
+			}
+		})
+	}
+}
+
+func TestMessagesPage_EmptyAndError(t *testing.T) {
+	page, err := MessagesPage(&fullMessageAdapter{}, "s", 5, 10)
+	if err != nil || len(page.Messages) != 0 || page.Total != 0 || page.HasOlder() {
+		t.Errorf("empty session page = %+v, %v", page, err)
+	}
+
+	wantErr := errors.New("boom")
+	if _, err := MessagesPage(&fullMessageAdapter{err: wantErr}, "s", 0, 10); !errors.Is(err, wantErr) {
+		t.Errorf("err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestMessagesPage_UsesPager(t *testing.T) {
+	a := &pagingAdapter{fullMessageAdapter: fullMessageAdapter{messages: numberedMessages(10)}}
+	page, err := MessagesPage(a, "s", 7, 3)
+	if err != nil {
+		t.Fatalf("MessagesPage: %v", err)
+	}
+	if a.calls != 1 || page.Total != 99 || page.Offset != 7 {
+		t.Errorf("expected delegation to MessagesPage, got calls=%d page=%+v", a.calls, page)
+	}
+}
@@ -42,6 +42,50 @@ func ModelCost(model string, usage Usage) float64 {
 	return inputCost + cacheReadCost + cacheWriteCost + outputCost
 }
 
+// Rates are prices in dollars per million tokens for one model.
+type Rates struct {
+	Input    float64 // Non-cache input tokens; cache reads/writes are priced from this
+	Output   float64
+	Thinking float64 // Thinking tokens; 0 bills them at the Output rate
+}
+
+// KnownRates returns the built-in rates for a recognized model family.
+// Unlike ModelCost, it does not fall back to a default tier: ok is false
+// when the model is not recognized.
+func KnownRates(model string) (Rates, bool) {
+	lower := strings.ToLower(model)
+	if !strings.Contains(lower, "opus") && !strings.Contains(lower, "sonnet") && !strings.Contains(lower, "haiku") {
+		return Rates{}, false
+	}
+	tier := classifyModel(lower)
+	return Rates{Input: tier.inRate, Output: tier.outRate}, true
+}
+
+// Cost calculates cost in dollars for usage at these rates. Thinking tokens
+// are counted within OutputTokens (as the API reports them), so they are
+// re-priced at the Thinking rate rather than added on top.
+func (r Rates) Cost(usage Usage, thinkingTokens int) float64 {
+	thinking := thinkingTokens
+	if thinking > usage.OutputTokens {
+		thinking = usage.OutputTokens
+	}
+	if thinking < 0 {
+		thinking = 0
+	}
+	thinkingRate := r.Thinking
+	if thinkingRate == 0 {
+		thinkingRate = r.Output
+	}
+
+	inputCost := float64(usage.InputTokens) * r.Input / 1_000_000
+	cacheReadCost := float64(usage.CacheRead) * r.Input * 0.1 / 1_000_000
+	cacheWriteCost := float64(usage.CacheWrite) * r.Input * 1.25 / 1_000_000
+	outputCost := float64(usage.OutputTokens-thinking) * r.Output / 1_000_000
+	thinkingCost := float64(thinking) * thinkingRate / 1_000_000
+
+	return inputCost + cacheReadCost + cacheWriteCost + outputCost + thinkingCost
+}
+
 // classifyModel determines the pricing tier for a model ID string.
 func classifyModel(model string) modelTier {
 	lower := strings.ToLower(model)
@@ -194,6 +194,47 @@ func TestClassifyModel(t *testing.T) {
 	}
 }
 
+func TestKnownRates(t *testing.T) {
+	tests := []struct {
+		model  string
+		want   Rates
+		wantOK bool
+	}{
+		{"claude-opus-4-5-20251101", Rates{Input: 5.0, Output: 25.0}, true},
+		{"claude-sonnet-4-5-20250929", Rates{Input: 3.0, Output: 15.0}, true},
+		{"Claude-3-Haiku-20240307", Rates{Input: 0.25, Output: 1.25}, true},
+		{"gpt-4o", Rates{}, false},
+		{"", Rates{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			got, ok := KnownRates(tt.model)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("KnownRates(%q) = %+v, %v; want %+v, %v", tt.model, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRatesCost(t *testing.T) {
+	rates := Rates{Input: 3.0, Output: 15.0}
+
+	// Matches ModelCost for the same tier
+	usage := Usage{InputTokens: 100_000, OutputTokens: 50_000, CacheRead: 200_000, CacheWrite: 10_000}
+	assertCost(t, ModelCost("claude-sonnet-4-5", usage), rates.Cost(usage, 0))
+
+	// Thinking at the output rate by default costs the same
+	assertCost(t, 15.0, rates.Cost(Usage{OutputTokens: 1_000_000}, 400_000))
+
+	// A separate thinking rate re-prices the thinking share of output
+	rates.Thinking = 5.0
+	// 600k × $15 + 400k × $5 = $9 + $2
+	assertCost(t, 11.0, rates.Cost(Usage{OutputTokens: 1_000_000}, 400_000))
+
+	// Thinking never exceeds the reported output
+	assertCost(t, 5.0, rates.Cost(Usage{OutputTokens: 1_000_000}, 2_000_000))
+}
+
 func assertCost(t *testing.T, expected, actual float64) {
 	t.Helper()
 	if math.Abs(expected-actual) > 0.01 {
@@ -0,0 +1,43 @@
+package adapter
+
+import (
+	"bytes"
+	"io"
+	"os"
+)
+
+// maxTailBytes bounds how much of a file LastLine reads.
+const maxTailBytes = 64 * 1024
+
+// LastLine returns the last non-blank line of the file at path, read from
+// the end so large transcripts aren't scanned. It returns nil when the file
+// is empty or its last line is longer than 64KB. A line still being written
+// is returned as-is; callers parsing it as JSON will reject it.
+func LastLine(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	start := max(0, info.Size()-maxTailBytes)
+	buf := make([]byte, info.Size()-start)
+	if _, err := f.ReadAt(buf, start); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	buf = bytes.TrimRight(buf, " \t\r\n")
+	idx := bytes.LastIndexByte(buf, '\n')
+	if idx < 0 && start > 0 {
+		return nil, nil // Line began before the window
+	}
+	line := bytes.TrimSpace(buf[idx+1:])
+	if len(line) == 0 {
+		return nil, nil
+	}
+	return line, nil
+}
@@ -0,0 +1,48 @@
+package adapter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLastLine(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"trailing newline", "{\"a\":1}\n{\"b\":2}\n", `{"b":2}`},
+		{"no trailing newline", "{\"a\":1}\n{\"b\":2}", `{"b":2}`},
+		{"blank lines at end", "{\"a\":1}\n\n\r\n", `{"a":1}`},
+		{"single line", "only", "only"},
+		{"empty", "", ""},
+		{"too long", "x\n" + strings.Repeat("y", maxTailBytes+10) + "\n", ""},
+		{"long file short last line", strings.Repeat("z", maxTailBytes*2) + "\nlast\n", "last"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LastLine(write(strings.ReplaceAll(tt.name, " ", "-"), tt.content))
+			if err != nil {
+				t.Fatalf("LastLine: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("LastLine = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := LastLine(filepath.Join(dir, "missing")); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
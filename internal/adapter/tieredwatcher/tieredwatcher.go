@@ -58,6 +58,7 @@ type TieredWatcher struct {
 	extractID   func(path string) string                // Extract session ID from path
 	scanDir     func(dir string) ([]SessionInfo, error) // Scan directory for sessions
 	filter      func(path string) bool                  // Optional filter for watched paths
+	ended       func(path string) bool                  // Optional check that a session finished
 }
 
 // Config holds configuration for creating a TieredWatcher.
@@ -72,6 +73,9 @@ type Config struct {
 	ScanDir func(dir string) ([]SessionInfo, error)
 	// Filter optionally filters watched paths (overrides FilePattern if set)
 	Filter func(path string) bool
+	// Ended optionally reports that a changed session file shows the agent
+	// finished, turning its event into EventSessionEnded
+	Ended func(path string) bool
 }
 
 // New creates a new TieredWatcher.
@@ -96,6 +100,7 @@ func New(cfg Config) (*TieredWatcher, <-chan adapter.Event, error) {
 		extractID:   cfg.ExtractID,
 		scanDir:     cfg.ScanDir,
 		filter:      cfg.Filter,
+		ended:       cfg.Ended,
 	}
 
 	// Watch the root directory if provided
@@ -374,6 +379,8 @@ func (tw *TieredWatcher) watchLoop() {
 				switch {
 				case capturedEvent.Op&fsnotify.Create != 0:
 					eventType = adapter.EventSessionCreated
+				case capturedEvent.Op&fsnotify.Write != 0 && tw.sessionEnded(lastPath):
+					eventType = adapter.EventSessionEnded
 				case capturedEvent.Op&fsnotify.Write != 0:
 					eventType = adapter.EventMessageAdded
 				case capturedEvent.Op&fsnotify.Remove != 0:
@@ -458,9 +465,13 @@ func (tw *TieredWatcher) pollColdSessions() {
 			}
 			tw.mu.Unlock()
 
+			eventType := adapter.EventSessionUpdated
+			if tw.sessionEnded(c.path) {
+				eventType = adapter.EventSessionEnded
+			}
 			select {
 			case tw.events <- adapter.Event{
-				Type:      adapter.EventSessionUpdated,
+				Type:      eventType,
 				SessionID: c.id,
 			}:
 			default:
@@ -772,3 +783,9 @@ func (m *Manager) Closers() []io.Closer {
 	defer m.mu.Unlock()
 	return m.closers
 }
+
+// sessionEnded reports whether the session file at path shows the agent
+// finished, using the configured Ended check.
+func (tw *TieredWatcher) sessionEnded(path string) bool {
+	return tw.ended != nil && tw.ended(path)
+}
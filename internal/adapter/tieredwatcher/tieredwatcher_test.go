@@ -6,6 +6,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
 )
 
 func TestNew(t *testing.T) {
@@ -295,3 +297,46 @@ func TestRegisterSessions(t *testing.T) {
 		t.Errorf("cold = %d, want 2", cold)
 	}
 }
+
+func TestPollColdSessionsReportsEnded(t *testing.T) {
+	tmpDir := t.TempDir()
+	sessionPath := filepath.Join(tmpDir, "s.jsonl")
+	if err := os.WriteFile(sessionPath, []byte("{}\n"), 0644); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+
+	tw, ch, err := New(Config{
+		RootDir:     tmpDir,
+		FilePattern: ".jsonl",
+		ExtractID: func(path string) string {
+			return strings.TrimSuffix(filepath.Base(path), ".jsonl")
+		},
+		Ended: func(path string) bool {
+			data, _ := os.ReadFile(path)
+			return strings.HasSuffix(string(data), "end\n")
+		},
+	})
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	defer func() { _ = tw.Close() }()
+	tw.RegisterSession("s", sessionPath) // hot target 0 keeps it COLD
+
+	expect := func(content string, want adapter.EventType) {
+		t.Helper()
+		if err := os.WriteFile(sessionPath, []byte(content), 0644); err != nil {
+			t.Fatalf("WriteFile error: %v", err)
+		}
+		tw.pollColdSessions()
+		select {
+		case evt := <-ch:
+			if evt.Type != want || evt.SessionID != "s" {
+				t.Errorf("event = %+v, want %s for s", evt, want)
+			}
+		default:
+			t.Fatalf("no event after writing %q", content)
+		}
+	}
+	expect("{}\nmore\n", adapter.EventSessionUpdated)
+	expect("{}\nmore\nend\n", adapter.EventSessionEnded)
+}
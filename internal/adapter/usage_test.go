@@ -0,0 +1,38 @@
+package adapter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUsageStats_AddMessage(t *testing.T) {
+	day1 := time.Date(2026, 3, 2, 10, 0, 0, 0, time.Local)
+	day2 := day1.Add(24 * time.Hour)
+
+	var s UsageStats
+	s.AddMessage(Message{Timestamp: day1, TokenUsage: TokenUsage{InputTokens: 100, OutputTokens: 10, CacheRead: 5}})
+	s.AddMessage(Message{Timestamp: day2, TokenUsage: TokenUsage{InputTokens: 200, OutputTokens: 20},
+		ThinkingBlocks: []ThinkingBlock{{TokenCount: 30}, {TokenCount: 12}}})
+	s.AddMessage(Message{Timestamp: day1.Add(time.Hour), TokenUsage: TokenUsage{InputTokens: 1}}) // out of order
+	s.AddMessage(Message{TokenUsage: TokenUsage{OutputTokens: 7}})                                // no timestamp
+
+	if s.TotalInputTokens != 301 || s.TotalOutputTokens != 37 || s.TotalCacheRead != 5 {
+		t.Errorf("totals = in:%d out:%d cache:%d", s.TotalInputTokens, s.TotalOutputTokens, s.TotalCacheRead)
+	}
+	if s.TotalThinkingTokens != 42 {
+		t.Errorf("TotalThinkingTokens = %d, want 42", s.TotalThinkingTokens)
+	}
+	if s.MessageCount != 4 {
+		t.Errorf("MessageCount = %d, want 4", s.MessageCount)
+	}
+
+	if len(s.Daily) != 2 {
+		t.Fatalf("got %d days, want 2", len(s.Daily))
+	}
+	if d := s.Daily[0]; d.Date.Day() != 2 || d.InputTokens != 101 || d.MessageCount != 2 {
+		t.Errorf("day 1 = %+v", d)
+	}
+	if d := s.Daily[1]; d.Date.Day() != 3 || d.OutputTokens != 20 || d.MessageCount != 1 {
+		t.Errorf("day 2 = %+v", d)
+	}
+}
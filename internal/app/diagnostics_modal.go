@@ -217,7 +217,7 @@ func (m *Model) diagnosticsErrorSection() modal.Section {
 // diagnosticsHintsSection renders the close hint.
 func (m *Model) diagnosticsHintsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		return modal.RenderedSection{Content: "\n" + styles.Subtle.Render("Press ! or esc to close")}
+		return modal.RenderedSection{Content: "\n" + styles.Subtle.Render("Press c to check for updates, ! or esc to close")}
 	}, nil)
 }
 
@@ -0,0 +1,89 @@
+package app
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// IssueLabels holds an issue's labels. td emits a JSON array, but labels
+// stored as a single string (comma- or space-separated, or a JSON array
+// serialized into a string) decode too.
+type IssueLabels []string
+
+// UnmarshalJSON accepts either an array of labels or one string.
+func (l *IssueLabels) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		*l = normalizeLabels(list)
+		return nil
+	}
+	var raw *string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*l = nil
+	if raw != nil {
+		*l = parseLabels(*raw)
+	}
+	return nil
+}
+
+// parseLabels splits a raw label string, detecting whether it holds a JSON
+// array, comma-separated or whitespace-separated labels.
+func parseLabels(raw string) []string {
+	raw = strings.TrimSpace(raw)
+	if strings.HasPrefix(raw, "[") {
+		var list []string
+		if err := json.Unmarshal([]byte(raw), &list); err == nil {
+			return normalizeLabels(list)
+		}
+		// Bracketed but not valid JSON, e.g. [bug, ui]
+		raw = strings.Trim(raw, "[]")
+	}
+	if strings.Contains(raw, ",") {
+		return normalizeLabels(strings.Split(raw, ","))
+	}
+	return normalizeLabels(strings.Fields(raw))
+}
+
+// normalizeLabels trims labels and drops empty and repeated ones, keeping
+// the original order.
+func normalizeLabels(labels []string) []string {
+	var out []string
+	seen := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" || seen[label] {
+			continue
+		}
+		seen[label] = true
+		out = append(out, label)
+	}
+	return out
+}
+
+// labelColor picks a palette color for a label. The same label always gets
+// the same color so it is recognizable across issues.
+func labelColor(label string) lipgloss.Color {
+	palette := []lipgloss.Color{styles.Primary, styles.Secondary, styles.Accent, styles.Success, styles.Error, styles.Info}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(strings.ToLower(label)))
+	return palette[h.Sum32()%uint32(len(palette))]
+}
+
+// renderLabelChips renders labels as colored chips separated by spaces.
+func renderLabelChips(labels []string) string {
+	chips := make([]string, len(labels))
+	for i, label := range labels {
+		chips[i] = lipgloss.NewStyle().
+			Foreground(labelColor(label)).
+			Background(styles.BgTertiary).
+			Padding(0, 1).
+			Render(label)
+	}
+	return strings.Join(chips, " ")
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want []string
+	}{
+		{"empty", "", nil},
+		{"single", "bug", []string{"bug"}},
+		{"comma", "bug, ui ,backend", []string{"bug", "ui", "backend"}},
+		{"comma with spaces in label", "needs review,good first issue", []string{"needs review", "good first issue"}},
+		{"whitespace", "bug  ui\tbackend", []string{"bug", "ui", "backend"}},
+		{"json array", `["bug", "ui"]`, []string{"bug", "ui"}},
+		{"json array with commas", `["a,b", "c"]`, []string{"a,b", "c"}},
+		{"bracketed list", `[bug, ui]`, []string{"bug", "ui"}},
+		{"duplicates and blanks", "bug,,bug, ui", []string{"bug", "ui"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseLabels(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseLabels(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueLabels_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want IssueLabels
+	}{
+		{"array", `{"labels": ["bug", " ui "]}`, IssueLabels{"bug", "ui"}},
+		{"string", `{"labels": "bug,ui"}`, IssueLabels{"bug", "ui"}},
+		{"json in string", `{"labels": "[\"bug\",\"ui\"]"}`, IssueLabels{"bug", "ui"}},
+		{"null", `{"labels": null}`, nil},
+		{"missing", `{}`, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data IssuePreviewData
+			if err := json.Unmarshal([]byte(tt.json), &data); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !reflect.DeepEqual(data.Labels, tt.want) {
+				t.Errorf("Labels = %q, want %q", data.Labels, tt.want)
+			}
+		})
+	}
+
+	var data IssuePreviewData
+	if err := json.Unmarshal([]byte(`{"labels": 42}`), &data); err == nil {
+		t.Error("expected error for non-string labels")
+	}
+}
+
+func TestRenderLabelChips(t *testing.T) {
+	if labelColor("bug") != labelColor("BUG") {
+		t.Error("label colors should be stable regardless of case")
+	}
+	got := ansi.Strip(renderLabelChips([]string{"bug", "ui"}))
+	if !strings.Contains(got, " bug ") || !strings.Contains(got, " ui ") {
+		t.Errorf("renderLabelChips() = %q, want padded chips", got)
+	}
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
@@ -31,29 +32,51 @@ type tdSearchResultWrapper struct {
 
 // IssueSearchResultMsg carries search results back to the app.
 type IssueSearchResultMsg struct {
+	Version int // issueSearchVersion the search was started for
 	Query   string
 	Results []IssueSearchResult
 	Error   error
 }
 
+// issueSearchDebounce is how long typing must pause before a search runs.
+const issueSearchDebounce = 250 * time.Millisecond
+
+// issueSearchTick schedules the debounce timer; tests replace it.
+var issueSearchTick = tea.Tick
+
+// issueSearchDebounceMsg fires after the debounce delay. The search only
+// runs if no newer input arrived meanwhile.
+type issueSearchDebounceMsg struct {
+	Version int
+	Query   string
+}
+
+// scheduleIssueSearch returns a command that fires the debounce message.
+func scheduleIssueSearch(version int, query string) tea.Cmd {
+	return issueSearchTick(issueSearchDebounce, func(time.Time) tea.Msg {
+		return issueSearchDebounceMsg{Version: version, Query: query}
+	})
+}
+
 // issueSearchCmd runs `td search <query> --json -n 50` asynchronously.
 // When includeClosed is false, filters to non-closed statuses.
 // workDir sets the command's working directory so td uses the correct project database.
-func issueSearchCmd(workDir, query string, includeClosed bool) tea.Cmd {
+// Cancelling ctx kills the td process.
+func issueSearchCmd(ctx context.Context, workDir, query string, includeClosed bool, version int) tea.Cmd {
 	return func() tea.Msg {
 		args := []string{"search", query, "--json", "-n", "50"}
 		if !includeClosed {
 			args = append(args, "-s", "open", "-s", "in_progress", "-s", "blocked", "-s", "in_review")
 		}
-		cmd := exec.Command("td", args...)
+		cmd := exec.CommandContext(ctx, "td", args...)
 		cmd.Dir = workDir
 		out, err := cmd.Output()
 		if err != nil {
-			return IssueSearchResultMsg{Query: query, Error: err}
+			return IssueSearchResultMsg{Version: version, Query: query, Error: err}
 		}
 		var wrappers []tdSearchResultWrapper
 		if err := json.Unmarshal(out, &wrappers); err != nil {
-			return IssueSearchResultMsg{Query: query, Error: err}
+			return IssueSearchResultMsg{Version: version, Query: query, Error: err}
 		}
 		// Sort by updated_at descending (most recently updated first).
 		sort.Slice(wrappers, func(i, j int) bool {
@@ -65,23 +88,23 @@ func issueSearchCmd(workDir, query string, includeClosed bool) tea.Cmd {
 		for i, w := range wrappers {
 			results[i] = w.Issue.IssueSearchResult
 		}
-		return IssueSearchResultMsg{Query: query, Results: results}
+		return IssueSearchResultMsg{Version: version, Query: query, Results: results}
 	}
 }
 
 // IssuePreviewData holds lightweight issue data fetched via CLI.
 type IssuePreviewData struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Status      string   `json:"status"`
-	Type        string   `json:"type"`
-	Priority    string   `json:"priority"`
-	Points      int      `json:"points"`
-	Description string   `json:"description"`
-	ParentID    string   `json:"parent_id"`
-	Labels      []string `json:"labels"`
-	CreatedAt   string   `json:"created_at"`
-	UpdatedAt   string   `json:"updated_at"`
+	ID          string      `json:"id"`
+	Title       string      `json:"title"`
+	Status      string      `json:"status"`
+	Type        string      `json:"type"`
+	Priority    string      `json:"priority"`
+	Points      int         `json:"points"`
+	Description string      `json:"description"`
+	ParentID    string      `json:"parent_id"`
+	Labels      IssueLabels `json:"labels"`
+	CreatedAt   string      `json:"created_at"`
+	UpdatedAt   string      `json:"updated_at"`
 }
 
 // IssuePreviewResultMsg carries fetched issue data back to the app.
@@ -2,6 +2,8 @@ package app
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -84,6 +86,24 @@ func (m *Model) renderIssueInputOverlay(content string) string {
 // issueSearchResultPrefix is the hit-region ID prefix for clickable search results.
 const issueSearchResultPrefix = "issue-search-"
 
+// issueSearchMaxVisible is how many search results the dropdown shows at once.
+const issueSearchMaxVisible = 10
+
+// issueSearchDigitIndex maps a digit key to the search result it selects:
+// 1–9 pick the Nth visible result. Returns false for other keys and for
+// digits past the last visible result.
+func issueSearchDigitIndex(key string, scrollOffset, total int) (int, bool) {
+	if len(key) != 1 || key[0] < '1' || key[0] > '9' {
+		return 0, false
+	}
+	n := int(key[0] - '0')
+	visible := min(issueSearchMaxVisible, total-scrollOffset)
+	if n > visible {
+		return 0, false
+	}
+	return scrollOffset + n - 1, true
+}
+
 func (m *Model) ensureIssueInputModal() {
 	modalW := 80
 	if modalW > m.width-4 {
@@ -106,7 +126,15 @@ func (m *Model) ensureIssueInputModal() {
 		hintBuf.WriteString(styles.KeyHint.Render("↑↓"))
 		hintBuf.WriteString(styles.Muted.Render(" select  "))
 		hintBuf.WriteString(styles.KeyHint.Render("tab"))
-		hintBuf.WriteString(styles.Muted.Render(" fill  "))
+		if m.issueInputFocus() == issueFocusResults {
+			hintBuf.WriteString(styles.Muted.Render(" fill  "))
+		} else {
+			hintBuf.WriteString(styles.Muted.Render(" next  "))
+		}
+		if m.issueSearchCursor >= 0 {
+			hintBuf.WriteString(styles.KeyHint.Render("1-9"))
+			hintBuf.WriteString(styles.Muted.Render(" open  "))
+		}
 	}
 	if m.issueSearchIncludeClosed {
 		hintBuf.WriteString(styles.KeyHint.Render("^x"))
@@ -125,7 +153,7 @@ func (m *Model) ensureIssueInputModal() {
 		modal.WithHints(false),
 		modal.WithCustomFooter(hintBuf.String()),
 	).
-		AddSection(modal.Input("issue-id", &m.issueInputInput))
+		AddSection(modal.Input(issueFocusInput, &m.issueInputInput))
 
 	// Status line — always present to avoid layout jumps
 	if m.issueSearchLoading {
@@ -141,61 +169,45 @@ func (m *Model) ensureIssueInputModal() {
 	}
 
 	// Search results dropdown — viewport window over all results
-	const maxVisible = 10
 	const minResultLines = 5
 	if len(m.issueSearchResults) > 0 {
 		searchResults := m.issueSearchResults
 		searchCursor := m.issueSearchCursor
-		searchScrollOffset := m.issueSearchScrollOffset
-		b = b.AddSection(modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-			var sb strings.Builder
-			total := len(searchResults)
-			endIdx := searchScrollOffset + maxVisible
-			if endIdx > total {
-				endIdx = total
-			}
-			visibleCount := endIdx - searchScrollOffset
-			focusables := make([]modal.FocusableInfo, 0, visibleCount)
-			for i := searchScrollOffset; i < endIdx; i++ {
+		items := make([]modal.ListItem, len(searchResults))
+		for i, r := range searchResults {
+			items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", issueSearchResultPrefix, i), Label: r.Title}
+		}
+		b = b.AddSection(modal.List(issueFocusResults, items, &m.issueSearchCursor,
+			modal.WithMaxVisible(issueSearchMaxVisible),
+			modal.WithScrollOffset(&m.issueSearchScrollOffset),
+			modal.WithPerItemFocus(),
+			modal.WithItemRenderer(func(_ modal.ListItem, i, row, width int) string {
 				r := searchResults[i]
 				tag := formatSearchStatusTag(r.Status)
 				icon := formatSearchTypeIcon(r.Type)
 				pri := formatSearchPriority(r.Priority)
 				idStr := styles.Muted.Render(r.ID)
-				prefix := fmt.Sprintf(" %s %s %s %s ", tag, icon, idStr, pri)
+				// Number the rows while the list has focus, for 1-9 selection
+				key := " "
+				if searchCursor >= 0 && row < 9 {
+					key = styles.KeyHint.Render(strconv.Itoa(row + 1))
+				}
+				prefix := fmt.Sprintf("%s%s %s %s %s ", key, tag, icon, idStr, pri)
 				title := r.Title
-				titleWidth := contentWidth - lipgloss.Width(prefix)
+				titleWidth := width - lipgloss.Width(prefix)
 				if titleWidth < 10 {
 					titleWidth = 10
 				}
 				if len(title) > titleWidth {
 					title = title[:titleWidth-3] + "..."
 				}
-				line := prefix + title
-				itemID := fmt.Sprintf("%s%d", issueSearchResultPrefix, i)
-				isHovered := itemID == hoverID
-				if i == searchCursor || isHovered {
-					sb.WriteString(styles.ListItemSelected.Render(line))
-				} else {
-					sb.WriteString(styles.ListItemNormal.Render(line))
-				}
-				if i < endIdx-1 {
-					sb.WriteString("\n")
-				}
-				focusables = append(focusables, modal.FocusableInfo{
-					ID:      itemID,
-					OffsetX: 0,
-					OffsetY: i - searchScrollOffset,
-					Width:   contentWidth,
-					Height:  1,
-				})
-			}
-			// Pad with empty lines to maintain minimum height
-			for i := visibleCount; i < minResultLines; i++ {
-				sb.WriteString("\n")
-			}
-			return modal.RenderedSection{Content: sb.String(), Focusables: focusables}
-		}, nil))
+				return prefix + title
+			}),
+		))
+		// Pad with empty lines to maintain minimum height
+		for i := len(searchResults); i < minResultLines; i++ {
+			b = b.AddSection(modal.Spacer())
+		}
 	} else {
 		// Reserve space for results even when empty
 		b = b.AddSection(modal.Custom(func(contentWidth int, _, _ string) modal.RenderedSection {
@@ -217,9 +229,81 @@ func (m *Model) ensureIssueInputModal() {
 		))
 	}
 
+	if m.issueInputButtonFocus != "" {
+		b.SetFocus(m.issueInputButtonFocus)
+	}
 	m.issueInputModal = b
 }
 
+// Focus stops of the Open Issue modal besides its Open and Cancel buttons.
+const (
+	issueFocusInput   = "issue-id"
+	issueFocusResults = "issue-search-results"
+)
+
+// issueInputFocusOrder returns the modal's focus stops in Tab order: the
+// input, then the results and the buttons once a search has results.
+func (m *Model) issueInputFocusOrder() []string {
+	if len(m.issueSearchResults) == 0 {
+		return []string{issueFocusInput}
+	}
+	return []string{issueFocusInput, issueFocusResults, "open", "cancel"}
+}
+
+// issueInputFocus returns the focus stop that has keyboard focus. The
+// results have it while one is selected.
+func (m *Model) issueInputFocus() string {
+	switch {
+	case m.issueInputButtonFocus != "":
+		return m.issueInputButtonFocus
+	case m.issueSearchCursor >= 0:
+		return issueFocusResults
+	}
+	return issueFocusInput
+}
+
+// setIssueInputFocus moves keyboard focus to stop. Entering the results
+// selects the first one; focusing a button keeps the selection so Open
+// opens it.
+func (m *Model) setIssueInputFocus(stop string) {
+	m.issueInputButtonFocus = ""
+	switch stop {
+	case issueFocusInput:
+		m.issueSearchCursor = -1
+	case issueFocusResults:
+		if m.issueSearchCursor < 0 {
+			m.issueSearchCursor = 0
+		}
+	default:
+		m.issueInputButtonFocus = stop
+	}
+	m.issueInputModal = nil
+	m.issueInputModalWidth = 0
+}
+
+// cycleIssueInputFocus moves focus delta stops along the Tab order,
+// wrapping at either end.
+func (m *Model) cycleIssueInputFocus(delta int) {
+	order := m.issueInputFocusOrder()
+	cur := max(0, slices.Index(order, m.issueInputFocus()))
+	m.setIssueInputFocus(order[(cur+delta+len(order))%len(order)])
+}
+
+// issueInputFocusBack steps focus back a section for Esc: from the buttons
+// to the results, and from the results to the input. It returns false when
+// the input already has focus.
+func (m *Model) issueInputFocusBack() bool {
+	switch m.issueInputFocus() {
+	case issueFocusInput:
+		return false
+	case issueFocusResults:
+		m.setIssueInputFocus(issueFocusInput)
+	default:
+		m.setIssueInputFocus(issueFocusResults)
+	}
+	return true
+}
+
 func (m *Model) renderIssuePreviewOverlay(content string) string {
 	m.ensureIssuePreviewModal()
 	if m.issuePreviewModal == nil {
@@ -242,12 +326,14 @@ func (m *Model) ensureIssuePreviewModal() {
 		modalW = 30
 	}
 
-	// Cache check -- also invalidate when data/error/loading changes
+	// Cache check -- also invalidate when data/error/loading changes. The
+	// height sizes the description window.
 	cacheKey := modalW
-	if m.issuePreviewModal != nil && m.issuePreviewModalWidth == cacheKey {
+	if m.issuePreviewModal != nil && m.issuePreviewModalWidth == cacheKey && m.issuePreviewModalHeight == m.height {
 		return
 	}
 	m.issuePreviewModalWidth = cacheKey
+	m.issuePreviewModalHeight = m.height
 
 	if m.issuePreviewLoading {
 		m.issuePreviewModal = modal.New("Loading...",
@@ -332,18 +418,20 @@ func (m *Model) ensureIssuePreviewModal() {
 	}
 
 	if len(data.Labels) > 0 {
-		b = b.AddSection(modal.Text("Labels: " + strings.Join(data.Labels, ", ")))
+		b = b.AddSection(modal.Text("Labels: " + renderLabelChips(data.Labels)))
 	}
 
-	// Description — render as markdown, let modal scroll handle overflow
+	// Description — rendered as markdown and scrolled within a window sized
+	// to the terminal, so the buttons stay in view. The window is rendered
+	// now: the closure outlives this copy of the model, so scrolling drops
+	// the cached modal instead (see scrollIssuePreview).
 	if data.Description != "" {
+		descWidth := max(1, min(modalW, m.width-4)-modal.ModalPadding)
+		desc := m.renderIssuePreviewDescription(descWidth)
 		b = b.AddSection(modal.Spacer())
-		desc := data.Description
-		if renderer, err := markdown.NewRenderer(); err == nil {
-			rendered := renderer.RenderContent(desc, modalW-modal.ModalPadding)
-			desc = strings.Join(rendered, "\n")
-		}
-		b = b.AddSection(modal.Text(desc))
+		b = b.AddSection(modal.Custom(func(int, string, string) modal.RenderedSection {
+			return modal.RenderedSection{Content: desc}
+		}, nil))
 	}
 
 	b = b.AddSection(modal.Spacer())
@@ -355,3 +443,89 @@ func (m *Model) ensureIssuePreviewModal() {
 
 	m.issuePreviewModal = b
 }
+
+const (
+	// issuePreviewChromeLines is the preview modal's height without the
+	// description or metadata: border and margin (6), title (2), footer (1),
+	// spacers (2), buttons (1) and the scroll indicator (1).
+	issuePreviewChromeLines = 13
+	// issuePreviewMinDescLines is the smallest description window.
+	issuePreviewMinDescLines = 3
+)
+
+// issuePreviewDescHeight returns how many description lines fit in the
+// preview modal at the current terminal height.
+func (m *Model) issuePreviewDescHeight() int {
+	meta := 0
+	if d := m.issuePreviewData; d != nil {
+		if d.Status != "" || d.Type != "" || d.Priority != "" || d.Points > 0 {
+			meta++
+		}
+		if d.ParentID != "" {
+			meta++
+		}
+		if len(d.Labels) > 0 {
+			meta++
+		}
+	}
+	return max(issuePreviewMinDescLines, m.height-issuePreviewChromeLines-meta)
+}
+
+// issuePreviewDescription returns the description rendered as markdown for
+// width. The result is cached by width and content, so repaints reuse it and
+// resizes re-render. Without a renderer the text is wrapped plainly.
+func (m *Model) issuePreviewDescription(width int) []string {
+	desc := ""
+	if m.issuePreviewData != nil {
+		desc = m.issuePreviewData.Description
+	}
+	if m.issuePreviewDescLines != nil && m.issuePreviewDescWidth == width && m.issuePreviewDescSource == desc {
+		return m.issuePreviewDescLines
+	}
+	if m.markdownRenderer == nil {
+		if renderer, err := markdown.NewRenderer(); err == nil {
+			m.markdownRenderer = renderer
+		}
+	}
+	var lines []string
+	if m.markdownRenderer != nil {
+		lines = m.markdownRenderer.RenderContent(desc, width)
+	} else {
+		lines = markdown.WrapText(desc, width)
+	}
+	m.issuePreviewDescLines = lines
+	m.issuePreviewDescWidth = width
+	m.issuePreviewDescSource = desc
+	return lines
+}
+
+// renderIssuePreviewDescription renders the visible window of the
+// description, with a position indicator when it doesn't all fit.
+func (m *Model) renderIssuePreviewDescription(width int) string {
+	lines := m.issuePreviewDescription(width)
+	visible := m.issuePreviewDescHeight()
+	m.issuePreviewScroll = clampIssuePreviewScroll(m.issuePreviewScroll, len(lines), visible)
+	if len(lines) <= visible {
+		return strings.Join(lines, "\n")
+	}
+	end := m.issuePreviewScroll + visible
+	indicator := styles.Muted.Render(fmt.Sprintf("lines %d-%d of %d", m.issuePreviewScroll+1, end, len(lines)))
+	return strings.Join(lines[m.issuePreviewScroll:end], "\n") + "\n" + indicator
+}
+
+// scrollIssuePreview moves the description window by delta lines, dropping
+// the cached modal so it is rebuilt with the new window.
+func (m *Model) scrollIssuePreview(delta int) {
+	total := len(m.issuePreviewDescLines)
+	scroll := clampIssuePreviewScroll(m.issuePreviewScroll+delta, total, m.issuePreviewDescHeight())
+	if scroll != m.issuePreviewScroll {
+		m.issuePreviewScroll = scroll
+		m.issuePreviewModal = nil
+	}
+}
+
+// clampIssuePreviewScroll keeps offset within [0, total-visible] so the
+// window never runs past the last line.
+func clampIssuePreviewScroll(offset, total, visible int) int {
+	return max(0, min(offset, total-visible))
+}
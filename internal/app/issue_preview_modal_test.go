@@ -0,0 +1,428 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/plugin"
+)
+
+func TestIssueSearchDigitIndex(t *testing.T) {
+	tests := []struct {
+		name         string
+		key          string
+		scrollOffset int
+		total        int
+		wantIdx      int
+		wantOK       bool
+	}{
+		{"first result", "1", 0, 5, 0, true},
+		{"last of few", "5", 0, 5, 4, true},
+		{"past last result", "6", 0, 5, 0, false},
+		{"nine of many", "9", 0, 20, 8, true},
+		{"scrolled list", "2", 7, 20, 8, true},
+		{"scrolled near end", "3", 18, 20, 0, false},
+		{"zero", "0", 0, 20, 0, false},
+		{"letter", "a", 0, 20, 0, false},
+		{"multi-char key", "f1", 0, 20, 0, false},
+		{"no results", "1", 0, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx, ok := issueSearchDigitIndex(tt.key, tt.scrollOffset, tt.total)
+			if ok != tt.wantOK || (ok && idx != tt.wantIdx) {
+				t.Errorf("issueSearchDigitIndex(%q, %d, %d) = (%d, %v), want (%d, %v)",
+					tt.key, tt.scrollOffset, tt.total, idx, ok, tt.wantIdx, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestOpenIssueSearchResult(t *testing.T) {
+	m := &Model{
+		registry:       plugin.NewRegistry(nil),
+		ui:             NewUIState(),
+		showIssueInput: true,
+		issueSearchResults: []IssueSearchResult{
+			{ID: "td-aaa"}, {ID: "td-bbb"}, {ID: "td-ccc"},
+		},
+		issueSearchCursor: 0,
+	}
+
+	// Out-of-range IDs leave the input open
+	if _, cmd := m.openIssueSearchResult(issueSearchResultPrefix + "7"); cmd != nil || !m.showIssueInput {
+		t.Fatal("out-of-range result should be ignored")
+	}
+
+	// Digit 3 and a click on the third row take the same path
+	idx, ok := issueSearchDigitIndex("3", 0, len(m.issueSearchResults))
+	if !ok {
+		t.Fatal("digit 3 should select a result")
+	}
+	if _, cmd := m.openIssueSearchResult(fmt.Sprintf("%s%d", issueSearchResultPrefix, idx)); cmd == nil {
+		t.Fatal("expected preview fetch command")
+	}
+	if m.issueSearchCursor != 2 || m.showIssueInput || !m.showIssuePreview {
+		t.Errorf("cursor=%d input=%v preview=%v, want third result previewed",
+			m.issueSearchCursor, m.showIssueInput, m.showIssuePreview)
+	}
+}
+
+// fakeIssueSearchTick replaces the debounce timer with one that records each
+// scheduled message instead of waiting.
+func fakeIssueSearchTick(t *testing.T) *[]issueSearchDebounceMsg {
+	t.Helper()
+	var scheduled []issueSearchDebounceMsg
+	orig := issueSearchTick
+	issueSearchTick = func(d time.Duration, fn func(time.Time) tea.Msg) tea.Cmd {
+		if d != issueSearchDebounce {
+			t.Errorf("debounce delay = %v, want %v", d, issueSearchDebounce)
+		}
+		scheduled = append(scheduled, fn(time.Now()).(issueSearchDebounceMsg))
+		return nil
+	}
+	t.Cleanup(func() { issueSearchTick = orig })
+	return &scheduled
+}
+
+func newIssueInputModel() *Model {
+	m := &Model{
+		registry:       plugin.NewRegistry(nil),
+		ui:             NewUIState(),
+		showIssueInput: true,
+	}
+	m.initIssueInput()
+	return m
+}
+
+// sendIssueMsg runs msg through Update, keeping the resulting model.
+func sendIssueMsg(m *Model, msg tea.Msg) tea.Cmd {
+	newModel, cmd := m.Update(msg)
+	switch nm := newModel.(type) {
+	case Model:
+		*m = nm
+	case *Model:
+		*m = *nm
+	}
+	return cmd
+}
+
+func typeIssueQuery(m *Model, text string) {
+	for _, r := range text {
+		m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestIssueSearchDebounce(t *testing.T) {
+	scheduled := fakeIssueSearchTick(t)
+	m := newIssueInputModel()
+
+	// One character schedules nothing; each later keystroke schedules a tick
+	typeIssueQuery(m, "abc")
+	if len(*scheduled) != 2 {
+		t.Fatalf("scheduled %d searches, want 2", len(*scheduled))
+	}
+	if !m.issueSearchLoading {
+		t.Error("indicator should show while a search is pending")
+	}
+
+	// The earlier tick is stale and must not start a search
+	stale, latest := (*scheduled)[0], (*scheduled)[1]
+	if cmd := sendIssueMsg(m, stale); cmd != nil {
+		t.Error("stale debounce tick started a search")
+	}
+
+	if latest.Query != "abc" {
+		t.Errorf("latest query = %q, want abc", latest.Query)
+	}
+	if cmd := sendIssueMsg(m, latest); cmd == nil {
+		t.Fatal("current debounce tick should start a search")
+	}
+	if m.issueSearchCancel == nil || !m.issueSearchLoading {
+		t.Error("search should be in flight")
+	}
+
+	// Results for the running search clear the indicator
+	sendIssueMsg(m, IssueSearchResultMsg{Version: latest.Version, Query: "abc", Results: []IssueSearchResult{{ID: "td-1"}}})
+	if m.issueSearchLoading || len(m.issueSearchResults) != 1 || m.issueSearchCancel != nil {
+		t.Errorf("loading=%v results=%d, want results shown", m.issueSearchLoading, len(m.issueSearchResults))
+	}
+}
+
+func TestIssueSearchCancelsSuperseded(t *testing.T) {
+	scheduled := fakeIssueSearchTick(t)
+	m := newIssueInputModel()
+
+	typeIssueQuery(m, "ab")
+	first := (*scheduled)[0]
+	sendIssueMsg(m, first)
+	cancelled := false
+	m.issueSearchCancel = func() { cancelled = true }
+
+	// A newer keystroke kills the running search and ignores its results
+	typeIssueQuery(m, "c")
+	if !cancelled {
+		t.Error("newer query should cancel the in-flight search")
+	}
+	sendIssueMsg(m, IssueSearchResultMsg{Version: first.Version, Query: "ab", Results: []IssueSearchResult{{ID: "td-old"}}})
+	if len(m.issueSearchResults) != 0 || !m.issueSearchLoading {
+		t.Error("results from a cancelled search should be dropped")
+	}
+
+	// Deleting below the minimum length stops everything and clears the indicator
+	cancelled = false
+	sendIssueMsg(m, (*scheduled)[1])
+	m.issueSearchCancel = func() { cancelled = true }
+	for range 2 {
+		m.handleKeyMsg(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	if !cancelled || m.issueSearchLoading {
+		t.Errorf("cancelled=%v loading=%v, want search stopped", cancelled, m.issueSearchLoading)
+	}
+}
+
+func TestClampIssuePreviewScroll(t *testing.T) {
+	tests := []struct {
+		name                   string
+		offset, total, visible int
+		want                   int
+	}{
+		{"top", 0, 50, 10, 0},
+		{"middle", 20, 50, 10, 20},
+		{"last window", 40, 50, 10, 40},
+		{"past end", 45, 50, 10, 40},
+		{"negative", -3, 50, 10, 0},
+		{"fits", 5, 8, 10, 0},
+		{"exact fit", 1, 10, 10, 0},
+		{"empty", 2, 0, 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clampIssuePreviewScroll(tt.offset, tt.total, tt.visible); got != tt.want {
+				t.Errorf("clampIssuePreviewScroll(%d, %d, %d) = %d, want %d",
+					tt.offset, tt.total, tt.visible, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssuePreviewDescriptionScroll(t *testing.T) {
+	m := &Model{
+		registry:         plugin.NewRegistry(nil),
+		ui:               NewUIState(),
+		width:            100,
+		height:           issuePreviewChromeLines + 10,
+		showIssuePreview: true,
+		issuePreviewData: &IssuePreviewData{ID: "td-1", Title: "Long"},
+	}
+	lines := make([]string, 30)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	m.issuePreviewDescLines = lines
+	m.issuePreviewDescWidth = 60
+
+	if got := m.issuePreviewDescHeight(); got != 10 {
+		t.Fatalf("issuePreviewDescHeight() = %d, want 10", got)
+	}
+
+	m.scrollIssuePreview(25)
+	if m.issuePreviewScroll != 20 {
+		t.Errorf("scroll past end = %d, want 20", m.issuePreviewScroll)
+	}
+	view := m.renderIssuePreviewDescription(60)
+	if !strings.Contains(view, "line 30") || strings.Contains(view, "line 20\n") {
+		t.Errorf("window should show the last 10 lines, got %q", view)
+	}
+	if !strings.Contains(view, "lines 21-30 of 30") {
+		t.Errorf("missing scroll indicator in %q", view)
+	}
+
+	// Growing the terminal widens the window and pulls the offset back
+	m.height += 5
+	m.renderIssuePreviewDescription(60)
+	if m.issuePreviewScroll != 15 {
+		t.Errorf("scroll after resize = %d, want 15", m.issuePreviewScroll)
+	}
+
+	// A description that fits has no indicator
+	m.height = 100
+	if view := m.renderIssuePreviewDescription(60); strings.Contains(view, "lines ") {
+		t.Errorf("unexpected indicator for fitting description: %q", view)
+	}
+}
+
+func TestIssuePreviewScrollThroughUpdate(t *testing.T) {
+	var desc strings.Builder
+	for i := 1; i <= 120; i++ {
+		fmt.Fprintf(&desc, "Paragraph %d.\n\n", i)
+	}
+	m := &Model{
+		registry:         plugin.NewRegistry(nil),
+		ui:               NewUIState(),
+		width:            100,
+		height:           30,
+		showIssuePreview: true,
+		issuePreviewData: &IssuePreviewData{ID: "td-1", Title: "Long", Description: desc.String()},
+	}
+	// render mirrors View, which works on its own copy of the model
+	render := func() string {
+		v := *m
+		return v.renderIssuePreviewOverlay("")
+	}
+	if view := render(); !strings.Contains(view, "lines 1-") {
+		t.Fatalf("expected the top of the description, got %q", view)
+	}
+
+	for range 10 {
+		sendIssueMsg(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	}
+	if m.issuePreviewScroll != 10 {
+		t.Fatalf("issuePreviewScroll = %d, want 10", m.issuePreviewScroll)
+	}
+	if view := render(); !strings.Contains(view, "lines 11-") {
+		t.Errorf("render did not follow the scroll: %q", view)
+	}
+
+	sendIssueMsg(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	total := len(m.issuePreviewDescLines)
+	if view := render(); !strings.Contains(view, fmt.Sprintf("-%d of %d", total, total)) {
+		t.Errorf("G should show the last line of %d: %q", total, view)
+	}
+
+	sendIssueMsg(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if view := render(); !strings.Contains(view, "lines 1-") {
+		t.Errorf("g should return to the top: %q", view)
+	}
+}
+
+func TestIssuePreviewDescriptionCache(t *testing.T) {
+	m := &Model{
+		issuePreviewData: &IssuePreviewData{ID: "td-1", Description: "Some **bold** text that is long enough to wrap at narrow widths."},
+	}
+
+	wide := m.issuePreviewDescription(80)
+	if len(wide) == 0 {
+		t.Fatal("expected rendered lines")
+	}
+	if strings.Contains(strings.Join(wide, "\n"), "**") {
+		t.Error("description should be rendered as markdown")
+	}
+
+	// Repaints at the same width reuse the cached lines
+	wide[0] = "cached"
+	if got := m.issuePreviewDescription(80); got[0] != "cached" {
+		t.Error("same width and content should hit the cache")
+	}
+
+	// A resize re-renders
+	narrow := m.issuePreviewDescription(40)
+	if narrow[0] == "cached" || m.issuePreviewDescWidth != 40 {
+		t.Error("width change should invalidate the cache")
+	}
+	if len(narrow) <= 1 {
+		t.Errorf("narrow render should wrap, got %d lines", len(narrow))
+	}
+
+	// So does a different issue at the same width
+	narrow[0] = "cached"
+	m.issuePreviewData = &IssuePreviewData{ID: "td-2", Description: "Other"}
+	if got := m.issuePreviewDescription(40); got[0] == "cached" {
+		t.Error("content change should invalidate the cache")
+	}
+}
+
+func TestIssueInputFocusOrder(t *testing.T) {
+	m := newIssueInputModel()
+	key := func(k tea.KeyType) { m.handleKeyMsg(tea.KeyMsg{Type: k}) }
+
+	// Without results there is only the input
+	key(tea.KeyTab)
+	if m.issueInputFocus() != issueFocusInput {
+		t.Errorf("tab without results moved focus to %q", m.issueInputFocus())
+	}
+
+	m.issueSearchResults = []IssueSearchResult{{ID: "td-aaa"}, {ID: "td-bbb"}}
+
+	// Tab: input → results → open → cancel → input
+	var got []string
+	for range 4 {
+		key(tea.KeyTab)
+		got = append(got, m.issueInputFocus())
+	}
+	if want := "[issue-search-results open cancel issue-id]"; fmt.Sprint(got) != want {
+		t.Errorf("tab order = %v, want %s", got, want)
+	}
+	if m.issueInputInput.Value() != "td-aaa" {
+		t.Errorf("leaving a result should fill its ID, input = %q", m.issueInputInput.Value())
+	}
+
+	// Shift+Tab goes the other way
+	key(tea.KeyShiftTab)
+	if m.issueInputFocus() != "cancel" {
+		t.Errorf("shift+tab from input = %q, want cancel", m.issueInputFocus())
+	}
+
+	// The focused button is applied to the rebuilt modal
+	m.width, m.height = 100, 40
+	m.renderIssueInputOverlay("")
+	if m.issueInputModal.FocusedID() != "cancel" {
+		t.Errorf("modal focus = %q, want cancel", m.issueInputModal.FocusedID())
+	}
+
+	// Esc steps back a section at a time, then closes
+	m.setIssueInputFocus("open")
+	m.issueSearchCursor = 1
+	for _, want := range []string{issueFocusResults, issueFocusInput} {
+		key(tea.KeyEsc)
+		if !m.showIssueInput || m.issueInputFocus() != want {
+			t.Fatalf("after esc: open=%v focus=%q, want %q", m.showIssueInput, m.issueInputFocus(), want)
+		}
+	}
+	if m.issueSearchCursor != -1 {
+		t.Errorf("returning to the input should clear the selection, cursor = %d", m.issueSearchCursor)
+	}
+	key(tea.KeyEsc)
+	if m.showIssueInput {
+		t.Error("esc in the input should close the modal")
+	}
+}
+
+func TestIssueSearchWheelScrollThroughUpdate(t *testing.T) {
+	m := newIssueInputModel()
+	m.width, m.height = 100, 40
+	for i := range 30 {
+		m.issueSearchResults = append(m.issueSearchResults, IssueSearchResult{ID: fmt.Sprintf("td-%02d", i), Title: fmt.Sprintf("Result %02d", i)})
+	}
+	// render mirrors View, which works on its own copy of the model
+	render := func() string {
+		v := *m
+		return ansi.Strip(v.renderIssueInputOverlay(""))
+	}
+	// Locate the first result row to aim the wheel at
+	wheelAt := func() (int, int) {
+		for y, line := range strings.Split(render(), "\n") {
+			if x := strings.Index(line, "td-"); x >= 0 {
+				return x, y
+			}
+		}
+		t.Fatal("no search results rendered")
+		return 0, 0
+	}
+
+	for want := 3; want <= 9; want += 3 {
+		x, y := wheelAt()
+		sendIssueMsg(m, tea.MouseMsg{X: x, Y: y, Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress})
+		if m.issueSearchScrollOffset != want {
+			t.Fatalf("issueSearchScrollOffset = %d, want %d", m.issueSearchScrollOffset, want)
+		}
+	}
+	if view := render(); !strings.Contains(view, "Result 09") || strings.Contains(view, "Result 08") {
+		t.Errorf("render does not match the scroll offset:\n%s", view)
+	}
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -13,6 +14,7 @@ import (
 	"github.com/marcus/sidecar/internal/community"
 	"github.com/marcus/sidecar/internal/config"
 	"github.com/marcus/sidecar/internal/keymap"
+	"github.com/marcus/sidecar/internal/markdown"
 	"github.com/marcus/sidecar/internal/modal"
 	"github.com/marcus/sidecar/internal/mouse"
 	"github.com/marcus/sidecar/internal/palette"
@@ -20,6 +22,7 @@ import (
 	"github.com/marcus/sidecar/internal/state"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/theme"
+	"github.com/marcus/sidecar/internal/ui"
 	"github.com/marcus/sidecar/internal/version"
 )
 
@@ -179,12 +182,15 @@ type Model struct {
 	issueInputMouseHandler *mouse.Handler
 
 	// Issue input auto-complete
-	issueSearchResults      []IssueSearchResult
-	issueSearchQuery        string // last query sent to td search
-	issueSearchLoading      bool
-	issueSearchCursor       int  // selected result index (-1 = none/input focused)
-	issueSearchScrollOffset int  // viewport scroll offset for search results
-	issueSearchIncludeClosed bool // whether to include closed issues in search
+	issueSearchResults       []IssueSearchResult
+	issueSearchQuery         string // last query sent to td search
+	issueSearchLoading       bool
+	issueSearchCursor        int                // selected result index (-1 = none/input focused)
+	issueSearchScrollOffset  int                // viewport scroll offset for search results
+	issueSearchIncludeClosed bool               // whether to include closed issues in search
+	issueSearchVersion       int                // bumped per query; stale debounce ticks and results are dropped
+	issueSearchCancel        context.CancelFunc // cancels the in-flight td search, if any
+	issueInputButtonFocus    string             // focused button ("open"/"cancel"), "" when the input or results have focus
 
 	// Issue preview - preview phase
 	showIssuePreview         bool
@@ -193,15 +199,21 @@ type Model struct {
 	issuePreviewError        error
 	issuePreviewModal        *modal.Modal
 	issuePreviewModalWidth   int
+	issuePreviewModalHeight  int
 	issuePreviewMouseHandler *mouse.Handler
+	issuePreviewDescLines    []string // rendered description, see issuePreviewDescription
+	issuePreviewDescWidth    int      // width issuePreviewDescLines was rendered at
+	issuePreviewDescSource   string   // description issuePreviewDescLines was rendered from
+	issuePreviewScroll       int      // first visible description line
+
+	// Shared markdown renderer for issue descriptions, created on first use
+	markdownRenderer *markdown.Renderer
 
 	// Header/footer
 	ui *UIState
 
-	// Status/toast messages
-	statusMsg     string
-	statusExpiry  time.Time
-	statusIsError bool
+	// Toast notifications, stacked in the top-right corner
+	toasts ui.ToastManager
 
 	// Error handling
 	lastError error
@@ -387,18 +399,14 @@ func (m *Model) FocusPluginByID(id string) tea.Cmd {
 	return nil
 }
 
-// ShowToast displays a temporary status message.
-func (m *Model) ShowToast(msg string, duration time.Duration) {
-	m.statusMsg = msg
-	m.statusExpiry = time.Now().Add(duration)
+// ShowToast displays a temporary notification.
+func (m *Model) ShowToast(msg string, severity ui.ToastSeverity, duration time.Duration) {
+	m.toasts.Push(msg, severity, duration)
 }
 
-// ClearToast clears any expired toast message.
+// ClearToast drops expired notifications.
 func (m *Model) ClearToast() {
-	if m.statusMsg != "" && time.Now().After(m.statusExpiry) {
-		m.statusMsg = ""
-		m.statusIsError = false
-	}
+	m.toasts.Prune()
 }
 
 // hasUpdatesAvailable returns true if either sidecar or td has an update available.
@@ -1103,6 +1111,8 @@ func (m *Model) initIssueInput() {
 	m.issueSearchCursor = -1
 	m.issueSearchScrollOffset = 0
 	m.issueSearchIncludeClosed = false
+	m.issueInputButtonFocus = ""
+	m.stopIssueSearch()
 }
 
 // resetIssueInput resets the issue input modal state.
@@ -1117,6 +1127,33 @@ func (m *Model) resetIssueInput() {
 	m.issueSearchCursor = -1
 	m.issueSearchScrollOffset = 0
 	m.issueSearchIncludeClosed = false
+	m.issueInputButtonFocus = ""
+	m.stopIssueSearch()
+}
+
+// stopIssueSearch drops any pending debounced search and kills the one in
+// flight, so neither can deliver results.
+func (m *Model) stopIssueSearch() {
+	m.issueSearchVersion++
+	m.cancelIssueSearch()
+}
+
+// cancelIssueSearch kills the in-flight td search, if any.
+func (m *Model) cancelIssueSearch() {
+	if m.issueSearchCancel != nil {
+		m.issueSearchCancel()
+		m.issueSearchCancel = nil
+	}
+}
+
+// startIssueSearch runs query now under the current version, cancelling
+// any earlier search still in flight.
+func (m *Model) startIssueSearch(query string) tea.Cmd {
+	m.cancelIssueSearch()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.issueSearchCancel = cancel
+	m.issueSearchLoading = true
+	return issueSearchCmd(ctx, m.ui.WorkDir, query, m.issueSearchIncludeClosed, m.issueSearchVersion)
 }
 
 // resetIssuePreview resets the issue preview modal state.
@@ -1128,6 +1165,10 @@ func (m *Model) resetIssuePreview() {
 	m.issuePreviewModal = nil
 	m.issuePreviewModalWidth = 0
 	m.issuePreviewMouseHandler = nil
+	m.issuePreviewDescLines = nil
+	m.issuePreviewDescWidth = 0
+	m.issuePreviewDescSource = ""
+	m.issuePreviewScroll = 0
 }
 
 // backToIssueInput closes the preview and returns to the search modal
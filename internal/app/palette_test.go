@@ -0,0 +1,113 @@
+package app
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/keymap"
+	"github.com/marcus/sidecar/internal/palette"
+	"github.com/marcus/sidecar/internal/plugin"
+)
+
+// palettePlugin is a mock plugin that records the keys it receives.
+type palettePlugin struct {
+	keys    []string
+	handled int
+}
+
+func (p *palettePlugin) ID() string                    { return "mock" }
+func (p *palettePlugin) Name() string                  { return "Mock" }
+func (p *palettePlugin) Icon() string                  { return "" }
+func (p *palettePlugin) Init(*plugin.Context) error    { return nil }
+func (p *palettePlugin) Start() tea.Cmd                { return nil }
+func (p *palettePlugin) Stop()                         {}
+func (p *palettePlugin) IsFocused() bool               { return true }
+func (p *palettePlugin) SetFocused(bool)               {}
+func (p *palettePlugin) FocusContext() string          { return "mock" }
+func (p *palettePlugin) View(width, height int) string { return "" }
+
+func (p *palettePlugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		p.keys = append(p.keys, key.String())
+	}
+	return p, nil
+}
+
+func (p *palettePlugin) Commands() []plugin.Command {
+	return []plugin.Command{
+		{ID: "stage-file", Name: "Stage", Description: "Stage the selected file", Context: "mock"},
+		{ID: "discard-changes", Name: "Discard", Description: "Discard unstaged changes", Context: "mock"},
+		{ID: "open-remote", Name: "Remote", Description: "Open in browser", Context: "mock", Handler: func() tea.Cmd {
+			p.handled++
+			return nil
+		}},
+		{ID: "show-blame", Name: "Blame", Description: "Show line history", Context: "mock-diff"},
+	}
+}
+
+// newPaletteModel returns a model with the mock plugin active and its
+// commands bound in the keymap.
+func newPaletteModel(t *testing.T) (*Model, *palettePlugin) {
+	t.Helper()
+	p := &palettePlugin{}
+	reg := plugin.NewRegistry(nil)
+	if err := reg.Register(p); err != nil {
+		t.Fatal(err)
+	}
+	km := keymap.NewRegistry()
+	km.RegisterPluginBinding("s", "stage-file", "mock")
+	km.RegisterPluginBinding("D", "discard-changes", "mock")
+	km.RegisterPluginBinding("o", "open-remote", "mock")
+	km.RegisterPluginBinding("b", "show-blame", "mock-diff")
+	m := &Model{registry: reg, keymap: km, ui: NewUIState(), activeContext: "mock"}
+	return m, p
+}
+
+func TestPaletteFiltersPluginCommands(t *testing.T) {
+	m, _ := newPaletteModel(t)
+	entries := palette.BuildEntries(m.keymap, m.registry.Plugins(), "mock", "mock")
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"stage", "stage-file"},
+		{"dsc", "discard-changes"},
+		{"blame", "show-blame"},
+	}
+	for _, tt := range tests {
+		got := palette.FilterEntries(entries, tt.query)
+		if len(got) == 0 || got[0].CommandID != tt.want {
+			t.Errorf("FilterEntries(%q) top = %v, want %s", tt.query, got, tt.want)
+		}
+	}
+	if got := palette.FilterEntries(entries, "zzz"); len(got) != 0 {
+		t.Errorf("FilterEntries(zzz) = %v, want none", got)
+	}
+}
+
+func TestPaletteDispatchesPluginCommand(t *testing.T) {
+	m, p := newPaletteModel(t)
+
+	// A command without a handler replays its key to the active plugin
+	m.showPalette = true
+	sendIssueMsg(m, palette.CommandSelectedMsg{CommandID: "discard-changes", Context: "mock", Key: "D"})
+	if m.showPalette {
+		t.Error("selecting a command should close the palette")
+	}
+	if len(p.keys) != 1 || p.keys[0] != "D" {
+		t.Errorf("plugin received %v, want [D]", p.keys)
+	}
+
+	// A command with a handler runs it instead
+	sendIssueMsg(m, palette.CommandSelectedMsg{CommandID: "open-remote", Context: "mock", Key: "o"})
+	if p.handled != 1 || len(p.keys) != 1 {
+		t.Errorf("handler ran %d times, keys %v; want the handler only", p.handled, p.keys)
+	}
+
+	// Keys bound in another context mean something else here
+	sendIssueMsg(m, palette.CommandSelectedMsg{CommandID: "show-blame", Context: "mock-diff", Key: "b"})
+	if len(p.keys) != 1 {
+		t.Errorf("a key from an inactive context was replayed: %v", p.keys)
+	}
+}
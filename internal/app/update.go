@@ -3,7 +3,6 @@ package app
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 	"time"
@@ -14,12 +13,14 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/marcus/sidecar/internal/community"
 	"github.com/marcus/sidecar/internal/config"
+	"github.com/marcus/sidecar/internal/keymap"
 	"github.com/marcus/sidecar/internal/mouse"
 	"github.com/marcus/sidecar/internal/palette"
 	"github.com/marcus/sidecar/internal/plugin"
 	"github.com/marcus/sidecar/internal/state"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/theme"
+	"github.com/marcus/sidecar/internal/ui"
 	"github.com/marcus/sidecar/internal/version"
 )
 
@@ -195,8 +196,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case ToastMsg:
-		m.ShowToast(msg.Message, msg.Duration)
-		m.statusIsError = msg.IsError
+		severity := ui.ToastSuccess
+		if msg.IsError {
+			severity = ui.ToastError
+		}
+		m.ShowToast(msg.Message, severity, msg.Duration)
 		return m, nil
 
 	case RefreshMsg:
@@ -212,7 +216,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case ErrorMsg:
 		m.lastError = msg.Err
-		m.ShowToast("Error: "+msg.Err.Error(), 5*time.Second)
+		m.ShowToast("Error: "+msg.Err.Error(), ui.ToastError, 5*time.Second)
 		return m, nil
 
 	case UpdateSuccessMsg:
@@ -234,7 +238,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		// Only show toast if modal is not open
 		if m.updateModalState == UpdateModalClosed {
-			m.ShowToast("Update complete! Restart sidecar to use new version", 10*time.Second)
+			m.ShowToast("Update complete! Restart sidecar to use new version", ui.ToastSuccess, 10*time.Second)
 		}
 		return m, nil
 
@@ -249,9 +253,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		// Only show toast if modal is not open
 		if m.updateModalState == UpdateModalClosed {
-			m.ShowToast("Update failed: "+msg.Err.Error(), 5*time.Second)
+			m.ShowToast("Update failed: "+msg.Err.Error(), ui.ToastError, 5*time.Second)
 		}
-		m.statusIsError = true
 		return m, nil
 
 	case UpdatePhaseChangeMsg:
@@ -325,13 +328,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case plugin.OpenFileMsg:
 		// Open file in editor using tea.ExecProcess
-		// Most editors support +lineNo syntax for opening at a line
-		args := []string{}
-		if msg.LineNo > 0 {
-			args = append(args, fmt.Sprintf("+%d", msg.LineNo))
-		}
-		args = append(args, msg.Path)
-		c := exec.Command(msg.Editor, args...)
+		c := plugin.EditorCommand(msg.Editor, msg.Path, msg.LineNo)
 		termState, _ := term.GetState(int(os.Stdout.Fd()))
 		return m, tea.ExecProcess(c, func(err error) tea.Msg {
 			if termState != nil {
@@ -357,11 +354,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Execute the selected command from the palette
 		m.showPalette = false
 		m.updateContext()
-		// Look up and execute the command
-		if cmd, ok := m.keymap.GetCommand(msg.CommandID); ok && cmd.Handler != nil {
-			return m, cmd.Handler()
-		}
-		return m, nil
+		cmd := m.runPaletteCommand(msg)
+		return m, cmd
 
 	case version.UpdateAvailableMsg:
 		m.updateAvailable = &msg
@@ -369,10 +363,25 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.clearDiagnosticsModal() // Force rebuild so modal picks up new update state
 		m.ShowToast(
 			fmt.Sprintf("Update %s available! Press ! for details", msg.LatestVersion),
+			ui.ToastInfo,
 			15*time.Second,
 		)
 		return m, nil
 
+	case version.UpToDateMsg:
+		m.updateAvailable = nil
+		m.clearDiagnosticsModal()
+		if msg.LatestVersion == "" {
+			m.ShowToast("Update checks are skipped for development builds", ui.ToastInfo, 3*time.Second)
+		} else {
+			m.ShowToast(fmt.Sprintf("sidecar %s is up to date", msg.CurrentVersion), ui.ToastSuccess, 3*time.Second)
+		}
+		return m, nil
+
+	case version.UpdateCheckFailedMsg:
+		m.ShowToast("Update check failed: "+msg.Err.Error(), ui.ToastError, 5*time.Second)
+		return m, nil
+
 	case version.TdVersionMsg:
 		m.tdVersionInfo = &msg
 		m.clearDiagnosticsModal() // Force rebuild so modal picks up new version state
@@ -380,6 +389,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.HasUpdate && m.updateAvailable == nil {
 			m.ShowToast(
 				fmt.Sprintf("td update %s available! Press ! for details", msg.LatestVersion),
+				ui.ToastInfo,
 				15*time.Second,
 			)
 		}
@@ -397,15 +407,26 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.issuePreviewModalWidth = 0
 		return m, nil
 
+	case issueSearchDebounceMsg:
+		// Typing has paused; search unless a newer query superseded this one
+		if msg.Version != m.issueSearchVersion || !m.showIssueInput {
+			return m, nil
+		}
+		return m, m.startIssueSearch(msg.Query)
+
 	case IssueSearchResultMsg:
 		// Discard stale results
-		if msg.Query != m.issueSearchQuery || !m.showIssueInput {
+		if msg.Version != m.issueSearchVersion || msg.Query != m.issueSearchQuery || !m.showIssueInput {
 			return m, nil
 		}
+		m.cancelIssueSearch() // already finished; releases the context
 		m.issueSearchLoading = false
 		if msg.Error == nil {
 			m.issueSearchResults = msg.Results
 		}
+		if len(m.issueSearchResults) == 0 {
+			m.issueInputButtonFocus = "" // the buttons are gone
+		}
 		m.issueSearchScrollOffset = 0
 		m.issueInputModal = nil
 		m.issueInputModalWidth = 0
@@ -491,6 +512,10 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.updateContext()
 			return m, nil
 		case ModalIssueInput:
+			// Esc steps back from the buttons or results before closing
+			if m.issueInputFocusBack() {
+				return m, nil
+			}
 			m.resetIssueInput()
 			m.updateContext()
 			return m, nil
@@ -624,6 +649,14 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				}
 			}
 		}
+		// Handle 'c' to check for updates now, bypassing the cache
+		if msg.String() == "c" {
+			m.ShowToast("Checking for updates...", ui.ToastInfo, 5*time.Second)
+			return m, tea.Batch(
+				version.CheckNowAsync(m.currentVersion),
+				version.ForceCheckTdAsync(),
+			)
+		}
 		// Handle 'u' shortcut for update - open update modal
 		if msg.String() == "u" && m.hasUpdatesAvailable() && !m.updateInProgress && !m.needsRestart {
 			m.updateReleaseNotes = ""
@@ -984,17 +1017,32 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.issueInputModal = nil
 			m.issueInputModalWidth = 0
 			if len(strings.TrimSpace(m.issueInputInput.Value())) >= 2 {
-				m.issueSearchLoading = true
-				return m, issueSearchCmd(m.ui.WorkDir, strings.TrimSpace(m.issueInputInput.Value()), m.issueSearchIncludeClosed)
+				m.stopIssueSearch()
+				return m, m.startIssueSearch(strings.TrimSpace(m.issueInputInput.Value()))
+			}
+			return m, nil
+		}
+
+		// While a result is selected, 1-9 open the Nth visible result like a
+		// click; otherwise digits are typed into the input
+		if m.issueSearchCursor >= 0 && len(msg.Runes) == 1 && msg.Runes[0] >= '1' && msg.Runes[0] <= '9' {
+			if idx, ok := issueSearchDigitIndex(msg.String(), m.issueSearchScrollOffset, len(m.issueSearchResults)); ok {
+				return m.openIssueSearchResult(fmt.Sprintf("%s%d", issueSearchResultPrefix, idx))
 			}
 			return m, nil
 		}
 
 		switch msg.Type {
 		case tea.KeyEnter:
+			if m.issueInputButtonFocus == "cancel" {
+				m.resetIssueInput()
+				m.updateContext()
+				return m, nil
+			}
 			return m.issueInputSubmit()
 		case tea.KeyUp:
 			if len(m.issueSearchResults) > 0 {
+				m.issueInputButtonFocus = ""
 				m.issueSearchCursor--
 				if m.issueSearchCursor < -1 {
 					m.issueSearchCursor = -1
@@ -1009,27 +1057,30 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 		case tea.KeyDown:
 			if len(m.issueSearchResults) > 0 {
+				m.issueInputButtonFocus = ""
 				m.issueSearchCursor++
 				if m.issueSearchCursor >= len(m.issueSearchResults) {
 					m.issueSearchCursor = len(m.issueSearchResults) - 1
 				}
 				// Keep cursor visible in viewport
-				const maxVisible = 10
-				if m.issueSearchCursor >= m.issueSearchScrollOffset+maxVisible {
-					m.issueSearchScrollOffset = m.issueSearchCursor - maxVisible + 1
+				if m.issueSearchCursor >= m.issueSearchScrollOffset+issueSearchMaxVisible {
+					m.issueSearchScrollOffset = m.issueSearchCursor - issueSearchMaxVisible + 1
 				}
 				m.issueInputModal = nil
 				m.issueInputModalWidth = 0
 				return m, nil
 			}
 		case tea.KeyTab:
-			if m.issueSearchCursor >= 0 && m.issueSearchCursor < len(m.issueSearchResults) {
+			// Tab moves input → results → buttons; leaving a selected result
+			// fills its ID into the input
+			if m.issueInputFocus() == issueFocusResults && m.issueSearchCursor < len(m.issueSearchResults) {
 				m.issueInputInput.SetValue(m.issueSearchResults[m.issueSearchCursor].ID)
 				m.issueInputInput.CursorEnd()
-				m.issueInputModal = nil
-				m.issueInputModalWidth = 0
 			}
-			// Tab is consumed (fill-in or no-op) — don't forward to textinput
+			m.cycleIssueInputFocus(1)
+			return m, nil
+		case tea.KeyShiftTab:
+			m.cycleIssueInputFocus(-1)
 			return m, nil
 		}
 
@@ -1039,23 +1090,28 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 		// Forward key to text input, then clear modal cache so it rebuilds
 		var cmd tea.Cmd
+		m.issueInputButtonFocus = ""
 		m.issueInputInput, cmd = m.issueInputInput.Update(msg)
 		m.issueInputModal = nil
 		m.issueInputModalWidth = 0
 
-		// Trigger search if input changed (min 2 chars)
+		// Schedule a debounced search if input changed (min 2 chars). The
+		// indicator shows from the first keystroke: a search is pending.
 		newValue := strings.TrimSpace(m.issueInputInput.Value())
 		if newValue != m.issueSearchQuery && len(newValue) >= 2 {
+			m.stopIssueSearch()
 			m.issueSearchQuery = newValue
 			m.issueSearchLoading = true
 			// Keep previous results visible while loading to avoid modal shrink/grow flicker.
 			// Results are replaced when the new IssueSearchResultMsg arrives.
 			m.issueSearchCursor = -1
-			return m, tea.Batch(cmd, issueSearchCmd(m.ui.WorkDir, newValue, m.issueSearchIncludeClosed))
+			return m, tea.Batch(cmd, scheduleIssueSearch(m.issueSearchVersion, newValue))
 		}
 		if len(newValue) < 2 {
+			m.stopIssueSearch()
 			m.issueSearchResults = nil
 			m.issueSearchQuery = ""
+			m.issueSearchLoading = false
 			m.issueSearchCursor = -1
 		}
 		return m, cmd
@@ -1071,22 +1127,28 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Shortcuts before modal.HandleKey (which consumes Enter/Esc/Tab)
 		switch msg.String() {
 		case "j", "down":
-			m.issuePreviewModal.ScrollBy(1)
+			m.scrollIssuePreview(1)
 			return m, nil
 		case "k", "up":
-			m.issuePreviewModal.ScrollBy(-1)
+			m.scrollIssuePreview(-1)
 			return m, nil
 		case "ctrl+d":
-			m.issuePreviewModal.ScrollBy(10)
+			m.scrollIssuePreview(10)
 			return m, nil
 		case "ctrl+u":
-			m.issuePreviewModal.ScrollBy(-10)
+			m.scrollIssuePreview(-10)
+			return m, nil
+		case "pgdown":
+			m.scrollIssuePreview(m.issuePreviewDescHeight())
+			return m, nil
+		case "pgup":
+			m.scrollIssuePreview(-m.issuePreviewDescHeight())
 			return m, nil
 		case "g":
-			m.issuePreviewModal.ScrollToTop()
+			m.scrollIssuePreview(-m.issuePreviewScroll)
 			return m, nil
 		case "G":
-			m.issuePreviewModal.ScrollToBottom()
+			m.scrollIssuePreview(len(m.issuePreviewDescLines))
 			return m, nil
 		case "o":
 			if m.issuePreviewData != nil {
@@ -1287,6 +1349,36 @@ func (m *Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// runPaletteCommand executes a command chosen in the palette: its handler
+// when it has one, otherwise its bound key replayed as if typed. Keys are
+// only replayed for commands in the active or global context, where they
+// do what the palette described.
+func (m *Model) runPaletteCommand(msg palette.CommandSelectedMsg) tea.Cmd {
+	if cmd, ok := m.keymap.GetCommand(msg.CommandID); ok && cmd.Handler != nil {
+		return cmd.Handler()
+	}
+	if p := m.ActivePlugin(); p != nil {
+		for _, c := range p.Commands() {
+			if c.ID == msg.CommandID && c.Context == msg.Context && c.Handler != nil {
+				return c.Handler()
+			}
+		}
+	}
+	if msg.Context != m.activeContext && msg.Context != "global" {
+		return nil
+	}
+	keys, ok := keymap.ParseKey(msg.Key)
+	if !ok {
+		return nil
+	}
+	cmds := make([]tea.Cmd, 0, len(keys))
+	for _, key := range keys {
+		_, cmd := m.handleKeyMsg(key)
+		cmds = append(cmds, cmd)
+	}
+	return tea.Sequence(cmds...)
+}
+
 // updateContext sets activeContext based on current state.
 func (m *Model) updateContext() {
 	if p := m.ActivePlugin(); p != nil {
@@ -1957,16 +2049,28 @@ func (m *Model) issueInputSubmit() (tea.Model, tea.Cmd) {
 	m.issuePreviewError = nil
 	m.issuePreviewModal = nil
 	m.issuePreviewModalWidth = 0
+	m.issuePreviewDescLines = nil
+	m.issuePreviewScroll = 0
 	m.issuePreviewMouseHandler = mouse.NewHandler()
 	return m, fetchIssuePreviewCmd(m.ui.WorkDir, issueID)
 }
 
 // handleIssueInputMouse handles mouse events for the issue input modal.
 func (m *Model) handleIssueInputMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	// The results list keeps pointers to the cursor and scroll offset of the
+	// Model it was built from. Update works on copies, so build it against
+	// this copy, and drop it afterwards so no later copy renders or scrolls
+	// through pointers into this one.
+	m.issueInputModal = nil
+	m.issueInputModalWidth = 0
 	m.ensureIssueInputModal()
 	if m.issueInputModal == nil {
 		return m, nil
 	}
+	defer func() {
+		m.issueInputModal = nil
+		m.issueInputModalWidth = 0
+	}()
 	if m.issueInputMouseHandler == nil {
 		m.issueInputMouseHandler = mouse.NewHandler()
 	}
@@ -1983,11 +2087,18 @@ func (m *Model) handleIssueInputMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		return m.issueInputSubmit()
 	case strings.HasPrefix(action, issueSearchResultPrefix):
 		// Click on a search result — select it and submit
-		idxStr := strings.TrimPrefix(action, issueSearchResultPrefix)
-		if idx, err := strconv.Atoi(idxStr); err == nil && idx >= 0 && idx < len(m.issueSearchResults) {
-			m.issueSearchCursor = idx
-			return m.issueInputSubmit()
-		}
+		return m.openIssueSearchResult(action)
+	}
+	return m, nil
+}
+
+// openIssueSearchResult selects the search result named by a hit-region ID
+// (issueSearchResultPrefix + index) and submits it.
+func (m *Model) openIssueSearchResult(id string) (tea.Model, tea.Cmd) {
+	idxStr := strings.TrimPrefix(id, issueSearchResultPrefix)
+	if idx, err := strconv.Atoi(idxStr); err == nil && idx >= 0 && idx < len(m.issueSearchResults) {
+		m.issueSearchCursor = idx
+		return m.issueInputSubmit()
 	}
 	return m, nil
 }
@@ -2004,6 +2115,14 @@ func (m *Model) handleIssuePreviewMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 	// Pre-render to sync hit regions and focusIDs on the modal, which may have
 	// been rebuilt (e.g. after data/error arrival cleared the cache).
 	m.issuePreviewModal.Render(m.width, m.height, m.issuePreviewMouseHandler)
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		m.scrollIssuePreview(-3)
+		return m, nil
+	case tea.MouseButtonWheelDown:
+		m.scrollIssuePreview(3)
+		return m, nil
+	}
 	action := m.issuePreviewModal.HandleMouse(msg, m.issuePreviewMouseHandler)
 	switch action {
 	case "cancel":
@@ -135,6 +135,9 @@ func (m *Model) ensureUpdatePreviewModal() {
 	if len(lines) > maxLines {
 		lines = lines[:maxLines]
 		lines = append(lines, styles.Muted.Render("... (truncated)"))
+		if url := m.updateAvailable.ReleaseURL; url != "" {
+			lines = append(lines, styles.Muted.Render("View full notes: ")+styles.Link.Render(url))
+		}
 	}
 	notesContent := strings.Join(lines, "\n")
 
@@ -29,8 +29,20 @@ func projectSwitcherItemID(idx int) string {
 	return fmt.Sprintf("%s%d", projectSwitcherItemPrefix, idx)
 }
 
-// View renders the entire application UI.
+// View renders the entire application UI, with toasts over the top-right
+// corner of the content area.
 func (m Model) View() string {
+	view := m.renderView()
+	if m.toasts.Len() == 0 || !m.ready {
+		return view
+	}
+	toasts := m.toasts.View(m.width / 2)
+	x := m.width - lipgloss.Width(toasts) - 1
+	return ui.OverlayAt(view, toasts, max(0, x), headerHeight)
+}
+
+// renderView renders the application and any open modal.
+func (m Model) renderView() string {
 	if !m.ready {
 		return "Loading..."
 	}
@@ -652,12 +664,6 @@ func (m Model) renderFooter() string {
 	var status string
 	if m.ui.HasToast() {
 		status = styles.StatusModified.Render(m.ui.ToastMessage)
-	} else if m.statusMsg != "" {
-		toastStyle := styles.ToastSuccess
-		if m.statusIsError {
-			toastStyle = styles.ToastError
-		}
-		status = toastStyle.Render(m.statusMsg)
 	}
 
 	// Last refresh
@@ -91,6 +91,8 @@ func Convert(scheme *CommunityScheme) styles.ColorPalette {
 		Link:             scheme.BrightBlue,
 		ToastSuccessText: contrastText(scheme.Green),
 		ToastErrorText:   contrastText(scheme.Red),
+		ToastWarningText: contrastText(scheme.Yellow),
+		ToastInfoText:    contrastText(scheme.Blue),
 
 		SyntaxTheme:   matchSyntaxTheme(bg),
 		MarkdownTheme: markdownTheme(isDark),
@@ -129,6 +131,8 @@ func PaletteToOverrides(p styles.ColorPalette) map[string]interface{} {
 		"link":             p.Link,
 		"toastSuccessText": p.ToastSuccessText,
 		"toastErrorText":   p.ToastErrorText,
+		"toastWarningText": p.ToastWarningText,
+		"toastInfoText":    p.ToastInfoText,
 		"syntaxTheme":      p.SyntaxTheme,
 		"markdownTheme":    p.MarkdownTheme,
 		"tabStyle":         p.TabStyle,
@@ -9,6 +9,12 @@ type Config struct {
 	Keymap   KeymapConfig   `json:"keymap"`
 	UI       UIConfig       `json:"ui"`
 	Features FeaturesConfig `json:"features"`
+	Updates  UpdatesConfig  `json:"updates"`
+}
+
+// UpdatesConfig configures the startup version check.
+type UpdatesConfig struct {
+	CheckInterval time.Duration `json:"checkInterval"` // how long a check result is cached; 0 checks every startup
 }
 
 // FeaturesConfig holds feature flag settings.
@@ -60,6 +66,20 @@ type ConversationsPluginConfig struct {
 	// Example: ["interactive"] hides cron/system sessions by default.
 	// Empty or omitted means show all sessions (no filter).
 	DefaultCategoryFilter []string `json:"defaultCategoryFilter,omitempty"`
+	// CollapseToolResults summarizes runs of tool-result-only messages in a
+	// turn as a single "N tool results" line. Default: true.
+	CollapseToolResults bool `json:"collapseToolResults"`
+	// Pricing overrides the built-in cost rates, keyed by a case-insensitive
+	// substring of the model name (the longest matching key wins).
+	// Example: {"opus-4-5": {"input": 5, "output": 25}}
+	Pricing map[string]ModelPricing `json:"pricing,omitempty"`
+}
+
+// ModelPricing holds cost rates in dollars per million tokens.
+type ModelPricing struct {
+	Input    float64 `json:"input"`
+	Output   float64 `json:"output"`
+	Thinking float64 `json:"thinking,omitempty"` // 0 = same as output
 }
 
 // WorkspacePluginConfig configures the workspace plugin.
@@ -79,6 +99,13 @@ type WorkspacePluginConfig struct {
 	InteractiveCopyKey string `json:"interactiveCopyKey,omitempty"`
 	// InteractivePasteKey is the keybinding to paste clipboard in interactive mode. Default: "alt+v".
 	InteractivePasteKey string `json:"interactivePasteKey,omitempty"`
+	// InteractiveScrollUpKey scrolls captured output up within sidecar in interactive mode. Default: "alt+pgup".
+	InteractiveScrollUpKey string `json:"interactiveScrollUpKey,omitempty"`
+	// InteractiveScrollDownKey scrolls captured output down within sidecar in interactive mode. Default: "alt+pgdown".
+	InteractiveScrollDownKey string `json:"interactiveScrollDownKey,omitempty"`
+	// TmuxEscapeDelayMs is the double-Escape detection window in interactive mode. Default: 150.
+	// Raise it on high-latency connections where the second Escape arrives late. Clamped to 50-1000.
+	TmuxEscapeDelayMs int `json:"tmuxEscapeDelayMs,omitempty"`
 }
 
 // NotesPluginConfig configures the notes plugin.
@@ -105,6 +132,7 @@ type UIConfig struct {
 type ThemeConfig struct {
 	Name      string                 `json:"name"`
 	Community string                 `json:"community,omitempty"` // community scheme name (resolved at runtime)
+	File      string                 `json:"file,omitempty"`      // JSON theme file, relative to the config directory
 	Overrides map[string]interface{} `json:"overrides,omitempty"` // user customizations on top
 }
 
@@ -126,8 +154,9 @@ func Default() *Config {
 				DBPath:          ".todos/issues.db",
 			},
 			Conversations: ConversationsPluginConfig{
-				Enabled:       true,
-				ClaudeDataDir: "~/.claude",
+				Enabled:             true,
+				ClaudeDataDir:       "~/.claude",
+				CollapseToolResults: true,
 			},
 			Workspace: WorkspacePluginConfig{
 				DirPrefix:           true,
@@ -147,6 +176,9 @@ func Default() *Config {
 		Features: FeaturesConfig{
 			Flags: make(map[string]bool),
 		},
+		Updates: UpdatesConfig{
+			CheckInterval: 3 * time.Hour,
+		},
 	}
 }
 
@@ -161,5 +193,8 @@ func (c *Config) Validate() error {
 	if c.Plugins.Workspace.TmuxCaptureMaxBytes <= 0 {
 		c.Plugins.Workspace.TmuxCaptureMaxBytes = 2 * 1024 * 1024
 	}
+	if c.Updates.CheckInterval < 0 {
+		c.Updates.CheckInterval = 3 * time.Hour
+	}
 	return nil
 }
@@ -35,6 +35,11 @@ type rawConfig struct {
 	Keymap   KeymapConfig      `json:"keymap"`
 	UI       rawUIConfig       `json:"ui"`
 	Features FeaturesConfig    `json:"features"`
+	Updates  rawUpdatesConfig  `json:"updates"`
+}
+
+type rawUpdatesConfig struct {
+	CheckInterval string `json:"checkInterval"`
 }
 
 type rawUIConfig struct {
@@ -59,16 +64,19 @@ type rawPluginsConfig struct {
 	GitStatus     rawGitStatusConfig     `json:"git-status"`
 	TDMonitor     rawTDMonitorConfig     `json:"td-monitor"`
 	Conversations rawConversationsConfig `json:"conversations"`
-	Workspace     rawWorkspaceConfig      `json:"workspace"`
+	Workspace     rawWorkspaceConfig     `json:"workspace"`
 }
 
 type rawWorkspaceConfig struct {
-	DirPrefix            *bool  `json:"dirPrefix"`
-	TmuxCaptureMaxBytes  *int   `json:"tmuxCaptureMaxBytes"`
-	InteractiveExitKey   string `json:"interactiveExitKey"`
-	InteractiveAttachKey string `json:"interactiveAttachKey"`
-	InteractiveCopyKey   string `json:"interactiveCopyKey"`
-	InteractivePasteKey  string `json:"interactivePasteKey"`
+	DirPrefix                *bool  `json:"dirPrefix"`
+	TmuxCaptureMaxBytes      *int   `json:"tmuxCaptureMaxBytes"`
+	InteractiveExitKey       string `json:"interactiveExitKey"`
+	InteractiveAttachKey     string `json:"interactiveAttachKey"`
+	InteractiveCopyKey       string `json:"interactiveCopyKey"`
+	InteractivePasteKey      string `json:"interactivePasteKey"`
+	InteractiveScrollUpKey   string `json:"interactiveScrollUpKey"`
+	InteractiveScrollDownKey string `json:"interactiveScrollDownKey"`
+	TmuxEscapeDelayMs        *int   `json:"tmuxEscapeDelayMs"`
 }
 
 type rawGitStatusConfig struct {
@@ -83,8 +91,10 @@ type rawTDMonitorConfig struct {
 }
 
 type rawConversationsConfig struct {
-	Enabled       *bool  `json:"enabled"`
-	ClaudeDataDir string `json:"claudeDataDir"`
+	Enabled             *bool                   `json:"enabled"`
+	ClaudeDataDir       string                  `json:"claudeDataDir"`
+	CollapseToolResults *bool                   `json:"collapseToolResults"`
+	Pricing             map[string]ModelPricing `json:"pricing"`
 }
 
 // Load loads configuration from the default location.
@@ -123,10 +133,14 @@ func LoadFrom(path string) (*Config, error) {
 
 	// Expand paths
 	cfg.Plugins.Conversations.ClaudeDataDir = ExpandPath(cfg.Plugins.Conversations.ClaudeDataDir)
+	cfg.UI.Theme.File = resolveThemeFile(path, cfg.UI.Theme.File)
 
 	// Expand paths in project list and warn if path doesn't exist
 	for i := range cfg.Projects.List {
 		cfg.Projects.List[i].Path = ExpandPath(cfg.Projects.List[i].Path)
+		if theme := cfg.Projects.List[i].Theme; theme != nil {
+			theme.File = resolveThemeFile(path, theme.File)
+		}
 		if _, err := os.Stat(cfg.Projects.List[i].Path); os.IsNotExist(err) {
 			slog.Warn("project path not found", "name", cfg.Projects.List[i].Name, "path", cfg.Projects.List[i].Path)
 		}
@@ -186,6 +200,12 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 	if raw.Plugins.Conversations.ClaudeDataDir != "" {
 		cfg.Plugins.Conversations.ClaudeDataDir = raw.Plugins.Conversations.ClaudeDataDir
 	}
+	if raw.Plugins.Conversations.CollapseToolResults != nil {
+		cfg.Plugins.Conversations.CollapseToolResults = *raw.Plugins.Conversations.CollapseToolResults
+	}
+	if raw.Plugins.Conversations.Pricing != nil {
+		cfg.Plugins.Conversations.Pricing = raw.Plugins.Conversations.Pricing
+	}
 
 	// Workspace
 	if raw.Plugins.Workspace.DirPrefix != nil {
@@ -206,6 +226,15 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 	if raw.Plugins.Workspace.InteractivePasteKey != "" {
 		cfg.Plugins.Workspace.InteractivePasteKey = raw.Plugins.Workspace.InteractivePasteKey
 	}
+	if raw.Plugins.Workspace.InteractiveScrollUpKey != "" {
+		cfg.Plugins.Workspace.InteractiveScrollUpKey = raw.Plugins.Workspace.InteractiveScrollUpKey
+	}
+	if raw.Plugins.Workspace.InteractiveScrollDownKey != "" {
+		cfg.Plugins.Workspace.InteractiveScrollDownKey = raw.Plugins.Workspace.InteractiveScrollDownKey
+	}
+	if raw.Plugins.Workspace.TmuxEscapeDelayMs != nil {
+		cfg.Plugins.Workspace.TmuxEscapeDelayMs = *raw.Plugins.Workspace.TmuxEscapeDelayMs
+	}
 
 	// Keymap
 	if raw.Keymap.Overrides != nil {
@@ -227,6 +256,9 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 	if raw.UI.Theme.Community != "" {
 		cfg.UI.Theme.Community = raw.UI.Theme.Community
 	}
+	if raw.UI.Theme.File != "" {
+		cfg.UI.Theme.File = raw.UI.Theme.File
+	}
 	if raw.UI.Theme.Overrides != nil {
 		for k, v := range raw.UI.Theme.Overrides {
 			cfg.UI.Theme.Overrides[k] = v
@@ -249,6 +281,26 @@ func mergeConfig(cfg *Config, raw *rawConfig) {
 			cfg.Features.Flags[k] = v
 		}
 	}
+
+	// Updates
+	if raw.Updates.CheckInterval != "" {
+		if d, err := time.ParseDuration(raw.Updates.CheckInterval); err == nil && d >= 0 {
+			cfg.Updates.CheckInterval = d
+		}
+	}
+}
+
+// resolveThemeFile expands a theme file path, resolving relative paths
+// against the directory of the config file that named it.
+func resolveThemeFile(configPath, file string) string {
+	if file == "" {
+		return ""
+	}
+	file = ExpandPath(file)
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(filepath.Dir(configPath), file)
+	}
+	return file
 }
 
 // ExpandPath expands ~ to home directory.
@@ -65,6 +65,118 @@ func TestLoadFrom_ValidJSON(t *testing.T) {
 	}
 }
 
+func TestLoadFrom_WorkspaceEscapeDelay(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{
+		"plugins": {
+			"workspace": {
+				"tmuxEscapeDelayMs": 400
+			}
+		}
+	}`)
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	if cfg.Plugins.Workspace.TmuxEscapeDelayMs != 400 {
+		t.Errorf("got escape delay %d, want 400", cfg.Plugins.Workspace.TmuxEscapeDelayMs)
+	}
+}
+
+func TestLoadFrom_UpdatesCheckInterval(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{
+		"updates": {
+			"checkInterval": "24h"
+		}
+	}`)
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	if cfg.Updates.CheckInterval != 24*time.Hour {
+		t.Errorf("got check interval %v, want 24h", cfg.Updates.CheckInterval)
+	}
+	if Default().Updates.CheckInterval != 3*time.Hour {
+		t.Errorf("default check interval = %v, want 3h", Default().Updates.CheckInterval)
+	}
+}
+
+func TestLoadFrom_CollapseToolResults(t *testing.T) {
+	if !Default().Plugins.Conversations.CollapseToolResults {
+		t.Error("tool results should be collapsed by default")
+	}
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{
+		"plugins": {
+			"conversations": {
+				"collapseToolResults": false
+			}
+		}
+	}`)
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	if cfg.Plugins.Conversations.CollapseToolResults {
+		t.Error("collapseToolResults: false should disable collapsing")
+	}
+}
+
+func TestLoadFrom_ConversationsPricing(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+
+	content := []byte(`{
+		"plugins": {
+			"conversations": {
+				"pricing": {
+					"gpt-5": {"input": 1.25, "output": 10, "thinking": 10}
+				}
+			}
+		}
+	}`)
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom failed: %v", err)
+	}
+
+	want := ModelPricing{Input: 1.25, Output: 10, Thinking: 10}
+	if got := cfg.Plugins.Conversations.Pricing["gpt-5"]; got != want {
+		t.Errorf("pricing[gpt-5] = %+v, want %+v", got, want)
+	}
+}
+
 func TestLoadFrom_InvalidJSON(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "config.json")
@@ -184,3 +296,28 @@ func TestLoadFrom_EmptyProjectsList(t *testing.T) {
 		t.Errorf("got %d projects, want 0", len(cfg.Projects.List))
 	}
 }
+
+func TestLoadFrom_ThemeFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	content := []byte(`{
+		"ui": {"theme": {"file": "themes/mine.json"}},
+		"projects": {"list": [
+			{"name": "p", "path": "/tmp", "theme": {"file": "/abs/theme.json"}}
+		]}
+	}`)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := filepath.Join(dir, "themes", "mine.json"); cfg.UI.Theme.File != want {
+		t.Errorf("theme file = %q, want %q", cfg.UI.Theme.File, want)
+	}
+	if got := cfg.Projects.List[0].Theme.File; got != "/abs/theme.json" {
+		t.Errorf("project theme file = %q, want /abs/theme.json", got)
+	}
+}
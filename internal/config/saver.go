@@ -15,6 +15,11 @@ type saveConfig struct {
 	Keymap   KeymapConfig       `json:"keymap"`
 	UI       UIConfig           `json:"ui"`
 	Features FeaturesConfig     `json:"features,omitempty"`
+	Updates  saveUpdatesConfig  `json:"updates"`
+}
+
+type saveUpdatesConfig struct {
+	CheckInterval string `json:"checkInterval,omitempty"`
 }
 
 type saveProjectsConfig struct {
@@ -27,7 +32,7 @@ type savePluginsConfig struct {
 	GitStatus     saveGitStatusConfig     `json:"git-status,omitempty"`
 	TDMonitor     saveTDMonitorConfig     `json:"td-monitor,omitempty"`
 	Conversations saveConversationsConfig `json:"conversations,omitempty"`
-	Workspace     saveWorkspaceConfig      `json:"workspace,omitempty"`
+	Workspace     saveWorkspaceConfig     `json:"workspace,omitempty"`
 }
 
 type saveGitStatusConfig struct {
@@ -42,17 +47,22 @@ type saveTDMonitorConfig struct {
 }
 
 type saveConversationsConfig struct {
-	Enabled       *bool  `json:"enabled,omitempty"`
-	ClaudeDataDir string `json:"claudeDataDir,omitempty"`
+	Enabled             *bool                   `json:"enabled,omitempty"`
+	ClaudeDataDir       string                  `json:"claudeDataDir,omitempty"`
+	CollapseToolResults *bool                   `json:"collapseToolResults,omitempty"`
+	Pricing             map[string]ModelPricing `json:"pricing,omitempty"`
 }
 
 type saveWorkspaceConfig struct {
-	DirPrefix            *bool  `json:"dirPrefix,omitempty"`
-	TmuxCaptureMaxBytes  *int   `json:"tmuxCaptureMaxBytes,omitempty"`
-	InteractiveExitKey   string `json:"interactiveExitKey,omitempty"`
-	InteractiveAttachKey string `json:"interactiveAttachKey,omitempty"`
-	InteractiveCopyKey   string `json:"interactiveCopyKey,omitempty"`
-	InteractivePasteKey  string `json:"interactivePasteKey,omitempty"`
+	DirPrefix                *bool  `json:"dirPrefix,omitempty"`
+	TmuxCaptureMaxBytes      *int   `json:"tmuxCaptureMaxBytes,omitempty"`
+	InteractiveExitKey       string `json:"interactiveExitKey,omitempty"`
+	InteractiveAttachKey     string `json:"interactiveAttachKey,omitempty"`
+	InteractiveCopyKey       string `json:"interactiveCopyKey,omitempty"`
+	InteractivePasteKey      string `json:"interactivePasteKey,omitempty"`
+	InteractiveScrollUpKey   string `json:"interactiveScrollUpKey,omitempty"`
+	InteractiveScrollDownKey string `json:"interactiveScrollDownKey,omitempty"`
+	TmuxEscapeDelayMs        int    `json:"tmuxEscapeDelayMs,omitempty"`
 }
 
 // toSaveConfig converts Config to the JSON-serializable format.
@@ -74,21 +84,29 @@ func toSaveConfig(cfg *Config) saveConfig {
 				DBPath:          cfg.Plugins.TDMonitor.DBPath,
 			},
 			Conversations: saveConversationsConfig{
-				Enabled:       &cfg.Plugins.Conversations.Enabled,
-				ClaudeDataDir: cfg.Plugins.Conversations.ClaudeDataDir,
+				Enabled:             &cfg.Plugins.Conversations.Enabled,
+				ClaudeDataDir:       cfg.Plugins.Conversations.ClaudeDataDir,
+				CollapseToolResults: &cfg.Plugins.Conversations.CollapseToolResults,
+				Pricing:             cfg.Plugins.Conversations.Pricing,
 			},
 			Workspace: saveWorkspaceConfig{
-				DirPrefix:            &cfg.Plugins.Workspace.DirPrefix,
-				TmuxCaptureMaxBytes:  &cfg.Plugins.Workspace.TmuxCaptureMaxBytes,
-				InteractiveExitKey:   cfg.Plugins.Workspace.InteractiveExitKey,
-				InteractiveAttachKey: cfg.Plugins.Workspace.InteractiveAttachKey,
-				InteractiveCopyKey:   cfg.Plugins.Workspace.InteractiveCopyKey,
-				InteractivePasteKey:  cfg.Plugins.Workspace.InteractivePasteKey,
+				DirPrefix:                &cfg.Plugins.Workspace.DirPrefix,
+				TmuxCaptureMaxBytes:      &cfg.Plugins.Workspace.TmuxCaptureMaxBytes,
+				InteractiveExitKey:       cfg.Plugins.Workspace.InteractiveExitKey,
+				InteractiveAttachKey:     cfg.Plugins.Workspace.InteractiveAttachKey,
+				InteractiveCopyKey:       cfg.Plugins.Workspace.InteractiveCopyKey,
+				InteractivePasteKey:      cfg.Plugins.Workspace.InteractivePasteKey,
+				InteractiveScrollUpKey:   cfg.Plugins.Workspace.InteractiveScrollUpKey,
+				InteractiveScrollDownKey: cfg.Plugins.Workspace.InteractiveScrollDownKey,
+				TmuxEscapeDelayMs:        cfg.Plugins.Workspace.TmuxEscapeDelayMs,
 			},
 		},
 		Keymap:   cfg.Keymap,
 		UI:       cfg.UI,
 		Features: cfg.Features,
+		Updates: saveUpdatesConfig{
+			CheckInterval: cfg.Updates.CheckInterval.String(),
+		},
 	}
 }
 
@@ -120,6 +138,7 @@ func Save(cfg *Config) error {
 		"plugins":  sc.Plugins,
 		"keymap":   sc.Keymap,
 		"ui":       sc.UI,
+		"updates":  sc.Updates,
 	}
 	if len(sc.Features.Flags) > 0 {
 		fields["features"] = sc.Features
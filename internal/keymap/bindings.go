@@ -32,6 +32,9 @@ func DefaultBindings() []Binding {
 		{Key: "enter", Command: "select", Context: "global"},
 		{Key: "esc", Command: "back", Context: "global"},
 
+		// Diagnostics context
+		{Key: "c", Command: "check-updates", Context: "diagnostics"},
+
 		// Project switcher context
 		{Key: "@", Command: "toggle", Context: "project-switcher"},
 		{Key: "esc", Command: "close", Context: "project-switcher"},
@@ -56,6 +59,7 @@ func DefaultBindings() []Binding {
 		{Key: "U", Command: "unstage-all", Context: "git-status"},
 		{Key: "c", Command: "commit", Context: "git-status"},
 		{Key: "A", Command: "amend", Context: "git-status"},
+		{Key: "X", Command: "undo-commit", Context: "git-status"},
 		{Key: "d", Command: "show-diff", Context: "git-status"},
 		{Key: "enter", Command: "show-diff", Context: "git-status"},
 		{Key: "r", Command: "refresh", Context: "git-status"},
@@ -67,11 +71,15 @@ func DefaultBindings() []Binding {
 		{Key: "z", Command: "stash", Context: "git-status"},
 		{Key: "Z", Command: "stash-pop", Context: "git-status"},
 		{Key: "ctrl+z", Command: "stash-apply", Context: "git-status"},
+		{Key: "t", Command: "stash-list", Context: "git-status"},
+		{Key: "/", Command: "filter-files", Context: "git-status"},
+		{Key: "T", Command: "toggle-grouping", Context: "git-status"},
 		{Key: "O", Command: "open-in-file-browser", Context: "git-status"},
 		{Key: "o", Command: "open-in-github", Context: "git-status"},
 		{Key: "y", Command: "yank-file", Context: "git-status"},
 		{Key: "Y", Command: "yank-path", Context: "git-status"},
 		{Key: "D", Command: "discard-changes", Context: "git-status"},
+		{Key: "R", Command: "restore-from-commit", Context: "git-status"},
 		{Key: "\\", Command: "toggle-sidebar", Context: "git-status"},
 
 		// Git status commits context (sidebar)
@@ -89,6 +97,7 @@ func DefaultBindings() []Binding {
 		{Key: "n", Command: "next-match", Context: "git-status-commits"},
 		{Key: "N", Command: "prev-match", Context: "git-status-commits"},
 		{Key: "o", Command: "open-in-github", Context: "git-status-commits"},
+		{Key: "C", Command: "cherry-pick", Context: "git-status-commits"},
 		{Key: "v", Command: "toggle-graph", Context: "git-status-commits"},
 		{Key: "P", Command: "push", Context: "git-status-commits"},
 		{Key: "L", Command: "pull", Context: "git-status-commits"},
@@ -105,6 +114,8 @@ func DefaultBindings() []Binding {
 		{Key: "alt+c", Command: "toggle-case", Context: "git-history-search"},
 
 		// Git path filter modal context
+		{Key: "enter", Command: "apply-filter", Context: "git-file-filter"},
+		{Key: "esc", Command: "cancel", Context: "git-file-filter"},
 		{Key: "enter", Command: "apply-filter", Context: "git-path-filter"},
 		{Key: "esc", Command: "cancel", Context: "git-path-filter"},
 
@@ -114,11 +125,14 @@ func DefaultBindings() []Binding {
 		{Key: "ctrl+d", Command: "page-down", Context: "git-status-diff"},
 		{Key: "ctrl+u", Command: "page-up", Context: "git-status-diff"},
 		{Key: "enter", Command: "full-diff", Context: "git-status-diff"},
-		{Key: "s", Command: "stage-file", Context: "git-status-diff"},
-		{Key: "u", Command: "unstage-file", Context: "git-status-diff"},
+		{Key: "s", Command: "stage-hunk", Context: "git-status-diff"},
+		{Key: "u", Command: "unstage-hunk", Context: "git-status-diff"},
 		{Key: "v", Command: "toggle-diff-view", Context: "git-status-diff"},
 		{Key: "\\", Command: "toggle-sidebar", Context: "git-status-diff"},
 		{Key: "w", Command: "toggle-wrap", Context: "git-status-diff"},
+		{Key: "n", Command: "toggle-line-numbers", Context: "git-status-diff"},
+		{Key: "W", Command: "toggle-whitespace", Context: "git-status-diff"},
+		{Key: "Y", Command: "yank-line-ref", Context: "git-status-diff"},
 
 		// Git commit preview context
 		{Key: "j", Command: "scroll-down", Context: "git-commit-preview"},
@@ -149,6 +163,9 @@ func DefaultBindings() []Binding {
 		{Key: "v", Command: "toggle-diff-view", Context: "git-diff"},
 		{Key: "\\", Command: "toggle-sidebar", Context: "git-diff"},
 		{Key: "w", Command: "toggle-wrap", Context: "git-diff"},
+		{Key: "n", Command: "toggle-line-numbers", Context: "git-diff"},
+		{Key: "W", Command: "toggle-whitespace", Context: "git-diff"},
+		{Key: "Y", Command: "yank-line-ref", Context: "git-diff"},
 
 		// Git push menu context
 		{Key: "p", Command: "push", Context: "git-push-menu"},
@@ -161,8 +178,13 @@ func DefaultBindings() []Binding {
 		{Key: "r", Command: "pull-rebase", Context: "git-pull-menu"},
 		{Key: "f", Command: "pull-ff-only", Context: "git-pull-menu"},
 		{Key: "a", Command: "pull-autostash", Context: "git-pull-menu"},
+		{Key: "o", Command: "pull-from", Context: "git-pull-menu"},
 		{Key: "esc", Command: "cancel", Context: "git-pull-menu"},
 
+		// Git pull ref picker context
+		{Key: "enter", Command: "pull-selected-ref", Context: "git-pull-ref-picker"},
+		{Key: "esc", Command: "cancel", Context: "git-pull-ref-picker"},
+
 		// Issue preview context
 		// Issue input modal context
 		{Key: "ctrl+x", Command: "toggle-closed", Context: "issue-input"},
@@ -179,13 +201,53 @@ func DefaultBindings() []Binding {
 		{Key: "esc", Command: "dismiss", Context: "git-error"},
 
 		// Git pull conflict context
+		{Key: "enter", Command: "resolve-conflict", Context: "git-pull-conflict"},
 		{Key: "a", Command: "abort-pull", Context: "git-pull-conflict"},
 		{Key: "esc", Command: "dismiss", Context: "git-pull-conflict"},
 
+		// Git conflict resolution context
+		{Key: "o", Command: "pick-ours", Context: "git-conflict-resolve"},
+		{Key: "t", Command: "pick-theirs", Context: "git-conflict-resolve"},
+		{Key: "b", Command: "pick-both", Context: "git-conflict-resolve"},
+		{Key: "u", Command: "undo-pick", Context: "git-conflict-resolve"},
+		{Key: "n", Command: "next-conflict", Context: "git-conflict-resolve"},
+		{Key: "N", Command: "prev-conflict", Context: "git-conflict-resolve"},
+		{Key: "m", Command: "mark-resolved", Context: "git-conflict-resolve"},
+		{Key: "esc", Command: "back", Context: "git-conflict-resolve"},
+
 		// Git stash pop context
 		{Key: "y", Command: "confirm-pop", Context: "git-stash-pop"},
 		{Key: "esc", Command: "dismiss", Context: "git-stash-pop"},
 
+		// Git amend confirm context
+		{Key: "y", Command: "confirm-amend", Context: "git-amend-confirm"},
+		{Key: "esc", Command: "dismiss", Context: "git-amend-confirm"},
+
+		// Git stash picker context
+		{Key: "enter", Command: "stash-apply-selected", Context: "git-stash-picker"},
+		{Key: "a", Command: "stash-apply-selected", Context: "git-stash-picker"},
+		{Key: "p", Command: "stash-pop-selected", Context: "git-stash-picker"},
+		{Key: "d", Command: "stash-drop-selected", Context: "git-stash-picker"},
+		{Key: "esc", Command: "dismiss", Context: "git-stash-picker"},
+
+		// Git undo commit context
+		{Key: "y", Command: "confirm-undo-commit", Context: "git-undo-commit"},
+		{Key: "esc", Command: "dismiss", Context: "git-undo-commit"},
+
+		// Git cherry-pick confirm context
+		{Key: "y", Command: "confirm-cherry-pick", Context: "git-cherry-pick"},
+		{Key: "esc", Command: "dismiss", Context: "git-cherry-pick"},
+
+		// Git cherry-pick picker context
+		{Key: "enter", Command: "select", Context: "git-cherry-pick-picker"},
+		{Key: "esc", Command: "cancel", Context: "git-cherry-pick-picker"},
+
+		// Git restore-from-commit contexts
+		{Key: "enter", Command: "restore-selected-commit", Context: "git-restore-picker"},
+		{Key: "esc", Command: "dismiss", Context: "git-restore-picker"},
+		{Key: "y", Command: "confirm-restore", Context: "git-restore-confirm"},
+		{Key: "esc", Command: "dismiss", Context: "git-restore-confirm"},
+
 		// Git commit context
 		{Key: "ctrl+s", Command: "execute-commit", Context: "git-commit"},
 		{Key: "ctrl+enter", Command: "execute-commit", Context: "git-commit"},
@@ -239,6 +301,16 @@ func DefaultBindings() []Binding {
 		{Key: "y", Command: "yank-details", Context: "conversations-main"},
 		{Key: "Y", Command: "yank-resume", Context: "conversations-main"},
 		{Key: "R", Command: "resume-in-workspace", Context: "conversations-main"},
+		{Key: "T", Command: "toggle-tool-results", Context: "conversations-main"},
+		{Key: "/", Command: "search-messages", Context: "conversations-main"},
+		{Key: "n", Command: "next-match", Context: "conversations-main"},
+		{Key: "N", Command: "prev-match", Context: "conversations-main"},
+		{Key: "I", Command: "turn-index", Context: "conversations-main"},
+		{Key: "U", Command: "usage", Context: "conversations-main"},
+
+		// Conversations turn index context
+		{Key: "enter", Command: "jump", Context: "conversations-turn-index"},
+		{Key: "esc", Command: "close", Context: "conversations-turn-index"},
 
 		// File browser tree context
 		{Key: "tab", Command: "switch-pane", Context: "file-browser-tree"},
@@ -257,6 +329,10 @@ func DefaultBindings() []Binding {
 		{Key: "Y", Command: "copy-path", Context: "file-browser-tree"},
 		{Key: "p", Command: "paste", Context: "file-browser-tree"},
 		{Key: "s", Command: "sort", Context: "file-browser-tree"},
+		{Key: "S", Command: "sort-reverse", Context: "file-browser-tree"},
+		{Key: "F", Command: "toggle-dirs-first", Context: "file-browser-tree"},
+		{Key: "b", Command: "bookmark", Context: "file-browser-tree"},
+		{Key: "'", Command: "bookmarks", Context: "file-browser-tree"},
 		{Key: "r", Command: "refresh", Context: "file-browser-tree"},
 		{Key: "m", Command: "move", Context: "file-browser-tree"},
 		{Key: "R", Command: "rename", Context: "file-browser-tree"},
@@ -304,6 +380,11 @@ func DefaultBindings() []Binding {
 		{Key: "n", Command: "next-match", Context: "file-browser-content-search"},
 		{Key: "N", Command: "prev-match", Context: "file-browser-content-search"},
 
+		// File browser bookmarks context
+		{Key: "enter", Command: "select", Context: "file-browser-bookmarks"},
+		{Key: "d", Command: "remove-bookmark", Context: "file-browser-bookmarks"},
+		{Key: "esc", Command: "close", Context: "file-browser-bookmarks"},
+
 		// File browser quick open context
 		{Key: "esc", Command: "cancel", Context: "file-browser-quick-open"},
 		{Key: "enter", Command: "select", Context: "file-browser-quick-open"},
@@ -389,6 +470,19 @@ func DefaultBindings() []Binding {
 		{Key: "k", Command: "scroll-up", Context: "workspace-preview"},
 		{Key: "ctrl+d", Command: "page-down", Context: "workspace-preview"},
 		{Key: "ctrl+u", Command: "page-up", Context: "workspace-preview"},
+		{Key: "/", Command: "search-output", Context: "workspace-preview"},
+		{Key: "o", Command: "open-link", Context: "workspace-preview"},
+		{Key: "y", Command: "yank-diff", Context: "workspace-preview"},
+		{Key: "Y", Command: "yank-file-diff", Context: "workspace-preview"},
+
+		// Workspace task link picker context
+		{Key: "esc", Command: "cancel", Context: "workspace-task-links"},
+
+		// Workspace output search context
+		{Key: "esc", Command: "cancel", Context: "workspace-output-search"},
+		{Key: "enter", Command: "confirm", Context: "workspace-output-search"},
+		{Key: "n", Command: "next-match", Context: "workspace-output-search"},
+		{Key: "N", Command: "prev-match", Context: "workspace-output-search"},
 
 		// Workspace merge error context
 		{Key: "esc", Command: "dismiss-merge-error", Context: "workspace-merge-error"},
@@ -293,3 +293,54 @@ func keyToString(key tea.KeyMsg) string {
 		return key.String()
 	}
 }
+
+// namedKeys maps key names ("enter", "ctrl+s", "shift+tab") to key types,
+// the inverse of tea.Key.String for keys that aren't plain runes.
+var namedKeys = func() map[string]tea.KeyType {
+	names := make(map[string]tea.KeyType)
+	for t := tea.KeyType(-128); t < 128; t++ {
+		if t == tea.KeyRunes {
+			continue
+		}
+		if name := (tea.Key{Type: t}).String(); name != "" {
+			names[name] = t
+		}
+	}
+	names["space"] = tea.KeySpace
+	return names
+}()
+
+// ParseKey converts a binding key such as "s", "ctrl+s", "alt+c" or "g g"
+// into the key presses that trigger it. It reports false for keys that
+// have no terminal equivalent, such as "ctrl+enter".
+func ParseKey(key string) ([]tea.KeyMsg, bool) {
+	parts := strings.Fields(key)
+	if key == " " {
+		parts = []string{"space"}
+	}
+	if len(parts) == 0 {
+		return nil, false
+	}
+	msgs := make([]tea.KeyMsg, 0, len(parts))
+	for _, part := range parts {
+		var k tea.Key
+		if t, ok := namedKeys[part]; ok {
+			k.Type = t
+		} else {
+			if rest, ok := strings.CutPrefix(part, "alt+"); ok && rest != "" {
+				k.Alt = true
+				part = rest
+			}
+			if t, ok := namedKeys[part]; ok {
+				k.Type = t
+			} else if runes := []rune(part); len(runes) == 1 {
+				k.Type = tea.KeyRunes
+				k.Runes = runes
+			} else {
+				return nil, false
+			}
+		}
+		msgs = append(msgs, tea.KeyMsg(k))
+	}
+	return msgs, true
+}
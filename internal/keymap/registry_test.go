@@ -1,6 +1,7 @@
 package keymap
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -220,3 +221,42 @@ func TestRegistry_GetCommand(t *testing.T) {
 		t.Error("GetCommand should return false for missing command")
 	}
 }
+
+func TestParseKey(t *testing.T) {
+	cases := []struct {
+		key  string
+		want []string
+	}{
+		{"s", []string{"s"}},
+		{"S", []string{"S"}},
+		{"?", []string{"?"}},
+		{"ctrl+s", []string{"ctrl+s"}},
+		{"alt+c", []string{"alt+c"}},
+		{"shift+tab", []string{"shift+tab"}},
+		{"enter", []string{"enter"}},
+		{"esc", []string{"esc"}},
+		{" ", []string{" "}},
+		{"space", []string{" "}},
+		{"g g", []string{"g", "g"}},
+	}
+	for _, tc := range cases {
+		msgs, ok := ParseKey(tc.key)
+		if !ok {
+			t.Errorf("ParseKey(%q) failed", tc.key)
+			continue
+		}
+		var got []string
+		for _, msg := range msgs {
+			got = append(got, msg.String())
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("ParseKey(%q) = %q, want %q", tc.key, got, tc.want)
+		}
+	}
+
+	for _, key := range []string{"", "ctrl+enter", "bogus"} {
+		if _, ok := ParseKey(key); ok {
+			t.Errorf("ParseKey(%q) should fail", key)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package modal
 
 import (
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -10,34 +12,56 @@ import (
 
 // renderedSection holds a section's rendered content and metadata.
 type renderedSection struct {
+	section    Section
 	content    string
 	height     int
 	focusables []FocusableInfo
 }
 
+// scrollRegion is the on-screen area of a Scrollable section in the last
+// render, clipped to the viewport.
+type scrollRegion struct {
+	section             Scrollable
+	x, y, width, height int
+}
+
 // renderSections renders all sections at the given content width and returns
 // the rendered sections along with collected focusable IDs.
 func (m *Modal) renderSections(contentWidth int) ([]renderedSection, []string) {
 	focusID := m.currentFocusID()
 	rendered := make([]renderedSection, 0, len(m.sections))
-	var focusIDs []string
 
 	for _, s := range m.sections {
 		res := s.Render(contentWidth, focusID, m.hoverID)
 		height := measureHeight(res.Content)
 
 		rendered = append(rendered, renderedSection{
+			section:    s,
 			content:    res.Content,
 			height:     height,
 			focusables: res.Focusables,
 		})
+	}
 
-		for _, f := range res.Focusables {
-			focusIDs = append(focusIDs, f.ID)
+	return rendered, focusOrder(rendered)
+}
+
+// focusOrder lists focusable IDs in Tab order: section by section, and
+// within a section top to bottom, then left to right.
+func focusOrder(sections []renderedSection) (ids []string) {
+	for _, r := range sections {
+		fs := slices.Clone(r.focusables)
+		sort.SliceStable(fs, func(a, b int) bool {
+			if fs[a].OffsetY != fs[b].OffsetY {
+				return fs[a].OffsetY < fs[b].OffsetY
+			}
+			return fs[a].OffsetX < fs[b].OffsetX
+		})
+		for _, f := range fs {
+			ids = append(ids, f.ID)
 		}
 	}
-
-	return rendered, focusIDs
+	return ids
 }
 
 // buildLayout renders all sections, measures heights, and registers hit regions.
@@ -128,6 +152,9 @@ func (m *Modal) buildLayout(screenW, screenH int, handler *mouse.Handler) string
 		padToHeight = false
 	}
 	m.lastViewportH = viewportHeight
+	if m.pendingFocus != "" {
+		m.applyPendingFocus()
+	}
 
 	// Clamp scroll offset
 	maxScroll := max(0, actualContentHeight-viewportHeight)
@@ -188,8 +215,16 @@ func (m *Modal) buildLayout(screenW, screenH int, handler *mouse.Handler) string
 		}
 
 		// Register focusable elements with measured positions
+		m.scrollRegions = m.scrollRegions[:0]
 		sectionStartY := 0
 		for _, r := range visible {
+			if sc, ok := r.section.(Scrollable); ok {
+				top := max(contentY+sectionStartY-m.scrollOffset, contentY)
+				bottom := min(contentY+sectionStartY+r.height-m.scrollOffset, contentY+viewportHeight)
+				if bottom > top {
+					m.scrollRegions = append(m.scrollRegions, scrollRegion{sc, contentX, top, contentWidth, bottom - top})
+				}
+			}
 
 			for _, f := range r.focusables {
 				// Calculate absolute position
@@ -19,26 +19,35 @@ type ListItem struct {
 // ListOption is a functional option for List sections.
 type ListOption func(*listSection)
 
+// ListItemRenderer renders one item's line for WithItemRenderer. index is
+// the item's position in the list, row its line within the visible window,
+// and width the content width available.
+type ListItemRenderer func(item ListItem, index, row, width int) string
+
 // listSection renders a scrollable list of items.
 type listSection struct {
 	id           string
 	items        []ListItem
-	selectedIdx  *int // Pointer to allow external control
-	maxVisible   int  // Maximum number of visible items
-	scrollOffset int  // Current scroll position
-	singleFocus  bool // If true, register as single focusable (Tab skips between sections, j/k changes selection)
+	selectedIdx  *int             // Pointer to allow external control
+	maxVisible   int              // Maximum number of visible items
+	scrollOffset *int             // Current scroll position; owned by the list unless WithScrollOffset
+	singleFocus  bool             // If true, register as single focusable (Tab skips between sections, j/k changes selection)
+	renderItem   ListItemRenderer // Custom line rendering (nil = cursor + label)
+	lastSelected int              // Selection at the last render; the window follows it only when it changes
 }
 
 // List creates a list section with selectable items.
 // selectedIdx is a pointer to the currently selected index (can be nil for no selection).
 func List(id string, items []ListItem, selectedIdx *int, opts ...ListOption) Section {
 	s := &listSection{
-		id:          id,
-		items:       items,
-		selectedIdx: selectedIdx,
-		maxVisible:  5,    // Default
-		singleFocus: true, // Default: Tab skips between sections, j/k changes selection
+		id:           id,
+		items:        items,
+		selectedIdx:  selectedIdx,
+		maxVisible:   5,    // Default
+		singleFocus:  true, // Default: Tab skips between sections, j/k changes selection
+		lastSelected: -1,
 	}
+	s.scrollOffset = new(int)
 	for _, opt := range opts {
 		opt(s)
 	}
@@ -72,28 +81,58 @@ func WithPerItemFocus() ListOption {
 	}
 }
 
+// WithScrollOffset stores the list's scroll position in offset, so it
+// survives rebuilding the modal and callers can read which items are shown.
+func WithScrollOffset(offset *int) ListOption {
+	return func(s *listSection) {
+		if offset != nil {
+			s.scrollOffset = offset
+		}
+	}
+}
+
+// WithItemRenderer renders each item's line with fn instead of the default
+// cursor and label. The list still handles scrolling, hit regions, and
+// selection and hover styling.
+func WithItemRenderer(fn ListItemRenderer) ListOption {
+	return func(s *listSection) {
+		s.renderItem = fn
+	}
+}
+
+// listScrollOffset returns the first visible item for a window of visible
+// items out of total: offset moved just enough to show selected (negative
+// for none), then clamped so the window stays full.
+func listScrollOffset(offset, selected, visible, total int) int {
+	if selected >= 0 {
+		if selected < offset {
+			offset = selected
+		} else if selected >= offset+visible {
+			offset = selected - visible + 1
+		}
+	}
+	return clamp(offset, 0, max(0, total-visible))
+}
+
 func (s *listSection) Render(contentWidth int, focusID, hoverID string) RenderedSection {
 	if len(s.items) == 0 {
 		return RenderedSection{Content: styles.Muted.Render("(no items)")}
 	}
 
-	// Determine visible range
+	// Determine visible range, scrolling to a newly selected item. An
+	// unchanged selection may be scrolled away from with the mouse wheel.
 	visibleCount := min(s.maxVisible, len(s.items))
-	selectedIdx := 0
+	selectedIdx := -1
 	if s.selectedIdx != nil {
 		selectedIdx = *s.selectedIdx
 	}
-
-	// Adjust scroll to keep selection visible
-	if selectedIdx < s.scrollOffset {
-		s.scrollOffset = selectedIdx
-	} else if selectedIdx >= s.scrollOffset+visibleCount {
-		s.scrollOffset = selectedIdx - visibleCount + 1
+	follow := selectedIdx
+	if selectedIdx == s.lastSelected {
+		follow = -1
 	}
-
-	// Clamp scroll offset
-	maxScroll := max(0, len(s.items)-visibleCount)
-	s.scrollOffset = clamp(s.scrollOffset, 0, maxScroll)
+	s.lastSelected = selectedIdx
+	*s.scrollOffset = listScrollOffset(*s.scrollOffset, follow, visibleCount, len(s.items))
+	scrollOffset := *s.scrollOffset
 
 	// In singleFocus mode, check if the list itself has focus
 	listHasFocus := s.singleFocus && focusID == s.id
@@ -102,7 +141,7 @@ func (s *listSection) Render(contentWidth int, focusID, hoverID string) Rendered
 	focusables := make([]FocusableInfo, 0, visibleCount)
 
 	for i := 0; i < visibleCount; i++ {
-		itemIdx := s.scrollOffset + i
+		itemIdx := scrollOffset + i
 		if itemIdx >= len(s.items) {
 			break
 		}
@@ -121,18 +160,22 @@ func (s *listSection) Render(contentWidth int, focusID, hoverID string) Rendered
 			style = styles.ListItemNormal
 		}
 
-		// Render cursor - show when selected, or when list has focus and this is selected item
-		cursor := "  "
-		if isSelected {
-			if listHasFocus {
-				cursor = styles.ListCursor.Render("▸ ") // Filled cursor when list has focus
-			} else {
-				cursor = styles.ListCursor.Render("> ")
+		// Render item
+		var line string
+		if s.renderItem != nil {
+			line = style.Render(s.renderItem(item, itemIdx, i, contentWidth))
+		} else {
+			// Render cursor - show when selected, or when list has focus and this is selected item
+			cursor := "  "
+			if isSelected {
+				if listHasFocus {
+					cursor = styles.ListCursor.Render("▸ ") // Filled cursor when list has focus
+				} else {
+					cursor = styles.ListCursor.Render("> ")
+				}
 			}
+			line = cursor + style.Render(item.Label)
 		}
-
-		// Render item
-		line := cursor + style.Render(item.Label)
 		if i > 0 {
 			sb.WriteString("\n")
 		}
@@ -163,7 +206,7 @@ func (s *listSection) Render(contentWidth int, focusID, hoverID string) Rendered
 
 	// Show scroll indicators if needed
 	content := sb.String()
-	hasTopIndicator := s.scrollOffset > 0
+	hasTopIndicator := scrollOffset > 0
 	if hasTopIndicator {
 		content = styles.Muted.Render("\u2191 more above") + "\n" + content
 		// Adjust focusable offsets since we prepended a line
@@ -171,7 +214,7 @@ func (s *listSection) Render(contentWidth int, focusID, hoverID string) Rendered
 			focusables[i].OffsetY++
 		}
 	}
-	if s.scrollOffset+visibleCount < len(s.items) {
+	if scrollOffset+visibleCount < len(s.items) {
 		content = content + "\n" + styles.Muted.Render("\u2193 more below")
 	}
 
@@ -181,6 +224,18 @@ func (s *listSection) Render(contentWidth int, focusID, hoverID string) Rendered
 	}
 }
 
+// ScrollBy moves the visible window by delta items without changing the
+// selection.
+func (s *listSection) ScrollBy(delta int) bool {
+	visibleCount := min(s.maxVisible, len(s.items))
+	next := clamp(*s.scrollOffset+delta, 0, max(0, len(s.items)-visibleCount))
+	if next == *s.scrollOffset {
+		return false
+	}
+	*s.scrollOffset = next
+	return true
+}
+
 func (s *listSection) Update(msg tea.Msg, focusID string) (string, tea.Cmd) {
 	// Check if the list or any of its items are focused
 	isFocused := false
@@ -240,4 +295,3 @@ func (s *listSection) Update(msg tea.Msg, focusID string) (string, tea.Cmd) {
 
 	return "", nil
 }
-
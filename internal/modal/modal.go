@@ -1,6 +1,8 @@
 package modal
 
 import (
+	"slices"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/marcus/sidecar/internal/mouse"
 )
@@ -20,11 +22,13 @@ type Modal struct {
 	focusIdx     int      // Current focused element index in focusIDs
 	hoverID      string   // Currently hovered element ID
 	focusIDs     []string // Ordered list of focusable IDs (built during Render)
+	pendingFocus string   // SetFocus target applied at the next Render
 	scrollOffset int      // Content scroll position in lines
 
 	// Focus-scroll tracking (cached during buildLayout)
 	focusPositions map[string]focusablePos // Absolute Y positions of focusable elements
 	lastViewportH  int                     // Viewport height from last render
+	scrollRegions  []scrollRegion          // Scrollable sections on screen, for the mouse wheel
 }
 
 // focusablePos records the absolute position of a focusable element within the full content.
@@ -145,15 +149,14 @@ func (m *Modal) HandleMouse(msg tea.MouseMsg, handler *mouse.Handler) string {
 		return ""
 
 	case mouse.ActionScrollUp:
-		if action.Region != nil && action.Region.ID == "modal-body" {
-			m.scrollOffset = max(0, m.scrollOffset-3)
+		if action.Region != nil && action.Region.ID != "modal-backdrop" {
+			m.scrollAt(action.X, action.Y, -3)
 		}
 		return ""
 
 	case mouse.ActionScrollDown:
-		if action.Region != nil && action.Region.ID == "modal-body" {
-			m.scrollOffset += 3
-			// Clamping happens in buildLayout
+		if action.Region != nil && action.Region.ID != "modal-backdrop" {
+			m.scrollAt(action.X, action.Y, 3)
 		}
 		return ""
 	}
@@ -161,6 +164,27 @@ func (m *Modal) HandleMouse(msg tea.MouseMsg, handler *mouse.Handler) string {
 	return ""
 }
 
+// scrollableAt returns the scrollable section drawn at screen position
+// (x, y) in the last render, or nil.
+func (m *Modal) scrollableAt(x, y int) Scrollable {
+	for _, r := range m.scrollRegions {
+		if x >= r.x && x < r.x+r.width && y >= r.y && y < r.y+r.height {
+			return r.section
+		}
+	}
+	return nil
+}
+
+// scrollAt scrolls the section under (x, y) by delta lines, or the modal's
+// content when that section has nowhere further to go.
+func (m *Modal) scrollAt(x, y, delta int) {
+	if s := m.scrollableAt(x, y); s != nil && s.ScrollBy(delta) {
+		return
+	}
+	// Clamping past the bottom happens in buildLayout
+	m.scrollOffset = max(0, m.scrollOffset+delta)
+}
+
 // ScrollBy adjusts the scroll offset by delta lines (positive = down, negative = up).
 // Clamping to valid range happens in buildLayout.
 func (m *Modal) ScrollBy(delta int) { m.scrollOffset += delta }
@@ -172,15 +196,30 @@ func (m *Modal) ScrollToTop() { m.scrollOffset = 0 }
 // The offset is clamped to the actual max in buildLayout.
 func (m *Modal) ScrollToBottom() { m.scrollOffset = 999999 }
 
-// SetFocus sets focus to a specific element by ID.
+// SetFocus sets focus to a specific element by ID. On a modal that hasn't
+// rendered yet, or whose element hasn't appeared, focus moves at the next
+// Render.
 func (m *Modal) SetFocus(id string) {
 	for i, fid := range m.focusIDs {
 		if fid == id {
 			m.focusIdx = i
+			m.pendingFocus = ""
 			m.scrollToFocused()
 			return
 		}
 	}
+	m.pendingFocus = id
+}
+
+// applyPendingFocus focuses the element requested by SetFocus before the
+// modal was rendered. Unknown IDs are dropped.
+func (m *Modal) applyPendingFocus() {
+	id := m.pendingFocus
+	m.pendingFocus = ""
+	if i := slices.Index(m.focusIDs, id); i >= 0 {
+		m.focusIdx = i
+		m.scrollToFocused()
+	}
 }
 
 // FocusedID returns the currently focused element ID.
@@ -1,6 +1,7 @@
 package modal
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -263,6 +264,54 @@ func TestHandleKeyTab(t *testing.T) {
 	}
 }
 
+func TestFocusOrder(t *testing.T) {
+	sections := []renderedSection{
+		{focusables: []FocusableInfo{{ID: "input"}}},
+		{content: "text only"},
+		{focusables: []FocusableInfo{
+			{ID: "row-2", OffsetY: 2},
+			{ID: "row-0", OffsetY: 0},
+			{ID: "row-1", OffsetY: 1},
+		}},
+		{focusables: []FocusableInfo{
+			{ID: "cancel", OffsetX: 12},
+			{ID: "ok", OffsetX: 0},
+		}},
+	}
+
+	ids := focusOrder(sections)
+	if want := "[input row-0 row-1 row-2 ok cancel]"; fmt.Sprint(ids) != want {
+		t.Errorf("focus order = %v, want %s", ids, want)
+	}
+	if sections[2].focusables[0].ID != "row-2" {
+		t.Error("focusOrder should not reorder the section's focusables")
+	}
+}
+
+func TestSetFocusBeforeRender(t *testing.T) {
+	selectedIdx := 0
+	items := []ListItem{{ID: "a", Label: "A"}, {ID: "b", Label: "B"}}
+	m := New("Test").
+		AddSection(Checkbox("first", "First", new(bool))).
+		AddSection(List("items", items, &selectedIdx, WithPerItemFocus())).
+		AddSection(Buttons(Btn(" OK ", "ok"), Btn(" Cancel ", "cancel")))
+
+	// SetFocus before the first render is applied by Render
+	m.SetFocus("cancel")
+	m.Render(80, 24, nil)
+	if m.FocusedID() != "cancel" {
+		t.Fatalf("focus = %q, want cancel", m.FocusedID())
+	}
+
+	// Focus order runs section by section; Tab from the last wraps to the first
+	for _, want := range []string{"first", "a", "b", "ok"} {
+		m.HandleKey(tea.KeyMsg{Type: tea.KeyTab})
+		if m.FocusedID() != want {
+			t.Errorf("focus after tab = %q, want %q", m.FocusedID(), want)
+		}
+	}
+}
+
 func TestHandleKeyEnter(t *testing.T) {
 	m := New("Test").
 		AddSection(Buttons(
@@ -440,6 +489,79 @@ func TestMouseScrollModal(t *testing.T) {
 	}
 }
 
+func TestMouseScrollSection(t *testing.T) {
+	items := make([]ListItem, 12)
+	for i := range items {
+		items[i] = ListItem{ID: fmt.Sprintf("row-%d", i), Label: fmt.Sprintf("Row %d", i)}
+	}
+	selectedIdx := 0
+	listOffset := 0
+	m := New("Test", WithWidth(40)).
+		AddSection(Text("Intro 1\nIntro 2\nIntro 3")).
+		AddSection(List("rows", items, &selectedIdx, WithMaxVisible(3), WithScrollOffset(&listOffset), WithPerItemFocus())).
+		AddSection(Text("Outro 1\nOutro 2\nOutro 3"))
+
+	handler := mouse.NewHandler()
+	m.Render(80, 16, handler)
+
+	wheel := func(button tea.MouseButton, x, y int) {
+		m.HandleMouse(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: button}, handler)
+		m.Render(80, 16, handler)
+	}
+	rowAt := func(id string) (int, int) {
+		for _, r := range handler.HitMap.Regions() {
+			if r.ID == id {
+				return r.Rect.X, r.Rect.Y
+			}
+		}
+		t.Fatalf("no hit region for %s", id)
+		return 0, 0
+	}
+
+	x, y := rowAt("row-0")
+	if m.scrollableAt(x, y) == nil {
+		t.Fatal("list rows should map to the list section")
+	}
+	if m.scrollableAt(x, 0) != nil {
+		t.Error("points outside the list should not map to it")
+	}
+
+	// The wheel over the list scrolls the list, not the modal
+	wheel(tea.MouseButtonWheelDown, x, y)
+	if listOffset != 3 || m.scrollOffset != 0 {
+		t.Errorf("after wheel over list: list=%d modal=%d, want 3 and 0", listOffset, m.scrollOffset)
+	}
+	if selectedIdx != 0 {
+		t.Errorf("wheel should not change the selection, got %d", selectedIdx)
+	}
+
+	// The list clamps at the bottom, then the modal takes over
+	for range 4 {
+		x, y = rowAt(fmt.Sprintf("row-%d", listOffset))
+		wheel(tea.MouseButtonWheelDown, x, y)
+	}
+	if listOffset != 9 {
+		t.Errorf("list offset = %d, want clamped at 9", listOffset)
+	}
+	if m.scrollOffset == 0 {
+		t.Error("modal should scroll once the list reaches its end")
+	}
+
+	// Back up over the list, then the wheel over plain text scrolls the modal
+	m.scrollOffset = 0
+	m.Render(80, 16, handler)
+	x, y = rowAt("row-9")
+	wheel(tea.MouseButtonWheelUp, x, y)
+	if listOffset != 6 {
+		t.Errorf("wheel up over list: offset = %d, want 6", listOffset)
+	}
+	_, textY := rowAt("row-6")
+	wheel(tea.MouseButtonWheelDown, x, textY+5)
+	if listOffset != 6 || m.scrollOffset == 0 {
+		t.Errorf("wheel below list: list=%d modal=%d, want list unchanged and modal scrolled", listOffset, m.scrollOffset)
+	}
+}
+
 func TestInputSection(t *testing.T) {
 	ti := textinput.New()
 	ti.Placeholder = "Enter name"
@@ -494,6 +616,78 @@ func TestListSection(t *testing.T) {
 	}
 }
 
+func TestListScrollOffset(t *testing.T) {
+	tests := []struct {
+		name                             string
+		offset, selected, visible, total int
+		want                             int
+	}{
+		{"selection in window", 2, 4, 5, 20, 2},
+		{"selection above window", 6, 3, 5, 20, 3},
+		{"selection below window", 0, 7, 5, 20, 3},
+		{"no selection keeps offset", 8, -1, 5, 20, 8},
+		{"offset past end", 18, -1, 5, 20, 15},
+		{"negative offset", -2, -1, 5, 20, 0},
+		{"fewer items than window", 3, 1, 5, 4, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := listScrollOffset(tt.offset, tt.selected, tt.visible, tt.total)
+			if got != tt.want {
+				t.Errorf("listScrollOffset(%d, %d, %d, %d) = %d, want %d",
+					tt.offset, tt.selected, tt.visible, tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListSectionItemRenderer(t *testing.T) {
+	items := make([]ListItem, 12)
+	for i := range items {
+		items[i] = ListItem{ID: fmt.Sprintf("row-%d", i), Label: fmt.Sprintf("Row %d", i)}
+	}
+	selectedIdx := -1
+	offset := 4
+	var rows []int
+	s := List("rows", items, &selectedIdx,
+		WithMaxVisible(3),
+		WithScrollOffset(&offset),
+		WithPerItemFocus(),
+		WithItemRenderer(func(item ListItem, index, row, width int) string {
+			rows = append(rows, row)
+			return fmt.Sprintf("#%d %s", index, item.Label)
+		}),
+	)
+
+	res := s.Render(40, "", "")
+	if !strings.Contains(res.Content, "#4 Row 4") || !strings.Contains(res.Content, "#6 Row 6") {
+		t.Errorf("expected rows 4-6 from the caller's offset, got %q", res.Content)
+	}
+	if strings.Contains(res.Content, "> ") {
+		t.Error("custom rendering should not add the cursor column")
+	}
+	if fmt.Sprint(rows) != "[0 1 2]" {
+		t.Errorf("renderer rows = %v, want [0 1 2]", rows)
+	}
+
+	// Items sit below the "more above" indicator
+	if len(res.Focusables) != 3 {
+		t.Fatalf("expected 3 focusables, got %d", len(res.Focusables))
+	}
+	for i, f := range res.Focusables {
+		if f.ID != fmt.Sprintf("row-%d", 4+i) || f.OffsetY != i+1 {
+			t.Errorf("focusable %d = %s at y=%d, want row-%d at y=%d", i, f.ID, f.OffsetY, 4+i, i+1)
+		}
+	}
+
+	// Selecting past the window scrolls it and updates the caller's offset
+	selectedIdx = 10
+	s.Render(40, "", "")
+	if offset != 8 {
+		t.Errorf("offset after selecting row 10 = %d, want 8", offset)
+	}
+}
+
 func TestHitRegionAccuracy(t *testing.T) {
 	m := New("Test Modal", WithWidth(50)).
 		AddSection(Text("Some text")).
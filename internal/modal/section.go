@@ -22,6 +22,14 @@ type Section interface {
 	Update(msg tea.Msg, focusID string) (action string, cmd tea.Cmd)
 }
 
+// Scrollable is implemented by sections that scroll their own content, such
+// as List. The mouse wheel over such a section scrolls it instead of the
+// modal. ScrollBy moves the content by delta lines and reports whether it
+// moved; at either end it returns false and the modal scrolls instead.
+type Scrollable interface {
+	ScrollBy(delta int) bool
+}
+
 // RenderedSection is the result of rendering a section.
 type RenderedSection struct {
 	Content    string          // Rendered string content
@@ -105,6 +113,14 @@ func (w *whenSection) Update(msg tea.Msg, focusID string) (string, tea.Cmd) {
 	return w.inner.Update(msg, focusID)
 }
 
+// ScrollBy scrolls the inner section when it is shown and scrollable.
+func (w *whenSection) ScrollBy(delta int) bool {
+	if inner, ok := w.inner.(Scrollable); ok && w.condition() {
+		return inner.ScrollBy(delta)
+	}
+	return false
+}
+
 // --- Custom Section ---
 
 // customSection allows escape-hatch for complex custom content.
@@ -22,3 +22,9 @@ func ShowToast(message string, duration time.Duration) tea.Cmd {
 		}
 	}
 }
+
+// NavigateToFileMsg asks the file browser to reveal and preview a file.
+// Plugins that can't import the file browser send this instead.
+type NavigateToFileMsg struct {
+	Path string // Relative path from workdir
+}
@@ -84,6 +84,7 @@ func (m *Model) handleMouseDoubleClick(action mouse.MouseAction) (Model, tea.Cmd
 				return CommandSelectedMsg{
 					CommandID: entry.CommandID,
 					Context:   entry.Context,
+					Key:       entry.Key,
 				}
 			}
 		}
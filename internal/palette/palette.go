@@ -12,6 +12,7 @@ import (
 type CommandSelectedMsg struct {
 	CommandID string
 	Context   string
+	Key       string // Key bound to the command in Context
 }
 
 // Model is the command palette state.
@@ -170,6 +171,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 					return CommandSelectedMsg{
 						CommandID: entry.CommandID,
 						Context:   entry.Context,
+						Key:       entry.Key,
 					}
 				}
 			}
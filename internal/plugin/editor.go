@@ -0,0 +1,103 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// fallbackEditors are tried in order when neither $EDITOR nor $VISUAL is set.
+var fallbackEditors = []string{"vim", "vi", "nano"}
+
+// DefaultEditor returns the user's editor: $EDITOR, then $VISUAL, then the
+// first of vim, vi or nano found on PATH.
+func DefaultEditor() string {
+	for _, env := range []string{"EDITOR", "VISUAL"} {
+		if editor := strings.TrimSpace(os.Getenv(env)); editor != "" {
+			return editor
+		}
+	}
+	for _, editor := range fallbackEditors {
+		if _, err := exec.LookPath(editor); err == nil {
+			return editor
+		}
+	}
+	return fallbackEditors[0]
+}
+
+// EditorArgs builds the argv that opens path in editor at lineNo (1-based,
+// 0 = start of file). The editor string may carry its own flags, e.g.
+// "code --wait", and is split like a shell would, so quoted paths with
+// spaces survive. Most editors take +N for the line; VS Code and Sublime
+// take path:N instead.
+func EditorArgs(editor, path string, lineNo int) []string {
+	args := splitEditorCommand(editor)
+	if len(args) == 0 {
+		args = splitEditorCommand(DefaultEditor())
+	}
+	if lineNo <= 0 {
+		return append(args, path)
+	}
+
+	switch strings.TrimSuffix(filepath.Base(args[0]), ".exe") {
+	case "code", "code-insiders", "codium", "cursor":
+		return append(args, "--goto", fmt.Sprintf("%s:%d", path, lineNo))
+	case "subl":
+		return append(args, fmt.Sprintf("%s:%d", path, lineNo))
+	}
+	return append(args, fmt.Sprintf("+%d", lineNo), path)
+}
+
+// splitEditorCommand splits an $EDITOR value into words using POSIX shell
+// quoting: single quotes are literal, double quotes allow \" and \\, and a
+// backslash outside quotes escapes the next character.
+func splitEditorCommand(s string) []string {
+	var (
+		words   []string
+		cur     strings.Builder
+		inWord  bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range s {
+		switch {
+		case escaped:
+			if quote == '"' && r != '"' && r != '\\' {
+				cur.WriteRune('\\')
+			}
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+	if inWord {
+		words = append(words, cur.String())
+	}
+	return words
+}
+
+// EditorCommand returns a command that opens path in editor at lineNo.
+func EditorCommand(editor, path string, lineNo int) *exec.Cmd {
+	args := EditorArgs(editor, path, lineNo)
+	return exec.Command(args[0], args[1:]...)
+}
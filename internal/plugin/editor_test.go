@@ -0,0 +1,68 @@
+package plugin
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditorArgs(t *testing.T) {
+	const path = "/repo/src/main.go"
+	tests := []struct {
+		name   string
+		editor string
+		lineNo int
+		want   []string
+	}{
+		{"plain", "vim", 0, []string{"vim", path}},
+		{"line", "nvim", 42, []string{"nvim", "+42", path}},
+		{"editor path", "/usr/local/bin/hx", 3, []string{"/usr/local/bin/hx", "+3", path}},
+		{"editor flags", "emacsclient -t", 0, []string{"emacsclient", "-t", path}},
+		{"editor flags and line", "emacsclient  -t ", 7, []string{"emacsclient", "-t", "+7", path}},
+		{"vscode", "code --wait", 10, []string{"code", "--wait", "--goto", path + ":10"}},
+		{"vscode no line", "code --wait", 0, []string{"code", "--wait", path}},
+		{"sublime", "subl -w", 5, []string{"subl", "-w", path + ":5"}},
+		{"single-quoted path", "'/Applications/My Editor/edit' --wait", 0, []string{"/Applications/My Editor/edit", "--wait", path}},
+		{"double-quoted path", `"/opt/my editor/bin/code" --wait`, 2, []string{"/opt/my editor/bin/code", "--wait", "--goto", path + ":2"}},
+		{"escaped space", `/opt/my\ editor/vim`, 4, []string{"/opt/my editor/vim", "+4", path}},
+		{"quoted flag value", `emacsclient -a ""`, 0, []string{"emacsclient", "-a", "", path}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EditorArgs(tt.editor, path, tt.lineNo); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EditorArgs(%q, %d) = %q, want %q", tt.editor, tt.lineNo, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEditorCommand(t *testing.T) {
+	cmd := EditorCommand("code --wait", "/tmp/a b.txt", 0)
+	if want := []string{"code", "--wait", "/tmp/a b.txt"}; !reflect.DeepEqual(cmd.Args, want) {
+		t.Errorf("Args = %q, want %q", cmd.Args, want)
+	}
+}
+
+func TestDefaultEditor(t *testing.T) {
+	t.Setenv("EDITOR", "nano -w")
+	t.Setenv("VISUAL", "code")
+	if got := DefaultEditor(); got != "nano -w" {
+		t.Errorf("DefaultEditor() = %q, want $EDITOR", got)
+	}
+
+	t.Setenv("EDITOR", "  ")
+	if got := DefaultEditor(); got != "code" {
+		t.Errorf("DefaultEditor() = %q, want $VISUAL", got)
+	}
+
+	// Nothing set and nothing on PATH still yields a usable command
+	t.Setenv("VISUAL", "")
+	t.Setenv("PATH", t.TempDir())
+	if got := DefaultEditor(); got != "vim" {
+		t.Errorf("DefaultEditor() = %q, want vim fallback", got)
+	}
+
+	// An empty editor string falls back too
+	if got := EditorArgs("", "f.txt", 0); !reflect.DeepEqual(got, []string{"vim", "f.txt"}) {
+		t.Errorf("EditorArgs(\"\") = %q", got)
+	}
+}
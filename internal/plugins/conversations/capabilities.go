@@ -0,0 +1,47 @@
+package conversations
+
+import (
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/plugin"
+)
+
+// missingCapability reports whether the adapter behind a session is known
+// not to support cap, returning the adapter's name for the UI note. Sessions
+// without a known adapter report false so their own errors surface instead.
+func (p *Plugin) missingCapability(sessionID string, cap adapter.Capability) (string, bool) {
+	a := p.adapterForSession(sessionID)
+	if a == nil || a.Capabilities().Supports(cap) {
+		return "", false
+	}
+	return a.Name(), true
+}
+
+// unsupportedNote formats the note shown in place of a feature the session's
+// adapter lacks, or "" when the feature is supported.
+func (p *Plugin) unsupportedNote(sessionID string, cap adapter.Capability, feature string) string {
+	name, missing := p.missingCapability(sessionID, cap)
+	if !missing {
+		return ""
+	}
+	return feature + " not supported by " + name
+}
+
+// capabilityCommands maps commands to the adapter capability they need.
+var capabilityCommands = map[string]adapter.Capability{
+	"usage": adapter.CapUsage,
+}
+
+// filterUnsupportedCommands drops commands the selected session's adapter
+// cannot serve.
+func (p *Plugin) filterUnsupportedCommands(cmds []plugin.Command) []plugin.Command {
+	filtered := cmds[:0]
+	for _, cmd := range cmds {
+		if c, ok := capabilityCommands[cmd.ID]; ok {
+			if _, missing := p.missingCapability(p.selectedSession, c); missing {
+				continue
+			}
+		}
+		filtered = append(filtered, cmd)
+	}
+	return filtered
+}
@@ -0,0 +1,101 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+// capsAdapter is a mock adapter with a declared capability set.
+type capsAdapter struct {
+	sessionsAdapter
+	caps adapter.CapabilitySet
+}
+
+func (a *capsAdapter) Capabilities() adapter.CapabilitySet { return a.caps }
+
+func newCapsPlugin(caps adapter.CapabilitySet) *Plugin {
+	a := &capsAdapter{caps: caps}
+	a.id = "mock"
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": a}
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "mock"}}
+	p.selectedSession = "s1"
+	p.activePane = PaneMessages
+	return p
+}
+
+func hasCommand(p *Plugin, id string) bool {
+	for _, cmd := range p.Commands() {
+		if cmd.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestCapabilityGating(t *testing.T) {
+	all := adapter.CapabilitySet{
+		adapter.CapSessions: true,
+		adapter.CapMessages: true,
+		adapter.CapUsage:    true,
+		adapter.CapWatch:    true,
+	}
+	noUsage := adapter.CapabilitySet{
+		adapter.CapSessions: true,
+		adapter.CapMessages: true,
+		adapter.CapWatch:    true,
+	}
+	noWatch := adapter.CapabilitySet{
+		adapter.CapSessions: true,
+		adapter.CapMessages: true,
+		adapter.CapUsage:    true,
+		adapter.CapWatch:    false,
+	}
+
+	tests := []struct {
+		name      string
+		caps      adapter.CapabilitySet
+		wantUsage bool
+		wantLive  bool
+	}{
+		{"all capabilities", all, true, true},
+		{"undeclared capabilities", nil, true, true},
+		{"no usage", noUsage, false, true},
+		{"no watch", noWatch, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newCapsPlugin(tt.caps)
+
+			if got := hasCommand(p, "usage"); got != tt.wantUsage {
+				t.Errorf("usage command shown = %v, want %v", got, tt.wantUsage)
+			}
+			if !hasCommand(p, "search-messages") {
+				t.Error("commands without a capability should always be shown")
+			}
+
+			p.openUsageModal()
+			if p.showUsageModal != tt.wantUsage {
+				t.Errorf("usage modal opened = %v, want %v", p.showUsageModal, tt.wantUsage)
+			}
+
+			note := p.unsupportedNote("s1", adapter.CapWatch, "Live tail")
+			if (note == "") != tt.wantLive {
+				t.Errorf("live tail note = %q, want supported = %v", note, tt.wantLive)
+			}
+			if !tt.wantLive && !strings.Contains(note, "not supported by mock agent") {
+				t.Errorf("note %q should name the adapter", note)
+			}
+		})
+	}
+}
+
+func TestMissingCapability_UnknownAdapter(t *testing.T) {
+	p := newCapsPlugin(adapter.CapabilitySet{})
+	p.sessions = []adapter.Session{{ID: "s2"}}
+	if _, missing := p.missingCapability("s2", adapter.CapUsage); missing {
+		t.Error("sessions without an adapter should not report a missing capability")
+	}
+}
@@ -10,7 +10,8 @@ import (
 const (
 	defaultCoalesceWindow = 250 * time.Millisecond
 	maxCoalesceWindow     = 5 * time.Second
-	maxPendingSessionIDs  = 10 // Above this, trigger full refresh
+	maxPendingSessionIDs  = 10              // Above this, trigger full refresh
+	maxBatchDelay         = 1 * time.Second // Longest a streaming batch waits for a quiet period
 
 	// sizeScaleFactor determines how much each 100MB adds to the debounce window
 	sizeScaleFactor = 100 * 1024 * 1024 // 100MB
@@ -20,16 +21,22 @@ const (
 // EventCoalescer batches rapid watch events into single refreshes.
 // When events arrive faster than the coalesce window, they are
 // accumulated and a single refresh is triggered after the window closes.
+// A steady stream is still flushed every maxDelay, and a session-ended
+// event flushes the batch at once.
 // td-190095: Uses dynamic window based on largest pending session's size.
 type EventCoalescer struct {
 	mu             sync.Mutex
 	pendingIDs     map[string]struct{} // SessionIDs to refresh
+	pendingOrder   []string            // pendingIDs in arrival order
 	refreshAll     bool                // true if we need full refresh (empty ID received)
 	timer          *time.Timer
 	coalesceWindow time.Duration
+	maxDelay       time.Duration              // cap on how long a batch is held open
+	batchStart     time.Time                  // when the first event of the batch arrived
 	msgChan        chan<- CoalescedRefreshMsg // channel to send messages
 	closed         bool                       // true after Stop() called, prevents send on closed channel
 	pendingEpoch   uint64                     // Epoch from first event in batch (for stale detection)
+	openSession    string                     // Session shown in the message view; never skipped as huge
 
 	// Session size tracking for dynamic debounce (td-190095)
 	sessionSizes map[string]int64
@@ -46,6 +53,7 @@ func NewEventCoalescer(window time.Duration, msgChan chan<- CoalescedRefreshMsg)
 		pendingIDs:     make(map[string]struct{}),
 		sessionSizes:   make(map[string]int64),
 		coalesceWindow: window,
+		maxDelay:       maxBatchDelay,
 		msgChan:        msgChan,
 	}
 }
@@ -54,26 +62,45 @@ func NewEventCoalescer(window time.Duration, msgChan chan<- CoalescedRefreshMsg)
 // Uses dynamic window based on largest pending session (td-190095).
 // The epoch parameter tracks the project context for stale detection.
 func (c *EventCoalescer) Add(sessionID string, epoch uint64) {
+	c.AddEvent(adapter.Event{Type: adapter.EventSessionUpdated, SessionID: sessionID}, epoch)
+}
+
+// AddEvent queues a watch event for refresh like Add. A session-ended
+// event flushes the batch immediately, after the events queued before it.
+func (c *EventCoalescer) AddEvent(evt adapter.Event, epoch uint64) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	// Store epoch from first event in batch (subsequent events in same batch use this)
 	if len(c.pendingIDs) == 0 && !c.refreshAll {
 		c.pendingEpoch = epoch
+		c.batchStart = time.Now()
 	}
 
-	if sessionID == "" {
+	ended := evt.Type == adapter.EventSessionEnded
+	if evt.SessionID == "" {
 		c.refreshAll = true
-	} else {
-		// Skip auto-reload for huge sessions (td-190095)
-		if !c.shouldAutoReloadLocked(sessionID) {
-			return
+	} else if evt.SessionID == c.openSession || c.shouldAutoReloadLocked(evt.SessionID) {
+		if _, ok := c.pendingIDs[evt.SessionID]; !ok {
+			c.pendingIDs[evt.SessionID] = struct{}{}
+			c.pendingOrder = append(c.pendingOrder, evt.SessionID)
 		}
-		c.pendingIDs[sessionID] = struct{}{}
+	} else if !ended {
+		// Skip auto-reload for huge sessions (td-190095)
+		return
 	}
 
-	// Compute dynamic window based on largest pending session
+	if ended {
+		c.flushLocked()
+		return
+	}
+
+	// Compute dynamic window based on largest pending session, but don't
+	// hold the batch open past its deadline while events keep arriving
 	window := c.maxWindowForPendingLocked()
+	if remaining := time.Until(c.batchStart.Add(max(window, c.maxDelay))); remaining < window {
+		window = max(remaining, 0)
+	}
 
 	// Reset timer - we wait for a quiet period
 	if c.timer != nil {
@@ -82,6 +109,15 @@ func (c *EventCoalescer) Add(sessionID string, epoch uint64) {
 	c.timer = time.AfterFunc(window, c.flush)
 }
 
+// SetOpenSession records the session whose messages are on screen. Its
+// events are always batched, even when the session is too large for
+// auto-reload, so the open conversation keeps updating.
+func (c *EventCoalescer) SetOpenSession(sessionID string) {
+	c.mu.Lock()
+	c.openSession = sessionID
+	c.mu.Unlock()
+}
+
 // UpdateSessionSize records the file size for a session (td-190095).
 // Call this after loading sessions to enable dynamic debounce.
 func (c *EventCoalescer) UpdateSessionSize(sessionID string, size int64) {
@@ -146,25 +182,34 @@ func (c *EventCoalescer) maxWindowForPendingLocked() time.Duration {
 func (c *EventCoalescer) flush() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	c.flushLocked()
+}
 
+// flushLocked sends the pending batch, if any. Requires mu held.
+func (c *EventCoalescer) flushLocked() {
 	// Check if stopped (channel may be closed)
 	if c.closed {
 		return
 	}
-
-	// Collect pending IDs
-	sessionIDs := make([]string, 0, len(c.pendingIDs))
-	for id := range c.pendingIDs {
-		sessionIDs = append(sessionIDs, id)
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
 	}
+	// Nothing pending, e.g. a timer that fired after an immediate flush
+	if len(c.pendingOrder) == 0 && !c.refreshAll {
+		return
+	}
+
+	// Pending IDs in the order their first event arrived
+	sessionIDs := c.pendingOrder
 
 	refreshAll := c.refreshAll || len(sessionIDs) > maxPendingSessionIDs
 	epoch := c.pendingEpoch
 
 	// Reset state
 	c.pendingIDs = make(map[string]struct{})
+	c.pendingOrder = nil
 	c.refreshAll = false
-	c.timer = nil
 	c.pendingEpoch = 0
 
 	// Send message with lock held - safe because select/default prevents blocking
@@ -5,6 +5,8 @@ import (
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
 )
 
 func TestEventCoalescer_SingleEvent(t *testing.T) {
@@ -129,6 +131,127 @@ func TestEventCoalescer_TooManyEvents(t *testing.T) {
 	}
 }
 
+func TestEventCoalescer_PreservesOrder(t *testing.T) {
+	// Session IDs are reported in the order their first event arrived
+	ch := make(chan CoalescedRefreshMsg, 1)
+	c := NewEventCoalescer(30*time.Millisecond, ch)
+	for _, id := range []string{"c", "a", "b", "a", "c"} {
+		c.Add(id, 0)
+	}
+
+	received := <-ch
+	if got := fmt.Sprint(received.SessionIDs); got != "[c a b]" {
+		t.Errorf("SessionIDs = %s, want [c a b]", got)
+	}
+}
+
+func TestEventCoalescer_BurstThenSessionEnded(t *testing.T) {
+	// A burst of updates followed by an end event flushes one batch at once
+	ch := make(chan CoalescedRefreshMsg, 2)
+	c := NewEventCoalescer(time.Second, ch)
+
+	start := time.Now()
+	for i := 0; i < 50; i++ {
+		c.AddEvent(adapter.Event{Type: adapter.EventMessageAdded, SessionID: "streaming"}, 7)
+		if i%10 == 0 {
+			c.Add("other", 7)
+		}
+	}
+	c.AddEvent(adapter.Event{Type: adapter.EventSessionEnded, SessionID: "finished"}, 7)
+
+	select {
+	case received := <-ch:
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Errorf("end event waited %v for the coalesce window", elapsed)
+		}
+		if got := fmt.Sprint(received.SessionIDs); got != "[streaming other finished]" {
+			t.Errorf("SessionIDs = %s, want [streaming other finished]", got)
+		}
+		if received.RefreshAll || received.Epoch != 7 {
+			t.Errorf("RefreshAll = %v, Epoch = %d", received.RefreshAll, received.Epoch)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("no batch after session ended")
+	}
+
+	// The burst was delivered in that single batch
+	time.Sleep(1200 * time.Millisecond)
+	select {
+	case extra := <-ch:
+		t.Errorf("unexpected second batch: %+v", extra)
+	default:
+	}
+}
+
+func TestEventCoalescer_SessionEndedForHugeSession(t *testing.T) {
+	// Ending a huge session isn't queued but still flushes pending events
+	ch := make(chan CoalescedRefreshMsg, 1)
+	c := NewEventCoalescer(time.Second, ch)
+	c.UpdateSessionSize("huge", adapter.HugeSessionThreshold+1)
+
+	c.Add("small", 0)
+	c.AddEvent(adapter.Event{Type: adapter.EventSessionEnded, SessionID: "huge"}, 0)
+
+	select {
+	case received := <-ch:
+		if got := fmt.Sprint(received.SessionIDs); got != "[small]" {
+			t.Errorf("SessionIDs = %s, want [small]", got)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("pending events not flushed by end event")
+	}
+}
+
+func TestEventCoalescer_OpenHugeSessionIsQueued(t *testing.T) {
+	// The open session is batched even when it's too large for auto-reload
+	ch := make(chan CoalescedRefreshMsg, 1)
+	c := NewEventCoalescer(time.Second, ch)
+	c.UpdateSessionSize("huge", adapter.HugeSessionThreshold+1)
+	c.UpdateSessionSize("other", adapter.HugeSessionThreshold+1)
+	c.SetOpenSession("huge")
+
+	c.Add("other", 0)
+	c.Add("huge", 0)
+	c.AddEvent(adapter.Event{Type: adapter.EventSessionEnded, SessionID: "huge"}, 0)
+
+	select {
+	case received := <-ch:
+		if got := fmt.Sprint(received.SessionIDs); got != "[huge]" {
+			t.Errorf("SessionIDs = %s, want [huge]", got)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("open huge session was not queued")
+	}
+}
+
+func TestEventCoalescer_SteadyStreamHitsMaxDelay(t *testing.T) {
+	// Events arriving faster than the window still flush by maxDelay
+	ch := make(chan CoalescedRefreshMsg, 1)
+	c := NewEventCoalescer(50*time.Millisecond, ch)
+	c.maxDelay = 150 * time.Millisecond
+
+	start := time.Now()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for time.Since(start) < 600*time.Millisecond {
+			c.Add("streaming", 0)
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	select {
+	case <-ch:
+		if elapsed := time.Since(start); elapsed < 120*time.Millisecond || elapsed > 400*time.Millisecond {
+			t.Errorf("expected flush near maxDelay (150ms), got %v", elapsed)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("steady stream never flushed")
+	}
+	<-done
+	c.Stop()
+}
+
 func TestEventCoalescer_Stop(t *testing.T) {
 	// Stop should cancel pending flush
 	ch := make(chan CoalescedRefreshMsg, 1)
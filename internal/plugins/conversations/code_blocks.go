@@ -0,0 +1,150 @@
+package conversations
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// codeIndent matches the left margin Glamour gives paragraphs.
+const codeIndent = "  "
+
+// contentSegment is a run of message content: either prose or the body of a
+// fenced code block with its fence language.
+type contentSegment struct {
+	Lang   string // fence language, empty if none was given
+	Text   string
+	IsCode bool
+}
+
+// extractCodeFences splits content into prose and fenced code segments.
+// Fences open with ``` or ~~~ (three or more) and close with a fence of the
+// same character at least as long. An unterminated fence runs to the end of
+// the content. Whitespace-only prose is dropped.
+func extractCodeFences(content string) []contentSegment {
+	var segments []contentSegment
+	var buf []string
+	var lang, fence string
+	inCode := false
+
+	flush := func() {
+		text := strings.Join(buf, "\n")
+		buf = nil
+		if inCode {
+			segments = append(segments, contentSegment{Lang: lang, Text: text, IsCode: true})
+		} else if strings.TrimSpace(text) != "" {
+			segments = append(segments, contentSegment{Text: text})
+		}
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		if inCode {
+			if isClosingFence(line, fence) {
+				flush()
+				inCode = false
+				continue
+			}
+			buf = append(buf, line)
+			continue
+		}
+		if f, l, ok := parseOpeningFence(line); ok {
+			flush()
+			inCode, fence, lang = true, f, l
+			continue
+		}
+		buf = append(buf, line)
+	}
+	flush()
+	return segments
+}
+
+// parseOpeningFence reports whether line opens a code fence, returning the
+// fence marker and the language from its info string.
+func parseOpeningFence(line string) (fence, lang string, ok bool) {
+	trimmed, ok := trimFenceIndent(line)
+	if !ok || len(trimmed) < 3 || (trimmed[0] != '`' && trimmed[0] != '~') {
+		return "", "", false
+	}
+	n := 0
+	for n < len(trimmed) && trimmed[n] == trimmed[0] {
+		n++
+	}
+	if n < 3 {
+		return "", "", false
+	}
+	info := strings.TrimSpace(trimmed[n:])
+	// Backticks in the info string mean this is inline code, not a fence
+	if trimmed[0] == '`' && strings.Contains(info, "`") {
+		return "", "", false
+	}
+	if fields := strings.Fields(info); len(fields) > 0 {
+		lang = strings.Trim(fields[0], "{}.")
+	}
+	return trimmed[:n], lang, true
+}
+
+// isClosingFence reports whether line closes a block opened with fence.
+func isClosingFence(line, fence string) bool {
+	trimmed, ok := trimFenceIndent(line)
+	if !ok {
+		return false
+	}
+	n := 0
+	for n < len(trimmed) && trimmed[n] == fence[0] {
+		n++
+	}
+	return n >= len(fence) && strings.TrimSpace(trimmed[n:]) == ""
+}
+
+// trimFenceIndent strips up to three spaces of indentation. Deeper
+// indentation is an indented code block, not a fence.
+func trimFenceIndent(line string) (string, bool) {
+	indent := len(line) - len(strings.TrimLeft(line, " "))
+	if indent > 3 {
+		return "", false
+	}
+	return line[indent:], true
+}
+
+// renderCodeBlock renders a fenced code block line by line with the diff
+// syntax highlighter, or as plain text when the language is unknown. Lines
+// are truncated rather than wrapped to keep the code's layout.
+func (p *Plugin) renderCodeBlock(seg contentSegment, width int) []string {
+	h := p.codeHighlighter(seg.Lang)
+	codeWidth := width - len(codeIndent)
+	lines := make([]string, 0, strings.Count(seg.Text, "\n")+1)
+	for _, line := range strings.Split(seg.Text, "\n") {
+		line = strings.ReplaceAll(line, "\t", "    ")
+		var sb strings.Builder
+		if h == nil {
+			sb.WriteString(styles.Body.Render(line))
+		} else {
+			for _, hs := range h.Highlight(line) {
+				sb.WriteString(hs.Style.Render(hs.Text))
+			}
+		}
+		rendered := sb.String()
+		if codeWidth > 0 && ansi.StringWidth(rendered) > codeWidth {
+			rendered = ansi.Truncate(rendered, codeWidth, "")
+		}
+		lines = append(lines, codeIndent+rendered)
+	}
+	return lines
+}
+
+// codeHighlighter returns the cached highlighter for a fence language, or nil
+// if the language is empty or unknown.
+func (p *Plugin) codeHighlighter(lang string) *gitstatus.SyntaxHighlighter {
+	lang = strings.ToLower(lang)
+	if h, ok := p.codeHighlighters[lang]; ok {
+		return h
+	}
+	if p.codeHighlighters == nil {
+		p.codeHighlighters = make(map[string]*gitstatus.SyntaxHighlighter)
+	}
+	h := gitstatus.NewSyntaxHighlighterForLanguage(lang)
+	p.codeHighlighters[lang] = h
+	return h
+}
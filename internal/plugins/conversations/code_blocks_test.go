@@ -0,0 +1,122 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestExtractCodeFences(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []contentSegment
+	}{
+		{
+			name:    "no fences",
+			content: "Just some text\nover two lines",
+			want:    []contentSegment{{Text: "Just some text\nover two lines"}},
+		},
+		{
+			name:    "fence with language",
+			content: "Try this:\n```go\nfunc main() {}\n```\nDone.",
+			want: []contentSegment{
+				{Text: "Try this:"},
+				{Lang: "go", Text: "func main() {}", IsCode: true},
+				{Text: "Done."},
+			},
+		},
+		{
+			name:    "fence without language",
+			content: "```\nplain\ntext\n```",
+			want:    []contentSegment{{Text: "plain\ntext", IsCode: true}},
+		},
+		{
+			name:    "info string after language",
+			content: "```python title=\"x.py\"\nprint(1)\n```",
+			want:    []contentSegment{{Lang: "python", Text: "print(1)", IsCode: true}},
+		},
+		{
+			name:    "tilde fence",
+			content: "~~~bash\necho hi\n~~~",
+			want:    []contentSegment{{Lang: "bash", Text: "echo hi", IsCode: true}},
+		},
+		{
+			name:    "unterminated fence runs to end",
+			content: "Here:\n```js\nconst a = 1;\nconst b = 2;",
+			want: []contentSegment{
+				{Text: "Here:"},
+				{Lang: "js", Text: "const a = 1;\nconst b = 2;", IsCode: true},
+			},
+		},
+		{
+			name:    "longer fence contains shorter one",
+			content: "````md\n```go\nx\n```\n````",
+			want:    []contentSegment{{Lang: "md", Text: "```go\nx\n```", IsCode: true}},
+		},
+		{
+			name:    "closing fence must match character",
+			content: "```\na\n~~~\nb\n```",
+			want:    []contentSegment{{Text: "a\n~~~\nb", IsCode: true}},
+		},
+		{
+			name:    "inline backticks are not a fence",
+			content: "```inline``` code",
+			want:    []contentSegment{{Text: "```inline``` code"}},
+		},
+		{
+			name:    "deeply indented fence is not a fence",
+			content: "    ```go\n    x",
+			want:    []contentSegment{{Text: "    ```go\n    x"}},
+		},
+		{
+			name:    "multiple blocks",
+			content: "```go\na\n```\n\n```rust\nb\n```",
+			want: []contentSegment{
+				{Lang: "go", Text: "a", IsCode: true},
+				{Lang: "rust", Text: "b", IsCode: true},
+			},
+		},
+		{
+			name:    "empty block",
+			content: "```sh\n```",
+			want:    []contentSegment{{Lang: "sh", IsCode: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractCodeFences(tt.content)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d segments %+v, want %d %+v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("segment %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRenderContent_CodeBlocks(t *testing.T) {
+	p := New()
+	p.contentRenderer = nil // plain text prose keeps the output predictable
+
+	lines := p.renderContent("Example:\n```go\nfunc main() {\n\treturn\n}\n```", 40)
+	plain := make([]string, len(lines))
+	for i, l := range lines {
+		plain[i] = ansi.Strip(l)
+	}
+	want := []string{"Example:", "", "  func main() {", "      return", "  }"}
+	if strings.Join(plain, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got %q, want %q", plain, want)
+	}
+
+	// Long code lines are truncated, not wrapped
+	lines = p.renderContent("```\n"+strings.Repeat("x", 100)+"\n```", 40)
+	if len(lines) != 1 || ansi.StringWidth(lines[0]) > 40 {
+		t.Errorf("expected one line of at most 40 columns, got %q", lines)
+	}
+}
@@ -0,0 +1,191 @@
+package conversations
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/adapter/pricing"
+	"github.com/marcus/sidecar/internal/config"
+)
+
+// unknownCost is shown in place of a cost when a model has no known rates.
+const unknownCost = "—"
+
+// costEstimate is an approximate dollar cost. Known is false when some
+// priced usage belonged to a model without rates, making Amount unreliable.
+type costEstimate struct {
+	Amount float64
+	Priced bool // at least one message had token usage
+	Known  bool
+}
+
+// add accumulates another estimate into e.
+func (e *costEstimate) add(o costEstimate) {
+	if !o.Priced {
+		return
+	}
+	if !e.Priced {
+		e.Known = true
+	}
+	e.Priced = true
+	e.Amount += o.Amount
+	e.Known = e.Known && o.Known
+}
+
+// String formats the estimate, or "—" when the model is unknown.
+func (e costEstimate) String() string {
+	if !e.Known {
+		return unknownCost
+	}
+	return formatCost(e.Amount)
+}
+
+// costTable resolves model names to rates: config overrides first, then the
+// built-in pricing table.
+type costTable struct {
+	overrides map[string]pricing.Rates // lowercased model substring -> rates
+	keys      []string                 // override keys, longest first
+}
+
+// newCostTable builds a cost table from the configured pricing overrides.
+func newCostTable(overrides map[string]config.ModelPricing) costTable {
+	t := costTable{overrides: make(map[string]pricing.Rates, len(overrides))}
+	for key, rate := range overrides {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key == "" {
+			continue
+		}
+		t.overrides[key] = pricing.Rates{Input: rate.Input, Output: rate.Output, Thinking: rate.Thinking}
+		t.keys = append(t.keys, key)
+	}
+	// Longest key wins so "opus-4-5" beats "opus"
+	sort.Slice(t.keys, func(i, j int) bool {
+		if len(t.keys[i]) != len(t.keys[j]) {
+			return len(t.keys[i]) > len(t.keys[j])
+		}
+		return t.keys[i] < t.keys[j]
+	})
+	return t
+}
+
+// rates returns the rates for model, or false if the model is unknown.
+func (t costTable) rates(model string) (pricing.Rates, bool) {
+	if model == "" {
+		return pricing.Rates{}, false
+	}
+	lower := strings.ToLower(model)
+	for _, key := range t.keys {
+		if strings.Contains(lower, key) {
+			return t.overrides[key], true
+		}
+	}
+	return pricing.KnownRates(model)
+}
+
+// messageCost estimates the cost of one message. Messages without a model
+// are priced as fallbackModel (the session's primary model).
+func (t costTable) messageCost(msg adapter.Message, fallbackModel string) costEstimate {
+	usage := pricing.Usage{
+		InputTokens:  msg.InputTokens,
+		OutputTokens: msg.OutputTokens,
+		CacheRead:    msg.CacheRead,
+		CacheWrite:   msg.CacheWrite,
+	}
+	if usage == (pricing.Usage{}) {
+		return costEstimate{}
+	}
+	model := msg.Model
+	if model == "" {
+		model = fallbackModel
+	}
+	rates, ok := t.rates(model)
+	if !ok {
+		return costEstimate{Priced: true}
+	}
+	thinking := 0
+	for _, tb := range msg.ThinkingBlocks {
+		thinking += tb.TokenCount
+	}
+	return costEstimate{Amount: rates.Cost(usage, thinking), Priced: true, Known: true}
+}
+
+// turnCost estimates the cost of all messages in a turn.
+func (t costTable) turnCost(turn Turn, fallbackModel string) costEstimate {
+	var total costEstimate
+	for _, msg := range turn.Messages {
+		total.add(t.messageCost(msg, fallbackModel))
+	}
+	return total
+}
+
+// conversationCost estimates the cost of all turns in a conversation.
+func (t costTable) conversationCost(turns []Turn, fallbackModel string) costEstimate {
+	var total costEstimate
+	for _, turn := range turns {
+		total.add(t.turnCost(turn, fallbackModel))
+	}
+	return total
+}
+
+// usageCost estimates the cost of a session's usage totals. Totals don't say
+// which model produced them, so they are all priced as model.
+func (t costTable) usageCost(stats *adapter.UsageStats, model string) costEstimate {
+	if stats == nil {
+		return costEstimate{}
+	}
+	usage := pricing.Usage{
+		InputTokens:  stats.TotalInputTokens,
+		OutputTokens: stats.TotalOutputTokens,
+		CacheRead:    stats.TotalCacheRead,
+		CacheWrite:   stats.TotalCacheWrite,
+	}
+	if usage == (pricing.Usage{}) {
+		return costEstimate{}
+	}
+	rates, ok := t.rates(model)
+	if !ok {
+		return costEstimate{Priced: true}
+	}
+	return costEstimate{Amount: rates.Cost(usage, stats.TotalThinkingTokens), Priced: true, Known: true}
+}
+
+// messagesWindowed reports whether the loaded messages are only a page of
+// the conversation.
+func (p *Plugin) messagesWindowed() bool {
+	return p.hasOlderMsgs || p.messageOffset > 0
+}
+
+// headerCost returns the conversation cost shown in the message view header,
+// or "" when there is none. The turns cover the whole conversation only when
+// no other page exists; otherwise the session's usage totals are priced, and
+// failing that the loaded window's cost is labeled as such.
+func (p *Plugin) headerCost(session *adapter.Session, model string) string {
+	var cost costEstimate
+	if !p.messagesWindowed() {
+		cost = p.costs.conversationCost(p.turns, model)
+	} else if session != nil {
+		cost = p.costs.usageCost(p.usageCache[session.ID], model)
+	}
+	switch {
+	case cost.Known:
+		return cost.String()
+	case session != nil && session.EstCost > 0:
+		return formatCost(session.EstCost)
+	case cost.Priced:
+		return cost.String()
+	case p.messagesWindowed():
+		if window := p.costs.conversationCost(p.turns, model); window.Priced {
+			return window.String() + " (loaded)"
+		}
+	}
+	return ""
+}
+
+// sessionModel returns the model used for messages that don't name one.
+func (p *Plugin) sessionModel() string {
+	if p.sessionSummary != nil {
+		return p.sessionSummary.PrimaryModel
+	}
+	return ""
+}
@@ -0,0 +1,161 @@
+package conversations
+
+import (
+	"math"
+	"testing"
+
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/config"
+)
+
+func TestCostTableRates(t *testing.T) {
+	table := newCostTable(map[string]config.ModelPricing{
+		"opus":       {Input: 1, Output: 2},
+		"Opus-4-5":   {Input: 4, Output: 8},
+		"gpt-5":      {Input: 1.25, Output: 10},
+		"   ":        {Input: 99, Output: 99},
+		"custom-llm": {Input: 0.5, Output: 1, Thinking: 2},
+	})
+
+	tests := []struct {
+		model     string
+		wantIn    float64
+		wantKnown bool
+	}{
+		{"claude-opus-4-5-20251101", 4, true}, // longest override wins
+		{"claude-3-opus-20240229", 1, true},   // shorter override
+		{"gpt-5-codex", 1.25, true},           // override for non-Claude model
+		{"claude-sonnet-4-5", 3, true},        // built-in table
+		{"gemini-2.5-pro", 0, false},          // unknown
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			rates, ok := table.rates(tt.model)
+			if ok != tt.wantKnown || rates.Input != tt.wantIn {
+				t.Errorf("rates(%q) = %+v, %v; want input %v, %v", tt.model, rates, ok, tt.wantIn, tt.wantKnown)
+			}
+		})
+	}
+}
+
+func TestTurnCost(t *testing.T) {
+	table := newCostTable(map[string]config.ModelPricing{
+		"test-model": {Input: 2, Output: 10, Thinking: 4},
+	})
+
+	turn := Turn{Messages: []adapter.Message{
+		{
+			Model:      "test-model",
+			TokenUsage: adapter.TokenUsage{InputTokens: 100_000, OutputTokens: 50_000, CacheRead: 1_000_000},
+			ThinkingBlocks: []adapter.ThinkingBlock{
+				{TokenCount: 20_000},
+			},
+		},
+		// No model on the message: priced as the session model
+		{TokenUsage: adapter.TokenUsage{InputTokens: 50_000}},
+		// No usage: not priced
+		{Content: "hi"},
+	}}
+
+	got := table.turnCost(turn, "test-model")
+	// 150k in × $2 = $0.30, 1M cache read × $0.20 = $0.20,
+	// 30k out × $10 = $0.30, 20k thinking × $4 = $0.08
+	want := 0.88
+	if !got.Priced || !got.Known || math.Abs(got.Amount-want) > 1e-9 {
+		t.Errorf("turnCost() = %+v, want known %.2f", got, want)
+	}
+	if got.String() != "$0.88" {
+		t.Errorf("String() = %q, want %q", got.String(), "$0.88")
+	}
+}
+
+func TestTurnCost_UnknownModel(t *testing.T) {
+	table := newCostTable(nil)
+
+	turn := Turn{Messages: []adapter.Message{
+		{Model: "claude-sonnet-4-5", TokenUsage: adapter.TokenUsage{InputTokens: 1000}},
+		{Model: "mystery-model", TokenUsage: adapter.TokenUsage{OutputTokens: 1000}},
+	}}
+	got := table.turnCost(turn, "")
+	if !got.Priced || got.Known {
+		t.Errorf("turnCost() = %+v, want priced but unknown", got)
+	}
+	if got.String() != unknownCost {
+		t.Errorf("String() = %q, want %q", got.String(), unknownCost)
+	}
+
+	// A turn without usage is not priced at all
+	if got := table.turnCost(Turn{Messages: []adapter.Message{{Content: "hi"}}}, ""); got.Priced {
+		t.Errorf("turnCost() = %+v, want unpriced", got)
+	}
+}
+
+func TestConversationCost(t *testing.T) {
+	table := newCostTable(nil)
+	turns := GroupMessagesIntoTurns([]adapter.Message{
+		{Role: "user", Content: "go"},
+		{Role: "assistant", Model: "claude-sonnet-4-5", TokenUsage: adapter.TokenUsage{InputTokens: 1_000_000}},
+		{Role: "user", Content: "again"},
+		{Role: "assistant", Model: "claude-sonnet-4-5", TokenUsage: adapter.TokenUsage{OutputTokens: 100_000}},
+	})
+
+	got := table.conversationCost(turns, "")
+	// $3 input + $1.50 output
+	if !got.Known || math.Abs(got.Amount-4.5) > 1e-9 {
+		t.Errorf("conversationCost() = %+v, want known 4.50", got)
+	}
+}
+
+func TestHeaderCostCoversWholeConversationWhenPaged(t *testing.T) {
+	p := New()
+	p.turns = GroupMessagesIntoTurns([]adapter.Message{
+		{Role: "user", Content: "go"},
+		{Role: "assistant", Model: "claude-sonnet-4-5", TokenUsage: adapter.TokenUsage{InputTokens: 1_000_000}},
+	})
+	session := &adapter.Session{ID: "s1"}
+	const model = "claude-sonnet-4-5"
+
+	if got := p.headerCost(session, model); got != "$3.0" {
+		t.Errorf("fully loaded headerCost() = %q, want $3.0 from the turns", got)
+	}
+
+	// Only a page is loaded: the window's cost is labeled until usage loads
+	p.hasOlderMsgs = true
+	if got := p.headerCost(session, model); got != "$3.0 (loaded)" {
+		t.Errorf("paged headerCost() = %q, want the labeled window cost", got)
+	}
+
+	session.EstCost = 7
+	if got := p.headerCost(session, model); got != "$7.0" {
+		t.Errorf("paged headerCost() = %q, want the session estimate", got)
+	}
+
+	p.usageCache["s1"] = &adapter.UsageStats{TotalInputTokens: 2_000_000, TotalOutputTokens: 100_000}
+	if got := p.headerCost(session, model); got != "$7.5" {
+		t.Errorf("paged headerCost() = %q, want $7.5 from the session usage totals", got)
+	}
+}
+
+func TestPagedMessagesLoadSessionUsage(t *testing.T) {
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": &mockAdapter{}}
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "mock"}}
+	p.selectedSession = "s1"
+
+	msgs := []adapter.Message{{ID: "m1", Role: "user", Content: "hi"}}
+	_, cmd := p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: msgs, TotalCount: 1})
+	if cmd != nil {
+		t.Error("a fully loaded conversation shouldn't fetch usage")
+	}
+
+	page := []adapter.Message{{ID: "m400", Role: "user", Content: "later"}}
+	_, cmd = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: page, TotalCount: 500, Offset: 0})
+	if cmd == nil || !p.usagePending["s1"] {
+		t.Fatal("a paged conversation should fetch the session's usage")
+	}
+	older := []adapter.Message{{ID: "m300", Role: "user", Content: "earlier"}}
+	if _, again := p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: older, TotalCount: 500, Offset: 100}); again != nil {
+		t.Error("usage already loading shouldn't be fetched again")
+	}
+}
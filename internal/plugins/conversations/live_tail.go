@@ -0,0 +1,119 @@
+package conversations
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// pollRefreshInterval is how often the open conversation is reloaded once
+// the watcher channel has closed.
+const pollRefreshInterval = 2 * time.Second
+
+// WatchClosedMsg is sent when the watcher channel closes.
+type WatchClosedMsg struct {
+	Epoch uint64 // Epoch when the watcher was listened to (for stale detection)
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m WatchClosedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// PollRefreshMsg triggers a poll-based reload of the open conversation.
+type PollRefreshMsg struct {
+	Epoch uint64 // Epoch when the poll was scheduled (for stale detection)
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m PollRefreshMsg) GetEpoch() uint64 { return m.Epoch }
+
+// schedulePollRefresh schedules the next poll of the open conversation.
+func (p *Plugin) schedulePollRefresh() tea.Cmd {
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	return tea.Tick(pollRefreshInterval, func(time.Time) tea.Msg {
+		return PollRefreshMsg{Epoch: epoch}
+	})
+}
+
+// handleWatchClosed falls back to polling when the watcher stops delivering
+// events, so the open conversation keeps updating.
+func (p *Plugin) handleWatchClosed(msg WatchClosedMsg) tea.Cmd {
+	if plugin.IsStale(p.ctx, msg) || p.stopped || p.watchPolling {
+		return nil
+	}
+	p.watchChan = nil
+	p.watchPolling = true
+	return p.schedulePollRefresh()
+}
+
+// handlePollRefresh reloads the open conversation and schedules the next
+// poll. Polling stops once a watcher is running again or the plugin stops.
+func (p *Plugin) handlePollRefresh(msg PollRefreshMsg) tea.Cmd {
+	if plugin.IsStale(p.ctx, msg) || p.stopped || !p.watchPolling {
+		return nil
+	}
+	cmds := []tea.Cmd{p.schedulePollRefresh()}
+	if p.focused && p.selectedSession != "" {
+		cmds = append(cmds, p.loadMessages(p.selectedSession))
+	}
+	return tea.Batch(cmds...)
+}
+
+// atConversationBottom reports whether the cursor is on the newest message
+// (conversation flow) or turn (turn view).
+func (p *Plugin) atConversationBottom() bool {
+	if p.turnViewMode {
+		return len(p.turns) == 0 || p.turnCursor >= len(p.turns)-1
+	}
+	visible := p.visibleMessageIndices()
+	return len(visible) == 0 || p.messageCursor >= visible[len(visible)-1]
+}
+
+// followNewMessages is called after new messages are appended to the open
+// conversation. If the cursor was at the bottom it follows the new messages;
+// otherwise they are counted for the "new messages" indicator.
+func (p *Plugin) followNewMessages(newMessages []adapter.Message, wasAtBottom bool) {
+	if !wasAtBottom {
+		for _, msg := range newMessages {
+			if !p.isToolResultOnlyMessage(msg) {
+				p.newMessageCount++
+			}
+		}
+		return
+	}
+
+	p.newMessageCount = 0
+	if len(p.turns) > 0 {
+		p.turnCursor = len(p.turns) - 1
+		p.ensureTurnCursorVisible()
+	}
+	if visible := p.visibleMessageIndices(); len(visible) > 0 {
+		p.messageCursor = visible[len(visible)-1]
+		p.messageScroll = 999999 // Will be clamped in renderer
+	}
+}
+
+// clearNewMessagesAtBottom hides the indicator once the user reaches the
+// newest message.
+func (p *Plugin) clearNewMessagesAtBottom() {
+	if p.newMessageCount > 0 && p.atConversationBottom() {
+		p.newMessageCount = 0
+	}
+}
+
+// renderNewMessagesIndicator renders the "new messages" hint shown while
+// the user is scrolled away from the bottom.
+func (p *Plugin) renderNewMessagesIndicator() string {
+	label := "new messages"
+	if p.newMessageCount == 1 {
+		label = "new message"
+	}
+	return styles.StatusInProgress.Render(fmt.Sprintf("↓ %d %s", p.newMessageCount, label)) +
+		styles.Subtle.Render("  [G:jump]")
+}
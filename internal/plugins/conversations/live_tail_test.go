@@ -0,0 +1,130 @@
+package conversations
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+func liveTailPlugin(messages []adapter.Message) *Plugin {
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": &mockAdapter{}}
+	p.selectedSession = "s1"
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: messages})
+	return p
+}
+
+func TestLiveTail_AppendRegroupsTurns(t *testing.T) {
+	initial := []adapter.Message{
+		{ID: "m1", Role: "user", Content: "Run the tests"},
+		{ID: "m2", Role: "assistant", Content: "Running"},
+	}
+	p := liveTailPlugin(initial)
+	if len(p.turns) != 2 {
+		t.Fatalf("got %d turns, want 2", len(p.turns))
+	}
+
+	// Same-role message extends the last turn, a new role starts a new turn
+	appended := append(append([]adapter.Message{}, initial...),
+		adapter.Message{ID: "m3", Role: "assistant", Content: "All tests pass"},
+		adapter.Message{ID: "m4", Role: "user", Content: "Great"},
+	)
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: appended})
+
+	if len(p.messages) != 4 {
+		t.Fatalf("got %d messages, want 4", len(p.messages))
+	}
+	if len(p.turns) != 3 {
+		t.Fatalf("got %d turns, want 3", len(p.turns))
+	}
+	if got := len(p.turns[1].Messages); got != 2 {
+		t.Errorf("assistant turn has %d messages, want 2", got)
+	}
+	if p.turns[2].StartIndex != 3 || p.turns[2].Role != "user" {
+		t.Errorf("last turn = %+v, want user turn starting at 3", p.turns[2])
+	}
+
+	// Regrouping incrementally must match grouping from scratch
+	fresh := GroupMessagesIntoTurns(appended)
+	for i := range fresh {
+		if len(fresh[i].Messages) != len(p.turns[i].Messages) || fresh[i].StartIndex != p.turns[i].StartIndex {
+			t.Errorf("turn %d differs from a full regroup", i)
+		}
+	}
+}
+
+func TestLiveTail_FollowsWhenAtBottom(t *testing.T) {
+	initial := []adapter.Message{
+		{ID: "m1", Role: "user", Content: "hi"},
+		{ID: "m2", Role: "assistant", Content: "hello"},
+	}
+	p := liveTailPlugin(initial)
+	p.messageCursor = 1 // on the newest message
+
+	appended := append(append([]adapter.Message{}, initial...),
+		adapter.Message{ID: "m3", Role: "user", ContentBlocks: []adapter.ContentBlock{{Type: "tool_result"}}},
+		adapter.Message{ID: "m4", Role: "assistant", Content: "done"},
+	)
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: appended})
+
+	if p.messageCursor != 3 {
+		t.Errorf("messageCursor = %d, want 3 (newest visible message)", p.messageCursor)
+	}
+	if p.newMessageCount != 0 {
+		t.Errorf("newMessageCount = %d, want 0 when following", p.newMessageCount)
+	}
+}
+
+func TestLiveTail_CountsWhenScrolledAway(t *testing.T) {
+	initial := []adapter.Message{
+		{ID: "m1", Role: "user", Content: "hi"},
+		{ID: "m2", Role: "assistant", Content: "hello"},
+	}
+	p := liveTailPlugin(initial)
+	p.activePane = PaneMessages
+	p.messageCursor = 0 // reading older history
+
+	appended := append(append([]adapter.Message{}, initial...),
+		adapter.Message{ID: "m3", Role: "user", ContentBlocks: []adapter.ContentBlock{{Type: "tool_result"}}},
+		adapter.Message{ID: "m4", Role: "assistant", Content: "done"},
+	)
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: appended})
+
+	if p.messageCursor != 0 {
+		t.Errorf("messageCursor = %d, want 0 (unchanged)", p.messageCursor)
+	}
+	if p.newMessageCount != 1 {
+		t.Errorf("newMessageCount = %d, want 1 (tool results not counted)", p.newMessageCount)
+	}
+
+	// Jumping to the bottom clears the indicator
+	_, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	if p.newMessageCount != 0 {
+		t.Errorf("newMessageCount = %d after G, want 0", p.newMessageCount)
+	}
+}
+
+func TestLiveTail_WatchClosedFallsBackToPolling(t *testing.T) {
+	p := New()
+	p.selectedSession = "s1"
+
+	if cmd := p.handleWatchClosed(WatchClosedMsg{}); cmd == nil {
+		t.Fatal("expected a poll to be scheduled")
+	}
+	if !p.watchPolling {
+		t.Error("expected polling after the watcher closed")
+	}
+	if cmd := p.handlePollRefresh(PollRefreshMsg{}); cmd == nil {
+		t.Error("expected polling to continue")
+	}
+
+	// A new watcher stops polling
+	_, _ = p.Update(WatchStartedMsg{Channel: make(chan adapter.Event)})
+	if p.watchPolling {
+		t.Error("expected polling to stop once a watcher is running")
+	}
+	if cmd := p.handlePollRefresh(PollRefreshMsg{}); cmd != nil {
+		t.Error("expected no further polls")
+	}
+}
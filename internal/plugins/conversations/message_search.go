@@ -0,0 +1,310 @@
+package conversations
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/adapter"
+	appmsg "github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// toolMarkerRegex matches the "[N tool result(s)]" markers adapters put in
+// message content; they are not searchable text.
+var toolMarkerRegex = regexp.MustCompile(`\[\d+ tool result\(s\)\]`)
+
+// messageSearchHit locates one match of the in-conversation search query.
+type messageSearchHit struct {
+	MsgIdx int // index in the searched message slice
+	Offset int // rune offset of the match in the message's searchable text
+}
+
+// searchableText returns the text message search matches against: the
+// message content (or its text blocks) with XML tags and tool markers removed.
+// Tool-result-only messages are hidden in the conversation flow, so they have
+// no searchable text.
+func searchableText(msg adapter.Message) string {
+	if toolResultCount(msg) > 0 {
+		return ""
+	}
+	content := msg.Content
+	if content == "" {
+		var parts []string
+		for _, block := range msg.ContentBlocks {
+			if block.Type == "text" && block.Text != "" {
+				parts = append(parts, block.Text)
+			}
+		}
+		content = strings.Join(parts, "\n")
+	}
+	content = xmlTagRegex.ReplaceAllString(content, "")
+	content = toolMarkerRegex.ReplaceAllString(content, "")
+	return strings.TrimSpace(content)
+}
+
+// foldRunes lowercases text rune by rune so offsets stay aligned with the
+// original text.
+func foldRunes(text string) []rune {
+	runes := []rune(text)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+// matchOffsets returns the rune offsets of non-overlapping, case-insensitive
+// occurrences of query in text.
+func matchOffsets(text, query string) []int {
+	q := foldRunes(query)
+	if len(q) == 0 {
+		return nil
+	}
+	t := foldRunes(text)
+	var offsets []int
+	for i := 0; i+len(q) <= len(t); i++ {
+		if string(t[i:i+len(q)]) == string(q) {
+			offsets = append(offsets, i)
+			i += len(q) - 1
+		}
+	}
+	return offsets
+}
+
+// findMessageMatches returns every match of query in messages, ordered by
+// message and then by position within the message.
+func findMessageMatches(messages []adapter.Message, query string) []messageSearchHit {
+	if strings.TrimSpace(query) == "" {
+		return nil
+	}
+	var hits []messageSearchHit
+	for i, msg := range messages {
+		for _, off := range matchOffsets(searchableText(msg), query) {
+			hits = append(hits, messageSearchHit{MsgIdx: i, Offset: off})
+		}
+	}
+	return hits
+}
+
+// highlightSearchLine re-renders a rendered line with each occurrence of
+// query highlighted and the remaining text in base. It reports false when
+// the line has no match, in which case the line should be used unchanged.
+func highlightSearchLine(line, query string, base lipgloss.Style) (string, bool) {
+	plain := ansi.Strip(line)
+	offsets := matchOffsets(plain, query)
+	if len(offsets) == 0 {
+		return line, false
+	}
+
+	matchStyle := lipgloss.NewStyle().
+		Background(styles.Warning).
+		Foreground(styles.BgPrimary).
+		Bold(true)
+
+	runes := []rune(plain)
+	n := len([]rune(query))
+	var sb strings.Builder
+	pos := 0
+	for _, off := range offsets {
+		if pos < off {
+			sb.WriteString(base.Render(string(runes[pos:off])))
+		}
+		sb.WriteString(matchStyle.Render(string(runes[off : off+n])))
+		pos = off + n
+	}
+	if pos < len(runes) {
+		sb.WriteString(base.Render(string(runes[pos:])))
+	}
+	return sb.String(), true
+}
+
+// openMessageSearch starts typing a new search within the open conversation.
+func (p *Plugin) openMessageSearch() {
+	p.msgSearchMode = true
+	p.msgSearchQuery = ""
+	p.msgSearchHits = nil
+	p.msgSearchCursor = 0
+	p.hitRegionsDirty = true
+}
+
+// clearMessageSearch ends the message search and removes its highlights.
+func (p *Plugin) clearMessageSearch() {
+	p.msgSearchMode = false
+	p.msgSearchQuery = ""
+	p.msgSearchHits = nil
+	p.msgSearchCursor = 0
+	p.hitRegionsDirty = true
+}
+
+// refreshMessageSearch recomputes the hits after the query or the loaded
+// messages change.
+func (p *Plugin) refreshMessageSearch() {
+	if p.msgSearchQuery == "" {
+		p.msgSearchHits = nil
+		p.msgSearchCursor = 0
+		return
+	}
+	p.msgSearchHits = findMessageMatches(p.messages, p.msgSearchQuery)
+	if p.msgSearchCursor >= len(p.msgSearchHits) {
+		p.msgSearchCursor = 0
+	}
+	p.hitRegionsDirty = true
+}
+
+// currentMessageIndex returns the message under the cursor in either view.
+func (p *Plugin) currentMessageIndex() int {
+	if p.turnViewMode {
+		if p.turnCursor < len(p.turns) {
+			return p.turns[p.turnCursor].StartIndex
+		}
+		return 0
+	}
+	return p.messageCursor
+}
+
+// turnIndexForMessage returns the turn containing the message at msgIdx.
+func (p *Plugin) turnIndexForMessage(msgIdx int) int {
+	for i, turn := range p.turns {
+		if msgIdx >= turn.StartIndex && msgIdx < turn.StartIndex+len(turn.Messages) {
+			return i
+		}
+	}
+	return -1
+}
+
+// messageSearchHitCount returns the number of hits in messages [start, end).
+func (p *Plugin) messageSearchHitCount(start, end int) int {
+	lo := sort.Search(len(p.msgSearchHits), func(i int) bool { return p.msgSearchHits[i].MsgIdx >= start })
+	hi := sort.Search(len(p.msgSearchHits), func(i int) bool { return p.msgSearchHits[i].MsgIdx >= end })
+	return hi - lo
+}
+
+// jumpToSearchHit moves the cursor to the current hit. In the conversation
+// flow the message is expanded so the match is visible; in turn view the
+// cursor moves to the turn containing the message.
+func (p *Plugin) jumpToSearchHit() {
+	if p.msgSearchCursor < 0 || p.msgSearchCursor >= len(p.msgSearchHits) {
+		return
+	}
+	hit := p.msgSearchHits[p.msgSearchCursor]
+	if hit.MsgIdx >= len(p.messages) {
+		return
+	}
+	if p.turnViewMode {
+		if idx := p.turnIndexForMessage(hit.MsgIdx); idx >= 0 {
+			p.turnCursor = idx
+			p.ensureTurnCursorVisible()
+		}
+	} else {
+		p.messageCursor = hit.MsgIdx
+		if id := p.messages[hit.MsgIdx].ID; !p.expandedMessages[id] {
+			p.expandedMessages[id] = true
+			p.invalidateCacheForMessage(id)
+		}
+		p.ensureMessageCursorVisible()
+	}
+	p.hitRegionsDirty = true
+}
+
+// jumpToFirstSearchHit selects the first hit at or after the cursor,
+// wrapping to the top of the conversation.
+func (p *Plugin) jumpToFirstSearchHit() {
+	if len(p.msgSearchHits) == 0 {
+		return
+	}
+	from := p.currentMessageIndex()
+	p.msgSearchCursor = sort.Search(len(p.msgSearchHits), func(i int) bool {
+		return p.msgSearchHits[i].MsgIdx >= from
+	})
+	if p.msgSearchCursor == len(p.msgSearchHits) {
+		p.msgSearchCursor = 0
+	}
+	p.jumpToSearchHit()
+}
+
+// stepSearchHit moves to the next (delta 1) or previous (delta -1) hit,
+// wrapping around the conversation.
+func (p *Plugin) stepSearchHit(delta int) tea.Cmd {
+	n := len(p.msgSearchHits)
+	if n == 0 {
+		return appmsg.ShowToast(fmt.Sprintf("No matches for %q", p.msgSearchQuery), 2*time.Second)
+	}
+	p.msgSearchCursor = ((p.msgSearchCursor+delta)%n + n) % n
+	p.jumpToSearchHit()
+	return nil
+}
+
+// updateMessageSearch handles keys while typing a message search query.
+func (p *Plugin) updateMessageSearch(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		p.clearMessageSearch()
+		return p, nil
+
+	case tea.KeyEnter:
+		p.msgSearchMode = false
+		if p.msgSearchQuery == "" {
+			p.clearMessageSearch()
+			return p, nil
+		}
+		if len(p.msgSearchHits) == 0 {
+			return p, appmsg.ShowToast(fmt.Sprintf("No matches for %q", p.msgSearchQuery), 2*time.Second)
+		}
+		return p, nil
+
+	case tea.KeyBackspace:
+		if runes := []rune(p.msgSearchQuery); len(runes) > 0 {
+			p.msgSearchQuery = string(runes[:len(runes)-1])
+		}
+
+	case tea.KeyRunes, tea.KeySpace:
+		p.msgSearchQuery += string(msg.Runes)
+
+	default:
+		return p, nil
+	}
+
+	// Search incrementally as the query changes
+	p.msgSearchCursor = 0
+	p.refreshMessageSearch()
+	p.jumpToFirstSearchHit()
+	return p, nil
+}
+
+// renderMessageSearchBar renders the search prompt and hit position.
+func (p *Plugin) renderMessageSearchBar(maxWidth int) string {
+	prompt := "/" + p.msgSearchQuery
+	if p.msgSearchMode {
+		prompt += "█"
+	}
+	status := ""
+	switch {
+	case p.msgSearchQuery == "":
+	case len(p.msgSearchHits) == 0:
+		status = " no matches"
+	default:
+		status = fmt.Sprintf(" %d/%d", p.msgSearchCursor+1, len(p.msgSearchHits))
+	}
+	hint := ""
+	if !p.msgSearchMode {
+		hint = "  [n/N:next/prev esc:clear]"
+	}
+
+	if lipgloss.Width(prompt+status+hint) > maxWidth {
+		hint = ""
+	}
+	if w := lipgloss.Width(prompt + status); w > maxWidth {
+		runes := []rune(prompt)
+		if cut := w - maxWidth; cut < len(runes) {
+			prompt = string(runes[cut:])
+		}
+	}
+	return styles.StatusInProgress.Render(prompt) + styles.Muted.Render(status) + styles.Subtle.Render(hint)
+}
@@ -0,0 +1,144 @@
+package conversations
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+func TestFindMessageMatches(t *testing.T) {
+	messages := []adapter.Message{
+		{ID: "m0", Role: "user", Content: "<user_query>Fix the parser</user_query>"},
+		{ID: "m1", Role: "assistant", Content: "The Parser fails on empty input; the parser needs a guard."},
+		{ID: "m2", Role: "user", Content: "[2 tool result(s)]"},
+		{ID: "m3", Role: "user", ContentBlocks: []adapter.ContentBlock{
+			{Type: "tool_result", ToolOutput: "parser.go:12: panic"},
+		}},
+		{ID: "m4", Role: "assistant", ContentBlocks: []adapter.ContentBlock{
+			{Type: "text", Text: "Patched the parser."},
+			{Type: "tool_use", ToolInput: `{"file":"parser.go"}`},
+		}},
+		{ID: "m5", Role: "assistant", Content: "Ünïcode pärser"},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []messageSearchHit
+	}{
+		{
+			name:  "case-insensitive across messages",
+			query: "parser",
+			want: []messageSearchHit{
+				{MsgIdx: 0, Offset: 8},
+				{MsgIdx: 1, Offset: 4},
+				{MsgIdx: 1, Offset: 37},
+				{MsgIdx: 4, Offset: 12},
+			},
+		},
+		{
+			name:  "XML tags are not searchable",
+			query: "user_query",
+			want:  nil,
+		},
+		{
+			name:  "tool markers and tool-result messages are skipped",
+			query: "tool result",
+			want:  nil,
+		},
+		{
+			name:  "tool output is not searchable",
+			query: "panic",
+			want:  nil,
+		},
+		{
+			name:  "rune offsets",
+			query: "PÄRSER",
+			want:  []messageSearchHit{{MsgIdx: 5, Offset: 8}},
+		},
+		{
+			name:  "empty query",
+			query: " ",
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findMessageMatches(messages, tt.query)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findMessageMatches(%q) = %+v, want %+v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchOffsets_NonOverlapping(t *testing.T) {
+	if got := matchOffsets("aaaa", "aa"); !reflect.DeepEqual(got, []int{0, 2}) {
+		t.Errorf("matchOffsets = %v, want [0 2]", got)
+	}
+}
+
+func TestHighlightSearchLine(t *testing.T) {
+	line := lipgloss.NewStyle().Bold(true).Render("fix the Parser now")
+
+	got, ok := highlightSearchLine(line, "parser", lipgloss.NewStyle())
+	if !ok {
+		t.Fatal("expected a match")
+	}
+	if plain := ansi.Strip(got); plain != "fix the Parser now" {
+		t.Errorf("highlighted text = %q, want original text", plain)
+	}
+
+	if _, ok := highlightSearchLine(line, "lexer", lipgloss.NewStyle()); ok {
+		t.Error("expected no match")
+	}
+}
+
+func TestMessageSearchJumpsToHit(t *testing.T) {
+	p := New()
+	p.messages = []adapter.Message{
+		{ID: "m0", Role: "user", Content: "find the needle"},
+		{ID: "m1", Role: "assistant", Content: "Looking"},
+		{ID: "m2", Role: "assistant", Content: "Found a needle here"},
+		{ID: "m3", Role: "user", Content: "thanks"},
+	}
+	p.turns = GroupMessagesIntoTurns(p.messages)
+	p.msgSearchQuery = "needle"
+	p.refreshMessageSearch()
+
+	if len(p.msgSearchHits) != 2 {
+		t.Fatalf("got %d hits, want 2", len(p.msgSearchHits))
+	}
+
+	// Conversation flow: the cursor lands on the matching message
+	p.jumpToFirstSearchHit()
+	if p.messageCursor != 0 {
+		t.Errorf("messageCursor = %d, want 0", p.messageCursor)
+	}
+	p.stepSearchHit(1)
+	if p.messageCursor != 2 {
+		t.Errorf("messageCursor = %d, want 2", p.messageCursor)
+	}
+	if !p.expandedMessages["m2"] {
+		t.Error("matching message should be expanded")
+	}
+	p.stepSearchHit(1)
+	if p.msgSearchCursor != 0 || p.messageCursor != 0 {
+		t.Errorf("next should wrap to first hit, got hit %d message %d", p.msgSearchCursor, p.messageCursor)
+	}
+	p.stepSearchHit(-1)
+	if p.msgSearchCursor != 1 {
+		t.Errorf("prev should wrap to last hit, got %d", p.msgSearchCursor)
+	}
+
+	// Turn view: the cursor lands on the turn holding the message
+	p.turnViewMode = true
+	p.jumpToSearchHit()
+	if want := p.turnIndexForMessage(2); p.turnCursor != want || want != 1 {
+		t.Errorf("turnCursor = %d, want 1", p.turnCursor)
+	}
+}
@@ -14,6 +14,10 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 		return p, cmd
 	}
 
+	if p.showUsageModal {
+		return p, p.handleUsageModalMouse(msg)
+	}
+
 	action := p.mouseHandler.HandleMouse(msg)
 
 	switch action.Type {
@@ -31,11 +35,28 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 
 	case mouse.ActionDragEnd:
 		return p.handleMouseDragEnd()
+
+	case mouse.ActionHover:
+		p.handleMouseHover(action)
 	}
 
 	return p, nil
 }
 
+// handleMouseHover tracks the session row under the mouse so the sidebar
+// can show its exact time.
+func (p *Plugin) handleMouseHover(action mouse.MouseAction) {
+	p.hoverSession = ""
+	if action.Region == nil || action.Region.ID != regionSessionItem {
+		return
+	}
+	if idx, ok := action.Region.Data.(int); ok {
+		if sessions := p.visibleSessions(); idx >= 0 && idx < len(sessions) {
+			p.hoverSession = sessions[idx].ID
+		}
+	}
+}
+
 // handleMouseClick handles single click events.
 func (p *Plugin) handleMouseClick(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 	if action.Region == nil {
@@ -75,6 +96,17 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 		p.activePane = PaneMessages
 		return p, nil
 
+	case regionTurnIndexItem:
+		// Click on a turn index row - jump to that turn
+		if idx, ok := action.Region.Data.(int); ok {
+			if idx >= 0 && idx < len(p.turns) {
+				p.turnIndexCursor = idx
+				p.activePane = PaneMessages
+				p.jumpToTurn(idx)
+			}
+		}
+		return p, nil
+
 	case regionMessageItem:
 		// Click on a message in conversation flow - select it
 		if idx, ok := action.Region.Data.(int); ok {
@@ -191,6 +223,13 @@ func (p *Plugin) handleMouseScroll(action mouse.MouseAction) (*Plugin, tea.Cmd)
 			return p.scrollDetailPane(action.Delta)
 		}
 		return p.scrollMainPane(action.Delta)
+
+	case regionTurnIndexItem:
+		// Scroll moves the turn index selection
+		p.turnIndexCursor = max(0, min(p.turnIndexCursor+action.Delta, len(p.turns)-1))
+		p.syncTurnIndex(false)
+		p.hitRegionsDirty = true
+		return p, nil
 	}
 
 	return p, nil
@@ -5,19 +5,22 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"sort"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/marcus/sidecar/internal/adapter"
 	"github.com/marcus/sidecar/internal/adapter/tieredwatcher"
 	"github.com/marcus/sidecar/internal/app"
 	"github.com/marcus/sidecar/internal/modal"
 	"github.com/marcus/sidecar/internal/mouse"
 	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
 	"github.com/marcus/sidecar/internal/state"
 	"github.com/marcus/sidecar/internal/ui"
 )
@@ -34,9 +37,8 @@ const (
 	// Default page size for session list pagination (td-7198a5)
 	defaultSessionPageSize = 50
 
-	previewDebounce     = 150 * time.Millisecond
-	watchReloadDebounce = 200 * time.Millisecond
-	loadSettleDelay     = 300 * time.Millisecond // Wait for sessions to settle before hiding skeleton
+	previewDebounce = 150 * time.Millisecond
+	loadSettleDelay = 300 * time.Millisecond // Wait for sessions to settle before hiding skeleton
 
 	// Divider width for pane separator
 	dividerWidth = 1
@@ -52,14 +54,15 @@ const (
 
 // Mouse hit region identifiers
 const (
-	regionSidebar     = "sidebar"
-	regionMainPane    = "main-pane"
-	regionPaneDivider = "pane-divider"
-	regionSessionItem = "session-item" // Individual session row (Data: session index)
-	regionTurnItem    = "turn-item"    // Individual turn row (Data: turn index)
-	regionMessageItem = "message-item" // Conversation flow: click to select (Data: msg index)
-	regionToolExpand  = "tool-expand"  // Conversation flow: toggle tool output (Data: tool_use_id)
-	regionShowMore    = "show-more"    // Conversation flow: expand long message (Data: msg ID)
+	regionSidebar       = "sidebar"
+	regionMainPane      = "main-pane"
+	regionPaneDivider   = "pane-divider"
+	regionSessionItem   = "session-item"    // Individual session row (Data: session index)
+	regionTurnItem      = "turn-item"       // Individual turn row (Data: turn index)
+	regionMessageItem   = "message-item"    // Conversation flow: click to select (Data: msg index)
+	regionTurnIndexItem = "turn-index-item" // Turn index row: click to jump (Data: turn index)
+	regionToolExpand    = "tool-expand"     // Conversation flow: toggle tool output (Data: tool_use_id)
+	regionShowMore      = "show-more"       // Conversation flow: expand long message (Data: msg ID)
 )
 
 // View represents the current view mode.
@@ -105,6 +108,9 @@ type Plugin struct {
 	hasMoreSessions bool // displayedCount < len(sessions) (td-7198a5)
 	loadingAdapters bool // true while adapter batches are still arriving (td-7198a5)
 
+	// Session ID under the mouse; its exact time shows in the detail line
+	hoverSession string
+
 	// Message view state
 	selectedSession string
 	loadedSession   string // sessionID that p.messages currently represent
@@ -116,34 +122,38 @@ type Plugin struct {
 	msgScrollOff    int
 	pageSize        int
 	hasMore         bool
+	newMessageCount int // messages appended while the cursor was away from the bottom
 
 	// Pagination state (td-313ea851)
-	messageOffset      int             // Start index in full message list (0 = most recent)
-	totalMessages      int             // Total message count from adapter
-	hasOlderMsgs       bool            // True if there are older messages to load
-	expandedThinking   map[string]bool // message ID -> thinking expanded
-	sessionSummary     *SessionSummary // computed summary for current session
-	summaryModelCounts map[string]int  // model usage counts for incremental summary updates
-	summaryFileSet     map[string]bool // unique files for incremental summary updates
-	showToolSummary    bool            // toggle for tool impact view
-	turnViewMode       bool            // false = conversation flow (default), true = turn view
+	messageOffset       int             // Start index in full message list (0 = most recent)
+	totalMessages       int             // Total message count from adapter
+	hasOlderMsgs        bool            // True if there are older messages to load
+	expandedThinking    map[string]bool // message ID -> thinking expanded
+	sessionSummary      *SessionSummary // computed summary for current session
+	summaryModelCounts  map[string]int  // model usage counts for incremental summary updates
+	summaryFileSet      map[string]bool // unique files for incremental summary updates
+	showToolSummary     bool            // toggle for tool impact view
+	turnViewMode        bool            // false = conversation flow (default), true = turn view
+	collapseToolResults bool            // summarize runs of tool-result-only messages in turns
+	costs               costTable       // model rates for turn/conversation cost estimates
 
 	// Message detail view state
-	detailMode   bool  // true when showing detail in right pane (two-pane mode)
-	detailTurn   *Turn // turn being viewed in detail
-	detailScroll int
+	detailMode            bool  // true when showing detail in right pane (two-pane mode)
+	detailTurn            *Turn // turn being viewed in detail
+	detailScroll          int
+	detailVisibleLines    int              // content lines shown at the last render
+	detailThinkingAnchors []thinkingAnchor // thinking positions at the last render
 
 	// Analytics view state
 	analyticsScrollOff int
 	analyticsLines     []string // pre-rendered lines for scrolling
 
 	// Layout state
-	activePane         FocusPane // Which pane is focused
-	sidebarRestore     FocusPane // Tracks pane focused before collapse; restored on expand via toggleSidebar()
-	sidebarWidth       int       // Calculated width (~30%)
-	sidebarVisible     bool      // Toggle sidebar visibility with \
-	previewToken       int       // monotonically increasing token for debounced preview loads
-	messageReloadToken int       // monotonically increasing token for debounced watch reloads
+	activePane     FocusPane // Which pane is focused
+	sidebarRestore FocusPane // Tracks pane focused before collapse; restored on expand via toggleSidebar()
+	sidebarWidth   int       // Calculated width (~30%)
+	sidebarVisible bool      // Toggle sidebar visibility with \
+	previewToken   int       // monotonically increasing token for debounced preview loads
 
 	// View dimensions
 	width  int
@@ -153,6 +163,7 @@ type Plugin struct {
 	watchChan    <-chan adapter.Event
 	watchClosers []io.Closer
 	watchCancel  context.CancelFunc // cancel function for watcher goroutines (td-eb2699b4)
+	watchPolling bool               // watcher channel closed; poll the open conversation instead
 	stopped      bool
 
 	// Tiered watcher manager for FD reduction (td-dca6fe)
@@ -172,14 +183,31 @@ type Plugin struct {
 	searchQuery   string
 	searchResults []adapter.Session
 
+	// In-conversation message search state
+	msgSearchMode   bool               // true while typing a message search query
+	msgSearchQuery  string             // active query ("" = no search)
+	msgSearchHits   []messageSearchHit // matches in p.messages, in order
+	msgSearchCursor int                // index into msgSearchHits of the current hit
+
+	// Turn index (jump-to-turn list over the main pane)
+	turnIndexOpen   bool
+	turnIndexCursor int // selected turn in the index
+	turnIndexScroll int // first turn shown in the index
+
 	// Filter state
 	filterMode             bool
 	filters                SearchFilters
 	filterActive           bool     // true when any filter is active
 	defaultCategoryFilter  []string // from config, used by C toggle to restore
 
+	// Unread tracking (persisted last-viewed times)
+	viewedSince time.Time            // baseline for sessions never viewed
+	lastViewed  map[string]time.Time // session ID -> last viewed time
+	keepUnread  map[string]bool      // read while the unread filter is applied
+
 	// Markdown rendering
-	contentRenderer *GlamourRenderer
+	contentRenderer  *GlamourRenderer
+	codeHighlighters map[string]*gitstatus.SyntaxHighlighter // fence language -> highlighter (nil if unknown)
 
 	// Conversation flow view state (Claude Code web UI style)
 	expandedMessages    map[string]bool // message ID -> content expanded (for long messages)
@@ -241,6 +269,16 @@ type Plugin struct {
 	resumeFocus           int
 	resumeSession         *adapter.Session
 
+	// Session usage modal state
+	showUsageModal  bool
+	usageModal      *modal.Modal
+	usageModalWidth int
+	usageSessionID  string
+	usageLoading    bool
+	usageErr        error
+	usageCache      map[string]*adapter.UsageStats // session ID -> loaded stats
+	usagePending    map[string]bool                // session ID -> stats load in flight
+
 	// Content search state (td-6ac70a: cross-conversation search)
 	contentSearchMode  bool                // True when content search modal is open
 	contentSearchState *ContentSearchState // Content search state
@@ -279,12 +317,16 @@ func New() *Plugin {
 		expandedThinking:    make(map[string]bool),
 		expandedMessages:    make(map[string]bool),
 		expandedToolResults: make(map[string]bool),
+		lastViewed:          make(map[string]time.Time),
+		keepUnread:          make(map[string]bool),
 		mouseHandler:        mouse.NewHandler(),
 		contentRenderer:     renderer,
 		coalesceChan:        coalesceChan,
 		adapterBatchChan:    make(chan AdapterBatchMsg, 8),
 		adapterSpinner:      ui.NewBrailleSpinner(),
 		renderCache:         make(map[renderCacheKey]string),
+		usageCache:          make(map[string]*adapter.UsageStats),
+		usagePending:        make(map[string]bool),
 		hitRegionsDirty:     true, // Start dirty to ensure first render builds regions
 		sidebarVisible:      true, // Sidebar visible by default
 		sidebarRestore:      PaneSidebar,
@@ -305,7 +347,36 @@ func (p *Plugin) Name() string { return pluginName }
 func (p *Plugin) Icon() string { return pluginIcon }
 
 // renderContent renders markdown content to styled lines, falling back to plain text.
+// Fenced code blocks are syntax highlighted separately.
 func (p *Plugin) renderContent(content string, width int) []string {
+	segments := extractCodeFences(content)
+	hasCode := false
+	for _, seg := range segments {
+		hasCode = hasCode || seg.IsCode
+	}
+	if !hasCode {
+		return p.renderMarkdown(content, width)
+	}
+
+	var lines []string
+	for _, seg := range segments {
+		var segLines []string
+		if seg.IsCode {
+			segLines = p.renderCodeBlock(seg, width)
+		} else {
+			segLines = p.renderMarkdown(seg.Text, width)
+		}
+		// Keep one blank line between segments
+		if len(lines) > 0 && len(segLines) > 0 && strings.TrimSpace(ansi.Strip(segLines[0])) != "" {
+			lines = append(lines, "")
+		}
+		lines = append(lines, segLines...)
+	}
+	return lines
+}
+
+// renderMarkdown renders markdown content with Glamour, falling back to plain text.
+func (p *Plugin) renderMarkdown(content string, width int) []string {
 	if p.contentRenderer != nil {
 		return p.contentRenderer.RenderContent(content, width)
 	}
@@ -336,6 +407,7 @@ func (p *Plugin) resetState() {
 	p.turns = nil
 	p.turnCursor = 0
 	p.turnScrollOff = 0
+	p.newMessageCount = 0
 	p.msgCursor = 0
 	p.msgScrollOff = 0
 	p.hasMore = false
@@ -365,18 +437,34 @@ func (p *Plugin) resetState() {
 	p.sidebarRestore = PaneSidebar
 	p.sidebarVisible = true
 	p.previewToken = 0
-	p.messageReloadToken = 0
 
 	// Search state
 	p.searchMode = false
 	p.searchQuery = ""
 	p.searchResults = nil
 
+	// Message search state
+	p.msgSearchMode = false
+	p.msgSearchQuery = ""
+	p.msgSearchHits = nil
+	p.msgSearchCursor = 0
+
+	// Turn index state
+	p.turnIndexOpen = false
+	p.turnIndexCursor = 0
+	p.turnIndexScroll = 0
+
+	// Usage modal state
+	p.closeUsageModal()
+	p.usageCache = make(map[string]*adapter.UsageStats)
+	p.usagePending = make(map[string]bool)
+
 	// Filter state
 	p.filterMode = false
 	p.filters = SearchFilters{}
 	p.filterActive = false
 	p.defaultCategoryFilter = nil
+	p.keepUnread = make(map[string]bool)
 
 	// Conversation flow view state
 	p.expandedMessages = make(map[string]bool)
@@ -442,6 +530,18 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 		p.sidebarWidth = savedWidth
 	}
 
+	// Load persisted last-viewed times for unread tracking
+	p.loadViewState()
+
+	// Collapse tool-result-only messages unless the config opts out
+	p.collapseToolResults = ctx.Config == nil || ctx.Config.Plugins.Conversations.CollapseToolResults
+
+	// Cost estimates use built-in model rates plus configured overrides
+	p.costs = newCostTable(nil)
+	if ctx.Config != nil {
+		p.costs = newCostTable(ctx.Config.Plugins.Conversations.Pricing)
+	}
+
 	// Store default category filter from config for C toggle (td-91bbc4)
 	// Don't apply on startup — non-Pi adapters leave SessionCategory empty,
 	// so filtering by "interactive" would hide all their sessions (td-d3b1f6)
@@ -469,6 +569,7 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 // Start begins plugin operation.
 func (p *Plugin) Start() tea.Cmd {
 	p.stopped = false
+	p.watchPolling = false
 	if len(p.adapters) == 0 {
 		return nil
 	}
@@ -484,6 +585,7 @@ func (p *Plugin) Start() tea.Cmd {
 // Stop cleans up plugin resources.
 func (p *Plugin) Stop() {
 	p.stopped = true
+	p.markSessionViewed(p.selectedSession)
 	// Cancel watcher goroutines (td-eb2699b4)
 	if p.watchCancel != nil {
 		p.watchCancel()
@@ -559,13 +661,19 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p, cmd
 		}
 
+		if p.showUsageModal {
+			return p, p.handleUsageModalKeys(msg)
+		}
+
 		switch p.view {
 		case ViewAnalytics:
 			return p.updateAnalytics(msg)
 		default:
 			// Route based on active pane
 			if p.activePane == PaneMessages {
-				return p.updateMessages(msg)
+				model, cmd := p.updateMessages(msg)
+				p.clearNewMessagesAtBottom()
+				return model, cmd
 			}
 			return p.updateSessions(msg)
 		}
@@ -586,21 +694,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p, nil
 		}
 
-		// Merge new sessions, deduplicating by ID
-		seen := make(map[string]bool, len(p.sessions))
-		for _, s := range p.sessions {
-			seen[s.ID] = true
-		}
-		for _, s := range msg.Sessions {
-			if !seen[s.ID] {
-				seen[s.ID] = true
-				p.sessions = append(p.sessions, s)
-			}
-		}
-		// Re-sort by UpdatedAt descending
-		sort.Slice(p.sessions, func(i, j int) bool {
-			return p.sessions[i].UpdatedAt.After(p.sessions[j].UpdatedAt)
-		})
+		// Merge this adapter's sessions into the list, newest first
+		p.sessions = mergeSessions(p.sessions, msg.Sessions)
 
 		// Update pagination state (td-7198a5)
 		if p.displayedCount == 0 {
@@ -666,6 +761,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p, nil // Ignore stale message from previous project
 		}
 		p.sessions = msg.Sessions
+		p.pruneViewState()
 		// Update session pagination state (td-7198a5)
 		if p.displayedCount == 0 {
 			p.displayedCount = defaultSessionPageSize
@@ -760,10 +856,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		for _, s := range refreshMap {
 			p.sessions = append(p.sessions, *s)
 		}
-		// Re-sort by UpdatedAt descending
-		sort.Slice(p.sessions, func(i, j int) bool {
-			return p.sessions[i].UpdatedAt.After(p.sessions[j].UpdatedAt)
-		})
+		sortSessionsByRecency(p.sessions)
 		p.hasMoreSessions = len(p.sessions) > p.displayedCount
 		p.updateTieredHotTargets()
 		return p, nil
@@ -789,21 +882,6 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if p.loadedSession == msg.SessionID && len(p.messages) > 0 {
 			return p, nil
 		}
-		return p, tea.Batch(
-			p.loadMessages(msg.SessionID),
-			p.loadUsage(msg.SessionID),
-		)
-
-	case MessageReloadMsg:
-		if plugin.IsStale(p.ctx, msg) {
-			return p, nil // Ignore stale message from previous project
-		}
-		if msg.Token != p.messageReloadToken {
-			return p, nil
-		}
-		if msg.SessionID == "" || msg.SessionID != p.selectedSession {
-			return p, nil
-		}
 		return p, p.loadMessages(msg.SessionID)
 
 	case MessagesLoadedMsg:
@@ -826,6 +904,15 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p, nil
 		}
 
+		// Appended messages are folded into cached usage; any other reload of
+		// the same session may have rewritten history, so it is refetched.
+		var usageCmd tea.Cmd
+		if isIncremental {
+			p.appendUsage(msg.SessionID, msg.Messages[len(p.messages):])
+		} else if p.loadedSession == msg.SessionID {
+			usageCmd = p.invalidateUsage(msg.SessionID)
+		}
+
 		// Get session duration for summary
 		var duration time.Duration
 		for _, s := range p.sessions {
@@ -839,10 +926,16 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			// Incremental update: only process new messages
 			oldLen := len(p.messages)
 			newMessages := msg.Messages[oldLen:]
+			wasAtBottom := p.atConversationBottom()
 			p.messages = msg.Messages
 
 			// Incrementally update turns (handles extending last turn if same role)
+			indexAtEnd := p.turnIndexCursor >= len(p.turns)-1
 			p.turns = AppendMessagesToTurns(p.turns, newMessages, oldLen)
+			p.syncTurnIndex(indexAtEnd)
+
+			// Live tail: follow new messages if the cursor was at the bottom
+			p.followNewMessages(newMessages, wasAtBottom)
 
 			// Incrementally update summary
 			if p.sessionSummary != nil {
@@ -858,6 +951,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.turns = GroupMessagesIntoTurns(msg.Messages)
 			p.turnCursor = 0
 			p.turnScrollOff = 0
+			p.syncTurnIndex(false)
+			p.newMessageCount = 0
 			// Snap messageCursor to first visible message (skip tool-result-only)
 			visibleIndices := p.visibleMessageIndices()
 			if len(visibleIndices) > 0 {
@@ -883,6 +978,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.hitRegionsDirty = true
 		}
 
+		// Keep message search hits in sync with the loaded messages
+		p.refreshMessageSearch()
+
 		p.hasMore = len(msg.Messages) >= p.pageSize
 
 		// Update pagination state (td-313ea851)
@@ -891,6 +989,12 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		// hasOlderMsgs: true when there are messages beyond the current window (td-07fc795d)
 		p.hasOlderMsgs = (msg.Offset + len(msg.Messages)) < msg.TotalCount
 
+		// A partial window can't price the whole conversation, so the header
+		// cost comes from the session's usage totals
+		if p.messagesWindowed() && usageCmd == nil {
+			usageCmd = p.ensureUsage(msg.SessionID)
+		}
+
 		// Process pending scroll request from content search (td-b74d9f)
 		// Uses message ID (not index) to handle pagination correctly
 		if p.pendingScrollActive && p.pendingScrollMsgID != "" {
@@ -907,6 +1011,17 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				}
 			}
 
+			// In turn view, move to the turn containing it
+			if foundIdx >= 0 && p.turnViewMode {
+				for i, turn := range p.turns {
+					if turn.StartIndex > foundIdx {
+						break
+					}
+					p.turnCursor = i
+				}
+				p.ensureTurnCursorVisible()
+			}
+
 			// If found, scroll to it
 			if foundIdx >= 0 {
 				// Find the corresponding visible index (skip tool-result-only messages)
@@ -926,6 +1041,13 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			}
 		}
 
+		return p, usageCmd
+
+	case UsageLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		p.handleUsageLoaded(msg)
 		return p, nil
 
 	case WatchStartedMsg:
@@ -945,8 +1067,17 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		p.closeWatchers()
 		p.watchClosers = msg.Closers
 		p.watchChan = msg.Channel
+		if msg.Channel != nil {
+			p.watchPolling = false
+		}
 		return p, p.listenForWatchEvents()
 
+	case WatchClosedMsg:
+		return p, p.handleWatchClosed(msg)
+
+	case PollRefreshMsg:
+		return p, p.handlePollRefresh(msg)
+
 	case WatchEventMsg:
 		if plugin.IsStale(p.ctx, msg) {
 			return p, nil // Ignore stale message from previous project
@@ -957,19 +1088,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if p.ctx != nil {
 			epoch = p.ctx.Epoch
 		}
-		p.coalescer.Add(msg.SessionID, epoch)
-
-		cmds := []tea.Cmd{
-			p.listenForWatchEvents(),
-		}
-
-		// Still reload messages immediately if selected session changed
-		// (coalescer handles session list refresh)
-		if msg.SessionID != "" && msg.SessionID == p.selectedSession {
-			cmds = append(cmds, p.scheduleMessageReload(p.selectedSession))
-		}
-
-		return p, tea.Batch(cmds...)
+		p.coalescer.SetOpenSession(p.selectedSession)
+		p.coalescer.AddEvent(adapter.Event{Type: msg.Type, SessionID: msg.SessionID}, epoch)
+		return p, p.listenForWatchEvents()
 
 	case CoalescedRefreshMsg:
 		if plugin.IsStale(p.ctx, msg) {
@@ -980,6 +1101,11 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.listenForCoalescedRefresh(), // Continue listening for more batches
 		}
 
+		// Reload the open conversation once per batch, even when unfocused
+		if p.selectedSession != "" && slices.Contains(msg.SessionIDs, p.selectedSession) {
+			cmds = append(cmds, p.loadMessages(p.selectedSession))
+		}
+
 		// Skip full session refresh when unfocused to reduce CPU (td-05149f66).
 		// Set pendingRefresh so we catch up on focus.
 		if !p.focused {
@@ -1084,6 +1210,11 @@ func (p *Plugin) View(width, height int) string {
 		return lipgloss.NewStyle().Width(width).Height(height).MaxHeight(height).Render(content)
 	}
 
+	if p.showUsageModal {
+		content := p.renderUsageModal(width, height)
+		return lipgloss.NewStyle().Width(width).Height(height).MaxHeight(height).Render(content)
+	}
+
 	var content string
 	if len(p.adapters) == 0 {
 		content = renderNoAdapter()
@@ -1120,6 +1251,11 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "case", Name: "Case", Description: "Toggle alt+c", Category: plugin.CategoryView, Context: "conversations-content-search", Priority: 6},
 		}
 	}
+	if p.showUsageModal {
+		return []plugin.Command{
+			{ID: "close", Name: "Close", Description: "Close usage summary", Category: plugin.CategoryNavigation, Context: "conversations-usage", Priority: 1},
+		}
+	}
 	if p.searchMode {
 		return []plugin.Command{
 			{ID: "select", Name: "Select", Description: "Select search result", Category: plugin.CategoryActions, Context: "conversations-search", Priority: 1},
@@ -1132,25 +1268,43 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "cancel", Name: "Cancel", Description: "Cancel filter", Category: plugin.CategoryActions, Context: "conversations-filter", Priority: 1},
 		}
 	}
+	if p.msgSearchMode {
+		return []plugin.Command{
+			{ID: "select", Name: "Done", Description: "Keep search and browse matches", Category: plugin.CategoryActions, Context: "conversations-message-search", Priority: 1},
+			{ID: "cancel", Name: "Cancel", Description: "Clear message search", Category: plugin.CategoryActions, Context: "conversations-message-search", Priority: 1},
+		}
+	}
 	// Detail mode (right pane shows turn detail)
 	if p.detailMode {
 		return []plugin.Command{
 			{ID: "back", Name: "Back", Description: "Return to turn list", Category: plugin.CategoryNavigation, Context: "turn-detail", Priority: 1},
 			{ID: "scroll", Name: "Scroll", Description: "Scroll detail", Category: plugin.CategoryNavigation, Context: "turn-detail", Priority: 2},
 			{ID: "yank", Name: "Yank", Description: "Yank turn content", Category: plugin.CategoryActions, Context: "turn-detail", Priority: 3},
+			{ID: "toggle-tool-results", Name: "Results", Description: "Collapse/expand tool results", Category: plugin.CategoryView, Context: "turn-detail", Priority: 4},
+			{ID: "toggle-thinking", Name: "Thinking", Description: "Collapse/expand thinking in view", Category: plugin.CategoryView, Context: "turn-detail", Priority: 4},
 		}
 	}
-	if p.activePane == PaneMessages {
+	if p.turnIndexOpen && p.activePane == PaneMessages {
 		return []plugin.Command{
+			{ID: "jump", Name: "Jump", Description: "Jump to selected turn", Category: plugin.CategoryNavigation, Context: "conversations-turn-index", Priority: 1},
+			{ID: "close", Name: "Close", Description: "Close the turn index column", Category: plugin.CategoryNavigation, Context: "conversations-turn-index", Priority: 1},
+		}
+	}
+	if p.activePane == PaneMessages {
+		return p.filterUnsupportedCommands([]plugin.Command{
 			{ID: "toggle-view", Name: "View", Description: "Toggle conversation/turn view", Category: plugin.CategoryView, Context: "conversations-main", Priority: 1},
 			{ID: "detail", Name: "Detail", Description: "View turn details", Category: plugin.CategoryView, Context: "conversations-main", Priority: 2},
 			{ID: "expand", Name: "Expand", Description: "Expand selected item", Category: plugin.CategoryView, Context: "conversations-main", Priority: 3},
+			{ID: "toggle-tool-results", Name: "Results", Description: "Collapse/expand tool results", Category: plugin.CategoryView, Context: "conversations-main", Priority: 5},
+			{ID: "search-messages", Name: "Search", Description: "Search this conversation", Category: plugin.CategorySearch, Context: "conversations-main", Priority: 3},
+			{ID: "turn-index", Name: "Turns", Description: "Toggle the turn index column", Category: plugin.CategoryNavigation, Context: "conversations-main", Priority: 4},
+			{ID: "usage", Name: "Usage", Description: "Show session usage summary", Category: plugin.CategoryView, Context: "conversations-main", Priority: 5},
 			{ID: "content-search", Name: "Find", Description: "Search content (F)", Category: plugin.CategorySearch, Context: "conversations-main", Priority: 3},
 			{ID: "back", Name: "Back", Description: "Return to sidebar", Category: plugin.CategoryNavigation, Context: "conversations-main", Priority: 4},
 			{ID: "open", Name: "Open", Description: "Open in CLI", Category: plugin.CategoryActions, Context: "conversations-main", Priority: 5},
 			{ID: "yank", Name: "Yank", Description: "Yank turn content", Category: plugin.CategoryActions, Context: "conversations-main", Priority: 6},
 			{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "conversations-main", Priority: 7},
-		}
+		})
 	}
 	if p.view == ViewAnalytics {
 		return []plugin.Command{
@@ -1180,16 +1334,25 @@ func (p *Plugin) FocusContext() string {
 	if p.showResumeModal {
 		return "conversations-resume-modal"
 	}
+	if p.showUsageModal {
+		return "conversations-usage"
+	}
 	if p.searchMode {
 		return "conversations-search"
 	}
 	if p.filterMode {
 		return "conversations-filter"
 	}
+	if p.msgSearchMode {
+		return "conversations-message-search"
+	}
 	// Detail mode (right pane shows turn detail)
 	if p.detailMode {
 		return "turn-detail"
 	}
+	if p.turnIndexOpen && p.activePane == PaneMessages {
+		return "conversations-turn-index"
+	}
 	switch p.view {
 	case ViewAnalytics:
 		return "analytics"
@@ -1205,7 +1368,7 @@ func (p *Plugin) FocusContext() string {
 // ConsumesTextInput reports whether conversation UI currently has a focused
 // text-entry flow where app shortcuts should not intercept characters.
 func (p *Plugin) ConsumesTextInput() bool {
-	return p.searchMode || p.filterMode || p.contentSearchMode
+	return p.searchMode || p.filterMode || p.contentSearchMode || p.msgSearchMode
 }
 
 // Diagnostics returns plugin health info.
@@ -1313,8 +1476,9 @@ type MessagesLoadedMsg struct {
 func (m MessagesLoadedMsg) GetEpoch() uint64 { return m.Epoch }
 
 type WatchEventMsg struct {
-	Epoch     uint64 // Epoch when request was issued (for stale detection)
-	SessionID string // ID of the session that changed (empty for periodic refresh)
+	Epoch     uint64            // Epoch when request was issued (for stale detection)
+	Type      adapter.EventType // Kind of change
+	SessionID string            // ID of the session that changed (empty for periodic refresh)
 }
 
 // GetEpoch implements plugin.EpochMessage.
@@ -1335,15 +1499,6 @@ type PreviewLoadMsg struct {
 // GetEpoch implements plugin.EpochMessage.
 func (m PreviewLoadMsg) GetEpoch() uint64 { return m.Epoch }
 
-type MessageReloadMsg struct {
-	Epoch     uint64 // Epoch when request was issued (for stale detection)
-	Token     int
-	SessionID string
-}
-
-// GetEpoch implements plugin.EpochMessage.
-func (m MessageReloadMsg) GetEpoch() uint64 { return m.Epoch }
-
 // checkLargeSessionWarnings returns toast warnings for any large sessions not yet warned.
 // Marks sessions as warned to avoid duplicate notifications.
 func (p *Plugin) checkLargeSessionWarnings() tea.Cmd {
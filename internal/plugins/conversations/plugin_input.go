@@ -136,10 +136,7 @@ func (p *Plugin) updateSessions(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		if len(sessions) > 0 && p.cursor < len(sessions) {
 			p.setSelectedSession(sessions[p.cursor].ID)
 			p.activePane = PaneMessages
-			return p, tea.Batch(
-				p.loadMessages(p.selectedSession),
-				p.loadUsage(p.selectedSession),
-			)
+			return p, p.loadMessages(p.selectedSession)
 		}
 
 	case "/":
@@ -247,10 +244,7 @@ func (p *Plugin) updateSearch(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			p.msgCursor = 0
 			p.msgScrollOff = 0
 			p.searchMode = false
-			return p, tea.Batch(
-				p.loadMessages(p.selectedSession),
-				p.loadUsage(p.selectedSession),
-			)
+			return p, p.loadMessages(p.selectedSession)
 		}
 
 	case "backspace":
@@ -415,8 +409,23 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		return p.updateDetailMode(msg)
 	}
 
+	// Typing a message search query
+	if p.msgSearchMode {
+		return p.updateMessageSearch(msg)
+	}
+
+	// Turn index navigation
+	if p.turnIndexOpen {
+		return p.updateTurnIndex(msg)
+	}
+
 	switch msg.String() {
 	case "esc":
+		// Clear an active message search before leaving the pane
+		if p.msgSearchQuery != "" {
+			p.clearMessageSearch()
+			return p, nil
+		}
 		// Restore sidebar if hidden, otherwise return focus to sidebar
 		if !p.sidebarVisible {
 			p.sidebarVisible = true
@@ -453,6 +462,8 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			if p.turnCursor < len(p.turns)-1 {
 				p.turnCursor++
 				p.ensureTurnCursorVisible()
+			} else if p.turnCursor < len(p.turns) {
+				return p, p.loadAdjacentPage(false, p.turns[p.turnCursor].Messages[0].ID)
 			}
 		} else {
 			// Conversation flow cursor navigation
@@ -466,6 +477,8 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 						if i < len(visibleIndices)-1 {
 							p.messageCursor = visibleIndices[i+1]
 							p.ensureMessageCursorVisible()
+						} else {
+							return p, p.loadAdjacentPage(false, p.messages[idx].ID)
 						}
 						break
 					}
@@ -483,6 +496,8 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			if p.turnCursor > 0 {
 				p.turnCursor--
 				p.ensureTurnCursorVisible()
+			} else if len(p.turns) > 0 {
+				return p, p.loadAdjacentPage(true, p.turns[0].Messages[0].ID)
 			}
 		} else {
 			// Conversation flow cursor navigation
@@ -496,6 +511,8 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 						if i > 0 {
 							p.messageCursor = visibleIndices[i-1]
 							p.ensureMessageCursorVisible()
+						} else {
+							return p, p.loadAdjacentPage(true, p.messages[idx].ID)
 						}
 						break
 					}
@@ -596,6 +613,11 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		// Toggle tool impact summary
 		p.showToolSummary = !p.showToolSummary
 
+	case "T":
+		// Toggle collapsing of tool-result-only messages in turns
+		p.collapseToolResults = !p.collapseToolResults
+		p.hitRegionsDirty = true
+
 	case "v":
 		// Toggle between conversation flow and turn view
 		p.turnViewMode = !p.turnViewMode
@@ -605,21 +627,34 @@ func (p *Plugin) updateMessages(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	case "p":
 		// Load older messages (td-313ea851)
 		if p.hasOlderMsgs && p.totalMessages > maxMessagesInMemory {
-			p.messageOffset += maxMessagesInMemory / 2 // Load half a page older
-			if p.messageOffset > p.totalMessages-maxMessagesInMemory {
-				p.messageOffset = p.totalMessages - maxMessagesInMemory
-			}
-			return p, p.loadMessages(p.selectedSession)
+			return p, p.shiftMessageWindow(maxMessagesInMemory / 2) // Load half a page older
 		}
 
+	case "I":
+		// Open the turn index to jump to a turn
+		p.openTurnIndex()
+
+	case "U":
+		// Show token usage for this session
+		return p, p.openUsageModal()
+
+	case "/":
+		// Search messages in this conversation
+		p.openMessageSearch()
+
 	case "n":
-		// Load newer messages (td-313ea851)
+		// Next search match, or load newer messages (td-313ea851)
+		if p.msgSearchQuery != "" {
+			return p, p.stepSearchHit(1)
+		}
 		if p.messageOffset > 0 {
-			p.messageOffset -= maxMessagesInMemory / 2 // Load half a page newer
-			if p.messageOffset < 0 {
-				p.messageOffset = 0
-			}
-			return p, p.loadMessages(p.selectedSession)
+			return p, p.shiftMessageWindow(-maxMessagesInMemory / 2) // Load half a page newer
+		}
+
+	case "N":
+		// Previous search match
+		if p.msgSearchQuery != "" {
+			return p, p.stepSearchHit(-1)
 		}
 
 	case "e":
@@ -776,6 +811,14 @@ func (p *Plugin) updateDetailMode(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	case "Y":
 		// Yank resume command to clipboard
 		return p, p.yankResumeCommand()
+
+	case "T":
+		// Toggle collapsing of tool-result-only messages
+		p.collapseToolResults = !p.collapseToolResults
+
+	case "t":
+		// Toggle the thinking blocks in view
+		p.toggleDetailThinking()
 	}
 
 	return p, nil
@@ -800,6 +843,7 @@ func (p *Plugin) updateFilter(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		p.filterMode = false
 		p.hitRegionsDirty = true // Session list returns (td-455e378b)
 		p.filterActive = p.filters.IsActive()
+		p.keepUnread = make(map[string]bool)
 		p.cursor = 0
 		p.scrollOff = 0
 
@@ -839,10 +883,18 @@ func (p *Plugin) updateFilter(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		// Toggle date filter: week
 		p.filters.SetDateRange("week")
 
+	case "l":
+		// Toggle date filter: older than a week
+		p.filters.SetDateRange("older")
+
 	case "a":
 		// Toggle active only
 		p.filters.ActiveOnly = !p.filters.ActiveOnly
 
+	case "u":
+		// Toggle unread only
+		p.filters.UnreadOnly = !p.filters.UnreadOnly
+
 	case "x":
 		// Clear all filters
 		p.filters = SearchFilters{}
@@ -220,10 +220,20 @@ func (p *Plugin) refreshSessions(sessionIDs []string) tea.Cmd {
 
 		for _, sessionID := range sessionIDs {
 			// Try each adapter's TargetedRefresher interface
-			for _, a := range adapters {
+			for id, a := range adapters {
 				if tr, ok := a.(adapter.TargetedRefresher); ok {
 					s, err := tr.SessionByID(sessionID)
 					if err == nil && s != nil {
+						// Tag like loadSessions so messages route to the owning adapter
+						if s.AdapterID == "" {
+							s.AdapterID = id
+						}
+						if s.AdapterName == "" {
+							s.AdapterName = a.Name()
+						}
+						if s.AdapterIcon == "" {
+							s.AdapterIcon = a.Icon()
+						}
 						refreshed = append(refreshed, *s)
 						break
 					}
@@ -239,6 +249,37 @@ func (p *Plugin) refreshSessions(sessionIDs []string) tea.Cmd {
 	}
 }
 
+// shiftMessageWindow moves the loaded message window delta messages toward
+// older (positive) or newer (negative) messages, clamped to the session, and
+// reloads it. Returns nil when the window can't move (td-313ea851).
+func (p *Plugin) shiftMessageWindow(delta int) tea.Cmd {
+	offset := max(0, min(p.messageOffset+delta, p.totalMessages-maxMessagesInMemory))
+	if offset == p.messageOffset {
+		return nil
+	}
+	p.messageOffset = offset
+	return p.loadMessages(p.selectedSession)
+}
+
+// loadAdjacentPage lazily loads pageSize more messages once the cursor
+// reaches the oldest or newest end of the loaded window, keeping the cursor
+// on the message anchorID after the reload.
+func (p *Plugin) loadAdjacentPage(older bool, anchorID string) tea.Cmd {
+	delta := -p.pageSize
+	if older {
+		if !p.hasOlderMsgs {
+			return nil
+		}
+		delta = p.pageSize
+	}
+	cmd := p.shiftMessageWindow(delta)
+	if cmd != nil {
+		p.pendingScrollMsgID = anchorID
+		p.pendingScrollActive = true
+	}
+	return cmd
+}
+
 // loadMessages loads messages for a session with pagination support (td-313ea851).
 func (p *Plugin) loadMessages(sessionID string) tea.Cmd {
 	// Capture epoch for stale detection on project switch
@@ -252,41 +293,23 @@ func (p *Plugin) loadMessages(sessionID string) tea.Cmd {
 		if len(p.adapters) == 0 {
 			return MessagesLoadedMsg{Epoch: epoch}
 		}
-		adapter := p.adapterForSession(sessionID)
-		if adapter == nil {
+		a := p.adapterForSession(sessionID)
+		if a == nil {
 			return MessagesLoadedMsg{Epoch: epoch}
 		}
-		messages, err := adapter.Messages(sessionID)
+		// Load a window of maxMessagesInMemory messages; offset counts back
+		// from the most recent message
+		page, err := adapter.MessagesPage(a, sessionID, offset, maxMessagesInMemory)
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
 
-		totalCount := len(messages)
-		resultOffset := 0
-
-		// Apply pagination: load a window of maxMessagesInMemory messages
-		if len(messages) > maxMessagesInMemory {
-			// offset indicates how many messages to skip from the end (most recent)
-			// offset=0 means show the most recent messages
-			// offset=100 means skip the 100 most recent and show older ones
-			endIdx := len(messages) - offset
-			if endIdx < 0 {
-				endIdx = 0
-			}
-			startIdx := endIdx - maxMessagesInMemory
-			if startIdx < 0 {
-				startIdx = 0
-			}
-			resultOffset = startIdx
-			messages = messages[startIdx:endIdx]
-		}
-
 		return MessagesLoadedMsg{
 			Epoch:      epoch,
 			SessionID:  sessionID,
-			Messages:   messages,
-			TotalCount: totalCount,
-			Offset:     resultOffset,
+			Messages:   page.Messages,
+			TotalCount: page.Total,
+			Offset:     page.Offset,
 		}
 	}
 }
@@ -432,11 +455,17 @@ func (p *Plugin) startWatcher() tea.Cmd {
 					return result, nil
 				}
 
+				var ended func(path string) bool
+				if detector, ok := p.adapters[adapterID].(adapter.SessionEndDetector); ok {
+					ended = detector.SessionEnded
+				}
+
 				tw, ch, err := tieredwatcher.New(tieredwatcher.Config{
 					FilePattern: "",
 					Filter:      extFilter,
 					ExtractID:   extractID,
 					ScanDir:     scanDir,
+					Ended:       ended,
 				})
 				if err != nil {
 					continue
@@ -476,6 +505,9 @@ func (p *Plugin) startWatcher() tea.Cmd {
 			if fileBasedAdapters[adapterID] {
 				continue // Already using tiered watcher
 			}
+			if !a.Capabilities().Supports(adapter.CapWatch) {
+				continue
+			}
 
 			// Check if adapter has global watch scope
 			isGlobal := false
@@ -549,10 +581,10 @@ func (p *Plugin) listenForWatchEvents() tea.Cmd {
 	return func() tea.Msg {
 		evt, ok := <-p.watchChan
 		if !ok {
-			// Channel closed
-			return nil
+			// Channel closed: fall back to polling
+			return WatchClosedMsg{Epoch: epoch}
 		}
-		return WatchEventMsg{Epoch: epoch, SessionID: evt.SessionID}
+		return WatchEventMsg{Epoch: epoch, Type: evt.Type, SessionID: evt.SessionID}
 	}
 }
 
@@ -673,12 +705,6 @@ func (p *Plugin) updateTieredHotTargets() {
 	}
 }
 
-// loadUsage loads usage stats for a session (placeholder for future implementation).
-func (p *Plugin) loadUsage(sessionID string) tea.Cmd {
-	// Usage is already computed from messages in MessagesLoadedMsg handler
-	return nil
-}
-
 // formatSessionCount formats a session count.
 func formatSessionCount(n int) string {
 	if n == 1 {
@@ -1,6 +1,36 @@
 package conversations
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+// sessionsAdapter is a mock adapter that owns a fixed set of sessions.
+type sessionsAdapter struct {
+	mockAdapter
+	id       string
+	sessions []adapter.Session
+	messages map[string][]adapter.Message
+}
+
+func (a *sessionsAdapter) ID() string   { return a.id }
+func (a *sessionsAdapter) Name() string { return a.id + " agent" }
+func (a *sessionsAdapter) Sessions(string) ([]adapter.Session, error) {
+	return a.sessions, nil
+}
+func (a *sessionsAdapter) Messages(sessionID string) ([]adapter.Message, error) {
+	return a.messages[sessionID], nil
+}
+func (a *sessionsAdapter) SessionByID(sessionID string) (*adapter.Session, error) {
+	for _, s := range a.sessions {
+		if s.ID == sessionID {
+			return &s, nil
+		}
+	}
+	return nil, nil
+}
 
 func TestDeriveWorktreeNameFromPath(t *testing.T) {
 	tests := []struct {
@@ -57,3 +87,92 @@ func TestDeriveWorktreeNameFromPath(t *testing.T) {
 		})
 	}
 }
+
+func TestMergeSessions(t *testing.T) {
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	current := []adapter.Session{
+		{ID: "c1", AdapterID: "claude-code", UpdatedAt: base.Add(-2 * time.Hour)},
+		{ID: "c2", AdapterID: "claude-code", UpdatedAt: base.Add(-4 * time.Hour)},
+	}
+	incoming := []adapter.Session{
+		{ID: "x1", AdapterID: "codex", UpdatedAt: base.Add(-3 * time.Hour)},
+		{ID: "x2", AdapterID: "codex", UpdatedAt: base},
+		// Fresh copy of a listed session replaces the stale one
+		{ID: "c2", AdapterID: "claude-code", UpdatedAt: base.Add(-time.Hour), IsActive: true},
+		// Same time as c1: tie broken by adapter, then ID
+		{ID: "x0", AdapterID: "codex", UpdatedAt: base.Add(-2 * time.Hour)},
+	}
+
+	got := mergeSessions(current, incoming)
+
+	var ids []string
+	for _, s := range got {
+		ids = append(ids, s.ID)
+	}
+	want := []string{"x2", "c2", "c1", "x0", "x1"}
+	if len(ids) != len(want) {
+		t.Fatalf("merged = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("merged = %v, want %v", ids, want)
+		}
+	}
+	if !got[1].IsActive {
+		t.Error("existing session should be replaced by the fresh copy")
+	}
+}
+
+func TestAdapterBatchesMergeAndRoute(t *testing.T) {
+	now := time.Now()
+	claude := &sessionsAdapter{
+		id: "claude-code",
+		sessions: []adapter.Session{
+			{ID: "c1", AdapterID: "claude-code", AdapterIcon: "◆", UpdatedAt: now.Add(-time.Hour)},
+		},
+		messages: map[string][]adapter.Message{"c1": {{ID: "cm", Role: "user", Content: "from claude"}}},
+	}
+	codex := &sessionsAdapter{
+		id: "codex",
+		sessions: []adapter.Session{
+			{ID: "x1", AdapterID: "codex", AdapterIcon: "▶", UpdatedAt: now},
+			{ID: "x2", AdapterID: "codex", AdapterIcon: "▶", UpdatedAt: now.Add(-2 * time.Hour)},
+		},
+		messages: map[string][]adapter.Message{"x1": {{ID: "xm", Role: "user", Content: "from codex"}}},
+	}
+
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"claude-code": claude, "codex": codex}
+	_, _ = p.Update(AdapterBatchMsg{Sessions: claude.sessions})
+	_, _ = p.Update(AdapterBatchMsg{Sessions: codex.sessions})
+	_, _ = p.Update(AdapterBatchMsg{Final: true})
+
+	if len(p.sessions) != 3 || p.sessions[0].ID != "x1" || p.sessions[1].ID != "c1" || p.sessions[2].ID != "x2" {
+		t.Fatalf("sessions not merged by recency: %+v", p.sessions)
+	}
+
+	// Messages come from the adapter that owns the session
+	for _, tt := range []struct{ session, want string }{{"c1", "from claude"}, {"x1", "from codex"}} {
+		msg, ok := p.loadMessages(tt.session)().(MessagesLoadedMsg)
+		if !ok || len(msg.Messages) != 1 || msg.Messages[0].Content != tt.want {
+			t.Errorf("loadMessages(%q) = %+v, want %q", tt.session, msg.Messages, tt.want)
+		}
+	}
+}
+
+func TestRefreshSessionsTagsAdapter(t *testing.T) {
+	codex := &sessionsAdapter{
+		id:       "codex",
+		sessions: []adapter.Session{{ID: "x1", UpdatedAt: time.Now()}},
+	}
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"codex": codex}
+
+	msg, ok := p.refreshSessions([]string{"x1"})().(SessionsRefreshedMsg)
+	if !ok || len(msg.Refreshed) != 1 {
+		t.Fatalf("refreshSessions() = %+v, want one session", msg)
+	}
+	if s := msg.Refreshed[0]; s.AdapterID != "codex" || s.AdapterName != "codex agent" {
+		t.Errorf("refreshed session = %+v, want tagged with codex", s)
+	}
+}
@@ -1,6 +1,7 @@
 package conversations
 
 import (
+	"sort"
 	"strings"
 	"time"
 
@@ -15,12 +16,14 @@ func (p *Plugin) setSelectedSession(sessionID string) {
 	if sessionID == "" || sessionID == p.selectedSession {
 		return
 	}
+	p.markSessionViewed(p.selectedSession)
 	p.selectedSession = sessionID
 	p.loadedSession = ""
 	p.messages = nil
 	p.turns = nil
 	p.turnCursor = 0
 	p.turnScrollOff = 0
+	p.newMessageCount = 0
 	p.sessionSummary = nil
 	p.showToolSummary = false
 	p.detailMode = false
@@ -33,6 +36,10 @@ func (p *Plugin) setSelectedSession(sessionID string) {
 	p.messageScroll = 0
 	p.messageCursor = 0
 	p.turnViewMode = false // Start in conversation flow mode
+	p.clearMessageSearch()
+	p.turnIndexOpen = false
+	p.turnIndexCursor = 0
+	p.turnIndexScroll = 0
 	// Reset pagination state (td-313ea851)
 	p.messageOffset = 0
 	p.totalMessages = 0
@@ -52,6 +59,41 @@ func (p *Plugin) setSelectedSession(sessionID string) {
 	p.updateTieredHotTargets()
 }
 
+// mergeSessions merges one adapter's sessions into the list. Sessions
+// already listed are replaced with the fresh copy, new ones are appended, and
+// the result is sorted by recency.
+func mergeSessions(current, incoming []adapter.Session) []adapter.Session {
+	index := make(map[string]int, len(current))
+	for i, s := range current {
+		index[s.ID] = i
+	}
+	for _, s := range incoming {
+		if i, ok := index[s.ID]; ok {
+			current[i] = s
+			continue
+		}
+		index[s.ID] = len(current)
+		current = append(current, s)
+	}
+	sortSessionsByRecency(current)
+	return current
+}
+
+// sortSessionsByRecency sorts sessions newest first. Ties are broken by
+// adapter and ID so the order doesn't shuffle between reloads.
+func sortSessionsByRecency(sessions []adapter.Session) {
+	sort.Slice(sessions, func(i, j int) bool {
+		a, b := sessions[i], sessions[j]
+		if !a.UpdatedAt.Equal(b.UpdatedAt) {
+			return a.UpdatedAt.After(b.UpdatedAt)
+		}
+		if a.AdapterID != b.AdapterID {
+			return a.AdapterID < b.AdapterID
+		}
+		return a.ID < b.ID
+	})
+}
+
 // findSelectedSession returns the currently selected session.
 func (p *Plugin) findSelectedSession() *adapter.Session {
 	for i := range p.sessions {
@@ -114,20 +156,25 @@ func (p *Plugin) filterSessions() {
 
 // visibleSessions returns sessions to display (filtered or all).
 func (p *Plugin) visibleSessions() []adapter.Session {
-	if p.searchMode && p.searchQuery != "" {
-		return p.searchResults
+	searching := p.searchMode && p.searchQuery != ""
+	sessions := p.sessions
+	if searching {
+		sessions = p.searchResults
 	}
 
-	// Apply filters if active
+	// Apply filters if active (narrowing search results too)
 	if p.filterActive && p.filters.IsActive() {
 		var filtered []adapter.Session
-		for _, s := range p.sessions {
-			if p.filters.Matches(s) {
+		for _, s := range sessions {
+			if p.sessionMatchesFilters(s) {
 				filtered = append(filtered, s)
 			}
 		}
 		return filtered
 	}
+	if searching {
+		return sessions
+	}
 
 	// Apply session pagination (td-7198a5)
 	if p.displayedCount > 0 && p.displayedCount < len(p.sessions) {
@@ -190,23 +237,6 @@ func (p *Plugin) schedulePreviewLoad(sessionID string) tea.Cmd {
 	})
 }
 
-// scheduleMessageReload schedules a debounced message reload for a session.
-func (p *Plugin) scheduleMessageReload(sessionID string) tea.Cmd {
-	if sessionID == "" {
-		return nil
-	}
-	p.messageReloadToken++
-	token := p.messageReloadToken
-	// Capture epoch for stale detection on project switch
-	var epoch uint64
-	if p.ctx != nil {
-		epoch = p.ctx.Epoch
-	}
-	return tea.Tick(watchReloadDebounce, func(time.Time) tea.Msg {
-		return MessageReloadMsg{Epoch: epoch, Token: token, SessionID: sessionID}
-	})
-}
-
 // Sidebar toggle method
 
 // toggleSidebar toggles the sidebar visibility.
@@ -234,7 +264,7 @@ func (p *Plugin) toggleSidebar() {
 func (p *Plugin) ensureCursorVisible() {
 	// Pane height - borders(2) - header(1-2)
 	paneHeight := p.height - 2
-	visibleRows := paneHeight - 3 // -2 for inner height calc, -1 for header
+	visibleRows := paneHeight - 4 // -2 for inner height calc, -1 for header, -1 for detail line
 	if visibleRows < 1 {
 		visibleRows = 1
 	}
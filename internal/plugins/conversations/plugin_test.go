@@ -10,6 +10,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/marcus/sidecar/internal/adapter"
 	"github.com/marcus/sidecar/internal/app"
+	"github.com/marcus/sidecar/internal/mouse"
 	"github.com/marcus/sidecar/internal/plugin"
 )
 
@@ -175,6 +176,76 @@ func TestFormatDuration(t *testing.T) {
 	}
 }
 
+func TestFormatAbsoluteTime(t *testing.T) {
+	ts := time.Date(2026, 3, 5, 14, 7, 9, 0, time.Local)
+	if got, want := formatAbsoluteTime(ts), "Thu Mar 5 2026 14:07:09"; got != want {
+		t.Errorf("formatAbsoluteTime = %q, want %q", got, want)
+	}
+	if got := formatAbsoluteTime(time.Time{}); got != "" {
+		t.Errorf("formatAbsoluteTime(zero) = %q, want empty", got)
+	}
+}
+
+func TestSessionTimeLabel(t *testing.T) {
+	now := time.Date(2026, 3, 5, 15, 0, 0, 0, time.Local)
+	session := adapter.Session{UpdatedAt: now.Add(-5 * time.Minute)}
+
+	if got := sessionTimeLabel(session, false, now); got != "5m ago" {
+		t.Errorf("unfocused label = %q, want relative", got)
+	}
+	if got, want := sessionTimeLabel(session, true, now), formatAbsoluteTime(session.UpdatedAt); got != want {
+		t.Errorf("focused label = %q, want %q", got, want)
+	}
+	if got := sessionTimeLabel(adapter.Session{}, false, now); got != "" {
+		t.Errorf("label without time = %q, want empty", got)
+	}
+}
+
+func TestSessionDetailLineFocusAndHover(t *testing.T) {
+	now := time.Date(2026, 3, 5, 15, 0, 0, 0, time.Local)
+	p := New()
+	p.sessions = []adapter.Session{
+		{ID: "a", UpdatedAt: now.Add(-time.Minute), Duration: 90 * time.Minute},
+		{ID: "b", UpdatedAt: now.Add(-2 * time.Hour)},
+	}
+	p.displayedCount = len(p.sessions)
+	p.cursor = 0
+
+	p.activePane = PaneMessages
+	if got := p.sessionDetailLine(now); got != "" {
+		t.Errorf("expected no detail line without focus or hover, got %q", got)
+	}
+
+	p.activePane = PaneSidebar
+	want := formatAbsoluteTime(p.sessions[0].UpdatedAt) + " · 1h30m long"
+	if got := p.sessionDetailLine(now); got != want {
+		t.Errorf("focused detail = %q, want %q", got, want)
+	}
+
+	// Hover wins over the cursor and works without sidebar focus
+	p.activePane = PaneMessages
+	p.hoverSession = "b"
+	if got, want := p.sessionDetailLine(now), formatAbsoluteTime(p.sessions[1].UpdatedAt); got != want {
+		t.Errorf("hovered detail = %q, want %q", got, want)
+	}
+}
+
+func TestHandleMouseHoverTracksSession(t *testing.T) {
+	p := New()
+	p.sessions = []adapter.Session{{ID: "a"}, {ID: "b"}}
+	p.displayedCount = len(p.sessions)
+
+	p.handleMouseHover(mouse.MouseAction{Region: &mouse.Region{ID: regionSessionItem, Data: 1}})
+	if p.hoverSession != "b" {
+		t.Errorf("hoverSession = %q, want b", p.hoverSession)
+	}
+
+	p.handleMouseHover(mouse.MouseAction{Region: &mouse.Region{ID: regionMainPane}})
+	if p.hoverSession != "" {
+		t.Errorf("hoverSession = %q, want cleared off the list", p.hoverSession)
+	}
+}
+
 func TestFormatSessionCount(t *testing.T) {
 	tests := []struct {
 		count    int
@@ -540,10 +611,10 @@ func TestListenForWatchEventsClosedChannel(t *testing.T) {
 		t.Fatal("expected non-nil command")
 	}
 
-	// Execute the command - should return nil when channel is closed
+	// Execute the command - should report the closed channel so the plugin can poll
 	msg := cmd()
-	if msg != nil {
-		t.Errorf("expected nil message for closed channel, got %T", msg)
+	if _, ok := msg.(WatchClosedMsg); !ok {
+		t.Errorf("expected WatchClosedMsg for closed channel, got %T", msg)
 	}
 }
 
@@ -1605,6 +1676,39 @@ func TestRegisterSessionHitRegions(t *testing.T) {
 	}
 }
 
+// TestRegisterSessionHitRegionsMatchRenderedRows checks that no session
+// region extends past the rows the sidebar actually renders.
+func TestRegisterSessionHitRegionsMatchRenderedRows(t *testing.T) {
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": &mockAdapter{}}
+	now := time.Now()
+	for i := range 40 {
+		p.sessions = append(p.sessions, adapter.Session{
+			ID:        fmt.Sprintf("s-%02d", i),
+			Name:      fmt.Sprintf("Session %02d", i),
+			UpdatedAt: now.Add(-time.Duration(i) * time.Second),
+		})
+	}
+	p.width = 150
+	p.height = 30
+
+	view := p.View(p.width, p.height)
+	regions := 0
+	for _, r := range p.mouseHandler.HitMap.Regions() {
+		if r.ID != regionSessionItem {
+			continue
+		}
+		regions++
+		name := p.sessions[r.Data.(int)].Name
+		if !strings.Contains(view, name) {
+			t.Errorf("hit region at y=%d selects %q, which is not rendered", r.Rect.Y, name)
+		}
+	}
+	if regions == 0 {
+		t.Fatal("expected session hit regions")
+	}
+}
+
 // TestScrollSidebarFunction tests the scrollSidebar function directly.
 func TestScrollSidebarFunction(t *testing.T) {
 	p := New()
@@ -1807,6 +1911,48 @@ func TestPaginationKeyHandling(t *testing.T) {
 	})
 }
 
+// TestLoadAdjacentPage tests lazily shifting the message window by pageSize
+// when scrolling past either end of the loaded messages.
+func TestLoadAdjacentPage(t *testing.T) {
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": &mockAdapter{}}
+	p.selectedSession = "test-1"
+	p.pageSize = 50
+	p.totalMessages = 1000
+
+	p.hasOlderMsgs = false
+	if cmd := p.loadAdjacentPage(true, "msg-0"); cmd != nil || p.messageOffset != 0 {
+		t.Errorf("expected no load without older messages, offset %d", p.messageOffset)
+	}
+
+	p.hasOlderMsgs = true
+	if cmd := p.loadAdjacentPage(true, "msg-0"); cmd == nil {
+		t.Fatal("expected a load command for older messages")
+	}
+	if p.messageOffset != 50 {
+		t.Errorf("expected offset 50, got %d", p.messageOffset)
+	}
+	if !p.pendingScrollActive || p.pendingScrollMsgID != "msg-0" {
+		t.Errorf("expected pending scroll to msg-0, got %q/%v", p.pendingScrollMsgID, p.pendingScrollActive)
+	}
+
+	p.pendingScrollActive = false
+	p.messageOffset = 30
+	if cmd := p.loadAdjacentPage(false, "msg-9"); cmd == nil || p.messageOffset != 0 {
+		t.Errorf("expected newer load clamped to offset 0, got %d", p.messageOffset)
+	}
+	if cmd := p.loadAdjacentPage(false, "msg-9"); cmd != nil {
+		t.Error("expected no load at the newest messages")
+	}
+
+	// Offset is capped so a full window is always loaded
+	p.messageOffset = 480
+	p.loadAdjacentPage(true, "msg-0")
+	if want := 1000 - maxMessagesInMemory; p.messageOffset != want {
+		t.Errorf("expected offset clamped to %d, got %d", want, p.messageOffset)
+	}
+}
+
 // TestMessagesLoadedMsgPagination tests pagination state update from MessagesLoadedMsg (td-313ea851).
 func TestMessagesLoadedMsgPagination(t *testing.T) {
 	t.Run("sets pagination state from message", func(t *testing.T) {
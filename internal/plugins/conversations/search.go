@@ -19,12 +19,13 @@ type SearchFilters struct {
 	MinTokens  int       // Sessions with > N tokens
 	MaxTokens  int       // Sessions with < N tokens
 	ActiveOnly bool      // Only currently active
+	UnreadOnly bool      // Only sessions updated since last viewed
 	HasFiles   []string  // Sessions that touched these files
 }
 
 // DateRange represents a date range filter.
 type DateRange struct {
-	Preset string    // "today", "yesterday", "week", "month", "all"
+	Preset string    // "today", "yesterday", "week", "month", "older", "all"
 	Start  time.Time // For custom range
 	End    time.Time
 }
@@ -39,6 +40,7 @@ func (f *SearchFilters) IsActive() bool {
 		f.MinTokens > 0 ||
 		f.MaxTokens > 0 ||
 		f.ActiveOnly ||
+		f.UnreadOnly ||
 		len(f.HasFiles) > 0
 }
 
@@ -124,13 +126,18 @@ func (f *SearchFilters) SetDateRange(preset string) {
 	case "month":
 		f.DateRange.Start = today.AddDate(0, -1, 0)
 		f.DateRange.End = now
+	case "older":
+		// Everything before the "This Week" group
+		f.DateRange.Start = time.Time{}
+		f.DateRange.End = today.AddDate(0, 0, -7)
 	default:
 		f.DateRange.Start = time.Time{}
 		f.DateRange.End = time.Time{}
 	}
 }
 
-// Matches checks if a session matches all filter criteria.
+// Matches checks if a session matches all filter criteria. UnreadOnly
+// depends on view history and is applied by the plugin.
 func (f *SearchFilters) Matches(session adapter.Session) bool {
 	// Text search
 	if f.Query != "" {
@@ -205,6 +212,9 @@ func (f *SearchFilters) String() string {
 	if f.ActiveOnly {
 		parts = append(parts, "[active]")
 	}
+	if f.UnreadOnly {
+		parts = append(parts, "[unread]")
+	}
 
 	return strings.Join(parts, " ")
 }
@@ -217,3 +217,58 @@ func TestFormatTokenCount(t *testing.T) {
 		}
 	}
 }
+
+func TestSessionDateBucket(t *testing.T) {
+	loc := time.FixedZone("test", -5*60*60)
+	now := time.Date(2026, 3, 12, 15, 30, 0, 0, loc) // Thursday afternoon
+	midnight := time.Date(2026, 3, 12, 0, 0, 0, 0, loc)
+
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"just now", now.Add(-time.Minute), "Today"},
+		{"start of today", midnight, "Today"},
+		{"future timestamp", now.Add(time.Hour), "Today"},
+		{"just before midnight", midnight.Add(-time.Second), "Yesterday"},
+		{"start of yesterday", midnight.AddDate(0, 0, -1), "Yesterday"},
+		{"two days ago", midnight.AddDate(0, 0, -2).Add(12 * time.Hour), "This Week"},
+		{"just after a week ago", midnight.AddDate(0, 0, -7).Add(time.Second), "This Week"},
+		{"exactly a week ago", midnight.AddDate(0, 0, -7), "Older"},
+		{"last month", now.AddDate(0, -1, 0), "Older"},
+		{"zero time", time.Time{}, "Older"},
+		{"other timezone, same instant as today", midnight.UTC(), "Today"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sessionDateBucket(tt.t, now); got != tt.want {
+				t.Errorf("sessionDateBucket(%v) = %q, want %q", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchFilters_Matches_Older(t *testing.T) {
+	now := time.Now()
+	f := &SearchFilters{}
+	f.SetDateRange("older")
+
+	if !f.IsActive() {
+		t.Fatal("older preset should make filters active")
+	}
+	if f.Matches(adapter.Session{UpdatedAt: now.Add(-time.Hour)}) {
+		t.Error("recent session should not match 'older'")
+	}
+	if !f.Matches(adapter.Session{UpdatedAt: now.AddDate(0, 0, -10)}) {
+		t.Error("session from 10 days ago should match 'older'")
+	}
+	// The filter agrees with the sidebar's date groups
+	for _, days := range []int{0, 1, 3, 8, 30} {
+		s := adapter.Session{UpdatedAt: now.AddDate(0, 0, -days)}
+		if got, want := f.Matches(s), getSessionGroup(s.UpdatedAt) == "Older"; got != want {
+			t.Errorf("%d days ago: Matches = %v, want %v", days, got, want)
+		}
+	}
+}
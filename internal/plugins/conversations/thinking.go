@@ -0,0 +1,71 @@
+package conversations
+
+import (
+	"fmt"
+
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// thinkingAnchor records where a message's thinking starts in the detail
+// pane, so "t" can toggle the thinking currently in view.
+type thinkingAnchor struct {
+	line  int
+	msgID string
+}
+
+// thinkingSummary returns the collapsed one-line summary for a message's
+// thinking blocks.
+func thinkingSummary(blocks []adapter.ThinkingBlock) string {
+	tokens := 0
+	for _, tb := range blocks {
+		tokens += tb.TokenCount
+	}
+	label := "thinking"
+	if len(blocks) > 1 {
+		label = fmt.Sprintf("%d thinking blocks", len(blocks))
+	}
+	return fmt.Sprintf("[%s, %s tokens — press t to expand]", label, formatK(tokens))
+}
+
+// detailThinkingLines renders a message's thinking blocks for the detail
+// pane. Collapsed thinking takes a single summary line, so its content does
+// not count toward the scroll height.
+func (p *Plugin) detailThinkingLines(msg adapter.Message, width int) []string {
+	if len(msg.ThinkingBlocks) == 0 {
+		return nil
+	}
+	if !p.expandedThinking[msg.ID] {
+		return []string{styles.Code.Render(thinkingSummary(msg.ThinkingBlocks)), ""}
+	}
+
+	var lines []string
+	for i, tb := range msg.ThinkingBlocks {
+		lines = append(lines, styles.Code.Render(fmt.Sprintf("Thinking %d (%d tokens)", i+1, tb.TokenCount)))
+		for _, line := range wrapText(tb.Content, width) {
+			lines = append(lines, styles.Muted.Render(line))
+		}
+		lines = append(lines, "")
+	}
+	return lines
+}
+
+// toggleDetailThinking toggles the thinking of the first message whose
+// thinking is visible in the detail pane, or else the one scrolled into.
+func (p *Plugin) toggleDetailThinking() {
+	var target string
+	for _, a := range p.detailThinkingAnchors {
+		if a.line >= p.detailScroll+p.detailVisibleLines {
+			break
+		}
+		target = a.msgID
+		if a.line >= p.detailScroll {
+			break
+		}
+	}
+	if target == "" {
+		return
+	}
+	p.expandedThinking[target] = !p.expandedThinking[target]
+	p.invalidateCacheForMessage(target)
+}
@@ -0,0 +1,93 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+func TestThinkingSummary(t *testing.T) {
+	tests := []struct {
+		blocks []adapter.ThinkingBlock
+		want   string
+	}{
+		{[]adapter.ThinkingBlock{{TokenCount: 150}}, "[thinking, 150 tokens — press t to expand]"},
+		{[]adapter.ThinkingBlock{{TokenCount: 1200}, {TokenCount: 300}}, "[2 thinking blocks, 1.5k tokens — press t to expand]"},
+	}
+	for _, tt := range tests {
+		if got := thinkingSummary(tt.blocks); got != tt.want {
+			t.Errorf("thinkingSummary() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDetailThinkingLines_Height(t *testing.T) {
+	p := New()
+	long := strings.Repeat("considering the options carefully ", 200)
+	msg := adapter.Message{ID: "m1", ThinkingBlocks: []adapter.ThinkingBlock{
+		{Content: long, TokenCount: 1500},
+		{Content: "short", TokenCount: 10},
+	}}
+	width := 40
+
+	// Collapsed: one summary line plus spacing, regardless of content size
+	if got := len(p.detailThinkingLines(msg, width)); got != 2 {
+		t.Errorf("collapsed height = %d, want 2", got)
+	}
+
+	// Expanded: a header, the wrapped content and spacing per block
+	p.expandedThinking["m1"] = true
+	want := 0
+	for _, tb := range msg.ThinkingBlocks {
+		want += 1 + len(wrapText(tb.Content, width)) + 1
+	}
+	if got := len(p.detailThinkingLines(msg, width)); got != want {
+		t.Errorf("expanded height = %d, want %d", got, want)
+	}
+
+	// No thinking blocks: no lines
+	if got := p.detailThinkingLines(adapter.Message{ID: "m2"}, width); got != nil {
+		t.Errorf("got %d lines for a message without thinking", len(got))
+	}
+}
+
+func TestDetailThinkingToggle(t *testing.T) {
+	p := New()
+	long := strings.Repeat("step by step reasoning ", 300)
+	turn := Turn{Role: "assistant", Messages: []adapter.Message{
+		{ID: "m1", Role: "assistant", Content: "answer", ThinkingBlocks: []adapter.ThinkingBlock{{Content: long, TokenCount: 2000}}},
+	}}
+	p.activePane = PaneMessages
+	p.detailMode = true
+	p.detailTurn = &turn
+
+	maxScroll := func() int {
+		p.detailScroll = 9999
+		p.renderDetailPaneContent(60, 20)
+		return p.detailScroll
+	}
+
+	if got := maxScroll(); got != 0 {
+		t.Errorf("collapsed max scroll = %d, want 0 (fits on screen)", got)
+	}
+
+	p.detailScroll = 0
+	p.renderDetailPaneContent(60, 20)
+	_, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	if !p.expandedThinking["m1"] {
+		t.Fatal("t should expand the thinking in view")
+	}
+	if got := maxScroll(); got == 0 {
+		t.Error("expanded thinking should make the detail pane scrollable")
+	}
+
+	// Scrolled into the expanded block, t collapses it again
+	p.detailScroll = 5
+	p.renderDetailPaneContent(60, 20)
+	_, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	if p.expandedThinking["m1"] {
+		t.Error("t should collapse the thinking scrolled into")
+	}
+}
@@ -0,0 +1,239 @@
+package conversations
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+const (
+	// turnIndexPreviewLen is the preview length shown per turn in the index.
+	turnIndexPreviewLen = 40
+	// turnIndexMaxWidth caps the width of the index column.
+	turnIndexMaxWidth = 60
+	// turnIndexGutter separates the message view from the index column.
+	turnIndexGutter = " │ "
+)
+
+// turnIndexColumnWidth returns the width of the index column beside message
+// content of the given width, or 0 when the index is closed.
+func (p *Plugin) turnIndexColumnWidth(contentWidth int) int {
+	if !p.turnIndexOpen {
+		return 0
+	}
+	return min(turnIndexMaxWidth, (contentWidth-len([]rune(turnIndexGutter)))*2/5)
+}
+
+// messageColumnWidth returns the width left for messages beside the index.
+func (p *Plugin) messageColumnWidth(contentWidth int) int {
+	if w := p.turnIndexColumnWidth(contentWidth); w > 0 {
+		return contentWidth - w - len([]rune(turnIndexGutter))
+	}
+	return contentWidth
+}
+
+// openTurnIndex shows the turn index column with the current turn selected.
+func (p *Plugin) openTurnIndex() {
+	if len(p.turns) == 0 {
+		return
+	}
+	p.turnIndexOpen = true
+	p.turnIndexCursor = p.turnCursor
+	if !p.turnViewMode {
+		if idx := p.turnIndexForMessage(p.messageCursor); idx >= 0 {
+			p.turnIndexCursor = idx
+		}
+	}
+	p.syncTurnIndex(false)
+	p.hitRegionsDirty = true
+}
+
+// closeTurnIndex hides the turn index column.
+func (p *Plugin) closeTurnIndex() {
+	p.turnIndexOpen = false
+	p.hitRegionsDirty = true
+}
+
+// syncTurnIndex keeps the index cursor valid as turns change. When follow is
+// true (the cursor was on the last turn) it moves to the newest turn.
+func (p *Plugin) syncTurnIndex(follow bool) {
+	last := len(p.turns) - 1
+	if follow || p.turnIndexCursor > last {
+		p.turnIndexCursor = last
+	}
+	if p.turnIndexCursor < 0 {
+		p.turnIndexCursor = 0
+	}
+	if p.turnIndexScroll > p.turnIndexCursor {
+		p.turnIndexScroll = p.turnIndexCursor
+	}
+}
+
+// jumpToTurn moves the main view to the start of turn i.
+func (p *Plugin) jumpToTurn(i int) {
+	if i < 0 || i >= len(p.turns) {
+		return
+	}
+	if p.turnViewMode {
+		p.turnCursor = i
+		p.turnScrollOff = i
+		p.hitRegionsDirty = true
+		return
+	}
+
+	// Land on the turn's first visible message (tool-result-only messages
+	// are shown inline), or the nearest one before it
+	start := p.turns[i].StartIndex
+	visible := p.visibleMessageIndices()
+	if len(visible) == 0 {
+		return
+	}
+	target := visible[len(visible)-1]
+	for j, idx := range visible {
+		if idx >= start {
+			target = idx
+			if idx >= start+len(p.turns[i].Messages) && j > 0 {
+				target = visible[j-1]
+			}
+			break
+		}
+	}
+	p.messageCursor = target
+	p.messageScroll = 0
+	for _, pos := range p.msgLinePositions {
+		if pos.MsgIdx == target {
+			p.messageScroll = pos.StartLine
+			break
+		}
+	}
+	p.ensureMessageCursorVisible()
+	p.hitRegionsDirty = true
+}
+
+// updateTurnIndex handles key events while the turn index is open. The
+// index keeps focus until it is closed, so enter jumps without closing it.
+func (p *Plugin) updateTurnIndex(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "I", "q":
+		p.closeTurnIndex()
+
+	case "j", "down":
+		if p.turnIndexCursor < len(p.turns)-1 {
+			p.turnIndexCursor++
+		}
+
+	case "k", "up":
+		if p.turnIndexCursor > 0 {
+			p.turnIndexCursor--
+		}
+
+	case "g":
+		p.turnIndexCursor = 0
+
+	case "G":
+		p.turnIndexCursor = len(p.turns) - 1
+
+	case "ctrl+d":
+		p.turnIndexCursor = min(p.turnIndexCursor+10, len(p.turns)-1)
+
+	case "ctrl+u":
+		p.turnIndexCursor = max(p.turnIndexCursor-10, 0)
+
+	case "enter":
+		p.jumpToTurn(p.turnIndexCursor)
+	}
+	p.syncTurnIndex(false)
+	p.hitRegionsDirty = true
+	return p, nil
+}
+
+// registerTurnIndexHitRegions registers a click region for each turn row in
+// the index column. contentWidth is the full message pane content width.
+func (p *Plugin) registerTurnIndexHitRegions(mainX, contentWidth, contentHeight, headerY int) {
+	indexX := mainX + p.messageColumnWidth(contentWidth) + len([]rune(turnIndexGutter))
+	indexWidth := p.turnIndexColumnWidth(contentWidth)
+	bottom := headerY + contentHeight - 4 // header lines above the index
+	y := headerY + 1                      // index title line
+	for i := p.turnIndexScroll; i < len(p.turns) && y < bottom; i++ {
+		p.mouseHandler.HitMap.AddRect(regionTurnIndexItem, indexX, y, indexWidth, 1, i)
+		y++
+	}
+}
+
+// withTurnIndex lays out message lines with the turn index column to their
+// right, padding or truncating each message line to the message column.
+func (p *Plugin) withTurnIndex(body []string, contentWidth, height int) []string {
+	msgWidth := p.messageColumnWidth(contentWidth)
+	index := p.renderTurnIndex(p.turnIndexColumnWidth(contentWidth), height)
+	gutter := styles.Muted.Render(turnIndexGutter)
+
+	lines := make([]string, 0, height)
+	for i := 0; i < height; i++ {
+		var left, right string
+		if i < len(body) {
+			left = ansi.Truncate(body[i], msgWidth, "")
+		}
+		if i < len(index) {
+			right = index[i]
+		}
+		if w := ansi.StringWidth(left); w < msgWidth {
+			left += strings.Repeat(" ", msgWidth-w)
+		}
+		lines = append(lines, left+gutter+right)
+	}
+	return lines
+}
+
+// renderTurnIndex renders the turn index column: one line per turn with its
+// role, preview and token count.
+func (p *Plugin) renderTurnIndex(width, height int) []string {
+	lines := []string{
+		ansi.Truncate(styles.Title.Render("Turns")+"  "+styles.Subtle.Render("[enter:jump esc:close]"), width, ""),
+	}
+	rows := height - 1
+	if rows < 1 {
+		rows = 1
+	}
+
+	// Keep the cursor in view
+	if p.turnIndexCursor < p.turnIndexScroll {
+		p.turnIndexScroll = p.turnIndexCursor
+	} else if p.turnIndexCursor >= p.turnIndexScroll+rows {
+		p.turnIndexScroll = p.turnIndexCursor - rows + 1
+	}
+
+	session := p.findSelectedSession()
+	for i := p.turnIndexScroll; i < len(p.turns) && len(lines) <= rows; i++ {
+		turn := &p.turns[i]
+		roleName, roleStyle := "you", styles.StatusInProgress
+		if turn.Role != "user" {
+			roleName, roleStyle = adapterShortName(session), styles.StatusStaged
+		}
+		tokens := formatK(turn.TotalTokensIn + turn.TotalTokensOut)
+
+		num := fmt.Sprintf("%3d ", i+1)
+		role := fmt.Sprintf("%-6s ", roleName)
+		previewWidth := width - len(num) - len(role) - len(tokens) - 1
+		preview := strings.Join(strings.Fields(turn.Preview(turnIndexPreviewLen)), " ")
+		if runes := []rune(preview); previewWidth > 3 && len(runes) > previewWidth {
+			preview = string(runes[:previewWidth-3]) + "..."
+		}
+		pad := width - len(num) - len(role) - lipgloss.Width(preview) - len(tokens)
+		if pad < 1 {
+			pad = 1
+		}
+
+		if i == p.turnIndexCursor {
+			lines = append(lines, p.styleTurnLine(num+role+preview+strings.Repeat(" ", pad)+tokens, true, width))
+			continue
+		}
+		lines = append(lines, styles.Muted.Render(num)+roleStyle.Render(role)+styles.Body.Render(preview)+
+			strings.Repeat(" ", pad)+styles.Subtle.Render(tokens))
+	}
+	return lines
+}
@@ -0,0 +1,149 @@
+package conversations
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/mouse"
+)
+
+func turnIndexMessages() []adapter.Message {
+	return []adapter.Message{
+		{ID: "m0", Role: "user", Content: "first question"},
+		{ID: "m1", Role: "assistant", Content: "first answer"},
+		{ID: "m2", Role: "user", ContentBlocks: []adapter.ContentBlock{{Type: "tool_result"}}},
+		{ID: "m3", Role: "assistant", Content: strings.Repeat("long reply line\n", 30)},
+		{ID: "m4", Role: "user", Content: "second question"},
+		{ID: "m5", Role: "assistant", Content: "final answer"},
+	}
+}
+
+func pressKey(p *Plugin, key string) {
+	var msg tea.KeyMsg
+	switch key {
+	case "enter":
+		msg = tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		msg = tea.KeyMsg{Type: tea.KeyEsc}
+	default:
+		msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}
+	}
+	_, _ = p.Update(msg)
+}
+
+func TestTurnIndexJump_ConversationFlow(t *testing.T) {
+	p := liveTailPlugin(turnIndexMessages())
+	p.activePane = PaneMessages
+	p.width, p.height = 100, 30
+	_ = p.View(100, 30)
+
+	pressKey(p, "I")
+	if !p.turnIndexOpen || p.FocusContext() != "conversations-turn-index" {
+		t.Fatal("I should open the turn index")
+	}
+	// Select turn 4 (the second question) and jump
+	for p.turnIndexCursor < 4 {
+		pressKey(p, "j")
+	}
+	pressKey(p, "enter")
+
+	if !p.turnIndexOpen {
+		t.Error("jumping should keep the index column open")
+	}
+	if want := p.turns[4].StartIndex; p.messageCursor != want {
+		t.Errorf("messageCursor = %d, want %d", p.messageCursor, want)
+	}
+	// The start message is scrolled into view, past the long reply above it
+	for _, pos := range p.msgLinePositions {
+		if pos.MsgIdx == p.messageCursor && (p.messageScroll == 0 || pos.StartLine < p.messageScroll) {
+			t.Errorf("messageScroll = %d, want it scrolled to start line %d", p.messageScroll, pos.StartLine)
+		}
+	}
+
+	// A turn of only tool results lands on the message that shows them inline
+	p.jumpToTurn(2)
+	if p.messageCursor != 1 {
+		t.Errorf("messageCursor = %d, want 1 for a tool-result-only turn", p.messageCursor)
+	}
+}
+
+func TestTurnIndexJump_TurnView(t *testing.T) {
+	p := liveTailPlugin(turnIndexMessages())
+	p.activePane = PaneMessages
+	p.turnViewMode = true
+
+	p.openTurnIndex()
+	for i := range p.turns {
+		p.turnIndexCursor = i
+		pressKey(p, "enter")
+		if p.turnCursor != i || p.turnScrollOff != i {
+			t.Errorf("index %d: turnCursor = %d, turnScrollOff = %d", i, p.turnCursor, p.turnScrollOff)
+		}
+	}
+
+	// esc closes without moving
+	pressKey(p, "k")
+	pressKey(p, "esc")
+	if p.turnIndexOpen || p.turnCursor != len(p.turns)-1 {
+		t.Errorf("esc should close without jumping, turnCursor = %d", p.turnCursor)
+	}
+}
+
+func TestTurnIndexFollowsNewTurns(t *testing.T) {
+	msgs := turnIndexMessages()
+	p := liveTailPlugin(msgs)
+	p.activePane = PaneMessages
+	p.openTurnIndex()
+	p.turnIndexCursor = len(p.turns) - 1
+
+	msgs = append(msgs, adapter.Message{ID: "m6", Role: "user", Content: "third question"})
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: msgs})
+
+	if len(p.turns) != 7 || p.turnIndexCursor != 6 {
+		t.Errorf("turns = %d, index cursor = %d; want the new turn selected", len(p.turns), p.turnIndexCursor)
+	}
+	lines := p.renderTurnIndex(80, 20)
+	if got := len(lines); got != 8 {
+		t.Errorf("rendered %d lines, want title + 7 turns", got)
+	}
+}
+
+func TestTurnIndexIsSideColumn(t *testing.T) {
+	p := liveTailPlugin(turnIndexMessages())
+	p.activePane = PaneMessages
+	p.width, p.height = 120, 30
+	p.openTurnIndex()
+
+	view := ansi.Strip(p.View(120, 30))
+	if !strings.Contains(view, "first question") || !strings.Contains(view, "Turns") {
+		t.Fatal("the index should render beside the messages, not replace them")
+	}
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "Turns") && !strings.Contains(line, "│ Turns") {
+			t.Errorf("index title should follow the gutter: %q", line)
+		}
+	}
+
+	// Clicking an index row jumps to that turn
+	var region *mouse.Region
+	for _, r := range p.mouseHandler.HitMap.Regions() {
+		if r.ID == regionTurnIndexItem && r.Data == 4 {
+			region = p.mouseHandler.HitMap.Test(r.Rect.X, r.Rect.Y)
+		}
+	}
+	if region == nil {
+		t.Fatal("no hit region for index row 4")
+	}
+	_, _ = p.handleMouseClick(mouse.MouseAction{Region: region})
+	if want := p.turns[4].StartIndex; p.messageCursor != want || p.turnIndexCursor != 4 {
+		t.Errorf("messageCursor = %d, index cursor = %d; want %d, 4", p.messageCursor, p.turnIndexCursor, want)
+	}
+
+	pressKey(p, "esc")
+	if p.messageColumnWidth(100) != 100 {
+		t.Error("closing the index should give the messages the full width")
+	}
+}
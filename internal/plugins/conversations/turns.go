@@ -1,7 +1,9 @@
 package conversations
 
 import (
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/marcus/sidecar/internal/adapter"
@@ -10,6 +12,10 @@ import (
 // xmlTagRegex is pre-compiled for performance in hot path (called per turn on render)
 var xmlTagRegex = regexp.MustCompile(`<[^>]+>`)
 
+// toolResultPlaceholderRegex matches the "[N tool result(s)]" content adapters
+// give messages that carry only tool results.
+var toolResultPlaceholderRegex = regexp.MustCompile(`^\[(\d+) tool result\(s\)\]$`)
+
 // Turn represents a sequence of consecutive messages from the same role.
 // In Claude Code, a single "turn" may contain multiple JSONL messages:
 // - User turn: system reminders, command output, actual user text
@@ -22,6 +28,69 @@ type Turn struct {
 	TotalTokensOut int               // Sum of output tokens
 	ThinkingTokens int               // Sum of thinking block tokens
 	ToolCount      int               // Number of tool uses
+	ToolResults    int               // Number of tool results in tool-result-only messages
+}
+
+// TurnEntry is one displayed item of a turn: a single message, or a run of
+// consecutive tool-result-only messages collapsed into a summary line.
+type TurnEntry struct {
+	Message     adapter.Message // The message, or the first of a collapsed run
+	Index       int             // Index of Message in Turn.Messages
+	Count       int             // Number of messages this entry covers
+	ToolResults int             // Tool results in a collapsed run; 0 for a single message
+}
+
+// Collapsed reports whether the entry summarizes a run of tool results.
+func (e TurnEntry) Collapsed() bool {
+	return e.ToolResults > 0
+}
+
+// toolResultCount returns the number of tool results in a message that
+// carries nothing else, or 0 if the message has real content.
+func toolResultCount(msg adapter.Message) int {
+	if len(msg.ContentBlocks) > 0 {
+		for _, block := range msg.ContentBlocks {
+			if block.Type != "tool_result" {
+				return 0
+			}
+		}
+		return len(msg.ContentBlocks)
+	}
+	if m := toolResultPlaceholderRegex.FindStringSubmatch(strings.TrimSpace(msg.Content)); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		return n
+	}
+	return 0
+}
+
+// formatToolResults returns a summary like "5 tool results".
+func formatToolResults(n int) string {
+	if n == 1 {
+		return "1 tool result"
+	}
+	return fmt.Sprintf("%d tool results", n)
+}
+
+// Entries returns the turn's messages for display. With collapseToolResults,
+// each run of tool-result-only messages becomes a single entry so the real
+// content stays visible; otherwise every message is its own entry.
+func (t *Turn) Entries(collapseToolResults bool) []TurnEntry {
+	entries := make([]TurnEntry, 0, len(t.Messages))
+	for i, msg := range t.Messages {
+		n := 0
+		if collapseToolResults {
+			n = toolResultCount(msg)
+		}
+		if n > 0 && len(entries) > 0 {
+			if last := &entries[len(entries)-1]; last.Collapsed() {
+				last.Count++
+				last.ToolResults += n
+				continue
+			}
+		}
+		entries = append(entries, TurnEntry{Message: msg, Index: i, Count: 1, ToolResults: n})
+	}
+	return entries
 }
 
 // FirstTimestamp returns the timestamp of the first message in the turn.
@@ -38,7 +107,7 @@ func (t *Turn) Preview(maxLen int) string {
 		maxLen = 4 // minimum to show "x..."
 	}
 	for _, msg := range t.Messages {
-		if msg.Content != "" && msg.Content != "[1 tool result(s)]" {
+		if msg.Content != "" && toolResultCount(msg) == 0 {
 			content := stripXMLTags(msg.Content)
 			if content == "" {
 				continue
@@ -49,7 +118,14 @@ func (t *Turn) Preview(maxLen int) string {
 			return content
 		}
 	}
-	// Fallback: show tool result count or first content
+	// Fallback: summarize tool results or show first content
+	if t.ToolResults > 0 {
+		summary := formatToolResults(t.ToolResults)
+		if runes := []rune(summary); len(runes) > maxLen {
+			return string(runes[:maxLen-3]) + "..."
+		}
+		return summary
+	}
 	if len(t.Messages) > 0 && t.Messages[0].Content != "" {
 		content := stripXMLTags(t.Messages[0].Content)
 		if runes := []rune(content); len(runes) > maxLen {
@@ -95,15 +171,7 @@ func GroupMessagesIntoTurns(messages []adapter.Message) []Turn {
 		}
 
 		// Add message to current turn
-		currentTurn.Messages = append(currentTurn.Messages, msg)
-		currentTurn.TotalTokensIn += msg.InputTokens + msg.CacheRead + msg.CacheWrite
-		currentTurn.TotalTokensOut += msg.OutputTokens
-		currentTurn.ToolCount += len(msg.ToolUses)
-
-		// Count thinking tokens
-		for _, tb := range msg.ThinkingBlocks {
-			currentTurn.ThinkingTokens += tb.TokenCount
-		}
+		addMessageToTurn(&currentTurn, msg)
 	}
 
 	// Don't forget the last turn
@@ -194,6 +262,7 @@ func addMessageToTurn(t *Turn, msg adapter.Message) {
 	t.TotalTokensIn += msg.InputTokens + msg.CacheRead + msg.CacheWrite
 	t.TotalTokensOut += msg.OutputTokens
 	t.ToolCount += len(msg.ToolUses)
+	t.ToolResults += toolResultCount(msg)
 	for _, tb := range msg.ThinkingBlocks {
 		t.ThinkingTokens += tb.TokenCount
 	}
@@ -466,11 +466,23 @@ func TestTurnPreview(t *testing.T) {
 			maxLen: 20,
 			want:   "Thinking...Response",
 		},
+		{
+			name: "Only tool results",
+			messages: []adapter.Message{
+				{Content: "[2 tool result(s)]"},
+				{Content: "[3 tool result(s)]"},
+			},
+			maxLen: 20,
+			want:   "5 tool results",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			turn := Turn{Messages: tt.messages}
+			for _, msg := range tt.messages {
+				turn.ToolResults += toolResultCount(msg)
+			}
 			got := turn.Preview(tt.maxLen)
 			if got != tt.want {
 				t.Errorf("Turn.Preview() = %q, want %q", got, tt.want)
@@ -478,3 +490,78 @@ func TestTurnPreview(t *testing.T) {
 		})
 	}
 }
+
+func TestTurnEntries(t *testing.T) {
+	toolResult := adapter.Message{Role: "user", ContentBlocks: []adapter.ContentBlock{
+		{Type: "tool_result", ToolUseID: "a"},
+		{Type: "tool_result", ToolUseID: "b"},
+	}}
+	placeholder := adapter.Message{Role: "user", Content: "[1 tool result(s)]"}
+	text := adapter.Message{Role: "user", Content: "Looks good, continue"}
+	mixed := adapter.Message{Role: "user", ContentBlocks: []adapter.ContentBlock{
+		{Type: "tool_result", ToolUseID: "c"},
+		{Type: "text", Text: "also this"},
+	}}
+
+	turn := Turn{Messages: []adapter.Message{toolResult, placeholder, toolResult, text, mixed, placeholder}}
+
+	type entry struct{ index, count, results int }
+	tests := []struct {
+		name     string
+		collapse bool
+		want     []entry
+	}{
+		{
+			name:     "collapsed",
+			collapse: true,
+			want:     []entry{{0, 3, 5}, {3, 1, 0}, {4, 1, 0}, {5, 1, 1}},
+		},
+		{
+			name:     "expanded",
+			collapse: false,
+			want:     []entry{{0, 1, 0}, {1, 1, 0}, {2, 1, 0}, {3, 1, 0}, {4, 1, 0}, {5, 1, 0}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := turn.Entries(tt.collapse)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Entries() returned %d entries, want %d", len(got), len(tt.want))
+			}
+			for i, w := range tt.want {
+				g := got[i]
+				if g.Index != w.index || g.Count != w.count || g.ToolResults != w.results {
+					t.Errorf("entry %d = {Index:%d Count:%d ToolResults:%d}, want %+v",
+						i, g.Index, g.Count, g.ToolResults, w)
+				}
+				if g.Collapsed() != (w.results > 0) {
+					t.Errorf("entry %d Collapsed() = %v", i, g.Collapsed())
+				}
+			}
+		})
+	}
+}
+
+func TestGroupMessagesIntoTurns_ToolResults(t *testing.T) {
+	messages := []adapter.Message{
+		{Role: "user", Content: "Run the tests"},
+		{Role: "assistant", Content: "Running"},
+		{Role: "user", Content: "[2 tool result(s)]"},
+		{Role: "user", ContentBlocks: []adapter.ContentBlock{{Type: "tool_result"}}},
+	}
+
+	turns := GroupMessagesIntoTurns(messages)
+	if len(turns) != 3 {
+		t.Fatalf("got %d turns, want 3", len(turns))
+	}
+	if turns[0].ToolResults != 0 {
+		t.Errorf("text turn ToolResults = %d, want 0", turns[0].ToolResults)
+	}
+	if turns[2].ToolResults != 3 {
+		t.Errorf("tool result turn ToolResults = %d, want 3", turns[2].ToolResults)
+	}
+	if got := turns[2].Preview(40); got != "3 tool results" {
+		t.Errorf("Preview() = %q, want %q", got, "3 tool results")
+	}
+}
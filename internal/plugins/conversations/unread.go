@@ -0,0 +1,102 @@
+package conversations
+
+import (
+	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/state"
+)
+
+// loadViewState restores per-session last-viewed times. The first run
+// records a baseline so existing sessions don't all start out unread.
+func (p *Plugin) loadViewState() {
+	vs := state.GetConversationsViewState()
+	if vs.Since.IsZero() {
+		vs.Since = time.Now()
+		_ = state.SetConversationsViewedSince(vs.Since)
+	}
+	p.viewedSince = vs.Since
+	p.lastViewed = vs.LastViewed
+	if p.lastViewed == nil {
+		p.lastViewed = make(map[string]time.Time)
+	}
+}
+
+// pruneViewState drops last-viewed times for sessions no longer listed. The
+// saved state is shared across projects, so it is only pruned by age when
+// saved; Init reloads it, so only this project's sessions are kept in memory.
+func (p *Plugin) pruneViewState() {
+	if len(p.lastViewed) == 0 && len(p.keepUnread) == 0 {
+		return
+	}
+	listed := make(map[string]bool, len(p.sessions))
+	for i := range p.sessions {
+		listed[p.sessions[i].ID] = true
+	}
+	for id := range p.lastViewed {
+		if !listed[id] {
+			delete(p.lastViewed, id)
+		}
+	}
+	for id := range p.keepUnread {
+		if !listed[id] {
+			delete(p.keepUnread, id)
+		}
+	}
+}
+
+// lastViewedAt returns when a session was last viewed, falling back to the
+// tracking baseline for sessions never opened.
+func (p *Plugin) lastViewedAt(sessionID string) time.Time {
+	if t, ok := p.lastViewed[sessionID]; ok {
+		return t
+	}
+	return p.viewedSince
+}
+
+// isSessionUnread reports whether a session has activity since it was last
+// viewed. The conversation on screen is never unread.
+func (p *Plugin) isSessionUnread(s adapter.Session) bool {
+	if s.ID == p.loadedSession && s.ID == p.selectedSession {
+		return false
+	}
+	return s.UpdatedAt.After(p.lastViewedAt(s.ID))
+}
+
+// markSessionViewed records that a session's messages were shown. It is
+// called when the user moves away, so updates seen while open count as read.
+func (p *Plugin) markSessionViewed(sessionID string) {
+	if sessionID == "" || sessionID != p.loadedSession {
+		return
+	}
+	// Keep the session in the unread list until the filter is reapplied,
+	// so the list doesn't shift under the cursor
+	if p.filterActive && p.filters.UnreadOnly {
+		p.keepUnread[sessionID] = true
+	}
+
+	var updatedAt time.Time
+	for i := range p.sessions {
+		if p.sessions[i].ID == sessionID {
+			updatedAt = p.sessions[i].UpdatedAt
+			break
+		}
+	}
+	if !updatedAt.After(p.lastViewedAt(sessionID)) {
+		return // already read; skip the state write
+	}
+	now := time.Now()
+	p.lastViewed[sessionID] = now
+	_ = state.SetConversationViewed(sessionID, now)
+}
+
+// sessionMatchesFilters applies the filter menu criteria to a session.
+func (p *Plugin) sessionMatchesFilters(s adapter.Session) bool {
+	if !p.filters.Matches(s) {
+		return false
+	}
+	if p.filters.UnreadOnly && !p.isSessionUnread(s) && !p.keepUnread[s.ID] {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,95 @@
+package conversations
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+func TestUnreadFilter(t *testing.T) {
+	since := time.Now().Add(-time.Hour)
+	p := New()
+	p.viewedSince = since
+	p.sessions = []adapter.Session{
+		{ID: "new", UpdatedAt: since.Add(30 * time.Minute)},     // never viewed, updated after baseline
+		{ID: "old", UpdatedAt: since.Add(-24 * time.Hour)},      // never viewed, before baseline
+		{ID: "seen", UpdatedAt: since.Add(10 * time.Minute)},    // viewed after last update
+		{ID: "updated", UpdatedAt: since.Add(40 * time.Minute)}, // updated since last view
+	}
+	p.lastViewed["seen"] = since.Add(20 * time.Minute)
+	p.lastViewed["updated"] = since.Add(20 * time.Minute)
+
+	p.filters.UnreadOnly = true
+	p.filterActive = true
+
+	ids := func() []string {
+		var out []string
+		for _, s := range p.visibleSessions() {
+			out = append(out, s.ID)
+		}
+		return out
+	}
+	if got := ids(); len(got) != 2 || got[0] != "new" || got[1] != "updated" {
+		t.Fatalf("unread sessions = %v, want [new updated]", got)
+	}
+
+	// Viewing a session marks it read but keeps it listed until the
+	// filter is reapplied
+	p.selectedSession = "new"
+	p.loadedSession = "new"
+	p.setSelectedSession("updated")
+	if p.isSessionUnread(p.sessions[0]) {
+		t.Error("session should be read after viewing")
+	}
+	if got := ids(); len(got) != 2 {
+		t.Errorf("visible = %v, want viewed session kept", got)
+	}
+
+	p.filterMode = true
+	p.updateFilter(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := ids(); len(got) != 1 || got[0] != "updated" {
+		t.Errorf("visible after reapplying = %v, want [updated]", got)
+	}
+}
+
+func TestUnreadFilter_CombinesWithSearch(t *testing.T) {
+	p := New()
+	p.viewedSince = time.Now().Add(-time.Hour)
+	p.sessions = []adapter.Session{
+		{ID: "a", Name: "fix parser", UpdatedAt: time.Now()},
+		{ID: "b", Name: "fix lexer", UpdatedAt: time.Now().Add(-2 * time.Hour)},
+		{ID: "c", Name: "docs", UpdatedAt: time.Now()},
+	}
+	p.filters.UnreadOnly = true
+	p.filterActive = true
+	p.searchMode = true
+	p.searchQuery = "fix"
+	p.filterSessions()
+
+	got := p.visibleSessions()
+	if len(got) != 1 || got[0].ID != "a" {
+		t.Errorf("visible = %+v, want only session a", got)
+	}
+}
+
+func TestSessionsLoadedPrunesViewState(t *testing.T) {
+	now := time.Now()
+	p := New()
+	p.lastViewed["kept"] = now
+	p.lastViewed["gone"] = now
+	p.keepUnread["gone"] = true
+
+	_, _ = p.Update(SessionsLoadedMsg{Sessions: []adapter.Session{{ID: "kept", UpdatedAt: now}}})
+
+	if _, ok := p.lastViewed["kept"]; !ok {
+		t.Error("expected listed session to keep its last-viewed time")
+	}
+	if _, ok := p.lastViewed["gone"]; ok {
+		t.Error("expected missing session to be pruned")
+	}
+	if p.keepUnread["gone"] {
+		t.Error("expected missing session to be dropped from keepUnread")
+	}
+}
@@ -0,0 +1,284 @@
+package conversations
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/adapter"
+	"github.com/marcus/sidecar/internal/modal"
+	appmsg "github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// usageCloseID is the close button of the usage modal.
+const usageCloseID = "usage-close"
+
+// UsageLoadedMsg carries usage stats loaded for a session.
+type UsageLoadedMsg struct {
+	Epoch     uint64 // Epoch when request was issued (for stale detection)
+	SessionID string
+	Stats     *adapter.UsageStats
+	Err       error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m UsageLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// usageRow is one label/value line of the usage modal.
+type usageRow struct {
+	Label string
+	Value string
+}
+
+// formatUsageRows turns usage stats into the modal's total rows. Thinking
+// and cache rows are only shown when the adapter reports them.
+func formatUsageRows(stats *adapter.UsageStats) []usageRow {
+	if stats == nil {
+		return nil
+	}
+	rows := []usageRow{
+		{"Messages", fmt.Sprintf("%d", stats.MessageCount)},
+		{"Input tokens", formatK(stats.TotalInputTokens)},
+		{"Output tokens", formatK(stats.TotalOutputTokens)},
+	}
+	if stats.TotalThinkingTokens > 0 {
+		rows = append(rows, usageRow{"Thinking tokens", formatK(stats.TotalThinkingTokens)})
+	}
+	if stats.TotalCacheRead > 0 || stats.TotalCacheWrite > 0 {
+		rows = append(rows,
+			usageRow{"Cache read", formatK(stats.TotalCacheRead)},
+			usageRow{"Cache write", formatK(stats.TotalCacheWrite)},
+		)
+	}
+	return rows
+}
+
+// formatDailyUsageRows turns the per-day breakdown into rows, oldest first.
+func formatDailyUsageRows(days []adapter.DailyUsage) []usageRow {
+	rows := make([]usageRow, 0, len(days))
+	for _, d := range days {
+		rows = append(rows, usageRow{
+			Label: d.Date.Format("Mon Jan 2"),
+			Value: fmt.Sprintf("in:%s out:%s  %d msgs", formatK(d.InputTokens), formatK(d.OutputTokens), d.MessageCount),
+		})
+	}
+	return rows
+}
+
+// openUsageModal shows usage for the selected session, loading it unless
+// it is already cached.
+func (p *Plugin) openUsageModal() tea.Cmd {
+	session := p.findSelectedSession()
+	if session == nil {
+		return appmsg.ShowToast("No session selected", 2*time.Second)
+	}
+	if note := p.unsupportedNote(session.ID, adapter.CapUsage, "Usage"); note != "" {
+		return appmsg.ShowToast(note, 2*time.Second)
+	}
+	p.showUsageModal = true
+	p.usageSessionID = session.ID
+	p.usageErr = nil
+	p.usageModal = nil
+	if _, ok := p.usageCache[session.ID]; ok {
+		p.usageLoading = false
+		return nil
+	}
+	p.usageLoading = true
+	return p.loadUsage(session.ID)
+}
+
+// closeUsageModal hides the usage modal. Cached stats are kept.
+func (p *Plugin) closeUsageModal() {
+	p.showUsageModal = false
+	p.usageModal = nil
+	p.usageLoading = false
+	p.usageErr = nil
+}
+
+// loadUsage fetches a session's usage stats from its adapter.
+func (p *Plugin) loadUsage(sessionID string) tea.Cmd {
+	var epoch uint64
+	if p.ctx != nil {
+		epoch = p.ctx.Epoch
+	}
+	a := p.adapterForSession(sessionID)
+	return func() tea.Msg {
+		if a == nil {
+			return UsageLoadedMsg{Epoch: epoch, SessionID: sessionID, Err: fmt.Errorf("no adapter for session")}
+		}
+		stats, err := a.Usage(sessionID)
+		return UsageLoadedMsg{Epoch: epoch, SessionID: sessionID, Stats: stats, Err: err}
+	}
+}
+
+// ensureUsage loads a session's usage stats in the background unless they
+// are cached, already loading, or unsupported by its adapter.
+func (p *Plugin) ensureUsage(sessionID string) tea.Cmd {
+	if _, ok := p.usageCache[sessionID]; ok || p.usagePending[sessionID] {
+		return nil
+	}
+	if _, missing := p.missingCapability(sessionID, adapter.CapUsage); missing {
+		return nil
+	}
+	p.usagePending[sessionID] = true
+	return p.loadUsage(sessionID)
+}
+
+// handleUsageLoaded caches loaded stats and updates the open modal.
+func (p *Plugin) handleUsageLoaded(msg UsageLoadedMsg) {
+	delete(p.usagePending, msg.SessionID)
+	if msg.Err == nil && msg.Stats != nil {
+		p.usageCache[msg.SessionID] = msg.Stats
+	}
+	if !p.showUsageModal || msg.SessionID != p.usageSessionID {
+		return
+	}
+	p.usageLoading = false
+	p.usageErr = msg.Err
+	if msg.Err == nil && msg.Stats == nil {
+		p.usageErr = fmt.Errorf("usage not available")
+	}
+	p.usageModal = nil
+}
+
+// appendUsage adds live-tailed messages to a session's cached stats so they
+// stay current without another full read of the session.
+func (p *Plugin) appendUsage(sessionID string, messages []adapter.Message) {
+	stats, ok := p.usageCache[sessionID]
+	if !ok || len(messages) == 0 {
+		return
+	}
+	for _, m := range messages {
+		stats.AddMessage(m)
+	}
+	if p.showUsageModal && p.usageSessionID == sessionID {
+		p.usageModal = nil
+	}
+}
+
+// invalidateUsage drops a session's cached stats after its messages were
+// reloaded, reloading them if the modal is showing that session.
+func (p *Plugin) invalidateUsage(sessionID string) tea.Cmd {
+	delete(p.usageCache, sessionID)
+	if !p.showUsageModal || p.usageSessionID != sessionID {
+		return nil
+	}
+	p.usageLoading = true
+	return p.loadUsage(sessionID)
+}
+
+// ensureUsageModal builds the usage modal for the current width.
+func (p *Plugin) ensureUsageModal() {
+	modalW := 50
+	if maxW := p.width - 4; modalW > maxW {
+		modalW = max(maxW, 20)
+	}
+	if p.usageModal != nil && p.usageModalWidth == modalW {
+		return
+	}
+	p.usageModalWidth = modalW
+
+	p.usageModal = modal.New("Session Usage",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	).
+		AddSection(p.usageSection()).
+		AddSection(modal.Spacer()).
+		AddSection(modal.Buttons(modal.Btn(" Close ", usageCloseID)))
+}
+
+// usageSection renders the stats, or the loading/error state.
+func (p *Plugin) usageSection() modal.Section {
+	return modal.Custom(
+		func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+			if p.usageLoading {
+				return modal.RenderedSection{Content: styles.Muted.Render("Loading usage...")}
+			}
+			if p.usageErr != nil {
+				return modal.RenderedSection{Content: styles.StatusDeleted.Render("Unable to load usage: " + p.usageErr.Error())}
+			}
+			stats := p.usageCache[p.usageSessionID]
+
+			var sb strings.Builder
+			if s := p.findSelectedSession(); s != nil && s.ID == p.usageSessionID {
+				sb.WriteString(styles.Title.Render(sessionTitle(s)))
+				sb.WriteString("\n\n")
+			}
+			writeUsageRows(&sb, formatUsageRows(stats), contentWidth)
+			if stats != nil && len(stats.Daily) > 0 {
+				sb.WriteString("\n\n")
+				sb.WriteString(styles.Subtitle.Render("By day"))
+				sb.WriteString("\n")
+				writeUsageRows(&sb, formatDailyUsageRows(stats.Daily), contentWidth)
+			}
+			return modal.RenderedSection{Content: sb.String()}
+		},
+		func(msg tea.Msg, focusID string) (string, tea.Cmd) {
+			return "", nil
+		},
+	)
+}
+
+// writeUsageRows writes rows with labels left and values right-aligned.
+func writeUsageRows(sb *strings.Builder, rows []usageRow, width int) {
+	for i, r := range rows {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		pad := width - len([]rune(r.Label)) - len([]rune(r.Value))
+		if pad < 1 {
+			pad = 1
+		}
+		sb.WriteString(styles.Muted.Render(r.Label))
+		sb.WriteString(strings.Repeat(" ", pad))
+		sb.WriteString(styles.Body.Render(r.Value))
+	}
+}
+
+// sessionTitle returns a display name for a session.
+func sessionTitle(s *adapter.Session) string {
+	if s.Name != "" {
+		return s.Name
+	}
+	if s.Slug != "" {
+		return s.Slug
+	}
+	return s.ID[:min(12, len(s.ID))]
+}
+
+// handleUsageModalKeys handles keyboard input for the usage modal.
+func (p *Plugin) handleUsageModalKeys(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "q", "U":
+		p.closeUsageModal()
+		return nil
+	}
+	p.ensureUsageModal()
+	action, cmd := p.usageModal.HandleKey(msg)
+	switch action {
+	case usageCloseID, "cancel":
+		p.closeUsageModal()
+	}
+	return cmd
+}
+
+// handleUsageModalMouse handles mouse input for the usage modal.
+func (p *Plugin) handleUsageModalMouse(msg tea.MouseMsg) tea.Cmd {
+	p.ensureUsageModal()
+	switch p.usageModal.HandleMouse(msg, p.mouseHandler) {
+	case usageCloseID, "cancel":
+		p.closeUsageModal()
+	}
+	return nil
+}
+
+// renderUsageModal renders the usage modal over the two-pane view.
+func (p *Plugin) renderUsageModal(width, height int) string {
+	p.ensureUsageModal()
+	background := p.renderTwoPane()
+	rendered := p.usageModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, rendered, width, height)
+}
@@ -0,0 +1,151 @@
+package conversations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marcus/sidecar/internal/adapter"
+)
+
+// usageAdapter is a mock adapter that counts Usage calls.
+type usageAdapter struct {
+	sessionsAdapter
+	stats *adapter.UsageStats
+	calls int
+}
+
+func (a *usageAdapter) Usage(string) (*adapter.UsageStats, error) {
+	a.calls++
+	return a.stats, nil
+}
+
+func TestFormatUsageRows(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats *adapter.UsageStats
+		want  []usageRow
+	}{
+		{
+			name:  "nil stats",
+			stats: nil,
+			want:  nil,
+		},
+		{
+			name:  "totals only",
+			stats: &adapter.UsageStats{TotalInputTokens: 1500, TotalOutputTokens: 320, MessageCount: 12},
+			want: []usageRow{
+				{"Messages", "12"},
+				{"Input tokens", "1.5k"},
+				{"Output tokens", "320"},
+			},
+		},
+		{
+			name: "thinking and cache",
+			stats: &adapter.UsageStats{
+				TotalInputTokens:    2_000_000,
+				TotalOutputTokens:   45_000,
+				TotalThinkingTokens: 900,
+				TotalCacheRead:      12_000,
+				MessageCount:        3,
+			},
+			want: []usageRow{
+				{"Messages", "3"},
+				{"Input tokens", "2.0M"},
+				{"Output tokens", "45.0k"},
+				{"Thinking tokens", "900"},
+				{"Cache read", "12.0k"},
+				{"Cache write", "0"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatUsageRows(tt.stats)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d rows %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("row %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFormatDailyUsageRows(t *testing.T) {
+	days := []adapter.DailyUsage{
+		{Date: time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local), InputTokens: 1200, OutputTokens: 80, MessageCount: 4},
+		{Date: time.Date(2026, 3, 3, 0, 0, 0, 0, time.Local), InputTokens: 50, OutputTokens: 2500, MessageCount: 1},
+	}
+	want := []usageRow{
+		{"Mon Mar 2", "in:1.2k out:80  4 msgs"},
+		{"Tue Mar 3", "in:50 out:2.5k  1 msgs"},
+	}
+	got := formatDailyUsageRows(days)
+	if len(got) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(got), len(want))
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("row %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestUsageModal_CachesAndInvalidates(t *testing.T) {
+	a := &usageAdapter{stats: &adapter.UsageStats{MessageCount: 2}}
+	a.id = "mock"
+	p := New()
+	p.adapters = map[string]adapter.Adapter{"mock": a}
+	p.sessions = []adapter.Session{{ID: "s1", AdapterID: "mock"}}
+	p.selectedSession = "s1"
+	msgs := []adapter.Message{{ID: "m1", Role: "user", Content: "hi"}}
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: msgs})
+
+	// Opening loads the stats asynchronously
+	cmd := p.openUsageModal()
+	if cmd == nil || !p.usageLoading {
+		t.Fatal("expected usage to load")
+	}
+	_, _ = p.Update(cmd())
+	if p.usageLoading || p.usageCache["s1"] == nil {
+		t.Fatal("expected stats to be cached")
+	}
+
+	// Reopening uses the cache
+	p.closeUsageModal()
+	if cmd := p.openUsageModal(); cmd != nil {
+		t.Error("expected cached stats to be reused")
+	}
+	p.closeUsageModal()
+
+	// Appended messages are folded into the cached stats
+	msgs = append(msgs, adapter.Message{ID: "m2", Role: "assistant", Content: "hello", TokenUsage: adapter.TokenUsage{InputTokens: 10, OutputTokens: 5}})
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: msgs})
+	stats := p.usageCache["s1"]
+	if stats == nil {
+		t.Fatal("expected appended messages to keep cached stats")
+	}
+	if stats.MessageCount != 3 || stats.TotalInputTokens != 10 || stats.TotalOutputTokens != 5 {
+		t.Errorf("stats = %+v, want appended message added", *stats)
+	}
+	if cmd := p.openUsageModal(); cmd != nil {
+		t.Error("expected updated cached stats to be reused")
+	}
+	p.closeUsageModal()
+
+	// A rewritten history invalidates the cache
+	msgs = []adapter.Message{{ID: "m9", Role: "user", Content: "new"}}
+	_, _ = p.Update(MessagesLoadedMsg{SessionID: "s1", Messages: msgs})
+	if _, ok := p.usageCache["s1"]; ok {
+		t.Error("expected rewritten messages to invalidate cached stats")
+	}
+	if cmd := p.openUsageModal(); cmd == nil {
+		t.Error("expected stats to reload after invalidation")
+	}
+	if a.calls != 1 {
+		t.Errorf("Usage called %d times before reload ran, want 1", a.calls)
+	}
+}
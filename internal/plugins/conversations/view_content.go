@@ -35,7 +35,11 @@ func renderNoAdapter() string {
 
 // getSessionGroup returns the time group label for a given timestamp.
 func getSessionGroup(t time.Time) string {
-	now := time.Now()
+	return sessionDateBucket(t, time.Now())
+}
+
+// sessionDateBucket classifies a session's last-activity time relative to now.
+func sessionDateBucket(t, now time.Time) string {
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 	yesterday := today.AddDate(0, 0, -1)
 	weekAgo := today.AddDate(0, 0, -7)
@@ -78,6 +82,26 @@ func formatDuration(d time.Duration) string {
 	return fmt.Sprintf("%dd ago", days)
 }
 
+// formatAbsoluteTime formats a timestamp in local time, or "" if unset.
+func formatAbsoluteTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Local().Format("Mon Jan 2 2006 15:04:05")
+}
+
+// sessionTimeLabel returns when a session was last active: the exact time for
+// the focused or hovered session, relative ("5m ago") otherwise.
+func sessionTimeLabel(session adapter.Session, focused bool, now time.Time) string {
+	if session.UpdatedAt.IsZero() {
+		return ""
+	}
+	if focused {
+		return formatAbsoluteTime(session.UpdatedAt)
+	}
+	return formatDuration(now.Sub(session.UpdatedAt))
+}
+
 // formatTokens formats token counts compactly.
 func formatTokens(input, output, cache int) string {
 	parts := []string{}
@@ -266,7 +290,9 @@ func adapterFilterOptions(adapters map[string]adapter.Adapter) []adapterFilterOp
 		"t": true,
 		"y": true,
 		"w": true,
+		"l": true,
 		"a": true,
+		"u": true,
 		"x": true,
 	}
 
@@ -725,10 +751,13 @@ func (p *Plugin) renderMessageBubble(msg adapter.Message, msgIndex int, maxWidth
 		}
 	}
 
+	// Highlight message search matches in the content (header excluded)
+	searchHit := p.msgSearchQuery != "" && p.messageSearchHitCount(msgIndex, msgIndex+1) > 0
+
 	// Apply selection highlighting if needed
 	if selected {
 		var styledLines []string
-		for _, line := range lines {
+		for i, line := range lines {
 			// Strip any existing background colors so selection bg shows through
 			line = stripANSIBackground(line)
 			// Use visible width (not byte length) for proper padding
@@ -736,11 +765,25 @@ func (p *Plugin) renderMessageBubble(msg adapter.Message, msgIndex int, maxWidth
 			if visibleWidth < maxWidth {
 				line += strings.Repeat(" ", maxWidth-visibleWidth)
 			}
+			if searchHit && i > 0 {
+				if hl, ok := highlightSearchLine(line, p.msgSearchQuery, styles.ListItemSelected); ok {
+					styledLines = append(styledLines, hl)
+					continue
+				}
+			}
 			styledLines = append(styledLines, styles.ListItemSelected.Render(line))
 		}
 		return styledLines
 	}
 
+	if searchHit {
+		for i := 1; i < len(lines); i++ {
+			if hl, ok := highlightSearchLine(lines[i], p.msgSearchQuery, lipgloss.NewStyle()); ok {
+				lines[i] = hl
+			}
+		}
+	}
+
 	return lines
 }
 
@@ -1060,6 +1103,7 @@ func (p *Plugin) renderFilterMenu(height int) string {
 		{"t", "Today", "today"},
 		{"y", "Yesterday", "yesterday"},
 		{"w", "This Week", "week"},
+		{"l", "Older", "older"},
 	}
 	for _, d := range dates {
 		checkbox := "[ ]"
@@ -1076,6 +1120,13 @@ func (p *Plugin) renderFilterMenu(height int) string {
 		activeCheck = "[✓]"
 	}
 	sb.WriteString(fmt.Sprintf("  %s %s Active only\n", styles.Code.Render("a"), activeCheck))
+
+	// Unread only
+	unreadCheck := "[ ]"
+	if p.filters.UnreadOnly {
+		unreadCheck = "[✓]"
+	}
+	sb.WriteString(fmt.Sprintf("  %s %s Unread only\n", styles.Code.Render("u"), unreadCheck))
 	sb.WriteString("\n")
 
 	// Clear filters
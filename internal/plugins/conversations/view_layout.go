@@ -3,6 +3,7 @@ package conversations
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/marcus/sidecar/internal/adapter"
@@ -120,7 +121,7 @@ func (p *Plugin) renderTwoPane() string {
 		p.mouseHandler.HitMap.AddRect(regionPaneDivider, dividerX, 0, dividerHitWidth, p.height, nil)
 
 		// Session item regions - HIGH PRIORITY
-		p.registerSessionHitRegions(sidebarWidth, innerHeight)
+		p.registerSessionHitRegions(sidebarWidth, p.sessionListHeight(innerHeight))
 
 		// Turn item regions - HIGHEST PRIORITY (registered last)
 		p.registerTurnHitRegions(mainX+1, mainWidth-2, innerHeight)
@@ -132,6 +133,8 @@ func (p *Plugin) renderTwoPane() string {
 }
 
 // registerSessionHitRegions registers mouse hit regions for visible session items.
+// contentHeight is the session list height from sessionListHeight, so no
+// region covers the header, indicator or time detail lines.
 // This mirrors the rendering logic in renderSidebarPane/renderGroupedCompactSessions.
 func (p *Plugin) registerSessionHitRegions(sidebarWidth, contentHeight int) {
 	if p.filterMode {
@@ -203,6 +206,12 @@ func (p *Plugin) registerTurnHitRegions(mainX, contentWidth, contentHeight int)
 	headerY := 5
 	currentY := headerY
 
+	// Turns and messages share the width with the turn index column
+	if p.turnIndexOpen {
+		p.registerTurnIndexHitRegions(mainX, contentWidth, contentHeight, headerY)
+		contentWidth = p.messageColumnWidth(contentWidth)
+	}
+
 	if p.turnViewMode {
 		// Turn view: register hit regions for turns
 		if len(p.turns) == 0 {
@@ -258,6 +267,28 @@ func (p *Plugin) calculateTurnHeight(turn Turn, maxWidth int) int {
 	return height
 }
 
+// sessionListHeight returns how many lines of a sidebar pane of the given
+// inner height are left for session rows, after the header and the lines
+// reserved below the list.
+func (p *Plugin) sessionListHeight(height int) int {
+	contentHeight := height - 1 // title line
+	if p.searchMode || p.filterActive {
+		contentHeight-- // search/filter line
+	}
+	// Reserve lines for indicators below session list (td-7198a5)
+	if p.hasMoreSessions && !p.searchMode && !p.filterMode {
+		contentHeight-- // "load more" line
+	}
+	if p.loadingAdapters && !p.searchMode && !p.filterMode {
+		contentHeight-- // spinner line
+	}
+	contentHeight-- // time detail line
+	if contentHeight < 1 {
+		contentHeight = 1
+	}
+	return contentHeight
+}
+
 // renderSidebarPane renders the session list for the sidebar.
 func (p *Plugin) renderSidebarPane(height int) string {
 	var sb strings.Builder
@@ -349,17 +380,7 @@ func (p *Plugin) renderSidebarPane(height int) string {
 	}
 
 	// Render sessions
-	contentHeight := height - linesUsed
-	// Reserve lines for indicators below session list (td-7198a5)
-	if p.hasMoreSessions && !p.searchMode && !p.filterMode {
-		contentHeight-- // "load more" line
-	}
-	if p.loadingAdapters && !p.searchMode && !p.filterMode {
-		contentHeight-- // spinner line
-	}
-	if contentHeight < 1 {
-		contentHeight = 1
-	}
+	contentHeight := p.sessionListHeight(height)
 
 	// Reserve 1 column for scrollbar
 	sessionWidth := contentWidth - 1
@@ -397,6 +418,12 @@ func (p *Plugin) renderSidebarPane(height int) string {
 	}
 
 	sessionContent := strings.TrimRight(sessionSB.String(), "\n")
+	if detail := p.sessionDetailLine(time.Now()); detail != "" {
+		if runes := []rune(detail); len(runes) > sessionWidth {
+			detail = string(runes[:sessionWidth])
+		}
+		sessionContent += "\n" + styles.Muted.Render(detail)
+	}
 
 	// Render scrollbar
 	scrollbar := ui.RenderScrollbar(ui.ScrollbarParams{
@@ -460,8 +487,42 @@ func (p *Plugin) renderGroupedCompactSessions(sb *strings.Builder, groups []Sess
 	}
 }
 
+// detailSession returns the session whose exact time the sidebar shows: the
+// hovered row, else the cursor row while the sidebar has focus.
+func (p *Plugin) detailSession() *adapter.Session {
+	sessions := p.visibleSessions()
+	if p.hoverSession != "" {
+		for i := range sessions {
+			if sessions[i].ID == p.hoverSession {
+				return &sessions[i]
+			}
+		}
+	}
+	if p.activePane == PaneSidebar && p.cursor >= 0 && p.cursor < len(sessions) {
+		return &sessions[p.cursor]
+	}
+	return nil
+}
+
+// sessionDetailLine renders the exact last-activity time and length of the
+// focused or hovered session, or "" when there is none.
+func (p *Plugin) sessionDetailLine(now time.Time) string {
+	session := p.detailSession()
+	if session == nil {
+		return ""
+	}
+	detail := sessionTimeLabel(*session, true, now)
+	if session.Duration > 0 {
+		if detail != "" {
+			detail += " · "
+		}
+		detail += formatSessionDuration(session.Duration) + " long"
+	}
+	return detail
+}
+
 // renderCompactSessionRow renders a compact session row for the sidebar.
-// Format: [active] [icon] [worktree] Session title...              12m  45k
+// Format: [active] [icon] [worktree] Session title...          12m ago  45k
 func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool, maxWidth int) string {
 	// Get badge text for width calculations (plain text length)
 	badgeText := adapterBadgeText(session)
@@ -478,11 +539,8 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 		worktreeBadge = "[" + wtName + "]"
 	}
 
-	// Format duration - only if we have data
-	lengthCol := ""
-	if session.Duration > 0 {
-		lengthCol = formatSessionDuration(session.Duration)
-	}
+	// Format last activity - relative here, exact in the detail line
+	timeCol := sessionTimeLabel(session, false, time.Now())
 
 	// Format token count - only if we have data
 	tokenCol := ""
@@ -492,8 +550,8 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 
 	// Calculate right column width (only for columns that have data)
 	rightColWidth := 0
-	if lengthCol != "" {
-		rightColWidth += len(lengthCol)
+	if timeCol != "" {
+		rightColWidth += len(timeCol)
 	}
 	if tokenCol != "" {
 		if rightColWidth > 0 {
@@ -566,10 +624,13 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 	}
 
 	// Activity indicator with colors
+	unread := p.isSessionUnread(session)
 	if session.IsActive {
 		sb.WriteString(styles.StatusInProgress.Render("●"))
 	} else if session.IsSubAgent {
 		sb.WriteString(styles.Muted.Render("↳"))
+	} else if unread {
+		sb.WriteString(styles.StatusModified.Render("•"))
 	} else {
 		sb.WriteString(" ")
 	}
@@ -606,15 +667,15 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 	if rightColWidth > 0 && padding > 0 {
 		sb.WriteString(strings.Repeat(" ", padding))
 		sb.WriteString(" ")
-		if lengthCol != "" {
+		if timeCol != "" {
 			if session.IsSubAgent {
-				sb.WriteString(styles.Muted.Render(lengthCol))
+				sb.WriteString(styles.Muted.Render(timeCol))
 			} else {
-				sb.WriteString(styles.Subtitle.Render(lengthCol))
+				sb.WriteString(styles.Subtitle.Render(timeCol))
 			}
 		}
 		if tokenCol != "" {
-			if lengthCol != "" {
+			if timeCol != "" {
 				sb.WriteString(" ")
 			}
 			sb.WriteString(styles.Subtle.Render(tokenCol))
@@ -633,6 +694,8 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 			plain.WriteString("●")
 		} else if session.IsSubAgent {
 			plain.WriteString("↳")
+		} else if unread {
+			plain.WriteString("•")
 		} else {
 			plain.WriteString(" ")
 		}
@@ -650,11 +713,11 @@ func (p *Plugin) renderCompactSessionRow(session adapter.Session, selected bool,
 		if rightColWidth > 0 && padding > 0 {
 			plain.WriteString(strings.Repeat(" ", padding))
 			plain.WriteString(" ")
-			if lengthCol != "" {
-				plain.WriteString(lengthCol)
+			if timeCol != "" {
+				plain.WriteString(timeCol)
 			}
 			if tokenCol != "" {
-				if lengthCol != "" {
+				if timeCol != "" {
 					plain.WriteString(" ")
 				}
 				plain.WriteString(tokenCol)
@@ -720,6 +783,13 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 		sb.WriteString(" ")
 	}
 	sb.WriteString(styles.Title.Render(sessionName))
+	// Note when the adapter can't stream new messages, if it fits
+	if note := p.unsupportedNote(p.selectedSession, adapter.CapWatch, "Live tail"); note != "" {
+		if len(sessionName)+4+len(note)+2 <= contentWidth {
+			sb.WriteString("  ")
+			sb.WriteString(styles.Subtle.Render(note))
+		}
+	}
 	sb.WriteString("\n")
 
 	// Header Line 2: Model badge │ msgs │ tokens │ cost │ date
@@ -749,9 +819,9 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 		// Token flow
 		statsParts = append(statsParts, fmt.Sprintf("in:%s out:%s", formatK(s.TotalTokensIn), formatK(s.TotalTokensOut)))
 
-		// Cost estimate
-		if session != nil && session.EstCost > 0 {
-			statsParts = append(statsParts, formatCost(session.EstCost))
+		// Cost estimate for the whole conversation, not just the loaded page
+		if cost := p.headerCost(session, s.PrimaryModel); cost != "" {
+			statsParts = append(statsParts, cost)
 		}
 
 		// Last updated
@@ -770,8 +840,11 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 		sb.WriteString("\n")
 	}
 
-	// Header Line 3: Resume command with copy hint
-	if session != nil {
+	// Header Line 3: Message search bar, or resume command with copy hint
+	if p.msgSearchMode || p.msgSearchQuery != "" {
+		sb.WriteString(p.renderMessageSearchBar(contentWidth))
+		sb.WriteString("\n")
+	} else if session != nil {
 		resumeCmd := resumeCommand(session)
 		if resumeCmd != "" {
 			maxCmdLen := contentWidth - 12 // Leave room for copy hint
@@ -821,6 +894,10 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 	if p.totalMessages > maxMessagesInMemory {
 		contentHeight--
 	}
+	// Reserve the last line for the live-tail "new messages" indicator
+	if p.newMessageCount > 0 {
+		contentHeight--
+	}
 	if contentHeight < 1 {
 		contentHeight = 1
 	}
@@ -835,32 +912,39 @@ func (p *Plugin) renderMainPane(paneWidth, height int) string {
 		return sb.String()
 	}
 
+	// The turn index column narrows the messages beside it
+	msgWidth := p.messageColumnWidth(contentWidth)
+	var body []string
 	if p.turnViewMode {
 		// Turn-based view (metadata-focused)
 		if len(p.turns) == 0 {
 			sb.WriteString(styles.Muted.Render("No turns"))
 			return sb.String()
 		}
-		lineCount := 0
-		for i := p.turnScrollOff; i < len(p.turns) && lineCount < contentHeight; i++ {
+		for i := p.turnScrollOff; i < len(p.turns) && len(body) < contentHeight; i++ {
 			turn := p.turns[i]
-			lines := p.renderCompactTurn(turn, i, contentWidth)
+			lines := p.renderCompactTurn(turn, i, msgWidth)
 			for _, line := range lines {
-				if lineCount >= contentHeight {
+				if len(body) >= contentHeight {
 					break
 				}
-				sb.WriteString(line)
-				sb.WriteString("\n")
-				lineCount++
+				body = append(body, line)
 			}
 		}
 	} else {
 		// Conversation flow view (content-focused, default)
-		lines := p.renderConversationFlow(contentWidth, contentHeight)
-		for _, line := range lines {
-			sb.WriteString(line)
-			sb.WriteString("\n")
-		}
+		body = p.renderConversationFlow(msgWidth, contentHeight)
+	}
+	if p.turnIndexOpen {
+		body = p.withTurnIndex(body, contentWidth, contentHeight)
+	}
+	for _, line := range body {
+		sb.WriteString(line)
+		sb.WriteString("\n")
+	}
+
+	if p.newMessageCount > 0 {
+		sb.WriteString(p.renderNewMessagesIndicator())
 	}
 
 	// Strip explicit background colors so everything falls through to the
@@ -911,6 +995,9 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 	if turn.ThinkingTokens > 0 {
 		stats = append(stats, fmt.Sprintf("%s thinking", formatK(turn.ThinkingTokens)))
 	}
+	if cost := p.costs.turnCost(*turn, p.sessionModel()); cost.Priced {
+		stats = append(stats, cost.String())
+	}
 	if turn.ToolCount > 0 {
 		stats = append(stats, fmt.Sprintf("%d tools", turn.ToolCount))
 	}
@@ -933,8 +1020,21 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 
 	// Build content lines for all messages in turn
 	var contentLines []string
+	p.detailThinkingAnchors = p.detailThinkingAnchors[:0]
+
+	for _, entry := range turn.Entries(p.collapseToolResults) {
+		msgIdx, msg := entry.Index, entry.Message
+
+		// Runs of tool-result-only messages collapse to one summary line
+		if entry.Collapsed() {
+			if msgIdx > 0 {
+				contentLines = append(contentLines, "")
+			}
+			contentLines = append(contentLines, styles.Muted.Render(fmt.Sprintf("── %s ──", formatToolResults(entry.ToolResults))))
+			contentLines = append(contentLines, "")
+			continue
+		}
 
-	for msgIdx, msg := range turn.Messages {
 		// Message separator (except for first)
 		if msgIdx > 0 {
 			contentLines = append(contentLines, "")
@@ -942,15 +1042,10 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 			contentLines = append(contentLines, "")
 		}
 
-		// Thinking blocks
-		for i, tb := range msg.ThinkingBlocks {
-			contentLines = append(contentLines, styles.Code.Render(fmt.Sprintf("Thinking %d (%d tokens)", i+1, tb.TokenCount)))
-			// Wrap thinking content
-			thinkingLines := wrapText(tb.Content, contentWidth-2)
-			for _, line := range thinkingLines {
-				contentLines = append(contentLines, styles.Muted.Render(line))
-			}
-			contentLines = append(contentLines, "")
+		// Thinking blocks (collapsed to a summary line unless expanded)
+		if len(msg.ThinkingBlocks) > 0 {
+			p.detailThinkingAnchors = append(p.detailThinkingAnchors, thinkingAnchor{line: len(contentLines), msgID: msg.ID})
+			contentLines = append(contentLines, p.detailThinkingLines(msg, contentWidth-2)...)
 		}
 
 		// Main content
@@ -1011,6 +1106,7 @@ func (p *Plugin) renderDetailPaneContent(contentWidth, height int) string {
 	if displayHeight < 1 {
 		displayHeight = 1
 	}
+	p.detailVisibleLines = displayHeight
 
 	start := p.detailScroll
 	end := start + displayHeight
@@ -1056,6 +1152,15 @@ func (p *Plugin) renderCompactTurn(turn Turn, turnIndex int, maxWidth int) []str
 	if turn.TotalTokensIn > 0 || turn.TotalTokensOut > 0 {
 		stats = append(stats, fmt.Sprintf("in:%s out:%s", formatK(turn.TotalTokensIn), formatK(turn.TotalTokensOut)))
 	}
+	if cost := p.costs.turnCost(turn, p.sessionModel()); cost.Priced {
+		stats = append(stats, cost.String())
+	}
+	searchHits := p.messageSearchHitCount(turn.StartIndex, turn.StartIndex+msgCount)
+	if searchHits == 1 {
+		stats = append(stats, "1 match")
+	} else if searchHits > 1 {
+		stats = append(stats, fmt.Sprintf("%d matches", searchHits))
+	}
 	statsStr := ""
 	if len(stats) > 0 {
 		statsStr = " (" + strings.Join(stats, ", ") + ")"
@@ -1104,8 +1209,17 @@ func (p *Plugin) renderCompactTurn(turn Turn, turnIndex int, maxWidth int) []str
 	content = strings.ReplaceAll(content, "\n", " ")
 	content = strings.TrimSpace(content)
 	if content != "" {
-		contentLine := "   " + content
-		lines = append(lines, p.styleTurnLine(contentLine, selected, maxWidth))
+		contentLine := p.styleTurnLine("   "+content, selected, maxWidth)
+		if searchHits > 0 {
+			base := styles.Muted
+			if selected {
+				base = styles.ListItemSelected
+			}
+			if hl, ok := highlightSearchLine(contentLine, p.msgSearchQuery, base); ok {
+				contentLine = hl
+			}
+		}
+		lines = append(lines, contentLine)
 	}
 
 	// Tool uses (aggregate) - indented under header
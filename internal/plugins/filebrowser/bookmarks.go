@@ -0,0 +1,169 @@
+package filebrowser
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	appmsg "github.com/marcus/sidecar/internal/msg"
+)
+
+// Bookmarks is an ordered set of bookmarked paths, relative to the
+// project root. Paths keep the order they were added in.
+type Bookmarks struct {
+	paths []string
+}
+
+// NewBookmarks creates a set from persisted paths, dropping duplicates and
+// paths that point outside the project.
+func NewBookmarks(paths []string) *Bookmarks {
+	b := &Bookmarks{}
+	for _, path := range paths {
+		path = filepath.Clean(path)
+		if path == "." || filepath.IsAbs(path) || strings.HasPrefix(path, "..") || b.Has(path) {
+			continue
+		}
+		b.paths = append(b.paths, path)
+	}
+	return b
+}
+
+// Paths returns the bookmarked paths in the order they were added.
+func (b *Bookmarks) Paths() []string {
+	if b == nil {
+		return nil
+	}
+	return slices.Clone(b.paths)
+}
+
+// Len returns the number of bookmarks.
+func (b *Bookmarks) Len() int {
+	if b == nil {
+		return 0
+	}
+	return len(b.paths)
+}
+
+// Has reports whether path is bookmarked.
+func (b *Bookmarks) Has(path string) bool {
+	return b != nil && slices.Contains(b.paths, path)
+}
+
+// Toggle adds or removes path, reporting whether it is now bookmarked.
+func (b *Bookmarks) Toggle(path string) bool {
+	if i := slices.Index(b.paths, path); i >= 0 {
+		b.paths = slices.Delete(b.paths, i, i+1)
+		return false
+	}
+	b.paths = append(b.paths, path)
+	return true
+}
+
+// RemoveUnder drops path and any bookmarks inside it, reporting whether
+// anything was removed.
+func (b *Bookmarks) RemoveUnder(path string) bool {
+	if b == nil {
+		return false
+	}
+	n := len(b.paths)
+	b.paths = slices.DeleteFunc(b.paths, func(p string) bool {
+		return isPathUnder(p, path)
+	})
+	return len(b.paths) != n
+}
+
+// Rename moves bookmarks at or inside oldPath to newPath, reporting whether
+// anything changed.
+func (b *Bookmarks) Rename(oldPath, newPath string) bool {
+	if b == nil {
+		return false
+	}
+	changed := false
+	for i, p := range b.paths {
+		if isPathUnder(p, oldPath) {
+			b.paths[i] = newPath + strings.TrimPrefix(p, oldPath)
+			changed = true
+		}
+	}
+	if changed {
+		// A rename onto an existing bookmark leaves a duplicate
+		b.paths = NewBookmarks(b.paths).paths
+	}
+	return changed
+}
+
+// isPathUnder reports whether path is dir or inside it.
+func isPathUnder(path, dir string) bool {
+	return path == dir || strings.HasPrefix(path, dir+string(filepath.Separator))
+}
+
+// toggleBookmark bookmarks or un-bookmarks the selected node.
+func (p *Plugin) toggleBookmark() tea.Cmd {
+	node := p.tree.GetNode(p.treeCursor)
+	if node == nil {
+		return nil
+	}
+	if p.bookmarks == nil {
+		p.bookmarks = NewBookmarks(nil)
+	}
+	message := "Bookmark removed: " + node.Path
+	if p.bookmarks.Toggle(node.Path) {
+		message = "Bookmarked: " + node.Path
+	}
+	p.saveState()
+	return appmsg.ShowToast(message, 2*time.Second)
+}
+
+// jumpToBookmark reveals a bookmarked path in the tree, loading and
+// expanding its ancestors. Bookmarked directories are expanded too.
+func (p *Plugin) jumpToBookmark(path string) tea.Cmd {
+	node := p.findAndExpandPath(path)
+	if node == nil {
+		return appmsg.ShowToast("Bookmark not found: "+path, 2*time.Second)
+	}
+	if node.IsDir {
+		if err := p.tree.Expand(node); err != nil {
+			p.ctx.Logger.Error("file browser: expand bookmark failed", "path", path, "error", err)
+		}
+	}
+	p.tree.Flatten()
+
+	idx := p.tree.IndexOf(node)
+	if idx < 0 {
+		return appmsg.ShowToast("Bookmark hidden by filter: "+path, 2*time.Second)
+	}
+	p.treeCursor = idx
+	p.ensureTreeCursorVisible()
+	p.activePane = PaneTree
+	if node.IsDir {
+		return nil
+	}
+	return p.openTab(node.Path, TabOpenPreview)
+}
+
+// moveBookmarks follows a rename or move of src to dst (absolute paths).
+func (p *Plugin) moveBookmarks(src, dst string) {
+	oldRel, ok := projectRelPath(p.ctx.WorkDir, src)
+	if !ok {
+		return
+	}
+	newRel, ok := projectRelPath(p.ctx.WorkDir, dst)
+	if !ok {
+		if p.bookmarks.RemoveUnder(oldRel) {
+			p.saveState()
+		}
+		return
+	}
+	if p.bookmarks.Rename(oldRel, newRel) {
+		p.saveState()
+	}
+}
+
+// removeBookmarks drops bookmarks at or inside a deleted path (absolute).
+func (p *Plugin) removeBookmarks(path string) {
+	if rel, ok := projectRelPath(p.ctx.WorkDir, path); ok && p.bookmarks.RemoveUnder(rel) {
+		p.saveState()
+	}
+}
@@ -0,0 +1,109 @@
+package filebrowser
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/marcus/sidecar/internal/state"
+)
+
+func TestBookmarks_Store(t *testing.T) {
+	b := NewBookmarks([]string{"src", "src/app.go", "src/", "../outside", "/abs", ".", "README.md"})
+	if got, want := b.Paths(), []string{"src", "src/app.go", "README.md"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("NewBookmarks() = %v, want %v", got, want)
+	}
+
+	if b.Toggle("README.md") || b.Has("README.md") {
+		t.Error("Toggle should remove an existing bookmark")
+	}
+	if !b.Toggle("main.go") || !b.Has("main.go") {
+		t.Error("Toggle should add a new bookmark")
+	}
+
+	// Renaming a directory carries the bookmarks inside it along
+	if !b.Rename("src", "lib") {
+		t.Error("Rename should report a change")
+	}
+	if got, want := b.Paths(), []string{"lib", "lib/app.go", "main.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after Rename = %v, want %v", got, want)
+	}
+	if b.Rename("srcx", "other") {
+		t.Error("Rename should only match whole path components")
+	}
+
+	if !b.RemoveUnder("lib") || b.Len() != 1 || !b.Has("main.go") {
+		t.Errorf("after RemoveUnder = %v, want [main.go]", b.Paths())
+	}
+
+	var none *Bookmarks
+	if none.Has("x") || none.Len() != 0 || none.Paths() != nil || none.RemoveUnder("x") {
+		t.Error("nil bookmarks should behave as empty")
+	}
+}
+
+func TestBookmarks_StateRoundTrip(t *testing.T) {
+	if err := state.InitWithDir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	tmpDir := t.TempDir()
+	p := createTestPlugin(t, tmpDir)
+	p.ctx.ProjectRoot = tmpDir
+
+	for _, path := range []string{"src", "README.md"} {
+		p.treeCursor = p.tree.IndexOf(p.tree.FindByPath(path))
+		if cmd := p.toggleBookmark(); cmd == nil {
+			t.Fatalf("expected toast toggling %s", path)
+		}
+	}
+
+	restored := createTestPlugin(t, t.TempDir())
+	restored.ctx.ProjectRoot = tmpDir
+	_, _ = restored.Update(StateRestoredMsg{State: state.GetFileBrowserState(tmpDir)})
+
+	if got, want := restored.bookmarks.Paths(), []string{"src", "README.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("restored bookmarks = %v, want %v", got, want)
+	}
+}
+
+func TestJumpToBookmark_ExpandsAncestors(t *testing.T) {
+	tmpDir := t.TempDir()
+	deep := filepath.Join(tmpDir, "pkg", "api", "v1")
+	if err := os.MkdirAll(deep, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(deep, "handler.go"), []byte("package v1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p := createTestPlugin(t, tmpDir)
+
+	target := filepath.Join("pkg", "api", "v1", "handler.go")
+	if p.tree.FindByPath(target) != nil {
+		t.Fatal("target should start hidden in collapsed directories")
+	}
+
+	if cmd := p.jumpToBookmark(target); cmd == nil {
+		t.Error("expected preview of the bookmarked file")
+	}
+	if node := p.tree.GetNode(p.treeCursor); node == nil || node.Path != target {
+		t.Fatalf("cursor on %v, want %s", node, target)
+	}
+	for _, dir := range []string{"pkg", "pkg/api", "pkg/api/v1"} {
+		if node := p.tree.FindByPath(filepath.FromSlash(dir)); node == nil || !node.IsExpanded {
+			t.Errorf("%s should be expanded", dir)
+		}
+	}
+
+	// Bookmarked directories open to show their contents
+	p.tree.Collapse(p.tree.FindByPath("src"))
+	_ = p.jumpToBookmark("src")
+	if node := p.tree.GetNode(p.treeCursor); node == nil || node.Path != "src" || !node.IsExpanded {
+		t.Errorf("cursor on %v, want expanded src", node)
+	}
+
+	cursor := p.treeCursor
+	if cmd := p.jumpToBookmark("gone.txt"); cmd == nil || p.treeCursor != cursor {
+		t.Error("missing bookmark should toast and leave the cursor alone")
+	}
+}
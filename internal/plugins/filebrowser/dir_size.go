@@ -0,0 +1,191 @@
+package filebrowser
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// dirSizeMaxEntries bounds how many entries a size walk visits, so huge
+// trees report a lower bound instead of walking forever.
+const dirSizeMaxEntries = 200_000
+
+// dirSize is the aggregated size of a directory's subtree.
+type dirSize struct {
+	Bytes     int64
+	Files     int
+	Truncated bool // Walk stopped early; totals are a lower bound
+}
+
+// String formats the size for display, e.g. "1.2MB (34 files)".
+func (s dirSize) String() string {
+	files := "files"
+	if s.Files == 1 {
+		files = "file"
+	}
+	size := fmt.Sprintf("%s (%d %s)", formatSize(s.Bytes), s.Files, files)
+	if s.Truncated {
+		size = "at least " + size
+	}
+	return size
+}
+
+// DirSizeMsg carries a computed directory size.
+type DirSizeMsg struct {
+	Epoch uint64 // Epoch when request was issued (for stale detection)
+	Gen   int    // Cache generation, to drop results from before a refresh
+	Path  string
+	Size  dirSize
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m DirSizeMsg) GetEpoch() uint64 { return m.Epoch }
+
+// dirSizeTickMsg advances the size spinner.
+type dirSizeTickMsg struct{}
+
+// dirSizeTick schedules the next spinner frame.
+func dirSizeTick() tea.Cmd {
+	return tea.Tick(ui.SkeletonTickInterval, func(time.Time) tea.Msg {
+		return dirSizeTickMsg{}
+	})
+}
+
+// childReader lists a directory node's children.
+type childReader func(dir *FileNode) ([]*FileNode, error)
+
+// sumDirSize adds up the sizes of every file below dir, reading children
+// with read. It stops after limit entries or when ctx is cancelled.
+// Unreadable directories are skipped.
+func sumDirSize(ctx context.Context, dir *FileNode, read childReader, limit int) dirSize {
+	var size dirSize
+	visited := 0
+
+	var walk func(node *FileNode)
+	walk = func(node *FileNode) {
+		children, err := read(node)
+		if err != nil {
+			return
+		}
+		for _, child := range children {
+			if size.Truncated {
+				return
+			}
+			if visited >= limit || ctx.Err() != nil {
+				size.Truncated = true
+				return
+			}
+			visited++
+			if child.IsDir {
+				walk(child)
+			} else {
+				size.Files++
+				size.Bytes += child.Size
+			}
+		}
+	}
+	walk(dir)
+	return size
+}
+
+// diskChildReader reads children from disk under root. Nodes are detached
+// from the tree, so a walk never touches the UI's FileTree.
+func diskChildReader(root string) childReader {
+	return func(dir *FileNode) ([]*FileNode, error) {
+		entries, err := os.ReadDir(filepath.Join(root, dir.Path))
+		if err != nil {
+			return nil, err
+		}
+		children := make([]*FileNode, 0, len(entries))
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			children = append(children, &FileNode{
+				Name:  entry.Name(),
+				Path:  filepath.Join(dir.Path, entry.Name()),
+				IsDir: entry.IsDir(), // Symlinked dirs aren't followed
+				Size:  info.Size(),
+			})
+		}
+		return children, nil
+	}
+}
+
+// startDirSize computes a directory's size in the background unless it is
+// cached, animating the info modal's spinner meanwhile.
+func (p *Plugin) startDirSize(path string) tea.Cmd {
+	p.cancelDirSize()
+	if _, ok := p.dirSizes[path]; ok {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.dirSizeCancel = cancel
+	p.dirSizePath = path
+	p.dirSizeSpinner.Start()
+
+	epoch, gen, root := p.ctx.Epoch, p.dirSizeGen, p.ctx.WorkDir
+	compute := func() tea.Msg {
+		node := &FileNode{Path: path, IsDir: true}
+		size := sumDirSize(ctx, node, diskChildReader(root), dirSizeMaxEntries)
+		return DirSizeMsg{Epoch: epoch, Gen: gen, Path: path, Size: size}
+	}
+	if p.dirSizeTicking {
+		return compute
+	}
+	p.dirSizeTicking = true
+	return tea.Batch(compute, dirSizeTick())
+}
+
+// handleDirSizeTick animates the spinner until the walk finishes.
+func (p *Plugin) handleDirSizeTick() tea.Cmd {
+	if !p.dirSizeSpinner.IsActive() {
+		p.dirSizeTicking = false
+		return nil
+	}
+	p.dirSizeSpinner.Tick()
+	return dirSizeTick()
+}
+
+// cancelDirSize stops any size computation in progress.
+func (p *Plugin) cancelDirSize() {
+	if p.dirSizeCancel != nil {
+		p.dirSizeCancel()
+		p.dirSizeCancel = nil
+	}
+	p.dirSizePath = ""
+	p.dirSizeSpinner.Stop()
+}
+
+// handleDirSize caches a computed size. Results from cancelled walks or from
+// before a refresh are dropped.
+func (p *Plugin) handleDirSize(msg DirSizeMsg) {
+	if msg.Gen != p.dirSizeGen || msg.Path != p.dirSizePath {
+		return
+	}
+	if p.dirSizes == nil {
+		p.dirSizes = make(map[string]dirSize)
+	}
+	p.dirSizes[msg.Path] = msg.Size
+	p.dirSizeCancel = nil
+	p.dirSizePath = ""
+	p.dirSizeSpinner.Stop()
+}
+
+// invalidateDirSizes drops cached sizes after the tree changes, recomputing
+// the one shown in the info modal.
+func (p *Plugin) invalidateDirSizes() tea.Cmd {
+	p.dirSizes = nil
+	p.dirSizeGen++
+	if !p.infoMode || !p.infoTargetIsDir() {
+		return nil
+	}
+	return p.startDirSize(p.infoTargetPath())
+}
@@ -0,0 +1,125 @@
+package filebrowser
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// treeChildren reads children straight from a synthetic FileNode tree.
+func treeChildren(dir *FileNode) ([]*FileNode, error) {
+	if dir.Name == "locked" {
+		return nil, errors.New("permission denied")
+	}
+	return dir.Children, nil
+}
+
+func sizeFile(name string, size int64) *FileNode {
+	return &FileNode{Name: name, Size: size}
+}
+
+func sizeDir(name string, children ...*FileNode) *FileNode {
+	return &FileNode{Name: name, IsDir: true, Size: 4096, Children: children}
+}
+
+func TestSumDirSize(t *testing.T) {
+	root := sizeDir("root",
+		sizeFile("a.txt", 100),
+		sizeDir("src",
+			sizeFile("main.go", 1000),
+			sizeDir("pkg",
+				sizeFile("util.go", 250),
+				sizeFile("util_test.go", 50),
+			),
+			sizeDir("empty"),
+		),
+		sizeDir("locked", sizeFile("secret", 1<<20)),
+		sizeFile("b.bin", 2048),
+	)
+
+	got := sumDirSize(context.Background(), root, treeChildren, dirSizeMaxEntries)
+	want := dirSize{Bytes: 100 + 1000 + 250 + 50 + 2048, Files: 5}
+	if got != want {
+		t.Errorf("sumDirSize() = %+v, want %+v", got, want)
+	}
+
+	// Directory entries themselves don't add to the total
+	if got := sumDirSize(context.Background(), sizeDir("only", sizeDir("a"), sizeDir("b")), treeChildren, dirSizeMaxEntries); got != (dirSize{}) {
+		t.Errorf("empty dirs = %+v, want zero", got)
+	}
+}
+
+func TestSumDirSize_Bounded(t *testing.T) {
+	root := sizeDir("root", sizeFile("1", 10), sizeFile("2", 10), sizeFile("3", 10), sizeFile("4", 10))
+
+	got := sumDirSize(context.Background(), root, treeChildren, 2)
+	if !got.Truncated || got.Files != 2 || got.Bytes != 20 {
+		t.Errorf("limited walk = %+v, want 2 files, truncated", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := sumDirSize(ctx, root, treeChildren, dirSizeMaxEntries); !got.Truncated || got.Files != 0 {
+		t.Errorf("cancelled walk = %+v, want truncated with no files", got)
+	}
+}
+
+func TestDirSize_String(t *testing.T) {
+	tests := []struct {
+		size dirSize
+		want string
+	}{
+		{dirSize{Bytes: 0, Files: 0}, "0B (0 files)"},
+		{dirSize{Bytes: 512, Files: 1}, "512B (1 file)"},
+		{dirSize{Bytes: 1536, Files: 3, Truncated: true}, "at least 1.5KB (3 files)"},
+	}
+	for _, tt := range tests {
+		if got := tt.size.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestDirSize_CacheAndInvalidate(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := createTestPlugin(t, tmpDir)
+	if err := os.WriteFile(filepath.Join(tmpDir, "src", "big.txt"), make([]byte, 1000), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	p.infoMode = true
+	p.treeCursor = p.tree.IndexOf(p.tree.FindByPath("src"))
+	cmd := p.startDirSize("src")
+	if cmd == nil || p.infoDirSize("src") == "--" {
+		t.Fatal("expected size computation with spinner")
+	}
+	p.handleDirSize(DirSizeMsg{Gen: p.dirSizeGen, Path: "src", Size: sumDirSize(context.Background(), &FileNode{Path: "src", IsDir: true}, diskChildReader(tmpDir), dirSizeMaxEntries)})
+
+	// app.go (11) + config.json (2) + big.txt (1000)
+	if got := p.infoDirSize("src"); got != "1013B (3 files)" {
+		t.Errorf("infoDirSize() = %q", got)
+	}
+	if p.dirSizeSpinner.IsActive() {
+		t.Error("spinner should stop once computed")
+	}
+	if cmd := p.startDirSize("src"); cmd != nil {
+		t.Error("cached size should not be recomputed")
+	}
+
+	// A refresh drops the cache and re-measures the open directory
+	gen := p.dirSizeGen
+	if cmd := p.invalidateDirSizes(); cmd == nil {
+		t.Error("expected the open directory to be re-measured")
+	}
+	if _, ok := p.dirSizes["src"]; ok {
+		t.Error("cache should be cleared")
+	}
+
+	// Results started before the refresh are ignored
+	p.handleDirSize(DirSizeMsg{Gen: gen, Path: "src", Size: dirSize{Bytes: 1}})
+	if _, ok := p.dirSizes["src"]; ok {
+		t.Error("stale result should be dropped")
+	}
+}
@@ -1,12 +1,15 @@
 package filebrowser
 
 import (
+	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
 
 	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/marcus/sidecar/internal/plugin"
 )
 
@@ -480,3 +483,144 @@ func TestValidateFilename(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectRelPath(t *testing.T) {
+	workDir := t.TempDir()
+
+	tests := []struct {
+		name   string
+		path   string
+		want   string
+		wantOK bool
+	}{
+		{"root", workDir, ".", true},
+		{"file", filepath.Join(workDir, "a.txt"), "a.txt", true},
+		{"nested", filepath.Join(workDir, "a", "b.txt"), filepath.Join("a", "b.txt"), true},
+		{"dotted name", filepath.Join(workDir, "..hidden"), "..hidden", true},
+		{"cleaned traversal", filepath.Join(workDir, "a", "..", "b.txt"), "b.txt", true},
+		{"parent", filepath.Join(workDir, ".."), "", false},
+		{"escape", workDir + string(filepath.Separator) + filepath.Join("..", "x.txt"), "", false},
+		{"unrelated", filepath.Join(os.TempDir(), "elsewhere.txt"), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := projectRelPath(workDir, tt.path)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("projectRelPath(%q) = (%q, %v), want (%q, %v)", tt.path, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCheckCollision(t *testing.T) {
+	tmpDir := t.TempDir()
+	existing := filepath.Join(tmpDir, "exists.txt")
+	if err := os.WriteFile(existing, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(tmpDir, "dir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		src     string
+		dst     string
+		wantErr string
+	}{
+		{"free name", "", filepath.Join(tmpDir, "new.txt"), ""},
+		{"existing file", "", existing, "already exists: exists.txt"},
+		{"existing dir", "", filepath.Join(tmpDir, "dir"), "already exists: dir"},
+		{"rename onto other file", filepath.Join(tmpDir, "dir"), existing, "already exists: exists.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCollision(tt.src, tt.dst)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.wantErr {
+				t.Errorf("checkCollision(%q, %q) = %q, want %q", tt.src, tt.dst, got, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDescribeFileOpError(t *testing.T) {
+	path := filepath.Join("src", "main.go")
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"permission", &fs.PathError{Op: "open", Path: path, Err: fs.ErrPermission}, "permission denied: main.go"},
+		{"exists", &fs.PathError{Op: "mkdir", Path: path, Err: fs.ErrExist}, "already exists: main.go"},
+		{"missing", &fs.PathError{Op: "rename", Path: path, Err: fs.ErrNotExist}, "not found: main.go"},
+		{"other", fmt.Errorf("disk full"), "disk full"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeFileOpError(tt.err, path).Error(); got != tt.want {
+				t.Errorf("describeFileOpError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoCreate_Collision(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "taken.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	p := &Plugin{ctx: &plugin.Context{WorkDir: tmpDir}}
+
+	msg := p.doCreate("taken.txt", false)()
+	errMsg, ok := msg.(FileOpErrorMsg)
+	if !ok {
+		t.Fatalf("expected FileOpErrorMsg, got %T", msg)
+	}
+	if errMsg.Err.Error() != "already exists: taken.txt" {
+		t.Errorf("error = %q", errMsg.Err)
+	}
+}
+
+func TestDeleteConfirmModal(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := createTestPlugin(t, tmpDir)
+
+	target := p.tree.FindByPath("main.go")
+	p.treeCursor = p.tree.IndexOf(target)
+
+	// D opens the confirmation without touching the file
+	_, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	if !p.fileOpConfirmDelete || p.fileOpBarVisible() {
+		t.Fatal("expected delete confirmation modal")
+	}
+	p.ensureDeleteModal()
+	if p.deleteModal == nil {
+		t.Fatal("expected modal to be built")
+	}
+
+	// Cancelling leaves the file in place
+	_, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if p.fileOpConfirmDelete || p.fileOpMode != FileOpNone {
+		t.Error("expected n to cancel")
+	}
+
+	// Confirming deletes it
+	_, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	_, cmd := p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if cmd == nil {
+		t.Fatal("expected delete command")
+	}
+	if _, ok := cmd().(DeleteSuccessMsg); !ok {
+		t.Fatal("expected delete to succeed")
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, "main.go")); !os.IsNotExist(err) {
+		t.Error("main.go should be deleted")
+	}
+}
@@ -8,28 +8,53 @@ import (
 )
 
 // GitIgnore manages .gitignore patterns for file filtering.
+// Patterns from nested .gitignore files are scoped to their directory and
+// take precedence over those of parent directories, as in git.
 type GitIgnore struct {
 	patterns []gitIgnorePattern
 	cache    map[string]bool // Path -> isIgnored cache
+	loaded   map[string]bool // Dirs whose .gitignore has been loaded
 }
 
 type gitIgnorePattern struct {
 	pattern  string
-	negate   bool // Starts with !
-	dirOnly  bool // Ends with /
-	anchored bool // Contains / (not at end)
+	base     string // Dir of the defining .gitignore, relative to root ("" for root)
+	negate   bool   // Starts with !
+	dirOnly  bool   // Ends with /
+	anchored bool   // Contains / (not at end)
 	regex    *regexp.Regexp
 }
 
 // NewGitIgnore creates a new GitIgnore instance.
 func NewGitIgnore() *GitIgnore {
 	return &GitIgnore{
-		cache: make(map[string]bool),
+		cache:  make(map[string]bool),
+		loaded: make(map[string]bool),
 	}
 }
 
-// LoadFile loads patterns from a .gitignore file.
+// LoadFile loads patterns from a .gitignore file that applies to the whole tree.
 func (gi *GitIgnore) LoadFile(path string) error {
+	return gi.loadFile(path, "")
+}
+
+// LoadDir loads the .gitignore in dir (relative to root), scoping its
+// patterns to that subtree. Each directory is loaded at most once, so
+// parents must be loaded before their children to keep git's precedence.
+func (gi *GitIgnore) LoadDir(root, dir string) error {
+	dir = filepath.ToSlash(dir)
+	if dir == "." {
+		dir = ""
+	}
+	if gi.loaded[dir] {
+		return nil
+	}
+	gi.loaded[dir] = true
+	return gi.loadFile(filepath.Join(root, dir, ".gitignore"), dir)
+}
+
+// loadFile loads patterns from a .gitignore file in the base directory.
+func (gi *GitIgnore) loadFile(path, base string) error {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -43,14 +68,21 @@ func (gi *GitIgnore) LoadFile(path string) error {
 		if line == "" || strings.HasPrefix(line, "#") {
 			continue
 		}
-		gi.addPattern(line)
+		gi.addScopedPattern(line, base)
 	}
+	// New patterns can change earlier results
+	gi.ClearCache()
 	return nil
 }
 
 // addPattern parses and adds a gitignore pattern.
 func (gi *GitIgnore) addPattern(line string) {
-	p := gitIgnorePattern{pattern: line}
+	gi.addScopedPattern(line, "")
+}
+
+// addScopedPattern parses and adds a pattern that applies under base.
+func (gi *GitIgnore) addScopedPattern(line, base string) {
+	p := gitIgnorePattern{pattern: line, base: base}
 
 	// Check for negation
 	if strings.HasPrefix(line, "!") {
@@ -132,12 +164,20 @@ func (gi *GitIgnore) IsIgnored(path string, isDir bool) bool {
 		return cached
 	}
 
+	// Git can't re-include a path whose parent directory is excluded
+	if parent := filepath.ToSlash(filepath.Dir(path)); parent != "." && parent != "/" && gi.IsIgnored(parent, true) {
+		gi.cache[cacheKey] = true
+		return true
+	}
+
+	// Later patterns win, and nested files are loaded after their parents
 	ignored := false
 	for _, p := range gi.patterns {
 		if p.dirOnly && !isDir {
 			continue
 		}
-		if p.matches(path) {
+		rel, ok := p.relPath(path)
+		if ok && p.matches(rel) {
 			ignored = !p.negate
 		}
 	}
@@ -146,6 +186,18 @@ func (gi *GitIgnore) IsIgnored(path string, isDir bool) bool {
 	return ignored
 }
 
+// relPath returns path relative to the pattern's directory, or false if the
+// path is outside it.
+func (p *gitIgnorePattern) relPath(path string) (string, bool) {
+	if p.base == "" {
+		return path, true
+	}
+	if rest, ok := strings.CutPrefix(path, p.base+"/"); ok {
+		return rest, true
+	}
+	return "", false
+}
+
 // matches checks if a path matches this pattern.
 func (p *gitIgnorePattern) matches(path string) bool {
 	if p.regex == nil {
@@ -138,3 +138,57 @@ func TestGitIgnore_Cache(t *testing.T) {
 		t.Error("expected cache to be empty after clear")
 	}
 }
+
+func TestGitIgnore_LoadDir_Nested(t *testing.T) {
+	tmpDir := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(tmpDir, "sub", "deep"), 0755)
+	_ = os.MkdirAll(filepath.Join(tmpDir, "other"), 0755)
+	_ = os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("*.log\nout/\n"), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "sub", ".gitignore"), []byte("!keep.log\n/local.txt\ntmp/\n"), 0644)
+
+	gi := NewGitIgnore()
+	for _, dir := range []string{"", "sub", "sub/deep", "other"} {
+		if err := gi.LoadDir(tmpDir, dir); err != nil {
+			t.Fatalf("LoadDir(%q) failed: %v", dir, err)
+		}
+	}
+	// Loading a directory again doesn't duplicate its patterns
+	_ = gi.LoadDir(tmpDir, "sub")
+	if len(gi.patterns) != 5 {
+		t.Errorf("patterns = %d, want 5", len(gi.patterns))
+	}
+
+	tests := []struct {
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{"keep.log", false, true},           // nested negation doesn't reach the root
+		{"sub/keep.log", false, false},      // re-included by sub/.gitignore
+		{"sub/deep/keep.log", false, false}, // and below it
+		{"sub/debug.log", false, true},      // root pattern still applies
+		{"sub/local.txt", false, true},      // anchored to sub/
+		{"sub/deep/local.txt", false, false},
+		{"local.txt", false, false},
+		{"sub/tmp", true, true}, // directory-only
+		{"sub/tmp", false, false},
+		{"other/tmp", true, false}, // scoped to sub/
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := gi.IsIgnored(tt.path, tt.isDir); got != tt.ignored {
+				t.Errorf("IsIgnored(%q, %v) = %v, want %v", tt.path, tt.isDir, got, tt.ignored)
+			}
+		})
+	}
+}
+
+func TestGitIgnore_ExcludedParentCannotBeReincluded(t *testing.T) {
+	gi := NewGitIgnore()
+	gi.addPattern("out/")
+	gi.addPattern("!out/keep.txt")
+
+	if !gi.IsIgnored("out/keep.txt", false) {
+		t.Error("expected a file inside an ignored directory to stay ignored")
+	}
+}
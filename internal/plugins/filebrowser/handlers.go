@@ -33,6 +33,11 @@ func (p *Plugin) handleKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		return p.handleInfoKey(msg)
 	}
 
+	// Handle bookmark jump list
+	if p.bookmarksMode {
+		return p.handleBookmarksKey(msg)
+	}
+
 	// Handle blame mode
 	if p.blameMode {
 		return p.handleBlameKey(msg)
@@ -237,6 +242,9 @@ func (p *Plugin) handleTreeKey(key string) (plugin.Plugin, tea.Cmd) {
 			p.clearInfoModal()
 			p.gitStatus = "Loading..."
 			p.gitLastCommit = "Loading..."
+			if node.IsDir {
+				return p, tea.Batch(p.fetchGitInfo(node.Path), p.startDirSize(node.Path))
+			}
 			return p, p.fetchGitInfo(node.Path)
 		}
 
@@ -301,7 +309,7 @@ func (p *Plugin) handleTreeKey(key string) (plugin.Plugin, tea.Cmd) {
 			p.fileOpTarget = node
 			p.fileOpConfirmDelete = true
 			p.fileOpError = ""
-			p.fileOpButtonFocus = 1 // Start with confirm button focused
+			p.clearDeleteModal()
 		}
 
 	case "y":
@@ -334,8 +342,26 @@ func (p *Plugin) handleTreeKey(key string) (plugin.Plugin, tea.Cmd) {
 
 	case "s":
 		// Cycle sort mode
-		newMode := p.tree.SortMode.Next()
-		p.tree.SetSortMode(newMode)
+		mode := p.tree.SortMode.Next()
+		p.applySort(SortOptions{Mode: mode, Descending: mode.DefaultDescending(), DirsFirst: p.tree.DirsFirst})
+
+	case "b":
+		return p, p.toggleBookmark()
+
+	case "'":
+		p.openBookmarks()
+
+	case "S":
+		// Reverse sort direction
+		opts := p.tree.sortOptions()
+		opts.Descending = !opts.Descending
+		p.applySort(opts)
+
+	case "F":
+		// Toggle directories-first
+		opts := p.tree.sortOptions()
+		opts.DirsFirst = !opts.DirsFirst
+		p.applySort(opts)
 
 	case ":":
 		p.lineJumpMode = true
@@ -380,10 +406,11 @@ func (p *Plugin) handleTreeKey(key string) (plugin.Plugin, tea.Cmd) {
 		}
 
 	case "H":
-		// Toggle git-ignored file visibility
-		p.showIgnored = !p.showIgnored
-		p.tree.ShowIgnored = p.showIgnored
+		// Cycle visibility: show all -> hide ignored -> hide ignored+dotfiles
+		p.visibility = p.visibility.Next()
+		p.tree.Visibility = p.visibility
 		p.tree.Flatten()
+		toast := appmsg.ShowToast("Files: "+p.visibility.Label(), 2*time.Second)
 		// Ensure cursor stays valid
 		if p.treeCursor >= p.tree.Len() {
 			p.treeCursor = max(0, p.tree.Len()-1)
@@ -395,9 +422,10 @@ func (p *Plugin) handleTreeKey(key string) (plugin.Plugin, tea.Cmd) {
 		if p.treeCursor >= 0 && p.treeCursor < p.tree.Len() {
 			node := p.tree.GetNode(p.treeCursor)
 			if node != nil && node.Path != p.previewFile {
-				return p, p.openTab(node.Path, TabOpenReplace)
+				return p, tea.Batch(toast, p.openTab(node.Path, TabOpenReplace))
 			}
 		}
+		return p, toast
 
 	case "[":
 		return p, p.cycleTab(-1)
@@ -604,46 +632,9 @@ func (p *Plugin) handlePreviewKey(key string) (plugin.Plugin, tea.Cmd) {
 func (p *Plugin) handleFileOpKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	key := msg.String()
 
-	// Handle delete confirmation mode
+	// Handle delete confirmation modal
 	if p.fileOpConfirmDelete {
-		switch key {
-		case "y", "Y", "enter":
-			// Proceed with delete (y/Y or Enter when confirm focused)
-			if key == "enter" && p.fileOpButtonFocus == 2 {
-				// Cancel button focused, treat as cancel
-				p.fileOpMode = FileOpNone
-				p.fileOpTarget = nil
-				p.fileOpError = ""
-				p.fileOpConfirmDelete = false
-				return p, nil
-			}
-			p.fileOpConfirmDelete = false
-			return p, p.doDelete()
-		case "n", "N", "esc":
-			// Cancel delete
-			p.fileOpMode = FileOpNone
-			p.fileOpTarget = nil
-			p.fileOpError = ""
-			p.fileOpConfirmDelete = false
-			return p, nil
-		case "tab":
-			// Toggle between confirm (1) and cancel (2)
-			if p.fileOpButtonFocus == 1 {
-				p.fileOpButtonFocus = 2
-			} else {
-				p.fileOpButtonFocus = 1
-			}
-			return p, nil
-		case "shift+tab":
-			// Reverse toggle
-			if p.fileOpButtonFocus == 2 {
-				p.fileOpButtonFocus = 1
-			} else {
-				p.fileOpButtonFocus = 2
-			}
-			return p, nil
-		}
-		return p, nil
+		return p.handleDeleteConfirmKey(msg)
 	}
 
 	// Handle confirmation mode for directory creation (during move)
@@ -936,6 +927,10 @@ func (p *Plugin) handleQuickOpenKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		p.quickOpenCursor = 0
 
 	case "enter":
+		// Don't act on matches for a query that is still being debounced
+		if p.quickOpenPending {
+			p.updateQuickOpenMatches()
+		}
 		if len(p.quickOpenMatches) > 0 && p.quickOpenCursor < len(p.quickOpenMatches) {
 			return p.selectQuickOpenMatch()
 		}
@@ -954,14 +949,14 @@ func (p *Plugin) handleQuickOpenKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		if len(p.quickOpenQuery) > 0 {
 			runes := []rune(p.quickOpenQuery)
 			p.quickOpenQuery = string(runes[:len(runes)-1])
-			p.updateQuickOpenMatches()
+			return p, p.scheduleQuickOpenFilter()
 		}
 
 	default:
 		// Append printable characters
 		if len(key) == 1 && key[0] >= 32 && key[0] <= 126 {
 			p.quickOpenQuery += key
-			p.updateQuickOpenMatches()
+			return p, p.scheduleQuickOpenFilter()
 		}
 	}
 
@@ -1452,3 +1447,15 @@ func (p *Plugin) handleLineJumpKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 
 	return p, nil
 }
+
+// applySort re-sorts the tree, keeping the cursor on the selected node, and
+// persists the new options.
+func (p *Plugin) applySort(opts SortOptions) {
+	var selected string
+	if node := p.tree.GetNode(p.treeCursor); node != nil {
+		selected = node.Path
+	}
+	p.tree.SetSortOptions(opts)
+	p.reselectPath(selected)
+	p.saveState()
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/marcus/sidecar/internal/app"
 	"github.com/marcus/sidecar/internal/features"
 	"github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/tty"
 	xterm "golang.org/x/term"
@@ -46,13 +47,7 @@ func (p *Plugin) enterInlineEditMode(path string, lineNo int) tea.Cmd {
 	fullPath := filepath.Join(p.ctx.WorkDir, path)
 
 	// Get user's editor preference
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		editor = os.Getenv("VISUAL")
-	}
-	if editor == "" {
-		editor = "vim"
-	}
+	editor := plugin.DefaultEditor()
 
 	// Generate a unique session name
 	sessionName := fmt.Sprintf("sidecar-edit-%d", time.Now().UnixNano())
@@ -79,13 +74,13 @@ func (p *Plugin) enterInlineEditMode(path string, lineNo int) tea.Cmd {
 		// Create a detached tmux session with the editor
 		// Use -x and -y to set initial size (will be resized later)
 		// Pass TERM environment for proper color/theme support
-		// Include +lineNo for editors that support it (vim, nano, emacs, helix, etc.)
-		editorArgs := []string{editor}
+		// Include the line for editors that support it (vim, nano, emacs, helix, etc.)
+		editorLine := 0
 		if lineNo > 0 {
 			// Convert 0-indexed to 1-indexed for editor
-			editorArgs = append(editorArgs, fmt.Sprintf("+%d", lineNo+1))
+			editorLine = lineNo + 1
 		}
-		editorArgs = append(editorArgs, fullPath)
+		editorArgs := plugin.EditorArgs(editor, fullPath, editorLine)
 
 		editorW, editorH := p.width, p.height
 		if editorW <= 0 || editorH <= 0 {
@@ -596,9 +591,9 @@ func (p *Plugin) calculateInlineEditorMouseCoords(x, y int) (col, row int, ok bo
 	contentY := 0
 
 	// Account for input bars (content search, file op, line jump)
-	if p.contentSearchMode || p.fileOpMode != FileOpNone || p.lineJumpMode {
+	if p.contentSearchMode || p.fileOpBarVisible() || p.lineJumpMode {
 		contentY++
-		if p.fileOpMode != FileOpNone && p.fileOpError != "" {
+		if p.fileOpBarVisible() && p.fileOpError != "" {
 			contentY++ // error line
 		}
 	}
@@ -73,9 +73,9 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 			// This is needed because regionPreviewPane encompasses tabs
 			if len(p.tabs) > 1 {
 				inputBarHeight := 0
-				if p.contentSearchMode || p.fileOpMode != FileOpNone || p.lineJumpMode {
+				if p.contentSearchMode || p.fileOpBarVisible() || p.lineJumpMode {
 					inputBarHeight = 1
-					if p.fileOpMode != FileOpNone && p.fileOpError != "" {
+					if p.fileOpBarVisible() && p.fileOpError != "" {
 						inputBarHeight = 2
 					}
 				}
@@ -188,11 +188,21 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 		return p.handleInfoModalMouse(msg)
 	}
 
+	// Handle bookmark jump list if active
+	if p.bookmarksMode {
+		return p.handleBookmarksMouse(msg)
+	}
+
 	// Handle blame modal if active
 	if p.blameMode {
 		return p.handleBlameModalMouse(msg)
 	}
 
+	// Handle delete confirmation modal if active
+	if p.fileOpConfirmDelete {
+		return p.handleDeleteModalMouse(msg)
+	}
+
 	action := p.mouseHandler.HandleMouse(msg)
 
 	switch action.Type {
@@ -2,6 +2,7 @@ package filebrowser
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -28,13 +29,7 @@ func (p *Plugin) openFile(path string) tea.Cmd {
 // openFileAtLine returns a command to open a file in the user's editor at a specific line.
 func (p *Plugin) openFileAtLine(path string, lineNo int) tea.Cmd {
 	return func() tea.Msg {
-		editor := os.Getenv("EDITOR")
-		if editor == "" {
-			editor = os.Getenv("VISUAL")
-		}
-		if editor == "" {
-			editor = "vim"
-		}
+		editor := plugin.DefaultEditor()
 		fullPath := filepath.Join(p.ctx.WorkDir, path)
 		return plugin.OpenFileMsg{Editor: editor, Path: fullPath, LineNo: lineNo}
 	}
@@ -103,29 +98,60 @@ func (p *Plugin) revealInFileManager(path string) tea.Cmd {
 	}
 }
 
-// validateDestPath checks that destination path is within workdir.
-// Returns error if path escapes the project directory.
-func (p *Plugin) validateDestPath(dstPath string) error {
-	// Clean and resolve the destination path
-	cleanDst := filepath.Clean(dstPath)
-
-	// Get absolute paths for comparison
-	absDst, err := filepath.Abs(cleanDst)
+// projectRelPath returns path relative to workDir, or false if it resolves
+// outside the project directory.
+func projectRelPath(workDir, path string) (string, bool) {
+	absPath, err := filepath.Abs(filepath.Clean(path))
 	if err != nil {
-		return fmt.Errorf("invalid destination path")
+		return "", false
 	}
+	absWorkDir, err := filepath.Abs(workDir)
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(absWorkDir, absPath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return rel, true
+}
 
-	absWorkDir, err := filepath.Abs(p.ctx.WorkDir)
+// checkCollision returns an error if dst already exists. A case-only rename
+// of src onto itself is allowed on case-insensitive filesystems.
+func checkCollision(src, dst string) error {
+	dstInfo, err := os.Lstat(dst)
 	if err != nil {
-		return fmt.Errorf("failed to resolve work directory")
+		return nil
 	}
+	if src != "" && strings.EqualFold(src, dst) {
+		if srcInfo, err := os.Lstat(src); err == nil && os.SameFile(srcInfo, dstInfo) {
+			return nil
+		}
+	}
+	return fmt.Errorf("already exists: %s", filepath.Base(dst))
+}
 
-	// Check if destination is within workdir
-	relPath, err := filepath.Rel(absWorkDir, absDst)
-	if err != nil || strings.HasPrefix(relPath, "..") {
-		return fmt.Errorf("cannot move files outside project directory")
+// describeFileOpError turns common filesystem errors into short messages
+// naming the affected file.
+func describeFileOpError(err error, path string) error {
+	name := filepath.Base(path)
+	switch {
+	case errors.Is(err, fs.ErrPermission):
+		return fmt.Errorf("permission denied: %s", name)
+	case errors.Is(err, fs.ErrExist):
+		return fmt.Errorf("already exists: %s", name)
+	case errors.Is(err, fs.ErrNotExist):
+		return fmt.Errorf("not found: %s", name)
 	}
+	return err
+}
 
+// validateDestPath checks that destination path is within workdir.
+// Returns error if path escapes the project directory.
+func (p *Plugin) validateDestPath(dstPath string) error {
+	if _, ok := projectRelPath(p.ctx.WorkDir, dstPath); !ok {
+		return fmt.Errorf("cannot move files outside project directory")
+	}
 	return nil
 }
 
@@ -230,7 +256,7 @@ func (p *Plugin) doFileOp(src, dst string) tea.Cmd {
 		// Create parent directories if needed (for move)
 		dstDir := filepath.Dir(dst)
 		if err := os.MkdirAll(dstDir, 0755); err != nil {
-			return FileOpErrorMsg{Err: err}
+			return FileOpErrorMsg{Err: describeFileOpError(err, dstDir)}
 		}
 
 		// Check if source and destination are the same
@@ -246,22 +272,22 @@ func (p *Plugin) doFileOp(src, dst string) tea.Cmd {
 			// Two-step rename: src -> temp -> dst
 			tempPath := src + ".sidecar-rename-tmp"
 			if err := os.Rename(src, tempPath); err != nil {
-				return FileOpErrorMsg{Err: fmt.Errorf("rename failed: %w", err)}
+				return FileOpErrorMsg{Err: fmt.Errorf("rename failed: %w", describeFileOpError(err, src))}
 			}
 			if err := os.Rename(tempPath, dst); err != nil {
 				// Try to rollback
 				_ = os.Rename(tempPath, src)
-				return FileOpErrorMsg{Err: fmt.Errorf("rename failed: %w", err)}
+				return FileOpErrorMsg{Err: fmt.Errorf("rename failed: %w", describeFileOpError(err, dst))}
 			}
 		} else {
 			// Check if destination exists (only for non-case-only renames)
-			if _, err := os.Stat(dst); err == nil {
-				return FileOpErrorMsg{Err: fmt.Errorf("destination already exists: %s", filepath.Base(dst))}
+			if err := checkCollision(src, dst); err != nil {
+				return FileOpErrorMsg{Err: fmt.Errorf("destination %w", err)}
 			}
 
 			// Perform the move/rename
 			if err := os.Rename(src, dst); err != nil {
-				return FileOpErrorMsg{Err: err}
+				return FileOpErrorMsg{Err: describeFileOpError(err, src)}
 			}
 		}
 
@@ -293,36 +319,28 @@ func (p *Plugin) doCreate(name string, isDir bool) tea.Cmd {
 		fullPath := filepath.Join(parentDir, name)
 
 		// Validate path is within project
-		absPath, err := filepath.Abs(fullPath)
-		if err != nil {
-			return FileOpErrorMsg{Err: fmt.Errorf("invalid path")}
-		}
-		absWorkDir, err := filepath.Abs(p.ctx.WorkDir)
-		if err != nil {
-			return FileOpErrorMsg{Err: fmt.Errorf("failed to resolve work directory")}
-		}
-		relPath, err := filepath.Rel(absWorkDir, absPath)
-		if err != nil || strings.HasPrefix(relPath, "..") {
+		if _, ok := projectRelPath(p.ctx.WorkDir, fullPath); !ok {
 			return FileOpErrorMsg{Err: fmt.Errorf("cannot create files outside project directory")}
 		}
 
 		// Check if already exists
-		if _, err := os.Stat(fullPath); err == nil {
-			return FileOpErrorMsg{Err: fmt.Errorf("already exists: %s", name)}
+		if err := checkCollision("", fullPath); err != nil {
+			return FileOpErrorMsg{Err: err}
 		}
 
 		if isDir {
 			if err := os.MkdirAll(fullPath, 0755); err != nil {
-				return FileOpErrorMsg{Err: err}
+				return FileOpErrorMsg{Err: describeFileOpError(err, fullPath)}
 			}
 		} else {
 			// Create parent directories if needed
 			if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
-				return FileOpErrorMsg{Err: err}
+				return FileOpErrorMsg{Err: describeFileOpError(err, filepath.Dir(fullPath))}
 			}
-			f, err := os.Create(fullPath)
+			// O_EXCL guards against a file appearing since the check above
+			f, err := os.OpenFile(fullPath, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
 			if err != nil {
-				return FileOpErrorMsg{Err: err}
+				return FileOpErrorMsg{Err: describeFileOpError(err, fullPath)}
 			}
 			_ = f.Close()
 		}
@@ -341,16 +359,8 @@ func (p *Plugin) doDelete() tea.Cmd {
 		fullPath := filepath.Join(p.ctx.WorkDir, p.fileOpTarget.Path)
 
 		// Validate path is within project (safety check)
-		absPath, err := filepath.Abs(fullPath)
-		if err != nil {
-			return FileOpErrorMsg{Err: fmt.Errorf("invalid path")}
-		}
-		absWorkDir, err := filepath.Abs(p.ctx.WorkDir)
-		if err != nil {
-			return FileOpErrorMsg{Err: fmt.Errorf("failed to resolve work directory")}
-		}
-		relPath, err := filepath.Rel(absWorkDir, absPath)
-		if err != nil || strings.HasPrefix(relPath, "..") {
+		relPath, ok := projectRelPath(p.ctx.WorkDir, fullPath)
+		if !ok {
 			return FileOpErrorMsg{Err: fmt.Errorf("cannot delete files outside project directory")}
 		}
 
@@ -361,7 +371,7 @@ func (p *Plugin) doDelete() tea.Cmd {
 
 		// Remove file or directory (recursively for directories)
 		if err := os.RemoveAll(fullPath); err != nil {
-			return FileOpErrorMsg{Err: err}
+			return FileOpErrorMsg{Err: describeFileOpError(err, fullPath)}
 		}
 
 		return DeleteSuccessMsg{Path: fullPath}
@@ -420,27 +430,18 @@ func (p *Plugin) doPaste(targetNode *FileNode) tea.Cmd {
 		}
 
 		// Validate destination is within project
-		absDestPath, err := filepath.Abs(destPath)
-		if err != nil {
-			return FileOpErrorMsg{Err: fmt.Errorf("invalid path")}
-		}
-		absWorkDir, err := filepath.Abs(p.ctx.WorkDir)
-		if err != nil {
-			return FileOpErrorMsg{Err: fmt.Errorf("failed to resolve work directory")}
-		}
-		relPath, err := filepath.Rel(absWorkDir, absDestPath)
-		if err != nil || strings.HasPrefix(relPath, "..") {
+		if _, ok := projectRelPath(p.ctx.WorkDir, destPath); !ok {
 			return FileOpErrorMsg{Err: fmt.Errorf("cannot paste outside project directory")}
 		}
 
 		// Copy file or directory
 		if srcInfo.IsDir() {
 			if err := copyDir(srcPath, destPath); err != nil {
-				return FileOpErrorMsg{Err: err}
+				return FileOpErrorMsg{Err: describeFileOpError(err, destPath)}
 			}
 		} else {
 			if err := copyFile(srcPath, destPath); err != nil {
-				return FileOpErrorMsg{Err: err}
+				return FileOpErrorMsg{Err: describeFileOpError(err, destPath)}
 			}
 		}
 
@@ -626,8 +627,24 @@ func (p *Plugin) openQuickOpen() (plugin.Plugin, tea.Cmd) {
 	return p, nil
 }
 
+// quickOpenDebounceMsg is sent after the debounce delay to re-filter matches.
+type quickOpenDebounceMsg struct {
+	Version int
+}
+
+// scheduleQuickOpenFilter re-filters quick open matches once typing pauses.
+func (p *Plugin) scheduleQuickOpenFilter() tea.Cmd {
+	p.quickOpenVersion++
+	p.quickOpenPending = true
+	version := p.quickOpenVersion
+	return tea.Tick(quickOpenDebounce, func(t time.Time) tea.Msg {
+		return quickOpenDebounceMsg{Version: version}
+	})
+}
+
 // updateQuickOpenMatches filters files using fuzzy matching.
 func (p *Plugin) updateQuickOpenMatches() {
+	p.quickOpenPending = false
 	p.quickOpenMatches = FuzzyFilter(p.quickOpenFiles, p.quickOpenQuery, quickOpenMaxResults)
 
 	// Reset cursor if out of bounds
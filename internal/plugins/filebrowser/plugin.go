@@ -1,6 +1,7 @@
 package filebrowser
 
 import (
+	"context"
 	"os"
 	"path/filepath"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/marcus/sidecar/internal/markdown"
 	"github.com/marcus/sidecar/internal/modal"
 	"github.com/marcus/sidecar/internal/mouse"
+	appmsg "github.com/marcus/sidecar/internal/msg"
 	"github.com/marcus/sidecar/internal/plugin"
 	"github.com/marcus/sidecar/internal/state"
 	"github.com/marcus/sidecar/internal/tty"
@@ -25,9 +27,10 @@ const (
 	pluginIcon = "F"
 
 	// Quick open limits
-	quickOpenMaxFiles   = 50000           // Max files to cache (prevents OOM on huge repos)
-	quickOpenMaxResults = 50              // Max matches to show
-	quickOpenTimeout    = 2 * time.Second // Max time to spend scanning
+	quickOpenMaxFiles   = 50000                 // Max files to cache (prevents OOM on huge repos)
+	quickOpenMaxResults = 50                    // Max matches to show
+	quickOpenTimeout    = 2 * time.Second       // Max time to spend scanning
+	quickOpenDebounce   = 60 * time.Millisecond // Delay before re-filtering on input
 
 	// Directory cache limits (for path auto-complete)
 	dirCacheMaxDirs    = 10000 // Max directories to cache
@@ -50,17 +53,23 @@ const (
 type (
 	RefreshMsg   struct{}
 	TreeBuiltMsg struct {
-		Err error
+		Err          error
+		SelectedPath string // Path selected when the refresh began, re-selected after
 	}
 	StateRestoredMsg struct {
 		State state.FileBrowserState
 	}
 	WatchStartedMsg struct{ Watcher *Watcher }
 	WatchEventMsg   struct{}
-	// NavigateToFileMsg requests navigation to a specific file (from other plugins).
-	NavigateToFileMsg struct {
-		Path string // Relative path from workdir
+	// TreeWatchStartedMsg is sent once the directory tree watcher is running.
+	TreeWatchStartedMsg struct{ Watcher *TreeWatcher }
+	// TreeChangedMsg is sent when files are created, removed, renamed or
+	// written on disk.
+	TreeChangedMsg struct {
+		Structural bool // Nodes changed; false when only file contents did
 	}
+	// NavigateToFileMsg requests navigation to a specific file (from other plugins).
+	NavigateToFileMsg = appmsg.NavigateToFileMsg
 	// RevealErrorMsg is sent when reveal in file manager fails.
 	RevealErrorMsg struct {
 		Err error
@@ -110,8 +119,8 @@ type Plugin struct {
 
 	// Pane state
 	activePane  FocusPane
-	treeVisible bool // Toggle tree pane visibility with \
-	showIgnored bool // Toggle git-ignored file visibility with H
+	treeVisible bool       // Toggle tree pane visibility with \
+	visibility  Visibility // Cycle ignored/hidden file visibility with H
 
 	// Tree state
 	treeCursor    int
@@ -177,6 +186,8 @@ type Plugin struct {
 	quickOpenMatches []QuickOpenMatch
 	quickOpenCursor  int
 	quickOpenFiles   []string // Cached file paths (relative)
+	quickOpenVersion int      // Debounce: only filter when version matches
+	quickOpenPending bool     // Query changed since matches were last filtered
 	quickOpenError   string   // Error message if scan failed/limited
 
 	// Project-wide search state (ctrl+s)
@@ -189,9 +200,28 @@ type Plugin struct {
 	infoMode       bool
 	infoModal      *modal.Modal
 	infoModalWidth int
+
+	// Bookmarks and the jump list (')
+	bookmarks           *Bookmarks
+	bookmarksMode       bool
+	bookmarksModal      *modal.Modal
+	bookmarksModalWidth int
+	bookmarkIdx         int
+
+	// Directory sizes for the info modal
+	dirSizes       map[string]dirSize // Cached totals by path, cleared on refresh
+	dirSizeGen     int                // Bumped on refresh to drop in-flight results
+	dirSizePath    string             // Directory being measured
+	dirSizeCancel  context.CancelFunc
+	dirSizeSpinner ui.BrailleSpinner
+	dirSizeTicking bool // Spinner tick loop is running
 	gitStatus      string
 	gitLastCommit  string
 
+	// Tree git status loads, coalesced while one is running
+	treeStatusLoading bool
+	treeStatusQueued  bool
+
 	// Blame view state
 	blameMode       bool
 	blameState      *BlameState
@@ -206,6 +236,7 @@ type Plugin struct {
 	fileOpConfirmCreate bool            // True when waiting for directory creation confirmation
 	fileOpConfirmPath   string          // The directory path to create
 	fileOpConfirmDelete bool            // True when waiting for delete confirmation
+	deleteModal         *modal.Modal    // Delete confirmation dialog
 	fileOpButtonFocus   int             // Button focus: 0=input, 1=confirm, 2=cancel
 	fileOpButtonHover   int             // Button hover: 0=none, 1=confirm, 2=cancel
 
@@ -223,9 +254,10 @@ type Plugin struct {
 	clipboardPath  string // Relative path of yanked file/directory
 	clipboardIsDir bool   // Whether yanked item is a directory
 
-	// File watcher
-	watcher     *Watcher
-	lastRefresh time.Time // Debounce rapid refreshes on focus
+	// File watchers
+	watcher     *Watcher     // Previewed file
+	treeWatcher *TreeWatcher // Directory tree under WorkDir
+	lastRefresh time.Time    // Debounce rapid refreshes on focus
 
 	// Mouse support
 	mouseHandler *mouse.Handler
@@ -262,7 +294,6 @@ func New() *Plugin {
 		mouseHandler:  mouse.NewHandler(),
 		imageRenderer: image.New(),  // Detect terminal graphics protocol once
 		treeVisible:   true,         // Tree pane visible by default
-		showIgnored:   true,         // Show git-ignored files by default
 		inlineEditor:  tty.New(nil), // Initialize inline editor with default config
 	}
 }
@@ -283,6 +314,8 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 
 	// Reset state flags for reinit support (project switching)
 	p.stateRestored = false
+	p.treeStatusLoading = false
+	p.treeStatusQueued = false
 
 	// Initialize markdown renderer
 	renderer, err := markdown.NewRenderer()
@@ -304,6 +337,7 @@ func (p *Plugin) Start() tea.Cmd {
 	return tea.Batch(
 		p.refresh(),
 		p.startWatcher(),
+		p.startTreeWatcher(),
 	)
 }
 
@@ -312,6 +346,10 @@ func (p *Plugin) Stop() {
 	if p.watcher != nil {
 		p.watcher.Stop()
 	}
+	if p.treeWatcher != nil {
+		p.treeWatcher.Stop()
+		p.treeWatcher = nil
+	}
 	// Kill any active inline edit sessions
 	p.cleanupAllEditSessions()
 	// Save state on shutdown
@@ -371,7 +409,11 @@ func (p *Plugin) saveState() {
 		ActivePane:    activePane,
 		PreviewFile:   p.previewFile,
 		TreeCursor:    p.treeCursor,
-		ShowIgnored:   &p.showIgnored,
+		Visibility:    p.visibility.String(),
+		Bookmarks:     p.bookmarks.Paths(),
+		SortMode:      p.tree.SortMode.Label(),
+		SortDesc:      p.tree.SortDescending,
+		MixDirs:       !p.tree.DirsFirst,
 		Tabs:          tabStates,
 		ActiveTab:     activeTab,
 	}
@@ -413,6 +455,34 @@ func (p *Plugin) listenForWatchEvents() tea.Cmd {
 	}
 }
 
+// startTreeWatcher initializes the directory tree watcher.
+func (p *Plugin) startTreeWatcher() tea.Cmd {
+	root := p.ctx.WorkDir
+	return func() tea.Msg {
+		watcher, err := NewTreeWatcher(root)
+		if err != nil {
+			p.ctx.Logger.Error("file browser: tree watcher failed", "error", err)
+			return nil
+		}
+		return TreeWatchStartedMsg{Watcher: watcher}
+	}
+}
+
+// listenForTreeChanges waits for the next coalesced tree change.
+func (p *Plugin) listenForTreeChanges() tea.Cmd {
+	if p.treeWatcher == nil {
+		return nil
+	}
+	events := p.treeWatcher.Events()
+	return func() tea.Msg {
+		change, ok := <-events
+		if !ok {
+			return nil
+		}
+		return TreeChangedMsg{Structural: change.Structural}
+	}
+}
+
 // updateWatchedFile updates the file watcher to watch the current preview file.
 func (p *Plugin) updateWatchedFile() {
 	if p.watcher == nil {
@@ -425,16 +495,55 @@ func (p *Plugin) updateWatchedFile() {
 	}
 }
 
-// refresh rebuilds the file tree, preserving expanded state.
+// refresh rebuilds the file tree, preserving expanded state and the
+// selected path.
 func (p *Plugin) refresh() tea.Cmd {
+	var selected string
+	if node := p.tree.GetNode(p.treeCursor); node != nil {
+		selected = node.Path
+	}
 	return func() tea.Msg {
 		expandedPaths := p.tree.GetExpandedPaths()
 		err := p.tree.Build()
 		p.tree.RestoreExpandedPaths(expandedPaths)
-		return TreeBuiltMsg{Err: err}
+		return TreeBuiltMsg{Err: err, SelectedPath: selected}
 	}
 }
 
+// refreshDirs reloads only the given directories (absolute paths) after a
+// file operation, preserving the selected path.
+func (p *Plugin) refreshDirs(dirs ...string) tea.Cmd {
+	var selected string
+	if node := p.tree.GetNode(p.treeCursor); node != nil {
+		selected = node.Path
+	}
+	rels := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		if rel, ok := projectRelPath(p.ctx.WorkDir, dir); ok {
+			rels = append(rels, rel)
+		}
+	}
+	return func() tea.Msg {
+		for _, rel := range rels {
+			if err := p.tree.RefreshDir(rel); err != nil {
+				return TreeBuiltMsg{Err: err, SelectedPath: selected}
+			}
+		}
+		return TreeBuiltMsg{SelectedPath: selected}
+	}
+}
+
+// reselectPath moves the tree cursor back to path after a rebuild. If the
+// path is gone, the cursor keeps its index, clamped to the new tree.
+func (p *Plugin) reselectPath(path string) {
+	if node := p.tree.FindByPath(path); path != "" && node != nil {
+		p.treeCursor = p.tree.IndexOf(node)
+	} else if p.treeCursor >= p.tree.Len() {
+		p.treeCursor = max(p.tree.Len()-1, 0)
+	}
+	p.ensureTreeCursorVisible()
+}
+
 // Update handles messages.
 func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 	// Handle exit confirmation dialog first
@@ -541,6 +650,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if msg.Err != nil {
 			p.ctx.Logger.Error("tree build failed", "error", msg.Err)
 		}
+		sizeCmd := p.invalidateDirSizes()
+		statusCmd := p.loadTreeGitStatus()
 		// Handle pending auto-open from file creation
 		if p.pendingOpenFile != "" {
 			path := p.pendingOpenFile
@@ -549,15 +660,23 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			// Restore state after first tree build
 			if !p.stateRestored {
 				p.stateRestored = true
-				return p, tea.Batch(navCmd, p.restoreState())
+				return p, tea.Batch(navCmd, p.restoreState(), sizeCmd, statusCmd)
 			}
-			return p, navCmd
+			return p, tea.Batch(navCmd, sizeCmd, statusCmd)
 		}
 		// Restore state after first tree build
 		if !p.stateRestored {
 			p.stateRestored = true
-			return p, p.restoreState()
+			return p, tea.Batch(p.restoreState(), sizeCmd, statusCmd)
 		}
+		p.reselectPath(msg.SelectedPath)
+		return p, tea.Batch(sizeCmd, statusCmd)
+
+	case TreeGitStatusMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		return p, p.handleTreeGitStatus(msg)
 
 	case StateRestoredMsg:
 		// Apply restored state
@@ -572,13 +691,24 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.tree.RestoreExpandedPaths(expandedPaths)
 		}
 
-		// Restore ignored file visibility (nil = default true)
-		if fbState.ShowIgnored != nil {
-			p.showIgnored = *fbState.ShowIgnored
-			p.tree.ShowIgnored = p.showIgnored
+		// Restore file visibility, falling back to the older ignored toggle
+		if v, ok := ParseVisibility(fbState.Visibility); ok {
+			p.visibility = v
+		} else if fbState.ShowIgnored != nil && !*fbState.ShowIgnored {
+			p.visibility = VisibilityHideIgnored
+		}
+		if p.tree.Visibility != p.visibility {
+			p.tree.Visibility = p.visibility
 			p.tree.Flatten()
 		}
 
+		p.bookmarks = NewBookmarks(fbState.Bookmarks)
+
+		// Restore sort options
+		if mode, ok := ParseSortMode(fbState.SortMode); ok {
+			p.tree.SetSortOptions(SortOptions{Mode: mode, Descending: fbState.SortDesc, DirsFirst: !fbState.MixDirs})
+		}
+
 		// Restore tree cursor position
 		if fbState.TreeCursor > 0 && fbState.TreeCursor < p.tree.Len() {
 			p.treeCursor = fbState.TreeCursor
@@ -646,10 +776,31 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 	case RefreshMsg:
 		return p, p.refresh()
 
+	case app.RefreshMsg:
+		// Back from the external editor: pick up saved edits and new files
+		cmds := []tea.Cmd{p.refresh()}
+		if p.previewFile != "" {
+			cmds = append(cmds, LoadPreview(p.ctx.WorkDir, p.previewFile, p.ctx.Epoch))
+		}
+		return p, tea.Batch(cmds...)
+
 	case WatchStartedMsg:
 		p.watcher = msg.Watcher
 		return p, p.listenForWatchEvents()
 
+	case TreeWatchStartedMsg:
+		p.treeWatcher = msg.Watcher
+		return p, p.listenForTreeChanges()
+
+	case TreeChangedMsg:
+		if !msg.Structural {
+			// Only contents changed: the nodes stay, but git status may not
+			return p, tea.Batch(p.listenForTreeChanges(), p.loadTreeGitStatus())
+		}
+		// Files were created, removed or renamed on disk
+		p.lastRefresh = time.Now()
+		return p, tea.Batch(p.listenForTreeChanges(), p.refresh())
+
 	case WatchEventMsg:
 		// Watched file changed - reload preview (watcher only watches the previewed file)
 		cmds := []tea.Cmd{p.listenForWatchEvents()}
@@ -666,13 +817,16 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 
 	case FileOpErrorMsg:
 		p.fileOpError = msg.Err.Error()
+		// Rebuild the delete confirmation to show the error
+		p.clearDeleteModal()
 
 	case FileOpSuccessMsg:
 		// Clear file operation state and refresh
 		p.fileOpMode = FileOpNone
 		p.fileOpTarget = nil
 		p.fileOpError = ""
-		return p, p.refresh()
+		p.moveBookmarks(msg.Src, msg.Dst)
+		return p, p.refreshDirs(filepath.Dir(msg.Src), filepath.Dir(msg.Dst))
 
 	case CreateSuccessMsg:
 		// Clear file operation state and refresh
@@ -685,7 +839,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				p.pendingOpenFile = relPath
 			}
 		}
-		return p, p.refresh()
+		return p, p.refreshDirs(filepath.Dir(msg.Path))
 
 	case DeleteSuccessMsg:
 		// Clear file operation state and refresh
@@ -693,13 +847,25 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		p.fileOpTarget = nil
 		p.fileOpError = ""
 		p.fileOpConfirmDelete = false
+		p.clearDeleteModal()
 		// Clean up tabs for the deleted file/directory
 		p.closeTabsForPath(msg.Path)
-		return p, p.refresh()
+		p.removeBookmarks(msg.Path)
+		return p, p.refreshDirs(filepath.Dir(msg.Path))
 
 	case PasteSuccessMsg:
-		// Refresh after paste
-		return p, p.refresh()
+		// Refresh the directory pasted into
+		return p, p.refreshDirs(filepath.Dir(msg.Dst))
+
+	case DirSizeMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		p.handleDirSize(msg)
+		return p, nil
+
+	case dirSizeTickMsg:
+		return p, p.handleDirSizeTick()
 
 	case GitInfoMsg:
 		p.gitStatus = msg.Status
@@ -721,6 +887,13 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		return p, nil
 
+	case quickOpenDebounceMsg:
+		// Only filter if no newer keystrokes arrived
+		if p.quickOpenMode && p.quickOpenVersion == msg.Version {
+			p.updateQuickOpenMatches()
+		}
+		return p, nil
+
 	case projectSearchDebounceMsg:
 		// Only run search if debounce version matches (no newer keystrokes)
 		if p.projectSearchState != nil && p.projectSearchState.DebounceVersion == msg.Version {
@@ -807,12 +980,16 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "copy-path", Name: "CopyPath", Description: "Copy relative path to clipboard", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 5},
 		{ID: "paste", Name: "Paste", Description: "Paste yanked file", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 5},
 		{ID: "sort", Name: "Sort", Description: "Cycle sort mode", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 6},
+		{ID: "sort-reverse", Name: "Reverse", Description: "Reverse sort direction", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 6},
+		{ID: "bookmark", Name: "Bookmark", Description: "Toggle bookmark on selected file or directory", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 5},
+		{ID: "bookmarks", Name: "Bookmarks", Description: "Jump to a bookmark", Category: plugin.CategoryNavigation, Context: "file-browser-tree", Priority: 3},
+		{ID: "toggle-dirs-first", Name: "DirsFirst", Description: "Toggle listing directories first", Category: plugin.CategoryView, Context: "file-browser-tree", Priority: 9},
 		{ID: "refresh", Name: "Refresh", Description: "Refresh file tree", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 6},
 		{ID: "rename", Name: "Rename", Description: "Rename file or directory", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 7},
 		{ID: "move", Name: "Move", Description: "Move file or directory", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 7},
 		{ID: "reveal", Name: "Reveal", Description: "Reveal in file manager", Category: plugin.CategoryActions, Context: "file-browser-tree", Priority: 8},
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle tree pane visibility", Category: plugin.CategoryView, Context: "file-browser-tree", Priority: 9},
-		{ID: "toggle-ignored", Name: "Ignored", Description: "Toggle git-ignored file visibility", Category: plugin.CategoryView, Context: "file-browser-tree", Priority: 9},
+		{ID: "toggle-ignored", Name: "Visibility", Description: "Cycle hidden/ignored file visibility", Category: plugin.CategoryView, Context: "file-browser-tree", Priority: 9},
 		// Preview pane commands
 		{ID: "quick-open", Name: "Open", Description: "Quick open file by name", Category: plugin.CategorySearch, Context: "file-browser-preview", Priority: 1},
 		{ID: "project-search", Name: "Find", Description: "Search in project", Category: plugin.CategorySearch, Context: "file-browser-preview", Priority: 2},
@@ -833,7 +1010,7 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "yank-contents", Name: "Yank", Description: "Copy file contents", Category: plugin.CategoryActions, Context: "file-browser-preview", Priority: 7},
 		{ID: "yank-path", Name: "Path", Description: "Copy file path", Category: plugin.CategoryActions, Context: "file-browser-preview", Priority: 8},
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle tree pane visibility", Category: plugin.CategoryView, Context: "file-browser-preview", Priority: 9},
-		{ID: "toggle-ignored", Name: "Ignored", Description: "Toggle git-ignored file visibility", Category: plugin.CategoryView, Context: "file-browser-preview", Priority: 9},
+		{ID: "toggle-ignored", Name: "Visibility", Description: "Cycle hidden/ignored file visibility", Category: plugin.CategoryView, Context: "file-browser-preview", Priority: 9},
 		// Tree search commands
 		{ID: "confirm", Name: "Go", Description: "Jump to match", Category: plugin.CategoryNavigation, Context: "file-browser-search", Priority: 1},
 		{ID: "cancel", Name: "Cancel", Description: "Cancel search", Category: plugin.CategoryActions, Context: "file-browser-search", Priority: 1},
@@ -855,6 +1032,10 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "cancel", Name: "Cancel", Description: "Cancel jump", Category: plugin.CategoryActions, Context: "file-browser-line-jump", Priority: 1},
 		// Info modal commands
 		{ID: "close", Name: "Close", Description: "Close info modal", Category: plugin.CategoryActions, Context: "file-browser-info", Priority: 1},
+		// Bookmarks commands
+		{ID: "select", Name: "Jump", Description: "Jump to bookmark", Category: plugin.CategoryNavigation, Context: "file-browser-bookmarks", Priority: 1},
+		{ID: "remove-bookmark", Name: "Remove", Description: "Remove bookmark", Category: plugin.CategoryActions, Context: "file-browser-bookmarks", Priority: 2},
+		{ID: "close", Name: "Close", Description: "Close bookmarks", Category: plugin.CategoryActions, Context: "file-browser-bookmarks", Priority: 1},
 		// Blame view commands
 		{ID: "close", Name: "Close", Description: "Close blame view", Category: plugin.CategoryActions, Context: "file-browser-blame", Priority: 1},
 		{ID: "view-commit", Name: "Details", Description: "View commit details", Category: plugin.CategoryActions, Context: "file-browser-blame", Priority: 2},
@@ -876,6 +1057,9 @@ func (p *Plugin) FocusContext() string {
 	if p.infoMode {
 		return "file-browser-info"
 	}
+	if p.bookmarksMode {
+		return "file-browser-bookmarks"
+	}
 	if p.blameMode {
 		return "file-browser-blame"
 	}
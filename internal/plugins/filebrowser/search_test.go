@@ -313,6 +313,40 @@ func TestSearch_ExpandParents(t *testing.T) {
 	}
 }
 
+func TestFindAndExpandPath_ExpandsAncestors(t *testing.T) {
+	tmpDir := t.TempDir()
+	deep := filepath.Join(tmpDir, "a", "b", "c")
+	_ = os.MkdirAll(deep, 0755)
+	_ = os.WriteFile(filepath.Join(deep, "leaf.go"), []byte("package c"), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "a", "sibling.go"), []byte("package a"), 0644)
+
+	p := New()
+	p.tree = NewFileTree(tmpDir)
+	if err := p.tree.Build(); err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	target := filepath.Join("a", "b", "c", "leaf.go")
+	node := p.findAndExpandPath(target)
+	if node == nil || node.Path != target {
+		t.Fatalf("findAndExpandPath(%q) = %v", target, node)
+	}
+	for n := node.Parent; n != p.tree.Root; n = n.Parent {
+		if !n.IsExpanded {
+			t.Errorf("ancestor %q not expanded", n.Path)
+		}
+	}
+	// The target is now reachable in the flattened tree
+	p.tree.Flatten()
+	if p.tree.IndexOf(node) < 0 {
+		t.Error("target should be visible after expanding ancestors")
+	}
+
+	if p.findAndExpandPath(filepath.Join("a", "missing.go")) != nil {
+		t.Error("expected nil for a path not in the tree")
+	}
+}
+
 func TestSearch_WalkTree(t *testing.T) {
 	tmpDir := t.TempDir()
 	p := createTestPlugin(t, tmpDir)
@@ -795,12 +829,23 @@ func TestQuickOpen_TypeQuery(t *testing.T) {
 
 	// Type "ma"
 	_, _ = p.handleQuickOpenKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}})
+	stale := p.quickOpenVersion
 	_, _ = p.handleQuickOpenKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
 
 	if p.quickOpenQuery != "ma" {
 		t.Errorf("query should be 'ma', got %q", p.quickOpenQuery)
 	}
 
+	// Filtering waits for typing to pause; superseded ticks are ignored
+	_, _ = p.Update(quickOpenDebounceMsg{Version: stale})
+	if len(p.quickOpenMatches) != 0 || !p.quickOpenPending {
+		t.Error("stale debounce tick should not filter")
+	}
+	_, _ = p.Update(quickOpenDebounceMsg{Version: p.quickOpenVersion})
+	if p.quickOpenPending {
+		t.Error("latest debounce tick should filter")
+	}
+
 	// Should match main.go
 	found := false
 	for _, m := range p.quickOpenMatches {
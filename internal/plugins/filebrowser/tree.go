@@ -1,11 +1,15 @@
 package filebrowser
 
 import (
+	"cmp"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
 )
 
 // SortMode represents how files are sorted in the tree.
@@ -39,13 +43,100 @@ func (s SortMode) Next() SortMode {
 	return (s + 1) % 4
 }
 
+// DefaultDescending reports the mode's natural direction: largest and
+// newest first for size and time, A-Z otherwise.
+func (s SortMode) DefaultDescending() bool {
+	return s == SortBySize || s == SortByTime
+}
+
+// ParseSortMode parses a persisted mode label, reporting whether it was valid.
+func ParseSortMode(s string) (SortMode, bool) {
+	for mode := SortByName; mode <= SortByType; mode++ {
+		if mode.Label() == s {
+			return mode, true
+		}
+	}
+	return SortByName, false
+}
+
+// SortOptions controls how a directory's children are ordered.
+type SortOptions struct {
+	Mode       SortMode
+	Descending bool // Reverse the sort key
+	DirsFirst  bool // List directories before files
+}
+
+// Visibility controls which nodes the flattened tree includes.
+type Visibility int
+
+const (
+	VisibilityAll         Visibility = iota // Show everything
+	VisibilityHideIgnored                   // Hide git-ignored files
+	VisibilityHideHidden                    // Hide git-ignored files and dotfiles
+)
+
+// Label returns a short label for display.
+func (v Visibility) Label() string {
+	switch v {
+	case VisibilityHideIgnored:
+		return "hide ignored"
+	case VisibilityHideHidden:
+		return "hide ignored+dotfiles"
+	default:
+		return "show all"
+	}
+}
+
+// Next returns the next visibility mode in the cycle.
+func (v Visibility) Next() Visibility {
+	return (v + 1) % 3
+}
+
+// String returns the persisted name of the mode.
+func (v Visibility) String() string {
+	switch v {
+	case VisibilityHideIgnored:
+		return "hide-ignored"
+	case VisibilityHideHidden:
+		return "hide-hidden"
+	default:
+		return "all"
+	}
+}
+
+// ParseVisibility parses a persisted mode name, reporting whether it was valid.
+func ParseVisibility(s string) (Visibility, bool) {
+	switch s {
+	case "all":
+		return VisibilityAll, true
+	case "hide-ignored":
+		return VisibilityHideIgnored, true
+	case "hide-hidden":
+		return VisibilityHideHidden, true
+	}
+	return VisibilityAll, false
+}
+
+// hides reports whether the mode hides node.
+func (v Visibility) hides(node *FileNode) bool {
+	switch v {
+	case VisibilityHideIgnored:
+		return node.IsIgnored
+	case VisibilityHideHidden:
+		return node.IsIgnored || strings.HasPrefix(node.Name, ".")
+	}
+	return false
+}
+
 // FileNode represents a file or directory in the tree.
 type FileNode struct {
 	Name       string
 	Path       string // Relative path from root
 	IsDir      bool
 	IsExpanded bool
-	IsIgnored  bool // Set by gitignore
+	IsIgnored  bool                 // Set by gitignore
+	GitStatus  gitstatus.FileStatus // Empty if unchanged
+	HasChanges bool                 // Directory contains changed files
 	Children   []*FileNode
 	Parent     *FileNode
 	Depth      int
@@ -55,29 +146,35 @@ type FileNode struct {
 
 // FileTree manages the hierarchical file structure.
 type FileTree struct {
-	Root        *FileNode
-	RootDir     string
-	FlatList    []*FileNode // Flattened visible nodes for cursor navigation
-	gitIgnore   *GitIgnore
-	SortMode    SortMode // Current sort mode
-	ShowIgnored bool     // Whether to include ignored files in FlatList
+	Root       *FileNode
+	RootDir    string
+	FlatList   []*FileNode // Flattened visible nodes for cursor navigation
+	gitIgnore  *GitIgnore
+	SortMode   SortMode   // Current sort mode
+	Visibility Visibility // Which ignored/hidden files to include in FlatList
+
+	SortDescending bool // Reverse the sort key
+	DirsFirst      bool // List directories before files
+
+	// Git status, set by SetGitStatus and applied to nodes as they load
+	gitStatus map[string]gitstatus.PathStatus // Changed paths, relative to RootDir
+	gitDirs   map[string]bool                 // Directories containing changed paths
 }
 
 // NewFileTree creates a new file tree rooted at the given directory.
 func NewFileTree(rootDir string) *FileTree {
 	return &FileTree{
-		RootDir:     rootDir,
-		FlatList:    make([]*FileNode, 0),
-		gitIgnore:   NewGitIgnore(),
-		ShowIgnored: true, // Show ignored files by default
+		RootDir:   rootDir,
+		FlatList:  make([]*FileNode, 0),
+		gitIgnore: NewGitIgnore(),
+		DirsFirst: true,
 	}
 }
 
 // Build initializes the tree by loading the root directory's children.
 func (t *FileTree) Build() error {
-	// Load .gitignore from root
+	// Reset gitignore rules; each directory's .gitignore loads with its children
 	t.gitIgnore = NewGitIgnore()
-	_ = t.gitIgnore.LoadFile(filepath.Join(t.RootDir, ".gitignore"))
 
 	t.Root = &FileNode{
 		Name:       filepath.Base(t.RootDir),
@@ -120,6 +217,12 @@ func (t *FileTree) loadChildren(node *FileNode) error {
 		return err
 	}
 
+	// Layer this directory's .gitignore over its parents' rules. Git doesn't
+	// read .gitignore files inside ignored directories.
+	if !node.IsIgnored {
+		_ = t.gitIgnore.LoadDir(t.RootDir, node.Path)
+	}
+
 	node.Children = make([]*FileNode, 0, len(entries))
 
 	for _, entry := range entries {
@@ -133,6 +236,7 @@ func (t *FileTree) loadChildren(node *FileNode) error {
 		}
 
 		childPath := filepath.Join(node.Path, entry.Name())
+		slashPath := filepath.ToSlash(childPath)
 		child := &FileNode{
 			Name:      entry.Name(),
 			Path:      childPath,
@@ -143,54 +247,88 @@ func (t *FileTree) loadChildren(node *FileNode) error {
 			Size:      info.Size(),
 			ModTime:   info.ModTime(),
 		}
+		t.applyGitStatus(child, slashPath)
 
 		node.Children = append(node.Children, child)
 	}
 
-	sortChildren(node.Children, t.SortMode)
+	sortChildren(node.Children, t.sortOptions())
 	return nil
 }
 
-// sortChildren sorts nodes according to the given mode.
-func sortChildren(children []*FileNode, mode SortMode) {
-	sort.Slice(children, func(i, j int) bool {
-		// Directories always come before files
-		if children[i].IsDir != children[j].IsDir {
-			return children[i].IsDir
-		}
+// SetGitStatus replaces the tree's git status and updates every loaded node.
+// Outside a repo statuses is empty and every node is simply unchanged.
+func (t *FileTree) SetGitStatus(statuses map[string]gitstatus.PathStatus) {
+	t.gitStatus = statuses
+	t.gitDirs = changedDirs(statuses)
+	if t.Root != nil {
+		t.applyGitStatusTree(t.Root)
+	}
+}
 
-		switch mode {
-		case SortBySize:
-			// Larger files first
-			if children[i].Size != children[j].Size {
-				return children[i].Size > children[j].Size
-			}
-			// Fall back to name
-			return strings.ToLower(children[i].Name) < strings.ToLower(children[j].Name)
+// applyGitStatusTree updates the git status of node's loaded descendants.
+func (t *FileTree) applyGitStatusTree(node *FileNode) {
+	for _, child := range node.Children {
+		t.applyGitStatus(child, filepath.ToSlash(child.Path))
+		t.applyGitStatusTree(child)
+	}
+}
 
-		case SortByTime:
-			// Newer files first
-			if !children[i].ModTime.Equal(children[j].ModTime) {
-				return children[i].ModTime.After(children[j].ModTime)
-			}
-			// Fall back to name
-			return strings.ToLower(children[i].Name) < strings.ToLower(children[j].Name)
-
-		case SortByType:
-			// Sort by extension, then by name
-			exti := strings.ToLower(filepath.Ext(children[i].Name))
-			extj := strings.ToLower(filepath.Ext(children[j].Name))
-			if exti != extj {
-				return exti < extj
-			}
-			return strings.ToLower(children[i].Name) < strings.ToLower(children[j].Name)
+// applyGitStatus sets a node's status from the tree's git status.
+func (t *FileTree) applyGitStatus(node *FileNode, slashPath string) {
+	if node.IsDir {
+		node.HasChanges = t.gitDirs[slashPath]
+	} else {
+		node.GitStatus = t.gitStatus[slashPath].Status
+	}
+}
+
+// changedDirs returns every directory that contains a changed path, so
+// collapsed parents can show that something inside them changed.
+func changedDirs(statuses map[string]gitstatus.PathStatus) map[string]bool {
+	dirs := make(map[string]bool)
+	for changed := range statuses {
+		for dir := path.Dir(changed); dir != "." && !dirs[dir]; dir = path.Dir(dir) {
+			dirs[dir] = true
+		}
+	}
+	return dirs
+}
 
-		default: // SortByName
-			return strings.ToLower(children[i].Name) < strings.ToLower(children[j].Name)
+// sortChildren sorts nodes according to the given options. Ties fall back
+// to name order so the result is stable across refreshes.
+func sortChildren(children []*FileNode, opts SortOptions) {
+	sort.Slice(children, func(i, j int) bool {
+		a, b := children[i], children[j]
+		if opts.DirsFirst && a.IsDir != b.IsDir {
+			return a.IsDir
 		}
+		if c := compareNodes(a, b, opts.Mode); c != 0 {
+			if opts.Descending {
+				return c > 0
+			}
+			return c < 0
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
 	})
 }
 
+// compareNodes compares two nodes by the mode's key in ascending order.
+func compareNodes(a, b *FileNode, mode SortMode) int {
+	switch mode {
+	case SortBySize:
+		return cmp.Compare(a.Size, b.Size)
+	case SortByTime:
+		return a.ModTime.Compare(b.ModTime)
+	case SortByType:
+		// Sort by extension, then by name
+		if c := strings.Compare(strings.ToLower(filepath.Ext(a.Name)), strings.ToLower(filepath.Ext(b.Name))); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+}
+
 // Expand opens a directory node, loading children if needed.
 func (t *FileTree) Expand(node *FileNode) error {
 	if !node.IsDir {
@@ -238,8 +376,8 @@ func (t *FileTree) Flatten() []*FileNode {
 
 func (t *FileTree) flattenNode(node *FileNode) {
 	for _, child := range node.Children {
-		// Skip ignored files/folders when ShowIgnored is false
-		if !t.ShowIgnored && child.IsIgnored {
+		// Skip files/folders hidden by the visibility mode
+		if t.Visibility.hides(child) {
 			continue
 		}
 		t.FlatList = append(t.FlatList, child)
@@ -345,19 +483,94 @@ func (t *FileTree) Refresh() error {
 	return nil
 }
 
-// SetSortMode changes the sort mode and re-sorts the tree.
+// RefreshDir reloads a single directory's children from disk, keeping the
+// expanded state below it. A path not yet in the tree reloads its nearest
+// ancestor instead. Directories whose children were never loaded are left
+// for Expand.
+func (t *FileTree) RefreshDir(path string) error {
+	node := t.findNode(path)
+	for node == nil && path != "" && path != "." {
+		path = filepath.Dir(path)
+		node = t.findNode(path)
+	}
+	if node == nil || !node.IsDir {
+		return nil
+	}
+	if node != t.Root && node.Children == nil {
+		return nil
+	}
+
+	expanded := make(map[string]bool)
+	t.collectExpanded(node, expanded)
+	if err := t.loadChildren(node); err != nil {
+		return err
+	}
+	t.restoreExpanded(node, expanded)
+	t.Flatten()
+	return nil
+}
+
+// findNode walks the tree to the node at path, including nodes hidden by
+// collapsed parents. The empty path is the root.
+func (t *FileTree) findNode(path string) *FileNode {
+	node := t.Root
+	if path == "" || path == "." {
+		return node
+	}
+	for _, name := range strings.Split(filepath.Clean(path), string(filepath.Separator)) {
+		if node == nil {
+			return nil
+		}
+		var next *FileNode
+		for _, child := range node.Children {
+			if child.Name == name {
+				next = child
+				break
+			}
+		}
+		node = next
+	}
+	return node
+}
+
+// SetSortMode changes the sort mode, resets the direction to the mode's
+// natural default, and re-sorts the tree.
 func (t *FileTree) SetSortMode(mode SortMode) {
-	t.SortMode = mode
+	t.SetSortOptions(SortOptions{Mode: mode, Descending: mode.DefaultDescending(), DirsFirst: t.DirsFirst})
+}
+
+// SetSortOptions changes all sort options and re-sorts the tree.
+func (t *FileTree) SetSortOptions(opts SortOptions) {
+	t.SortMode = opts.Mode
+	t.SortDescending = opts.Descending
+	t.DirsFirst = opts.DirsFirst
 	if t.Root != nil {
 		t.resortNode(t.Root)
 		t.Flatten()
 	}
 }
 
+// sortOptions returns the tree's current sort options.
+func (t *FileTree) sortOptions() SortOptions {
+	return SortOptions{Mode: t.SortMode, Descending: t.SortDescending, DirsFirst: t.DirsFirst}
+}
+
+// SortLabel describes the sort options for display, e.g. "size ↓".
+func (t *FileTree) SortLabel() string {
+	label := t.SortMode.Label() + " ↑"
+	if t.SortDescending {
+		label = t.SortMode.Label() + " ↓"
+	}
+	if !t.DirsFirst {
+		label += ", mixed"
+	}
+	return label
+}
+
 // resortNode recursively re-sorts a node and its children.
 func (t *FileTree) resortNode(node *FileNode) {
 	if len(node.Children) > 0 {
-		sortChildren(node.Children, t.SortMode)
+		sortChildren(node.Children, t.sortOptions())
 		for _, child := range node.Children {
 			if child.IsDir {
 				t.resortNode(child)
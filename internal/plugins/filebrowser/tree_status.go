@@ -0,0 +1,45 @@
+package filebrowser
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
+)
+
+// TreeGitStatusMsg carries the git status of the project's changed paths.
+type TreeGitStatusMsg struct {
+	Epoch    uint64 // Epoch when request was issued (for stale detection)
+	Statuses map[string]gitstatus.PathStatus
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m TreeGitStatusMsg) GetEpoch() uint64 { return m.Epoch }
+
+// loadTreeGitStatus loads the tree's git status in the background. While a
+// load is running, further requests queue a single rerun, so a burst of tree
+// changes runs git at most twice.
+func (p *Plugin) loadTreeGitStatus() tea.Cmd {
+	if p.treeStatusLoading {
+		p.treeStatusQueued = true
+		return nil
+	}
+	p.treeStatusLoading = true
+
+	epoch, root := p.ctx.Epoch, p.ctx.WorkDir
+	return func() tea.Msg {
+		// Outside a repo there are no statuses and every node is unchanged
+		statuses, _ := gitstatus.LoadPathStatuses(root)
+		return TreeGitStatusMsg{Epoch: epoch, Statuses: statuses}
+	}
+}
+
+// handleTreeGitStatus applies loaded statuses to the tree, starting the
+// queued rerun if the tree changed while git was running.
+func (p *Plugin) handleTreeGitStatus(msg TreeGitStatusMsg) tea.Cmd {
+	p.treeStatusLoading = false
+	p.tree.SetGitStatus(msg.Statuses)
+	if !p.treeStatusQueued {
+		return nil
+	}
+	p.treeStatusQueued = false
+	return p.loadTreeGitStatus()
+}
@@ -0,0 +1,50 @@
+package filebrowser
+
+import (
+	"testing"
+
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
+)
+
+func TestTreeGitStatus_CoalescesLoads(t *testing.T) {
+	p := createTestPlugin(t, t.TempDir())
+
+	if cmd := p.loadTreeGitStatus(); cmd == nil {
+		t.Fatal("expected a status load")
+	}
+	// Requests while a load runs queue one rerun instead of starting more
+	if cmd := p.loadTreeGitStatus(); cmd != nil {
+		t.Error("expected no second load while one is running")
+	}
+	_ = p.loadTreeGitStatus()
+
+	statuses := map[string]gitstatus.PathStatus{"main.go": {Status: gitstatus.StatusModified}}
+	if cmd := p.handleTreeGitStatus(TreeGitStatusMsg{Statuses: statuses}); cmd == nil {
+		t.Error("expected the queued rerun to start")
+	}
+	if n := p.tree.FindByPath("main.go"); n == nil || n.GitStatus != gitstatus.StatusModified {
+		t.Errorf("main.go status = %v, want modified", n)
+	}
+
+	if cmd := p.handleTreeGitStatus(TreeGitStatusMsg{}); cmd != nil {
+		t.Error("expected no further load without a queued request")
+	}
+	if p.treeStatusLoading || p.treeStatusQueued {
+		t.Error("expected loading state to be cleared")
+	}
+}
+
+func TestTreeChanged_ContentWriteReloadsStatusOnly(t *testing.T) {
+	p := createTestPlugin(t, t.TempDir())
+	before := p.lastRefresh
+
+	if _, cmd := p.Update(TreeChangedMsg{}); cmd == nil {
+		t.Fatal("expected a status reload")
+	}
+	if !p.treeStatusLoading {
+		t.Error("content change should start a git status load")
+	}
+	if !p.lastRefresh.Equal(before) {
+		t.Error("content change should not rebuild the tree")
+	}
+}
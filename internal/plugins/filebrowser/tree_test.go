@@ -2,8 +2,14 @@ package filebrowser
 
 import (
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
 )
 
 func TestIsSystemFile(t *testing.T) {
@@ -140,6 +146,100 @@ func TestFileTree_ExpandCollapse(t *testing.T) {
 	}
 }
 
+func TestFileTree_NestedGitIgnore(t *testing.T) {
+	tmpDir := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(tmpDir, "sub"), 0755)
+	_ = os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("*.log\n"), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "root.log"), []byte(""), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "sub", ".gitignore"), []byte("!keep.log\n"), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "sub", "keep.log"), []byte(""), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "sub", "other.log"), []byte(""), 0644)
+
+	tree := NewFileTree(tmpDir)
+	if err := tree.Build(); err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	sub := tree.FindByPath("sub")
+	if sub == nil {
+		t.Fatal("sub not found")
+	}
+	if err := tree.Expand(sub); err != nil {
+		t.Fatalf("Expand failed: %v", err)
+	}
+
+	want := map[string]bool{
+		"root.log":      true,
+		"sub/keep.log":  false, // re-included by the nested .gitignore
+		"sub/other.log": true,
+	}
+	for path, ignored := range want {
+		node := tree.FindByPath(path)
+		if node == nil {
+			t.Fatalf("%s not found", path)
+		}
+		if node.IsIgnored != ignored {
+			t.Errorf("%s IsIgnored = %v, want %v", path, node.IsIgnored, ignored)
+		}
+	}
+}
+
+func TestFileTree_FlattenVisibility(t *testing.T) {
+	tmpDir := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(tmpDir, "src"), 0755)
+	_ = os.MkdirAll(filepath.Join(tmpDir, "build"), 0755)
+	_ = os.MkdirAll(filepath.Join(tmpDir, ".config"), 0755)
+	_ = os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("build/\n*.log\n"), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte(""), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "debug.log"), []byte(""), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, ".env"), []byte(""), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "src", "app.go"), []byte(""), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "src", ".hidden"), []byte(""), 0644)
+	_ = os.WriteFile(filepath.Join(tmpDir, "build", "out.bin"), []byte(""), 0644)
+
+	tree := NewFileTree(tmpDir)
+	if err := tree.Build(); err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	for _, dir := range []string{"src", "build"} {
+		if err := tree.Expand(tree.FindByPath(dir)); err != nil {
+			t.Fatalf("Expand(%s) failed: %v", dir, err)
+		}
+	}
+
+	tests := []struct {
+		mode Visibility
+		want []string
+	}{
+		{VisibilityAll, []string{".config", "build", "build/out.bin", "src", "src/.hidden", "src/app.go", ".env", ".gitignore", "debug.log", "main.go"}},
+		{VisibilityHideIgnored, []string{".config", "src", "src/.hidden", "src/app.go", ".env", ".gitignore", "main.go"}},
+		{VisibilityHideHidden, []string{"src", "src/app.go", "main.go"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			tree.Visibility = tt.mode
+			var got []string
+			for _, n := range tree.Flatten() {
+				got = append(got, filepath.ToSlash(n.Path))
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Cycling comes back to showing everything, and names round-trip
+	v := VisibilityAll
+	for i := 0; i < 3; i++ {
+		if parsed, ok := ParseVisibility(v.String()); !ok || parsed != v {
+			t.Errorf("ParseVisibility(%q) = %v, %v", v.String(), parsed, ok)
+		}
+		v = v.Next()
+	}
+	if v != VisibilityAll {
+		t.Errorf("cycle ended at %v, want VisibilityAll", v)
+	}
+}
+
 func TestFileTree_GetNode(t *testing.T) {
 	tmpDir := t.TempDir()
 	_ = os.WriteFile(filepath.Join(tmpDir, "test.txt"), []byte("test"), 0644)
@@ -172,7 +272,7 @@ func TestSortChildren(t *testing.T) {
 		{Name: "delta", IsDir: true},
 	}
 
-	sortChildren(children, SortByName)
+	sortChildren(children, SortOptions{Mode: SortByName, DirsFirst: true})
 
 	// Directories should come first
 	if !children[0].IsDir || !children[1].IsDir {
@@ -188,6 +288,79 @@ func TestSortChildren(t *testing.T) {
 	}
 }
 
+func TestSortChildren_Options(t *testing.T) {
+	base := time.Unix(1_700_000_000, 0)
+	nodes := func() []*FileNode {
+		return []*FileNode{
+			{Name: "b.go", Size: 300, ModTime: base.Add(1 * time.Hour)},
+			{Name: "lib", IsDir: true, Size: 4096, ModTime: base.Add(2 * time.Hour)},
+			{Name: "a.txt", Size: 100, ModTime: base.Add(3 * time.Hour)},
+			{Name: "C.md", Size: 200, ModTime: base},
+			{Name: "cmd", IsDir: true, Size: 4096, ModTime: base.Add(4 * time.Hour)},
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts SortOptions
+		want []string
+	}{
+		{"name asc", SortOptions{Mode: SortByName, DirsFirst: true}, []string{"cmd", "lib", "a.txt", "b.go", "C.md"}},
+		{"name desc", SortOptions{Mode: SortByName, Descending: true, DirsFirst: true}, []string{"lib", "cmd", "C.md", "b.go", "a.txt"}},
+		{"name mixed", SortOptions{Mode: SortByName}, []string{"a.txt", "b.go", "C.md", "cmd", "lib"}},
+		{"size asc", SortOptions{Mode: SortBySize, DirsFirst: true}, []string{"cmd", "lib", "a.txt", "C.md", "b.go"}},
+		{"size desc", SortOptions{Mode: SortBySize, Descending: true, DirsFirst: true}, []string{"cmd", "lib", "b.go", "C.md", "a.txt"}},
+		{"size desc mixed", SortOptions{Mode: SortBySize, Descending: true}, []string{"cmd", "lib", "b.go", "C.md", "a.txt"}},
+		{"size asc mixed", SortOptions{Mode: SortBySize}, []string{"a.txt", "C.md", "b.go", "cmd", "lib"}},
+		{"time asc", SortOptions{Mode: SortByTime, DirsFirst: true}, []string{"lib", "cmd", "C.md", "b.go", "a.txt"}},
+		{"time desc", SortOptions{Mode: SortByTime, Descending: true, DirsFirst: true}, []string{"cmd", "lib", "a.txt", "b.go", "C.md"}},
+		{"time desc mixed", SortOptions{Mode: SortByTime, Descending: true}, []string{"cmd", "a.txt", "lib", "b.go", "C.md"}},
+		{"type asc", SortOptions{Mode: SortByType, DirsFirst: true}, []string{"cmd", "lib", "b.go", "C.md", "a.txt"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			children := nodes()
+			sortChildren(children, tt.opts)
+			got := make([]string, len(children))
+			for i, c := range children {
+				got[i] = c.Name
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sortChildren(%+v) = %v, want %v", tt.opts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileTree_SetSortMode(t *testing.T) {
+	tree := NewFileTree(t.TempDir())
+	if !tree.DirsFirst {
+		t.Error("directories should be listed first by default")
+	}
+
+	tree.SetSortMode(SortBySize)
+	if !tree.SortDescending || tree.SortLabel() != "size ↓" {
+		t.Errorf("size sort = %q, want largest first", tree.SortLabel())
+	}
+	tree.SetSortOptions(SortOptions{Mode: SortBySize, Descending: false, DirsFirst: false})
+	if got := tree.SortLabel(); got != "size ↑, mixed" {
+		t.Errorf("SortLabel() = %q", got)
+	}
+	tree.SetSortMode(SortByName)
+	if tree.SortDescending || tree.DirsFirst {
+		t.Error("name sort should be ascending and keep the dirs-first setting")
+	}
+
+	for mode := SortByName; mode <= SortByType; mode++ {
+		if got, ok := ParseSortMode(mode.Label()); !ok || got != mode {
+			t.Errorf("ParseSortMode(%q) = %v, %v", mode.Label(), got, ok)
+		}
+	}
+	if _, ok := ParseSortMode("bogus"); ok {
+		t.Error("expected invalid mode to be rejected")
+	}
+}
+
 func TestFileTree_RefreshPreservesExpandedState(t *testing.T) {
 	// Create temp directory structure
 	tmpDir := t.TempDir()
@@ -358,3 +531,163 @@ func TestFileTree_RestoreExpandedPaths_DeletedDir(t *testing.T) {
 		}
 	}
 }
+
+func TestChangedDirs(t *testing.T) {
+	statuses := map[string]gitstatus.PathStatus{
+		"README.md":               {Status: gitstatus.StatusModified},
+		"src/app/main.go":         {Status: gitstatus.StatusModified},
+		"src/app/handlers/new.go": {Status: gitstatus.StatusUntracked},
+		"docs/guide.md":           {Status: gitstatus.StatusAdded},
+	}
+	want := map[string]bool{
+		"src":              true,
+		"src/app":          true,
+		"src/app/handlers": true,
+		"docs":             true,
+	}
+	if got := changedDirs(statuses); !reflect.DeepEqual(got, want) {
+		t.Errorf("changedDirs() = %v, want %v", got, want)
+	}
+	if got := changedDirs(nil); len(got) != 0 {
+		t.Errorf("changedDirs(nil) = %v, want empty", got)
+	}
+}
+
+func TestFileTree_GitStatus(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	tmpDir := t.TempDir()
+	mustWrite := func(rel, content string) {
+		t.Helper()
+		full := filepath.Join(tmpDir, rel)
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustWrite("clean/a.go", "package clean")
+	mustWrite("src/deep/b.go", "package deep")
+	runGit(t, tmpDir, "init")
+	runGit(t, tmpDir, "add", ".")
+	runGit(t, tmpDir, "commit", "-m", "init")
+
+	mustWrite("src/deep/b.go", "package deep // changed")
+	mustWrite("new.txt", "untracked")
+
+	tree := NewFileTree(tmpDir)
+	if err := tree.Build(); err != nil {
+		t.Fatal(err)
+	}
+	loadStatus := func() {
+		t.Helper()
+		statuses, err := gitstatus.LoadPathStatuses(tmpDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tree.SetGitStatus(statuses)
+	}
+	loadStatus()
+
+	// Collapsed ancestors of the modified file are marked
+	if n := tree.FindByPath("src"); n == nil || !n.HasChanges {
+		t.Error("src should contain changes")
+	}
+	if n := tree.FindByPath("clean"); n == nil || n.HasChanges {
+		t.Error("clean should not contain changes")
+	}
+	if n := tree.FindByPath("new.txt"); n == nil || n.GitStatus != gitstatus.StatusUntracked {
+		t.Errorf("new.txt status = %v, want untracked", n)
+	}
+
+	// Expanding reaches the changed file itself
+	if err := tree.Expand(tree.FindByPath("src")); err != nil {
+		t.Fatal(err)
+	}
+	deep := tree.FindByPath(filepath.Join("src", "deep"))
+	if deep == nil || !deep.HasChanges {
+		t.Fatal("src/deep should contain changes")
+	}
+	if err := tree.Expand(deep); err != nil {
+		t.Fatal(err)
+	}
+	if n := tree.FindByPath(filepath.Join("src", "deep", "b.go")); n == nil || n.GitStatus != gitstatus.StatusModified {
+		t.Errorf("b.go status = %v, want modified", n)
+	}
+
+	// Reloading status updates nodes that are already loaded
+	mustWrite("clean/a.go", "package clean // changed")
+	loadStatus()
+	if n := tree.FindByPath("clean"); n == nil || !n.HasChanges {
+		t.Error("clean should contain changes after reloading status")
+	}
+}
+
+func TestFileTree_RefreshDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, dir := range []string{"a/inner", "b"} {
+		if err := os.MkdirAll(filepath.Join(tmpDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tree := NewFileTree(tmpDir)
+	if err := tree.Build(); err != nil {
+		t.Fatal(err)
+	}
+	a := tree.FindByPath("a")
+	_ = tree.Expand(a)
+	_ = tree.Expand(tree.FindByPath(filepath.Join("a", "inner")))
+	bNode := tree.FindByPath("b")
+
+	if err := os.WriteFile(filepath.Join(tmpDir, "a", "new.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "b", "other.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.RefreshDir("a"); err != nil {
+		t.Fatal(err)
+	}
+
+	if tree.FindByPath(filepath.Join("a", "new.txt")) == nil {
+		t.Error("new file in refreshed dir should appear")
+	}
+	if n := tree.FindByPath(filepath.Join("a", "inner")); n == nil || !n.IsExpanded {
+		t.Error("expanded subdirectory should stay expanded")
+	}
+	// Other directories are left untouched
+	if tree.FindByPath("b") != bNode || bNode.Children != nil {
+		t.Error("unrelated directory should not be reloaded")
+	}
+
+	// A path not in the tree reloads its nearest ancestor
+	if err := os.MkdirAll(filepath.Join(tmpDir, "c", "d"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.RefreshDir(filepath.Join("c", "d")); err != nil {
+		t.Fatal(err)
+	}
+	if tree.FindByPath("c") == nil {
+		t.Error("new directory should appear after refreshing its ancestor")
+	}
+}
+
+// runGit runs a git command in the given dir, failing the test on error.
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=test",
+		"GIT_AUTHOR_EMAIL=test@test",
+		"GIT_COMMITTER_NAME=test",
+		"GIT_COMMITTER_EMAIL=test@test",
+	)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+	}
+}
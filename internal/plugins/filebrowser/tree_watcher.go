@@ -0,0 +1,310 @@
+package filebrowser
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+const (
+	treeWatchQuiet   = 200 * time.Millisecond // Wait for a burst to settle
+	treeWatchMaxWait = time.Second            // Refresh at least this often during long bursts
+	maxTreeWatchDirs = 4096                   // Cap on watched directories
+)
+
+// eventCoalescer batches filesystem events into a single refresh. It fires
+// once events have been quiet for the quiet period, or once maxWait has
+// passed since the first event so a constant stream can't starve it.
+type eventCoalescer struct {
+	quiet   time.Duration
+	maxWait time.Duration
+	first   time.Time
+	last    time.Time
+	paths   map[string]bool
+}
+
+// newEventCoalescer creates a coalescer with the given timings.
+func newEventCoalescer(quiet, maxWait time.Duration) *eventCoalescer {
+	return &eventCoalescer{
+		quiet:   quiet,
+		maxWait: maxWait,
+		paths:   make(map[string]bool),
+	}
+}
+
+// Add records an event for path at the given time.
+func (c *eventCoalescer) Add(now time.Time, path string) {
+	if len(c.paths) == 0 {
+		c.first = now
+	}
+	c.last = now
+	c.paths[path] = true
+}
+
+// Pending reports whether any events are waiting to be flushed.
+func (c *eventCoalescer) Pending() bool {
+	return len(c.paths) > 0
+}
+
+// Deadline returns when the pending events should be flushed.
+func (c *eventCoalescer) Deadline() time.Time {
+	deadline := c.last.Add(c.quiet)
+	if limit := c.first.Add(c.maxWait); limit.Before(deadline) {
+		return limit
+	}
+	return deadline
+}
+
+// Ready reports whether pending events are due at the given time.
+func (c *eventCoalescer) Ready(now time.Time) bool {
+	return c.Pending() && !now.Before(c.Deadline())
+}
+
+// Flush returns the distinct changed paths, sorted, and resets the batch.
+func (c *eventCoalescer) Flush() []string {
+	paths := make([]string, 0, len(c.paths))
+	for p := range c.paths {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	c.paths = make(map[string]bool)
+	return paths
+}
+
+// TreeChange describes a coalesced batch of filesystem events.
+type TreeChange struct {
+	Structural bool // Something was created, removed or renamed, or a .gitignore changed
+}
+
+// TreeWatcher monitors the directory tree under a root for structural
+// changes (files created, removed or renamed) and content writes, which can
+// change git status. Git-ignored directories, .git and OS system files are
+// not watched, so build output and VCS churn don't trigger refreshes.
+type TreeWatcher struct {
+	root      string
+	fsWatcher *fsnotify.Watcher
+	gitIgnore *GitIgnore
+	coalescer *eventCoalescer
+	watched   map[string]bool // Watched directories
+	events    chan TreeChange
+	stop      chan struct{}
+	mu        sync.Mutex
+}
+
+// NewTreeWatcher creates a watcher for all non-ignored directories under root.
+func NewTreeWatcher(root string) (*TreeWatcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &TreeWatcher{
+		root:      root,
+		fsWatcher: fsw,
+		gitIgnore: NewGitIgnore(),
+		coalescer: newEventCoalescer(treeWatchQuiet, treeWatchMaxWait),
+		watched:   make(map[string]bool),
+		events:    make(chan TreeChange, 1),
+		stop:      make(chan struct{}),
+	}
+	w.addTree(root)
+
+	go w.run()
+	return w, nil
+}
+
+// addTree adds watches for dir and its non-ignored subdirectories that
+// aren't watched yet.
+func (w *TreeWatcher) addTree(dir string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(w.root, path)
+		if err != nil {
+			return filepath.SkipDir
+		}
+		if rel == "." {
+			rel = ""
+		}
+		if rel != "" && w.skipPath(rel, true) {
+			return filepath.SkipDir
+		}
+		_ = w.gitIgnore.LoadDir(w.root, rel)
+		if w.watched[path] {
+			return nil
+		}
+		if len(w.watched) >= maxTreeWatchDirs {
+			return filepath.SkipAll
+		}
+		if err := w.fsWatcher.Add(path); err != nil {
+			return filepath.SkipDir
+		}
+		w.watched[path] = true
+		return nil
+	})
+}
+
+// forgetTree drops watched directories at or below a removed or renamed
+// path, so they are watched again if recreated.
+func (w *TreeWatcher) forgetTree(dir string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	prefix := dir + string(filepath.Separator)
+	for path := range w.watched {
+		if path == dir || strings.HasPrefix(path, prefix) {
+			delete(w.watched, path)
+		}
+	}
+}
+
+// reloadIgnores re-reads every .gitignore after one changes, watching any
+// directories the new rules no longer ignore.
+func (w *TreeWatcher) reloadIgnores() {
+	w.mu.Lock()
+	w.gitIgnore = NewGitIgnore()
+	w.mu.Unlock()
+	w.addTree(w.root)
+}
+
+// skipPath reports whether changes to rel (relative to root) are ignored.
+func (w *TreeWatcher) skipPath(rel string, isDir bool) bool {
+	for _, part := range strings.Split(filepath.ToSlash(rel), "/") {
+		if part == ".git" || isSystemFile(part) {
+			return true
+		}
+	}
+	return w.gitIgnore.IsIgnored(rel, isDir)
+}
+
+// relevant reports whether an event changes what the tree shows, its
+// structure or its git status, returning the event's path relative to root.
+func (w *TreeWatcher) relevant(event fsnotify.Event) (string, bool) {
+	rel, err := filepath.Rel(w.root, event.Name)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Remove) && !event.Has(fsnotify.Rename) &&
+		!event.Has(fsnotify.Write) {
+		return "", false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	isDir := event.Has(fsnotify.Create) && isDirPath(event.Name)
+	if w.skipPath(rel, isDir) {
+		return "", false
+	}
+	return rel, true
+}
+
+// isStructural reports whether an event changes the tree's nodes rather
+// than only a file's content. Writes to ignore rules count, since they
+// change which nodes are shown.
+func isStructural(event fsnotify.Event, rel string) bool {
+	return event.Has(fsnotify.Create) || event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) ||
+		filepath.Base(rel) == ".gitignore"
+}
+
+// isDirPath reports whether path is an existing directory.
+func isDirPath(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// run processes file system events, coalescing bursts into one signal.
+func (w *TreeWatcher) run() {
+	defer close(w.events)
+
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	defer timer.Stop()
+
+	var change TreeChange // Accumulates the pending batch
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case event, ok := <-w.fsWatcher.Events:
+			if !ok {
+				return
+			}
+			rel, ok := w.relevant(event)
+			if !ok {
+				continue
+			}
+			if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+				w.forgetTree(event.Name)
+			}
+			if filepath.Base(rel) == ".gitignore" {
+				w.reloadIgnores()
+			}
+			// Watch directories created after startup
+			if event.Has(fsnotify.Create) && isDirPath(event.Name) {
+				w.addTree(event.Name)
+			}
+			if isStructural(event, rel) {
+				change.Structural = true
+			}
+			now := time.Now()
+			w.coalescer.Add(now, rel)
+			timer.Reset(w.coalescer.Deadline().Sub(now))
+
+		case <-timer.C:
+			now := time.Now()
+			if !w.coalescer.Ready(now) {
+				if w.coalescer.Pending() {
+					timer.Reset(w.coalescer.Deadline().Sub(now))
+				}
+				continue
+			}
+			w.coalescer.Flush()
+			w.send(change)
+			change = TreeChange{}
+
+		case _, ok := <-w.fsWatcher.Errors:
+			if !ok {
+				return
+			}
+			// Ignore errors, continue watching
+		}
+	}
+}
+
+// send delivers a batch, merging it into a signal that is still unread so
+// a pending structural change isn't downgraded to a status-only one.
+func (w *TreeWatcher) send(change TreeChange) {
+	select {
+	case w.events <- change:
+		return
+	default:
+	}
+	select {
+	case prev := <-w.events:
+		change.Structural = change.Structural || prev.Structural
+	default: // Read in the meantime
+	}
+	// Only run sends, so the channel has room now
+	w.events <- change
+}
+
+// Events returns a channel that signals when the tree changes.
+func (w *TreeWatcher) Events() <-chan TreeChange {
+	return w.events
+}
+
+// Stop shuts down the watcher.
+func (w *TreeWatcher) Stop() {
+	close(w.stop)
+	_ = w.fsWatcher.Close()
+}
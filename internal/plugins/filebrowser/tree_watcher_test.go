@@ -0,0 +1,258 @@
+package filebrowser
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestEventCoalescer_Burst(t *testing.T) {
+	c := newEventCoalescer(100*time.Millisecond, time.Second)
+	start := time.Unix(1000, 0)
+
+	if c.Pending() || c.Ready(start) {
+		t.Fatal("empty coalescer should not be pending")
+	}
+
+	// A burst of events 10ms apart, with repeats
+	paths := []string{"a.go", "b.go", "a.go", "dir", "dir/c.go", "b.go"}
+	for i, path := range paths {
+		c.Add(start.Add(time.Duration(i)*10*time.Millisecond), path)
+	}
+	last := start.Add(50 * time.Millisecond)
+
+	if c.Ready(last.Add(99 * time.Millisecond)) {
+		t.Error("should wait for the burst to go quiet")
+	}
+	if !c.Ready(last.Add(100 * time.Millisecond)) {
+		t.Error("should be ready once quiet")
+	}
+
+	got := c.Flush()
+	want := []string{"a.go", "b.go", "dir", "dir/c.go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Flush() = %v, want %v", got, want)
+	}
+	if c.Pending() {
+		t.Error("Flush should reset the batch")
+	}
+}
+
+func TestEventCoalescer_MaxWait(t *testing.T) {
+	c := newEventCoalescer(100*time.Millisecond, 300*time.Millisecond)
+	start := time.Unix(1000, 0)
+
+	// A steady stream never goes quiet, so maxWait caps the delay
+	for i := 0; i < 10; i++ {
+		c.Add(start.Add(time.Duration(i)*50*time.Millisecond), "log.txt")
+	}
+	if want := start.Add(300 * time.Millisecond); !c.Deadline().Equal(want) {
+		t.Errorf("Deadline() = %v, want %v", c.Deadline(), want)
+	}
+
+	// The next batch starts its own maxWait window
+	c.Flush()
+	next := start.Add(time.Second)
+	c.Add(next, "log.txt")
+	if want := next.Add(100 * time.Millisecond); !c.Deadline().Equal(want) {
+		t.Errorf("Deadline() = %v, want %v", c.Deadline(), want)
+	}
+}
+
+func TestTreeWatcher_Relevant(t *testing.T) {
+	tmpDir := t.TempDir()
+	for _, dir := range []string{".git", "build", "src"} {
+		if err := os.Mkdir(filepath.Join(tmpDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, ".gitignore"), []byte("build/\n*.log\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewTreeWatcher(tmpDir)
+	if err != nil {
+		t.Fatalf("NewTreeWatcher() failed: %v", err)
+	}
+	defer w.Stop()
+
+	tests := []struct {
+		name string
+		path string
+		op   fsnotify.Op
+		want bool
+	}{
+		{"create file", "src/main.go", fsnotify.Create, true},
+		{"remove file", "main.go", fsnotify.Remove, true},
+		{"rename file", "src/old.go", fsnotify.Rename, true},
+		{"content write", "src/main.go", fsnotify.Write, true},
+		{"chmod", "src/main.go", fsnotify.Chmod, false},
+		{"gitignore write", ".gitignore", fsnotify.Write, true},
+		{"git internals", ".git/index.lock", fsnotify.Create, false},
+		{"ignored dir", "build/out.bin", fsnotify.Create, false},
+		{"ignored file", "debug.log", fsnotify.Create, false},
+		{"system file", "src/.DS_Store", fsnotify.Create, false},
+		{"outside root", "../elsewhere.go", fsnotify.Create, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := fsnotify.Event{Name: filepath.Join(tmpDir, tt.path), Op: tt.op}
+			if _, got := w.relevant(event); got != tt.want {
+				t.Errorf("relevant(%s %s) = %v, want %v", tt.op, tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+// waitForTreeChange fails the test unless the watcher signals before the
+// deadline. Watches are in place once NewTreeWatcher returns, so no settling
+// delay is needed before changing files.
+func waitForTreeChange(t *testing.T, w *TreeWatcher, what string) {
+	t.Helper()
+	select {
+	case <-w.Events():
+	case <-time.After(2 * time.Second):
+		t.Fatalf("timeout waiting for %s", what)
+	}
+}
+
+func TestTreeWatcher_SignalsOnceForBurst(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	w, err := NewTreeWatcher(tmpDir)
+	if err != nil {
+		t.Fatalf("NewTreeWatcher() failed: %v", err)
+	}
+	defer w.Stop()
+
+	for i := 0; i < 5; i++ {
+		name := filepath.Join(tmpDir, "file"+string(rune('a'+i))+".txt")
+		if err := os.WriteFile(name, []byte("x"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitForTreeChange(t, w, "tree change")
+
+	// The burst was coalesced into the single signal above
+	select {
+	case <-w.Events():
+		t.Error("expected one signal for the burst")
+	case <-time.After(treeWatchQuiet + 100*time.Millisecond):
+	}
+}
+
+func TestTreeWatcher_ContentWriteIsNotStructural(t *testing.T) {
+	tmpDir := t.TempDir()
+	file := filepath.Join(tmpDir, "main.go")
+	if err := os.WriteFile(file, []byte("package main\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewTreeWatcher(tmpDir)
+	if err != nil {
+		t.Fatalf("NewTreeWatcher() failed: %v", err)
+	}
+	defer w.Stop()
+
+	if err := os.WriteFile(file, []byte("package main // edited\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case change := <-w.Events():
+		if change.Structural {
+			t.Error("content write reported as a structural change")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for content write")
+	}
+}
+
+func TestTreeWatcher_WatchesNewDirs(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	w, err := NewTreeWatcher(tmpDir)
+	if err != nil {
+		t.Fatalf("NewTreeWatcher() failed: %v", err)
+	}
+	defer w.Stop()
+
+	sub := filepath.Join(tmpDir, "sub")
+	if err := os.Mkdir(sub, 0755); err != nil {
+		t.Fatal(err)
+	}
+	waitForTreeChange(t, w, "directory creation")
+
+	if err := os.WriteFile(filepath.Join(sub, "new.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitForTreeChange(t, w, "file created in new directory")
+}
+
+func TestTreeWatcher_ReloadsGitignore(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(tmpDir, "build"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	gitignore := filepath.Join(tmpDir, ".gitignore")
+	if err := os.WriteFile(gitignore, []byte("build/\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := NewTreeWatcher(tmpDir)
+	if err != nil {
+		t.Fatalf("NewTreeWatcher() failed: %v", err)
+	}
+	defer w.Stop()
+
+	created := fsnotify.Event{Name: filepath.Join(tmpDir, "build", "out.bin"), Op: fsnotify.Create}
+	if _, ok := w.relevant(created); ok {
+		t.Fatal("build/ should start out ignored")
+	}
+
+	// Dropping the rule un-ignores build/ and starts watching it
+	if err := os.WriteFile(gitignore, []byte("*.log\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitForTreeChange(t, w, ".gitignore change")
+	if _, ok := w.relevant(created); !ok {
+		t.Error("build/ should no longer be ignored")
+	}
+
+	if err := os.WriteFile(created.Name, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitForTreeChange(t, w, "file created in un-ignored directory")
+}
+
+func TestTreeChanged_PreservesSelection(t *testing.T) {
+	tmpDir := t.TempDir()
+	p := createTestPlugin(t, tmpDir)
+	p.stateRestored = true
+	p.height = 24
+
+	p.treeCursor = p.tree.IndexOf(p.tree.FindByPath("main.go"))
+	if p.treeCursor < 0 {
+		t.Fatal("main.go not in tree")
+	}
+
+	// A file sorting before main.go shifts its index
+	if err := os.WriteFile(filepath.Join(tmpDir, "aaa.txt"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, cmd := p.Update(TreeChangedMsg{Structural: true})
+	if cmd == nil {
+		t.Fatal("expected refresh command")
+	}
+	_, _ = p.Update(p.refresh()())
+
+	if node := p.tree.GetNode(p.treeCursor); node == nil || node.Path != "main.go" {
+		t.Errorf("cursor on %v, want main.go", node)
+	}
+	if p.tree.FindByPath("aaa.txt") == nil {
+		t.Error("expected new file in tree")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/x/ansi"
 	"github.com/charmbracelet/x/cellbuf"
 	"github.com/marcus/sidecar/internal/image"
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/ui"
 )
@@ -83,6 +84,20 @@ func (p *Plugin) renderView() string {
 		return ui.OverlayModal(background, modal, p.width, p.height)
 	}
 
+	// Bookmark jump list is a full overlay - render modal over dimmed background
+	if p.bookmarksMode {
+		background := p.renderNormalPanes()
+		modal := p.renderBookmarksModalContent()
+		return ui.OverlayModal(background, modal, p.width, p.height)
+	}
+
+	// Delete confirmation is a full overlay - render modal over dimmed background
+	if p.fileOpConfirmDelete {
+		background := p.renderNormalPanes()
+		modal := p.renderDeleteModalContent()
+		return ui.OverlayModal(background, modal, p.width, p.height)
+	}
+
 	// Blame view is a full overlay - render modal over dimmed background
 	if p.blameMode {
 		background := p.renderNormalPanes()
@@ -98,10 +113,10 @@ func (p *Plugin) renderNormalPanes() string {
 	// Account for input bar if active (content search or file op or line jump)
 	// Note: tree search bar is rendered inside the tree pane, not here
 	inputBarHeight := 0
-	if p.contentSearchMode || p.fileOpMode != FileOpNone || p.lineJumpMode {
+	if p.contentSearchMode || p.fileOpBarVisible() || p.lineJumpMode {
 		inputBarHeight = 1
 		// Add extra line for error message if present
-		if p.fileOpMode != FileOpNone && p.fileOpError != "" {
+		if p.fileOpBarVisible() && p.fileOpError != "" {
 			inputBarHeight = 2
 		}
 	}
@@ -138,7 +153,7 @@ func (p *Plugin) renderNormalPanes() string {
 		}
 
 		// Add file operation bar if in file operation mode
-		if p.fileOpMode != FileOpNone {
+		if p.fileOpBarVisible() {
 			parts = append(parts, p.renderFileOpBar())
 		}
 
@@ -201,7 +216,7 @@ func (p *Plugin) renderNormalPanes() string {
 	}
 
 	// Add file operation bar if in file operation mode
-	if p.fileOpMode != FileOpNone {
+	if p.fileOpBarVisible() {
 		parts = append(parts, p.renderFileOpBar())
 	}
 
@@ -311,17 +326,14 @@ func (p *Plugin) renderTreeSearchBar() string {
 	return styles.StatusInProgress.Render(searchLine)
 }
 
+// fileOpBarVisible reports whether the file operation input bar is shown.
+// Delete confirmation uses a modal instead.
+func (p *Plugin) fileOpBarVisible() bool {
+	return p.fileOpMode != FileOpNone && !p.fileOpConfirmDelete
+}
+
 // renderFileOpBar renders the file operation input bar (move/rename/create/delete).
 func (p *Plugin) renderFileOpBar() string {
-	// Handle delete confirmation mode
-	if p.fileOpConfirmDelete && p.fileOpTarget != nil {
-		itemType := "file"
-		if p.fileOpTarget.IsDir {
-			itemType = "directory"
-		}
-		return p.renderFileOpConfirmation(fmt.Sprintf("Delete %s '%s'?", itemType, p.fileOpTarget.Name))
-	}
-
 	// Handle confirmation mode for directory creation (during move)
 	if p.fileOpConfirmCreate {
 		return p.renderFileOpConfirmation(fmt.Sprintf("Create '%s'?", p.fileOpConfirmPath))
@@ -431,10 +443,10 @@ func (p *Plugin) renderTreePane(visibleHeight int) string {
 	sb.WriteString(header)
 	if p.tree != nil {
 		sb.WriteString("  ")
-		sb.WriteString(styles.Muted.Render("[" + p.tree.SortMode.Label() + "]"))
-		if !p.showIgnored {
+		sb.WriteString(styles.Muted.Render("[" + p.tree.SortLabel() + "]"))
+		if p.visibility != VisibilityAll {
 			sb.WriteString(" ")
-			sb.WriteString(styles.Muted.Render("[ignored: hidden]"))
+			sb.WriteString(styles.Muted.Render("[" + p.visibility.Label() + "]"))
 		}
 	}
 	sb.WriteString("\n")
@@ -573,9 +585,20 @@ func (p *Plugin) renderTreeNode(node *FileNode, selected bool, maxWidth int) str
 		}
 	}
 
-	// Calculate available width for name (after indent and icon)
+	// Bookmark marker and git status badge after the name
+	badge, badgeStyle := gitBadge(node)
+	badgeLen := 0
+	if badge != "" {
+		badgeLen = ansi.StringWidth(badge) + 1
+	}
+	bookmarked := p.bookmarks.Has(node.Path)
+	if bookmarked {
+		badgeLen += ansi.StringWidth(bookmarkMarker) + 1
+	}
+
+	// Calculate available width for name (after indent, icon and badge)
 	prefixLen := len(indent) + len(icon)
-	availableWidth := maxWidth - prefixLen
+	availableWidth := maxWidth - prefixLen - badgeLen
 	if availableWidth < 3 {
 		availableWidth = 3
 	}
@@ -588,28 +611,63 @@ func (p *Plugin) renderTreeNode(node *FileNode, selected bool, maxWidth int) str
 
 	// Name styling
 	var name string
-	if node.IsDir {
-		name = styles.FileBrowserDir.Render(displayName)
-	} else if node.IsIgnored {
+	if node.IsIgnored {
 		name = styles.FileBrowserIgnored.Render(displayName)
+	} else if node.IsDir {
+		name = styles.FileBrowserDir.Render(displayName)
 	} else {
 		name = styles.FileBrowserFile.Render(displayName)
 	}
 
 	line := fmt.Sprintf("%s%s%s", indent, styles.FileBrowserIcon.Render(icon), name)
+	if bookmarked {
+		line += " " + bookmarkStyle().Render(bookmarkMarker)
+	}
+	if badge != "" {
+		line += " " + badgeStyle.Render(badge)
+	}
 
 	if selected {
 		// Build plain text version for full-width highlight
 		plainLine := indent + icon + displayName
+		if bookmarked {
+			plainLine += " " + bookmarkMarker
+		}
+		if badge != "" {
+			plainLine += " " + badge
+		}
 		// Pad to full width
-		if len(plainLine) < maxWidth {
-			plainLine += strings.Repeat(" ", maxWidth-len(plainLine))
+		if w := ansi.StringWidth(plainLine); w < maxWidth {
+			plainLine += strings.Repeat(" ", maxWidth-w)
 		}
 		return styles.ListItemSelected.Render(plainLine)
 	}
 	return line
 }
 
+// gitBadge returns a node's git status badge and its style. Collapsed
+// directories show a dot when something inside them changed.
+func gitBadge(node *FileNode) (string, lipgloss.Style) {
+	if node.IsDir {
+		if node.HasChanges && !node.IsExpanded {
+			return "•", styles.StatusModified
+		}
+		return "", lipgloss.Style{}
+	}
+	switch node.GitStatus {
+	case "":
+		return "", lipgloss.Style{}
+	case gitstatus.StatusAdded, gitstatus.StatusRenamed, gitstatus.StatusCopied:
+		return string(node.GitStatus), styles.StatusStaged
+	case gitstatus.StatusDeleted, gitstatus.StatusUnmerged:
+		return string(node.GitStatus), styles.StatusDeleted
+	case gitstatus.StatusUntracked:
+		return string(node.GitStatus), styles.StatusUntracked
+	default:
+		return string(node.GitStatus), styles.StatusModified
+	}
+}
+
 // renderPreviewPane renders the file preview in the right pane.
 func (p *Plugin) renderPreviewPane(visibleHeight int) string {
 	// Handle inline edit mode - render editor within preview pane
@@ -913,9 +971,9 @@ func (p *Plugin) previewSelectionAtXY(x, y int) (int, int, bool) {
 
 	// Must match renderNormalPanes() inputBarHeight calculation exactly
 	inputBarHeight := 0
-	if p.contentSearchMode || p.fileOpMode != FileOpNone || p.lineJumpMode {
+	if p.contentSearchMode || p.fileOpBarVisible() || p.lineJumpMode {
 		inputBarHeight = 1
-		if p.fileOpMode != FileOpNone && p.fileOpError != "" {
+		if p.fileOpBarVisible() && p.fileOpError != "" {
 			inputBarHeight = 2
 		}
 	}
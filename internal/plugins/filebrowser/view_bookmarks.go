@@ -0,0 +1,171 @@
+package filebrowser
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/marcus/sidecar/internal/modal"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+const (
+	bookmarkItemPrefix  = "bookmark-"      // List item ID prefix, followed by the index
+	bookmarksActionID   = "bookmarks-jump" // Primary action (Enter key)
+	bookmarksMaxVisible = 12               // Rows shown before the list scrolls
+	bookmarkMarker      = "★"              // Shown after bookmarked names in the tree
+)
+
+// bookmarkStyle renders the bookmark marker. Built on demand so theme
+// changes apply.
+func bookmarkStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(styles.Accent)
+}
+
+// openBookmarks shows the bookmark jump list.
+func (p *Plugin) openBookmarks() {
+	p.bookmarksMode = true
+	p.bookmarkIdx = 0
+	p.clearBookmarksModal()
+}
+
+// closeBookmarks hides the bookmark jump list.
+func (p *Plugin) closeBookmarks() {
+	p.bookmarksMode = false
+	p.clearBookmarksModal()
+}
+
+// renderBookmarksModalContent renders the bookmark jump list.
+func (p *Plugin) renderBookmarksModalContent() string {
+	p.ensureBookmarksModal()
+	if p.bookmarksModal == nil {
+		return ""
+	}
+	return p.bookmarksModal.Render(p.width, p.height, p.mouseHandler)
+}
+
+// ensureBookmarksModal builds/rebuilds the bookmark jump list.
+func (p *Plugin) ensureBookmarksModal() {
+	modalW := 60
+	if modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 30 {
+		modalW = 30
+	}
+
+	if p.bookmarksModal != nil && p.bookmarksModalWidth == modalW {
+		return
+	}
+	p.bookmarksModalWidth = modalW
+
+	paths := p.bookmarks.Paths()
+	p.bookmarksModal = modal.New("Bookmarks",
+		modal.WithWidth(modalW),
+		modal.WithPrimaryAction(bookmarksActionID),
+	)
+	if len(paths) == 0 {
+		p.bookmarksModal.AddSection(modal.Text(styles.Muted.Render("No bookmarks yet. Press b on a file or directory to add one.")))
+		return
+	}
+
+	items := make([]modal.ListItem, len(paths))
+	for i, path := range paths {
+		items[i] = modal.ListItem{
+			ID:    bookmarkItemPrefix + strconv.Itoa(i),
+			Label: p.bookmarkLabel(path),
+		}
+	}
+	p.bookmarkIdx = min(p.bookmarkIdx, len(items)-1)
+	p.bookmarksModal.
+		AddSection(modal.List("bookmark-list", items, &p.bookmarkIdx, modal.WithMaxVisible(bookmarksMaxVisible))).
+		AddSection(modal.Spacer()).
+		AddSection(modal.Text(styles.Muted.Render("enter jump · d remove · esc close")))
+}
+
+// bookmarkLabel formats a bookmark for the list: directories get a trailing
+// slash and paths that no longer exist are flagged.
+func (p *Plugin) bookmarkLabel(path string) string {
+	info, err := os.Stat(filepath.Join(p.ctx.WorkDir, path))
+	switch {
+	case err != nil:
+		return path + " (missing)"
+	case info.IsDir():
+		return path + string(filepath.Separator)
+	}
+	return path
+}
+
+func (p *Plugin) clearBookmarksModal() {
+	p.bookmarksModal = nil
+	p.bookmarksModalWidth = 0
+}
+
+// selectedBookmark returns the path for a list item ID, or the highlighted
+// row when id is the primary action.
+func (p *Plugin) selectedBookmark(id string) (string, bool) {
+	idx := p.bookmarkIdx
+	if id != bookmarksActionID {
+		n, err := strconv.Atoi(strings.TrimPrefix(id, bookmarkItemPrefix))
+		if err != nil || !strings.HasPrefix(id, bookmarkItemPrefix) {
+			return "", false
+		}
+		idx = n
+	}
+	paths := p.bookmarks.Paths()
+	if idx < 0 || idx >= len(paths) {
+		return "", false
+	}
+	return paths[idx], true
+}
+
+// handleBookmarksAction runs a modal action, jumping to the chosen bookmark.
+func (p *Plugin) handleBookmarksAction(action string) tea.Cmd {
+	if action == "cancel" {
+		p.closeBookmarks()
+		return nil
+	}
+	path, ok := p.selectedBookmark(action)
+	if !ok {
+		return nil
+	}
+	p.closeBookmarks()
+	return p.jumpToBookmark(path)
+}
+
+// handleBookmarksKey handles key input in the bookmark jump list.
+func (p *Plugin) handleBookmarksKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	switch msg.String() {
+	case "q", "'":
+		p.closeBookmarks()
+		return p, nil
+	case "d", "x":
+		if path, ok := p.selectedBookmark(bookmarksActionID); ok {
+			p.bookmarks.Toggle(path)
+			p.saveState()
+			p.clearBookmarksModal()
+		}
+		return p, nil
+	}
+
+	p.ensureBookmarksModal()
+	action, cmd := p.bookmarksModal.HandleKey(msg)
+	if action != "" {
+		return p, p.handleBookmarksAction(action)
+	}
+	return p, cmd
+}
+
+// handleBookmarksMouse handles mouse events in the bookmark jump list.
+func (p *Plugin) handleBookmarksMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	p.ensureBookmarksModal()
+	action := p.bookmarksModal.HandleMouse(msg, p.mouseHandler)
+	if action == "" {
+		return p, nil
+	}
+	return p, p.handleBookmarksAction(action)
+}
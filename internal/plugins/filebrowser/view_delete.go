@@ -0,0 +1,96 @@
+package filebrowser
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// renderDeleteModalContent renders the delete confirmation modal.
+func (p *Plugin) renderDeleteModalContent() string {
+	p.ensureDeleteModal()
+	if p.deleteModal == nil {
+		return ""
+	}
+	return p.deleteModal.Render(p.width, p.height, p.mouseHandler)
+}
+
+// ensureDeleteModal builds the delete confirmation modal for the target.
+func (p *Plugin) ensureDeleteModal() {
+	if p.deleteModal != nil || p.fileOpTarget == nil {
+		return
+	}
+
+	itemType := "file"
+	message := fmt.Sprintf("'%s' will be permanently deleted.", p.fileOpTarget.Path)
+	if p.fileOpTarget.IsDir {
+		itemType = "directory"
+		message = fmt.Sprintf("'%s' and everything in it will be permanently deleted.", p.fileOpTarget.Path)
+	}
+	if p.fileOpError != "" {
+		message += "\n\n" + p.fileOpError
+	}
+
+	dialog := ui.NewConfirmDialog("Delete "+itemType+"?", message)
+	dialog.ConfirmLabel = " Delete "
+	dialog.BorderColor = styles.Error
+	p.deleteModal = dialog.ToModal()
+}
+
+func (p *Plugin) clearDeleteModal() {
+	p.deleteModal = nil
+}
+
+// cancelDelete closes the delete confirmation without deleting.
+func (p *Plugin) cancelDelete() {
+	p.fileOpMode = FileOpNone
+	p.fileOpTarget = nil
+	p.fileOpError = ""
+	p.fileOpConfirmDelete = false
+	p.clearDeleteModal()
+}
+
+// handleDeleteConfirmKey handles key input in the delete confirmation modal.
+func (p *Plugin) handleDeleteConfirmKey(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		return p, p.doDelete()
+	case "n", "N":
+		p.cancelDelete()
+		return p, nil
+	}
+
+	p.ensureDeleteModal()
+	if p.deleteModal == nil {
+		p.cancelDelete()
+		return p, nil
+	}
+	action, cmd := p.deleteModal.HandleKey(msg)
+	switch action {
+	case "confirm":
+		return p, p.doDelete()
+	case "cancel":
+		p.cancelDelete()
+		return p, nil
+	}
+	return p, cmd
+}
+
+// handleDeleteModalMouse handles mouse events in the delete confirmation modal.
+func (p *Plugin) handleDeleteModalMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	p.ensureDeleteModal()
+	if p.deleteModal == nil {
+		p.cancelDelete()
+		return p, nil
+	}
+	switch p.deleteModal.HandleMouse(msg, p.mouseHandler) {
+	case "confirm":
+		return p, p.doDelete()
+	case "cancel":
+		p.cancelDelete()
+	}
+	return p, nil
+}
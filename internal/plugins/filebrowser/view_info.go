@@ -46,9 +46,20 @@ func (p *Plugin) ensureInfoModal() {
 		AddSection(p.infoModalDetailsSection())
 }
 
+// clearInfoModal drops the built modal and stops any directory size walk.
 func (p *Plugin) clearInfoModal() {
 	p.infoModal = nil
 	p.infoModalWidth = 0
+	p.cancelDirSize()
+}
+
+// infoTargetIsDir reports whether the info modal describes a directory.
+func (p *Plugin) infoTargetIsDir() bool {
+	if p.activePane == PanePreview && p.previewFile != "" {
+		return false
+	}
+	node := p.tree.GetNode(p.treeCursor)
+	return node != nil && node.IsDir
 }
 
 func (p *Plugin) infoTargetPath() string {
@@ -62,6 +73,18 @@ func (p *Plugin) infoTargetPath() string {
 	return ""
 }
 
+// infoDirSize returns the directory size line: the cached total, or a
+// spinner while it is computed.
+func (p *Plugin) infoDirSize(path string) string {
+	if size, ok := p.dirSizes[path]; ok {
+		return size.String()
+	}
+	if p.dirSizePath == path {
+		return p.dirSizeSpinner.View() + " calculating..."
+	}
+	return "--"
+}
+
 func (p *Plugin) infoModalDetailsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		path := p.infoTargetPath()
@@ -95,7 +118,7 @@ func (p *Plugin) infoModalDetailsSection() modal.Section {
 
 		size := formatSize(info.Size())
 		if isDir {
-			size = "--"
+			size = p.infoDirSize(path)
 		}
 
 		modTime := info.ModTime().Format("Jan 2, 2006 at 15:04")
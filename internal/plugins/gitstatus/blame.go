@@ -0,0 +1,147 @@
+package gitstatus
+
+import (
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// fileBlameFormat is the git log format read by parseFileBlame:
+// shorthash\x00author\x00timestamp
+const fileBlameFormat = "%h%x00%an%x00%at"
+
+// FileBlame summarizes the most recent commit that touched a file.
+type FileBlame struct {
+	ShortHash string
+	Author    string
+	Date      time.Time
+}
+
+// FileBlameLoadedMsg is sent when the last-commit summary for a file loads.
+type FileBlameLoadedMsg struct {
+	Epoch uint64 // Epoch when request was issued (for stale detection)
+	Gen   int    // Cache generation when request was issued
+	File  string
+	Blame *FileBlame // nil when the file has no history
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m FileBlameLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// GetFileBlame returns the most recent commit that touched path, or nil if
+// the path has never been committed.
+func GetFileBlame(workDir, path string) (*FileBlame, error) {
+	cmd := exec.Command("git", "log", "-1", "--format="+fileBlameFormat, "--", path)
+	cmd.Dir = workDir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseFileBlame(string(output)), nil
+}
+
+// parseFileBlame parses a line of fileBlameFormat output. Returns nil for
+// empty or malformed input.
+func parseFileBlame(line string) *FileBlame {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil
+	}
+	parts := strings.Split(line, "\x00")
+	if len(parts) < 3 {
+		return nil
+	}
+	timestamp, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &FileBlame{
+		ShortHash: parts[0],
+		Author:    parts[1],
+		Date:      time.Unix(timestamp, 0),
+	}
+}
+
+// loadFileBlame fetches the last-commit summary for path unless it is cached
+// or already loading.
+func (p *Plugin) loadFileBlame(path string) tea.Cmd {
+	if path == "" {
+		return nil
+	}
+	if _, ok := p.fileBlames[path]; ok {
+		return nil
+	}
+	if p.fileBlamePending[path] {
+		return nil
+	}
+	if p.fileBlamePending == nil {
+		p.fileBlamePending = make(map[string]bool)
+	}
+	p.fileBlamePending[path] = true
+
+	epoch := p.ctx.Epoch
+	gen := p.fileBlameGen
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		blame, _ := GetFileBlame(workDir, path)
+		return FileBlameLoadedMsg{Epoch: epoch, Gen: gen, File: path, Blame: blame}
+	}
+}
+
+// setFileBlame caches a loaded summary. Results requested before the last
+// invalidation are dropped.
+func (p *Plugin) setFileBlame(msg FileBlameLoadedMsg) {
+	if msg.Gen != p.fileBlameGen {
+		return
+	}
+	delete(p.fileBlamePending, msg.File)
+	if p.fileBlames == nil {
+		p.fileBlames = make(map[string]*FileBlame)
+	}
+	p.fileBlames[msg.File] = msg.Blame
+}
+
+// getHeadHash returns the commit HEAD points at, or "" in a repository
+// without commits.
+func getHeadHash(workDir string) string {
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", "HEAD")
+	cmd.Dir = workDir
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// syncFileBlameHead clears the cache when HEAD has moved since the last
+// refresh (commits, checkouts, resets). Worktree edits leave HEAD alone, so
+// the summaries stay on screen instead of reloading for every visible file.
+func (p *Plugin) syncFileBlameHead(head string) {
+	if head == p.fileBlameHead {
+		return
+	}
+	p.fileBlameHead = head
+	p.invalidateFileBlames()
+}
+
+// invalidateFileBlames clears the cache so summaries reload.
+func (p *Plugin) invalidateFileBlames() {
+	p.fileBlames = nil
+	p.fileBlamePending = nil
+	p.fileBlameGen++
+}
+
+// renderFileBlameLine renders the last-commit summary shown under the diff
+// header, or an empty string while loading or for files with no history.
+func (p *Plugin) renderFileBlameLine(maxWidth int) string {
+	blame := p.fileBlames[p.selectedDiffFile]
+	if blame == nil {
+		return ""
+	}
+	line := blame.ShortHash + " " + blame.Author + " · " + RelativeTime(blame.Date)
+	return styles.Muted.Render(truncateStyledLine(line, maxWidth))
+}
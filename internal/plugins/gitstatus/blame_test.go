@@ -0,0 +1,91 @@
+package gitstatus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marcus/sidecar/internal/plugin"
+)
+
+func TestParseFileBlame(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		want   *FileBlame
+		wantOK bool
+	}{
+		{
+			name:   "basic",
+			line:   "a1b2c3d\x00Jane Doe\x001700000000\n",
+			want:   &FileBlame{ShortHash: "a1b2c3d", Author: "Jane Doe", Date: time.Unix(1700000000, 0)},
+			wantOK: true,
+		},
+		{
+			name:   "author with separators",
+			line:   "a1b2c3d\x00Doe, Jane | Ops\x001700000000",
+			want:   &FileBlame{ShortHash: "a1b2c3d", Author: "Doe, Jane | Ops", Date: time.Unix(1700000000, 0)},
+			wantOK: true,
+		},
+		{name: "empty (never committed)", line: "", wantOK: false},
+		{name: "whitespace only", line: "\n", wantOK: false},
+		{name: "missing fields", line: "a1b2c3d\x00Jane Doe", wantOK: false},
+		{name: "bad timestamp", line: "a1b2c3d\x00Jane Doe\x00yesterday", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseFileBlame(tt.line)
+			if !tt.wantOK {
+				if got != nil {
+					t.Errorf("parseFileBlame() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("parseFileBlame() = nil")
+			}
+			if got.ShortHash != tt.want.ShortHash || got.Author != tt.want.Author || !got.Date.Equal(tt.want.Date) {
+				t.Errorf("parseFileBlame() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetFileBlameDropsInvalidatedResults(t *testing.T) {
+	p := &Plugin{ctx: &plugin.Context{WorkDir: "/tmp"}}
+	blame := &FileBlame{ShortHash: "a1b2c3d", Author: "Jane Doe"}
+
+	p.loadFileBlame("main.go")
+	p.invalidateFileBlames()
+	p.setFileBlame(FileBlameLoadedMsg{Gen: 0, File: "main.go", Blame: blame})
+	if _, ok := p.fileBlames["main.go"]; ok {
+		t.Fatal("result requested before invalidation was cached")
+	}
+
+	p.setFileBlame(FileBlameLoadedMsg{Gen: p.fileBlameGen, File: "main.go", Blame: blame})
+	if p.fileBlames["main.go"] != blame {
+		t.Fatal("current result was not cached")
+	}
+	if cmd := p.loadFileBlame("main.go"); cmd != nil {
+		t.Error("loadFileBlame() refetched a cached path")
+	}
+}
+
+func TestSyncFileBlameHeadKeepsCacheUntilHeadMoves(t *testing.T) {
+	p := &Plugin{}
+	blame := &FileBlame{ShortHash: "a1b2c3d", Author: "Jane Doe"}
+
+	p.syncFileBlameHead("aaa")
+	p.setFileBlame(FileBlameLoadedMsg{Gen: p.fileBlameGen, File: "main.go", Blame: blame})
+
+	// A worktree-only refresh leaves HEAD alone
+	p.syncFileBlameHead("aaa")
+	if p.fileBlames["main.go"] != blame {
+		t.Fatal("refresh without a HEAD change dropped the cached summary")
+	}
+
+	p.syncFileBlameHead("bbb")
+	if _, ok := p.fileBlames["main.go"]; ok {
+		t.Error("HEAD change kept the stale summary")
+	}
+}
@@ -20,23 +20,35 @@ type Branch struct {
 	LastCommit string // Short hash of last commit
 }
 
-// GetBranches retrieves the list of local branches.
-func GetBranches(workDir string) ([]*Branch, error) {
+// trackRe matches tracking info: [ahead N], [behind N], [ahead N, behind N]
+var trackRe = regexp.MustCompile(`\[(?:ahead (\d+))?(?:, )?(?:behind (\d+))?\]`)
+
+// GetBranches retrieves the list of local branches. When includeRemote is
+// set, remote-tracking branches that no local branch tracks are appended.
+func GetBranches(workDir string, includeRemote bool) ([]*Branch, error) {
 	// Use git branch with format to get detailed info
-	// Format: refname:short, HEAD, upstream:short, upstream:track
-	cmd := exec.Command("git", "branch",
-		"--format=%(refname:short)|%(HEAD)|%(upstream:short)|%(upstream:track)")
+	// Format: refname, HEAD, upstream:short, upstream:track
+	args := []string{"branch", "--format=%(refname)|%(HEAD)|%(upstream:short)|%(upstream:track)"}
+	if includeRemote {
+		args = append(args, "-a")
+	}
+	cmd := exec.Command("git", args...)
 	cmd.Dir = workDir
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, err
 	}
 
-	var branches []*Branch
-	scanner := bufio.NewScanner(bytes.NewReader(output))
+	return parseBranchList(output), nil
+}
 
-	// Pattern for tracking info: [ahead N], [behind N], [ahead N, behind N]
-	trackRe := regexp.MustCompile(`\[(?:ahead (\d+))?(?:, )?(?:behind (\d+))?\]`)
+// parseBranchList parses `git branch [-a] --format` output (see GetBranches).
+// Remote branches are flagged IsRemote; symbolic remote HEADs and remote
+// branches already tracked by a local branch are skipped.
+func parseBranchList(output []byte) []*Branch {
+	var local, remote []*Branch
+	tracked := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
 
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -45,13 +57,24 @@ func GetBranches(workDir string) ([]*Branch, error) {
 			continue
 		}
 
+		ref := parts[0]
+		if strings.HasPrefix(ref, "refs/remotes/") {
+			name := strings.TrimPrefix(ref, "refs/remotes/")
+			if strings.HasSuffix(name, "/HEAD") {
+				continue
+			}
+			remote = append(remote, &Branch{Name: name, IsRemote: true})
+			continue
+		}
+
 		branch := &Branch{
-			Name:      parts[0],
+			Name:      strings.TrimPrefix(ref, "refs/heads/"),
 			IsCurrent: parts[1] == "*",
 		}
 
 		if len(parts) > 2 && parts[2] != "" {
 			branch.Upstream = parts[2]
+			tracked[parts[2]] = true
 		}
 
 		if len(parts) > 3 && parts[3] != "" {
@@ -66,10 +89,28 @@ func GetBranches(workDir string) ([]*Branch, error) {
 			}
 		}
 
-		branches = append(branches, branch)
+		local = append(local, branch)
+	}
+
+	branches := local
+	for _, b := range remote {
+		if !tracked[b.Name] {
+			branches = append(branches, b)
+		}
 	}
+	return branches
+}
 
-	return branches, nil
+// LocalName returns the branch name without its remote prefix
+// (e.g. "feature" for "origin/feature").
+func (b *Branch) LocalName() string {
+	if !b.IsRemote {
+		return b.Name
+	}
+	if _, name, ok := strings.Cut(b.Name, "/"); ok {
+		return name
+	}
+	return b.Name
 }
 
 // CheckoutBranch switches to a branch.
@@ -83,9 +124,27 @@ func CheckoutBranch(workDir, branchName string) error {
 	return nil
 }
 
-// CreateBranch creates a new branch from HEAD.
-func CreateBranch(workDir, branchName string) error {
-	cmd := exec.Command("git", "checkout", "-b", branchName)
+// CheckoutRemoteBranch creates and switches to a local branch tracking the
+// given remote branch (e.g. "origin/feature"). The remote is named
+// explicitly so a branch that exists on several remotes is not ambiguous.
+func CheckoutRemoteBranch(workDir, remoteBranch, localName string) error {
+	cmd := exec.Command("git", "checkout", "-b", localName, "--track", remoteBranch)
+	cmd.Dir = workDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return &BranchError{Output: string(output), Err: err}
+	}
+	return nil
+}
+
+// CreateBranch creates and switches to a new branch starting at startPoint,
+// or at HEAD when startPoint is empty.
+func CreateBranch(workDir, branchName, startPoint string) error {
+	args := []string{"checkout", "-b", branchName}
+	if startPoint != "" {
+		args = append(args, startPoint)
+	}
+	cmd := exec.Command("git", args...)
 	cmd.Dir = workDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
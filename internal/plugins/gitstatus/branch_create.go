@@ -0,0 +1,233 @@
+package gitstatus
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/modal"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+const (
+	branchCreateInputID  = "branch-create-input"
+	branchCreateBaseID   = "branch-create-base"
+	branchCreateActionID = "branch-create-action"
+	branchCreateCreateID = "branch-create-create"
+	branchCreateCancelID = "branch-create-cancel"
+)
+
+// validateBranchName checks a new branch name against git's ref naming rules
+// (see git-check-ref-format). Returns nil when the name is valid.
+func validateBranchName(name string) error {
+	if name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if name == "@" {
+		return errors.New("name cannot be '@'")
+	}
+	for _, r := range name {
+		if r < 32 || r == 127 {
+			return errors.New("name contains a control character")
+		}
+	}
+	for _, s := range []string{" ", "~", "^", ":", "?", "*", "[", "\\", "..", "@{", "//", "/."} {
+		if strings.Contains(name, s) {
+			return fmt.Errorf("name contains '%s'", s)
+		}
+	}
+	for _, s := range []string{"-", ".", "/"} {
+		if strings.HasPrefix(name, s) {
+			return fmt.Errorf("name starts with '%s'", s)
+		}
+	}
+	for _, s := range []string{".", "/"} {
+		if strings.HasSuffix(name, s) {
+			return fmt.Errorf("name ends with '%s'", s)
+		}
+	}
+	// git rejects ".lock" at the end of any slash-separated component, not
+	// just the whole name.
+	for _, part := range strings.Split(name, "/") {
+		if strings.HasSuffix(part, ".lock") {
+			return errors.New("name component ends with '.lock'")
+		}
+	}
+	return nil
+}
+
+// openBranchCreate opens the new-branch prompt, pre-filled with the current
+// filter text.
+func (p *Plugin) openBranchCreate() {
+	p.branchNameInput = textinput.New()
+	p.branchNameInput.SetValue(p.branchFilter)
+	p.branchNameInput.CharLimit = 100
+	p.branchNameInput.Width = 40
+	p.branchNameInput.Prompt = ""
+	p.branchNameInput.Focus()
+	p.branchCreateFromSelected = false
+	p.branchCreateError = ""
+	p.branchCreating = true
+	p.branchCreateModal = nil
+}
+
+// closeBranchCreate returns from the new-branch prompt to the branch list.
+func (p *Plugin) closeBranchCreate() {
+	p.branchCreating = false
+	p.branchNameInput = textinput.Model{}
+	p.branchCreateError = ""
+	p.branchCreateModal = nil
+	p.branchCreateModalWidth = 0
+}
+
+// branchCreateBase returns the highlighted branch when it can be offered as
+// the start point, or nil when the new branch can only start from HEAD.
+func (p *Plugin) branchCreateBase() *Branch {
+	branches := p.filteredBranches()
+	if p.branchCursor < 0 || p.branchCursor >= len(branches) {
+		return nil
+	}
+	if b := branches[p.branchCursor]; !b.IsCurrent {
+		return b
+	}
+	return nil
+}
+
+// updateBranchCreate handles key events in the new-branch prompt.
+func (p *Plugin) updateBranchCreate(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	p.ensureBranchCreateModal()
+	if p.branchCreateModal == nil {
+		return p, nil
+	}
+
+	// Clear error on typing when input is focused
+	if p.branchCreateModal.FocusedID() == branchCreateInputID {
+		p.branchCreateError = ""
+	}
+
+	action, cmd := p.branchCreateModal.HandleKey(msg)
+	switch action {
+	case "cancel", branchCreateCancelID:
+		p.closeBranchCreate()
+		return p, nil
+	case branchCreateActionID, branchCreateCreateID:
+		return p, p.submitBranchCreate()
+	}
+	return p, cmd
+}
+
+// submitBranchCreate validates the prompt and creates the branch.
+func (p *Plugin) submitBranchCreate() tea.Cmd {
+	name := strings.TrimSpace(p.branchNameInput.Value())
+	if err := validateBranchName(name); err != nil {
+		p.branchCreateError = err.Error()
+		return nil
+	}
+	for _, b := range p.branches {
+		if !b.IsRemote && b.Name == name {
+			p.branchCreateError = "branch already exists"
+			return nil
+		}
+	}
+
+	startPoint := ""
+	if base := p.branchCreateBase(); base != nil && p.branchCreateFromSelected {
+		startPoint = base.Name
+	}
+	p.closeBranchCreate()
+	return p.doCreateBranch(name, startPoint)
+}
+
+// doCreateBranch creates and switches to a new branch.
+func (p *Plugin) doCreateBranch(name, startPoint string) tea.Cmd {
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		if err := CreateBranch(workDir, name, startPoint); err != nil {
+			return BranchErrorMsg{Err: err}
+		}
+		return BranchSwitchSuccessMsg{Branch: name}
+	}
+}
+
+// ensureBranchCreateModal builds/rebuilds the new-branch prompt modal.
+func (p *Plugin) ensureBranchCreateModal() {
+	modalW := 50
+	if modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 20 {
+		modalW = 20
+	}
+	if p.branchCreateModal != nil && p.branchCreateModalWidth == modalW {
+		return
+	}
+	p.branchCreateModalWidth = modalW
+
+	baseLabel := ""
+	if base := p.branchCreateBase(); base != nil {
+		baseLabel = "Start from " + base.Name
+	}
+
+	p.branchCreateModal = modal.New("New Branch",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	).
+		AddSection(modal.InputWithLabel(branchCreateInputID, "Name:", &p.branchNameInput,
+			modal.WithSubmitAction(branchCreateActionID))).
+		AddSection(modal.When(func() bool { return p.branchCreateError != "" }, p.branchCreateErrorSection())).
+		AddSection(modal.Spacer()).
+		AddSection(modal.When(func() bool { return baseLabel != "" },
+			modal.Checkbox(branchCreateBaseID, baseLabel, &p.branchCreateFromSelected))).
+		AddSection(modal.When(func() bool { return baseLabel == "" },
+			modal.Text(styles.Muted.Render("Starts from HEAD")))).
+		AddSection(modal.Spacer()).
+		AddSection(modal.Buttons(
+			modal.Btn(" Create ", branchCreateCreateID, modal.BtnPrimary()),
+			modal.Btn(" Cancel ", branchCreateCancelID),
+		))
+}
+
+// branchCreateErrorSection renders the inline validation error.
+func (p *Plugin) branchCreateErrorSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if p.branchCreateError == "" {
+			return modal.RenderedSection{}
+		}
+		return modal.RenderedSection{Content: styles.StatusDeleted.Render("✗ Invalid: " + p.branchCreateError)}
+	}, nil)
+}
+
+// handleBranchCreateMouse processes mouse events in the new-branch prompt.
+func (p *Plugin) handleBranchCreateMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	p.ensureBranchCreateModal()
+	if p.branchCreateModal == nil {
+		return p, nil
+	}
+
+	switch p.branchCreateModal.HandleMouse(msg, p.mouseHandler) {
+	case "cancel", branchCreateCancelID:
+		p.closeBranchCreate()
+	case branchCreateBaseID:
+		p.branchCreateFromSelected = !p.branchCreateFromSelected
+	case branchCreateCreateID:
+		return p, p.submitBranchCreate()
+	}
+	return p, nil
+}
+
+// renderBranchCreate renders the new-branch prompt over the status view.
+func (p *Plugin) renderBranchCreate() string {
+	background := p.renderThreePaneView()
+
+	p.ensureBranchCreateModal()
+	if p.branchCreateModal == nil {
+		return background
+	}
+
+	modalContent := p.branchCreateModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
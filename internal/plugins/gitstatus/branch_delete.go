@@ -0,0 +1,139 @@
+package gitstatus
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// BranchDeletedMsg is sent when a branch is deleted.
+type BranchDeletedMsg struct {
+	Branch string
+}
+
+// BranchNotMergedMsg is sent when a safe delete fails because the branch has
+// commits not merged into HEAD or its upstream.
+type BranchNotMergedMsg struct {
+	Branch string
+}
+
+// branchDeleteNeedsForce reports whether a failed `git branch -d` can be
+// retried with -D, i.e. git refused only because the branch is unmerged.
+func branchDeleteNeedsForce(err error) bool {
+	var branchErr *BranchError
+	if !errors.As(err, &branchErr) {
+		return false
+	}
+	return strings.Contains(branchErr.Output, "is not fully merged")
+}
+
+// confirmBranchDelete opens the delete confirmation for the highlighted
+// branch. The current branch and remote branches cannot be deleted.
+func (p *Plugin) confirmBranchDelete() tea.Cmd {
+	branches := p.filteredBranches()
+	if p.branchCursor < 0 || p.branchCursor >= len(branches) {
+		return nil
+	}
+	branch := branches[p.branchCursor]
+	if branch.IsCurrent {
+		return msg.ShowToast("Cannot delete the current branch", 2*time.Second)
+	}
+	if branch.IsRemote {
+		return msg.ShowToast("Cannot delete remote branches", 2*time.Second)
+	}
+	p.openBranchDelete(branch.Name, false)
+	return nil
+}
+
+// openBranchDelete shows the delete confirmation for name. With force set
+// the dialog offers `git branch -D` for an unmerged branch.
+func (p *Plugin) openBranchDelete(name string, force bool) {
+	title := "Delete Branch?"
+	message := fmt.Sprintf("Delete branch %s?", styles.Subtitle.Render(name))
+	confirmLabel := " Delete "
+	if force {
+		title = "Force Delete Branch?"
+		message = fmt.Sprintf("Branch %s is not fully merged.\n%s",
+			styles.Subtitle.Render(name),
+			styles.StatusDeleted.Render("Force delete (-D) will lose its unmerged commits."))
+		confirmLabel = " Force Delete "
+	}
+
+	dialog := ui.NewConfirmDialog(title, message)
+	dialog.ConfirmLabel = confirmLabel
+	dialog.BorderColor = styles.Error
+
+	p.branchDeleteName = name
+	p.branchDeleteForce = force
+	p.branchDeleteModal = dialog.ToModal()
+}
+
+// closeBranchDelete dismisses the delete confirmation.
+func (p *Plugin) closeBranchDelete() {
+	p.branchDeleteName = ""
+	p.branchDeleteForce = false
+	p.branchDeleteModal = nil
+}
+
+// updateBranchDelete handles key events in the delete confirmation.
+func (p *Plugin) updateBranchDelete(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	action, cmd := p.branchDeleteModal.HandleKey(msg)
+	return p, tea.Batch(cmd, p.handleBranchDeleteAction(action))
+}
+
+// handleBranchDeleteMouse processes mouse events in the delete confirmation.
+func (p *Plugin) handleBranchDeleteMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	action := p.branchDeleteModal.HandleMouse(msg, p.mouseHandler)
+	return p, p.handleBranchDeleteAction(action)
+}
+
+func (p *Plugin) handleBranchDeleteAction(action string) tea.Cmd {
+	switch action {
+	case "cancel":
+		p.closeBranchDelete()
+	case "confirm":
+		name, force := p.branchDeleteName, p.branchDeleteForce
+		p.closeBranchDelete()
+		return p.doDeleteBranch(name, force)
+	}
+	return nil
+}
+
+// doDeleteBranch deletes a branch with -d, or -D when force is set.
+func (p *Plugin) doDeleteBranch(name string, force bool) tea.Cmd {
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		var err error
+		if force {
+			err = ForceDeleteBranch(workDir, name)
+		} else {
+			err = DeleteBranch(workDir, name)
+		}
+		if err != nil {
+			if !force && branchDeleteNeedsForce(err) {
+				return BranchNotMergedMsg{Branch: name}
+			}
+			return BranchErrorMsg{Err: err}
+		}
+		return BranchDeletedMsg{Branch: name}
+	}
+}
+
+// branchDeletedToast confirms a successful delete.
+func branchDeletedToast(name string) tea.Cmd {
+	return msg.ShowToast("Deleted branch "+name, 2*time.Second)
+}
+
+// renderBranchDelete renders the delete confirmation over the status view.
+func (p *Plugin) renderBranchDelete() string {
+	background := p.renderThreePaneView()
+	modalContent := p.branchDeleteModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
@@ -0,0 +1,92 @@
+package gitstatus
+
+import (
+	"sort"
+	"strings"
+)
+
+// Match tiers for branch filtering; higher tiers rank first.
+const (
+	branchMatchFuzzy     = 1 // Query characters appear in order
+	branchMatchSubstring = 2 // Query appears contiguously
+	branchMatchPrefix    = 3 // Name starts with the query
+)
+
+// branchMatchScore scores how well query matches a branch name,
+// case-insensitively. Returns 0 when there is no match. Prefix matches
+// outrank substring matches, which outrank fuzzy subsequence matches;
+// within a tier shorter names and earlier, tighter matches score higher.
+func branchMatchScore(query, name string) int {
+	if query == "" {
+		return 0
+	}
+	q := strings.ToLower(query)
+	n := strings.ToLower(name)
+
+	tier, penalty := 0, 0
+	if strings.HasPrefix(n, q) {
+		tier, penalty = branchMatchPrefix, len(n)
+	} else if idx := strings.Index(n, q); idx >= 0 {
+		tier, penalty = branchMatchSubstring, idx*10+len(n)
+	} else if gaps, ok := fuzzyGaps(q, n); ok {
+		tier, penalty = branchMatchFuzzy, gaps*10+len(n)
+	} else {
+		return 0
+	}
+	return tier*10000 - min(penalty, 9999)
+}
+
+// fuzzyGaps reports whether q's characters appear in order in n, and the
+// number of skipped characters between the first and last matched ones.
+func fuzzyGaps(q, n string) (int, bool) {
+	qi, first, gaps := 0, -1, 0
+	for i := 0; i < len(n) && qi < len(q); i++ {
+		if n[i] != q[qi] {
+			if first >= 0 {
+				gaps++
+			}
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		qi++
+	}
+	return gaps, qi == len(q)
+}
+
+// scoreBranch scores a branch against query. Remote branches also match on
+// their name without the remote prefix so "fix" ranks origin/fix as a prefix.
+func scoreBranch(query string, b *Branch) int {
+	score := branchMatchScore(query, b.Name)
+	if b.IsRemote {
+		score = max(score, branchMatchScore(query, b.LocalName()))
+	}
+	return score
+}
+
+// filterBranches returns the branches matching query, best matches first.
+// Ties keep their original order. An empty query returns branches unchanged.
+func filterBranches(branches []*Branch, query string) []*Branch {
+	if query == "" {
+		return branches
+	}
+	type scored struct {
+		branch *Branch
+		score  int
+	}
+	var matches []scored
+	for _, b := range branches {
+		if s := scoreBranch(query, b); s > 0 {
+			matches = append(matches, scored{b, s})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+	result := make([]*Branch, len(matches))
+	for i, m := range matches {
+		result[i] = m.branch
+	}
+	return result
+}
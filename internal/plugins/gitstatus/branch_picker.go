@@ -34,40 +34,79 @@ func parseBranchPickerItem(id string) (int, bool) {
 
 // updateBranchPicker handles key events in the branch picker modal.
 func (p *Plugin) updateBranchPicker(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	if p.branchCreating {
+		return p.updateBranchCreate(msg)
+	}
+	if p.branchDeleteModal != nil {
+		return p.updateBranchDelete(msg)
+	}
+
 	p.ensureBranchPickerModal()
 	if p.branchPickerModal == nil {
 		return p, nil
 	}
 
-	switch msg.String() {
-	case "esc", "q":
-		// Close picker
+	key := msg.String()
+	switch key {
+	case "esc":
+		// Clear the filter first, then close
+		if p.branchFilter != "" {
+			p.setBranchFilter("")
+			return p, nil
+		}
 		p.closeBranchPicker()
 		return p, nil
 
-	case "j", "down":
+	case "down", "ctrl+j":
 		p.moveBranchCursor(1)
 		return p, nil
 
-	case "k", "up":
+	case "up", "ctrl+k":
 		p.moveBranchCursor(-1)
 		return p, nil
 
-	case "g":
+	case "home":
 		p.branchCursor = 0
 		return p, nil
 
-	case "G":
-		if len(p.branches) > 0 {
-			p.branchCursor = len(p.branches) - 1
+	case "end":
+		if n := len(p.filteredBranches()); n > 0 {
+			p.branchCursor = n - 1
 		}
 		return p, nil
 
+	case "backspace":
+		if runes := []rune(p.branchFilter); len(runes) > 0 {
+			p.setBranchFilter(string(runes[:len(runes)-1]))
+		}
+		return p, nil
+
+	case "ctrl+n":
+		// Prompt for a new branch name
+		p.openBranchCreate()
+		return p, nil
+
+	case "ctrl+d":
+		// Delete the highlighted branch after confirmation
+		return p, p.confirmBranchDelete()
+
+	case "tab":
+		// Toggle between local-only and all branches
+		p.branchShowRemote = !p.branchShowRemote
+		p.clearBranchPickerModal()
+		return p, p.loadBranches()
+
 	case "enter":
 		// Switch to selected branch
 		return p, p.switchSelectedBranch()
 	}
 
+	// Append typed characters to the filter, including j and k
+	if msg.Type == tea.KeyRunes && !msg.Alt {
+		p.setBranchFilter(p.branchFilter + string(msg.Runes))
+		return p, nil
+	}
+
 	action, cmd := p.branchPickerModal.HandleKey(msg)
 	if action == "cancel" {
 		p.closeBranchPicker()
@@ -80,15 +119,50 @@ func (p *Plugin) updateBranchPicker(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	return p, cmd
 }
 
-// doSwitchBranch switches to a different branch.
-func (p *Plugin) doSwitchBranch(branchName string) tea.Cmd {
+// filteredBranches returns the branches shown in the picker, narrowed and
+// ranked by the current filter.
+func (p *Plugin) filteredBranches() []*Branch {
+	return filterBranches(p.branches, p.branchFilter)
+}
+
+// setBranchFilter updates the picker filter and moves the cursor to the
+// best match.
+func (p *Plugin) setBranchFilter(query string) {
+	p.branchFilter = query
+	p.branchCursor = 0
+	if query == "" {
+		p.selectCurrentBranch()
+	}
+}
+
+// selectCurrentBranch positions the picker cursor on the checked-out branch.
+func (p *Plugin) selectCurrentBranch() {
+	for i, b := range p.filteredBranches() {
+		if b.IsCurrent {
+			p.branchCursor = i
+			return
+		}
+	}
+}
+
+// doSwitchBranch switches to a different branch. Remote branches are checked
+// out as a new local branch tracking the remote.
+func (p *Plugin) doSwitchBranch(branch *Branch) tea.Cmd {
 	workDir := p.repoRoot
+	branchName := branch.Name
+	isRemote := branch.IsRemote
+	localName := branch.LocalName()
 	return func() tea.Msg {
-		err := CheckoutBranch(workDir, branchName)
+		var err error
+		if isRemote {
+			err = CheckoutRemoteBranch(workDir, branchName, localName)
+		} else {
+			err = CheckoutBranch(workDir, branchName)
+		}
 		if err != nil {
 			return BranchErrorMsg{Err: err}
 		}
-		return BranchSwitchSuccessMsg{Branch: branchName}
+		return BranchSwitchSuccessMsg{Branch: localName}
 	}
 }
 
@@ -96,8 +170,9 @@ func (p *Plugin) doSwitchBranch(branchName string) tea.Cmd {
 func (p *Plugin) loadBranches() tea.Cmd {
 	epoch := p.ctx.Epoch
 	workDir := p.repoRoot
+	includeRemote := p.branchShowRemote
 	return func() tea.Msg {
-		branches, err := GetBranches(workDir)
+		branches, err := GetBranches(workDir, includeRemote)
 		if err != nil {
 			return BranchErrorMsg{Err: err}
 		}
@@ -145,21 +220,32 @@ func (p *Plugin) branchPickerListSection() modal.Section {
 			return modal.RenderedSection{Content: styles.Muted.Render("  Loading branches...")}
 		}
 
+		filterLine := styles.Muted.Render("  Type to filter")
+		if p.branchFilter != "" {
+			filterLine = "  " + styles.Muted.Render("Filter: ") + p.branchFilter + styles.Muted.Render("_")
+		}
+
+		branches := p.filteredBranches()
+		if len(branches) == 0 {
+			return modal.RenderedSection{Content: filterLine + "\n\n" + styles.Muted.Render("  No matching branches")}
+		}
+
 		maxVisible := p.branchPickerMaxVisible()
 		start := 0
 		if p.branchCursor >= maxVisible {
 			start = p.branchCursor - maxVisible + 1
 		}
 		end := start + maxVisible
-		if end > len(p.branches) {
-			end = len(p.branches)
+		if end > len(branches) {
+			end = len(branches)
 		}
 
 		var sb strings.Builder
+		sb.WriteString(filterLine + "\n\n")
 		focusables := make([]modal.FocusableInfo, 0, end-start)
 
 		for i := start; i < end; i++ {
-			branch := p.branches[i]
+			branch := branches[i]
 			itemID := branchPickerItemID(i)
 			selected := i == p.branchCursor
 			hovered := itemID == hoverID
@@ -173,15 +259,15 @@ func (p *Plugin) branchPickerListSection() modal.Section {
 			focusables = append(focusables, modal.FocusableInfo{
 				ID:      itemID,
 				OffsetX: 0,
-				OffsetY: i - start,
+				OffsetY: i - start + 2, // Below the filter line
 				Width:   ansi.StringWidth(line),
 				Height:  1,
 			})
 		}
 
 		content := sb.String()
-		if len(p.branches) > maxVisible {
-			content += "\n\n" + styles.Muted.Render(fmt.Sprintf("  %d/%d branches", p.branchCursor+1, len(p.branches)))
+		if len(branches) > maxVisible {
+			content += "\n\n" + styles.Muted.Render(fmt.Sprintf("  %d/%d branches", p.branchCursor+1, len(branches)))
 		}
 
 		return modal.RenderedSection{
@@ -202,12 +288,12 @@ func (p *Plugin) branchPickerListUpdate(msg tea.Msg, focusID string) (string, te
 	}
 
 	switch keyMsg.String() {
-	case "up", "k":
+	case "up", "ctrl+k":
 		p.moveBranchCursor(-1)
-	case "down", "j":
+	case "down", "ctrl+j":
 		p.moveBranchCursor(1)
 	case "enter":
-		if len(p.branches) > 0 && p.branchCursor >= 0 && p.branchCursor < len(p.branches) {
+		if n := len(p.filteredBranches()); n > 0 && p.branchCursor >= 0 && p.branchCursor < n {
 			return branchPickerItemID(p.branchCursor), nil
 		}
 	}
@@ -217,7 +303,15 @@ func (p *Plugin) branchPickerListUpdate(msg tea.Msg, focusID string) (string, te
 
 func (p *Plugin) branchPickerHintsSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		return modal.RenderedSection{Content: styles.Muted.Render("  Enter to switch, j/k to navigate, Esc to cancel")}
+		scope := "Tab show remotes"
+		if p.branchShowRemote {
+			scope = "Tab local only"
+		}
+		esc := "Esc to cancel"
+		if p.branchFilter != "" {
+			esc = "Esc to clear filter"
+		}
+		return modal.RenderedSection{Content: styles.Muted.Render("  Enter to switch, ↑/↓ to navigate, ctrl+n new, ctrl+d delete,\n  " + scope + ", " + esc)}
 	}, nil)
 }
 
@@ -233,15 +327,16 @@ func (p *Plugin) branchPickerMaxVisible() int {
 }
 
 func (p *Plugin) moveBranchCursor(delta int) {
-	if len(p.branches) == 0 {
+	n := len(p.filteredBranches())
+	if n == 0 {
 		return
 	}
 	newCursor := p.branchCursor + delta
 	if newCursor < 0 {
 		newCursor = 0
 	}
-	if newCursor >= len(p.branches) {
-		newCursor = len(p.branches) - 1
+	if newCursor >= n {
+		newCursor = n - 1
 	}
 	p.branchCursor = newCursor
 }
@@ -251,20 +346,24 @@ func (p *Plugin) switchSelectedBranch() tea.Cmd {
 }
 
 func (p *Plugin) switchBranchByIndex(idx int) tea.Cmd {
-	if idx < 0 || idx >= len(p.branches) {
+	branches := p.filteredBranches()
+	if idx < 0 || idx >= len(branches) {
 		return nil
 	}
 	p.branchCursor = idx
-	branch := p.branches[idx]
+	branch := branches[idx]
 	if branch.IsCurrent {
 		return nil
 	}
-	return p.doSwitchBranch(branch.Name)
+	return p.doSwitchBranch(branch)
 }
 
 func (p *Plugin) closeBranchPicker() {
 	p.viewMode = p.branchReturnMode
 	p.branches = nil
+	p.branchFilter = ""
+	p.closeBranchCreate()
+	p.closeBranchDelete()
 	p.clearBranchPickerModal()
 }
 
@@ -275,6 +374,13 @@ func (p *Plugin) clearBranchPickerModal() {
 
 // renderBranchPicker renders the branch picker modal.
 func (p *Plugin) renderBranchPicker() string {
+	if p.branchCreating {
+		return p.renderBranchCreate()
+	}
+	if p.branchDeleteModal != nil {
+		return p.renderBranchDelete()
+	}
+
 	// Render the background (status view dimmed)
 	background := p.renderThreePaneView()
 
@@ -337,6 +443,8 @@ func (p *Plugin) renderBranchLine(branch *Branch, selected, hovered bool) string
 	nameStyle := styles.Body
 	if branch.IsCurrent {
 		nameStyle = styles.StatusStaged
+	} else if branch.IsRemote {
+		nameStyle = styles.Muted
 	}
 
 	return styles.ListItemNormal.Render(fmt.Sprintf("%s%s%s%s", indicator, nameStyle.Render(name), trackingInfo, upstream))
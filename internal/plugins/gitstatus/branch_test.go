@@ -0,0 +1,224 @@
+package gitstatus
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestParseBranchList(t *testing.T) {
+	output := []byte(`refs/heads/main|*|origin/main|[ahead 2, behind 1]
+refs/heads/feature| ||
+refs/remotes/origin/HEAD| ||
+refs/remotes/origin/main| ||
+refs/remotes/origin/fix| ||
+refs/remotes/upstream/fix| ||
+`)
+
+	branches := parseBranchList(output)
+
+	want := []struct {
+		name     string
+		isRemote bool
+		local    string
+	}{
+		{"main", false, "main"},
+		{"feature", false, "feature"},
+		{"origin/fix", true, "fix"},
+		{"upstream/fix", true, "fix"},
+	}
+	if len(branches) != len(want) {
+		t.Fatalf("got %d branches, want %d", len(branches), len(want))
+	}
+	for i, w := range want {
+		b := branches[i]
+		if b.Name != w.name || b.IsRemote != w.isRemote || b.LocalName() != w.local {
+			t.Errorf("branch %d = {%q remote=%v local=%q}, want {%q remote=%v local=%q}",
+				i, b.Name, b.IsRemote, b.LocalName(), w.name, w.isRemote, w.local)
+		}
+	}
+
+	main := branches[0]
+	if !main.IsCurrent || main.Upstream != "origin/main" || main.Ahead != 2 || main.Behind != 1 {
+		t.Errorf("main = %+v, want current tracking origin/main ahead 2 behind 1", main)
+	}
+}
+
+func TestParseBranchList_LocalOnly(t *testing.T) {
+	branches := parseBranchList([]byte("refs/heads/main|*||\nrefs/heads/dev| |origin/dev|[behind 3]\n"))
+	if len(branches) != 2 {
+		t.Fatalf("got %d branches, want 2", len(branches))
+	}
+	for _, b := range branches {
+		if b.IsRemote {
+			t.Errorf("unexpected remote branch %q", b.Name)
+		}
+	}
+	if branches[1].Behind != 3 {
+		t.Errorf("dev behind = %d, want 3", branches[1].Behind)
+	}
+}
+
+func TestBranchMatchScore_Ranking(t *testing.T) {
+	prefix := branchMatchScore("feat", "feature/login")
+	substring := branchMatchScore("feat", "my-feature")
+	fuzzy := branchMatchScore("feat", "fix-eslint-attempt")
+
+	if prefix <= substring {
+		t.Errorf("prefix score %d should outrank substring score %d", prefix, substring)
+	}
+	if substring <= fuzzy {
+		t.Errorf("substring score %d should outrank fuzzy score %d", substring, fuzzy)
+	}
+	if fuzzy <= 0 {
+		t.Errorf("expected fuzzy subsequence to match, got %d", fuzzy)
+	}
+	if got := branchMatchScore("feat", "main"); got != 0 {
+		t.Errorf("expected no match for main, got %d", got)
+	}
+	if got := branchMatchScore("FEAT", "feature"); got <= substring {
+		t.Errorf("expected case-insensitive prefix match, got %d", got)
+	}
+}
+
+func TestFilterBranches(t *testing.T) {
+	branches := []*Branch{
+		{Name: "main", IsCurrent: true},
+		{Name: "hotfix-login"},
+		{Name: "fix-typo"},
+		{Name: "origin/fixup", IsRemote: true},
+		{Name: "release"},
+	}
+
+	got := filterBranches(branches, "fix")
+	var names []string
+	for _, b := range got {
+		names = append(names, b.Name)
+	}
+	want := []string{"origin/fixup", "fix-typo", "hotfix-login"} // shorter prefix matches first
+	if strings.Join(names, ",") != strings.Join(want, ",") {
+		t.Errorf("filterBranches(fix) = %v, want %v", names, want)
+	}
+
+	if got := filterBranches(branches, ""); len(got) != len(branches) {
+		t.Errorf("empty query should return all branches, got %d", len(got))
+	}
+}
+
+func TestValidateBranchName(t *testing.T) {
+	valid := []string{"main", "feature/login", "fix-123", "release/v1.2", "user@host", "lock/file.locked"}
+	for _, name := range valid {
+		if err := validateBranchName(name); err != nil {
+			t.Errorf("validateBranchName(%q) = %v, want nil", name, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"@",
+		"has space",
+		"tilde~1",
+		"caret^",
+		"colon:x",
+		"what?",
+		"star*",
+		"open[",
+		"back\\slash",
+		"double..dot",
+		"at@{brace",
+		"double//slash",
+		"feature/.hidden",
+		"-leading-dash",
+		".leading-dot",
+		"/leading-slash",
+		"trailing.lock",
+		"feature.lock/child",
+		"a/mid.lock/b",
+		"trailing.",
+		"trailing/",
+		"ctrl\x07char",
+	}
+	for _, name := range invalid {
+		if err := validateBranchName(name); err == nil {
+			t.Errorf("validateBranchName(%q) = nil, want error", name)
+		}
+	}
+}
+
+func TestBranchDeleteNeedsForce(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "unmerged branch",
+			err: &BranchError{Output: "error: the branch 'feature' is not fully merged.\n" +
+				"If you are sure you want to delete it, run 'git branch -D feature'.\n"},
+			want: true,
+		},
+		{
+			name: "checked out in worktree",
+			err:  &BranchError{Output: "error: cannot delete branch 'feature' used by worktree at '/tmp/wt'\n"},
+			want: false,
+		},
+		{
+			name: "branch not found",
+			err:  &BranchError{Output: "error: branch 'nope' not found.\n"},
+			want: false,
+		},
+		{
+			name: "non-git error",
+			err:  errors.New("is not fully merged"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := branchDeleteNeedsForce(tt.err); got != tt.want {
+				t.Errorf("branchDeleteNeedsForce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfirmBranchDelete_RefusesCurrentBranch(t *testing.T) {
+	p := &Plugin{branches: []*Branch{{Name: "main", IsCurrent: true}, {Name: "old"}}}
+
+	if cmd := p.confirmBranchDelete(); cmd == nil {
+		t.Error("expected a toast when deleting the current branch")
+	}
+	if p.branchDeleteModal != nil {
+		t.Fatal("expected no confirmation for the current branch")
+	}
+
+	p.branchCursor = 1
+	p.confirmBranchDelete()
+	if p.branchDeleteModal == nil || p.branchDeleteName != "old" || p.branchDeleteForce {
+		t.Errorf("expected safe delete confirmation for old, got name=%q force=%v", p.branchDeleteName, p.branchDeleteForce)
+	}
+}
+
+func TestUpdateBranchPicker_TypesJAndK(t *testing.T) {
+	p := &Plugin{branches: []*Branch{{Name: "main"}, {Name: "jira-123"}, {Name: "hotfix/kafka"}}}
+
+	for _, r := range "jk" {
+		p.updateBranchPicker(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if p.branchFilter != "jk" {
+		t.Fatalf("branchFilter = %q, want %q", p.branchFilter, "jk")
+	}
+
+	p.setBranchFilter("")
+	p.updateBranchPicker(tea.KeyMsg{Type: tea.KeyDown})
+	if p.branchCursor != 1 || p.branchFilter != "" {
+		t.Errorf("down: cursor = %d, filter = %q; want 1 and empty", p.branchCursor, p.branchFilter)
+	}
+	p.updateBranchPicker(tea.KeyMsg{Type: tea.KeyCtrlK})
+	if p.branchCursor != 0 {
+		t.Errorf("ctrl+k: cursor = %d, want 0", p.branchCursor)
+	}
+}
@@ -0,0 +1,200 @@
+package gitstatus
+
+import (
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// CherryPickOutcome classifies the result of a cherry-pick.
+type CherryPickOutcome int
+
+const (
+	CherryPickApplied  CherryPickOutcome = iota // New commit created on HEAD
+	CherryPickConflict                          // Stopped with conflicts to resolve
+	CherryPickEmpty                             // Changes already present; nothing to commit
+	CherryPickFailed                            // Refused to start (dirty tree, bad ref, ...)
+)
+
+// CherryPickDoneMsg is sent when a cherry-pick finishes.
+type CherryPickDoneMsg struct {
+	ShortHash string
+	Outcome   CherryPickOutcome
+	Err       error
+}
+
+// ExecuteCherryPick applies the commit hash on top of HEAD.
+func ExecuteCherryPick(workDir, hash string) (string, error) {
+	cmd := exec.Command("git", "cherry-pick", hash)
+	cmd.Dir = workDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), &CommitError{Output: string(output), Err: err}
+	}
+	return string(output), nil
+}
+
+// AbortCherryPick runs git cherry-pick --abort.
+func AbortCherryPick(workDir string) error {
+	cmd := exec.Command("git", "cherry-pick", "--abort")
+	cmd.Dir = workDir
+	_, err := cmd.CombinedOutput()
+	return err
+}
+
+// classifyCherryPick maps git cherry-pick output to an outcome. The empty
+// check comes first because git's "now empty" message mentions conflict
+// resolution.
+func classifyCherryPick(output string, err error) CherryPickOutcome {
+	if err == nil {
+		return CherryPickApplied
+	}
+	lower := strings.ToLower(output)
+	switch {
+	case strings.Contains(lower, "is now empty"),
+		strings.Contains(lower, "nothing to commit"):
+		return CherryPickEmpty
+	case strings.Contains(lower, "conflict"),
+		strings.Contains(lower, "could not apply"):
+		return CherryPickConflict
+	}
+	return CherryPickFailed
+}
+
+// confirmCherryPick asks before applying a commit from another branch on HEAD.
+func (p *Plugin) confirmCherryPick(commit *Commit) tea.Cmd {
+	if commit.IsMerge {
+		return msg.ShowToast("Cannot cherry-pick a merge commit", 2*time.Second)
+	}
+
+	dialog := ui.NewConfirmDialog("Cherry-pick Commit?",
+		styles.Subtitle.Render(commit.ShortHash)+" "+commit.Subject+"\n"+
+			styles.Muted.Render("Applies its changes as a new commit on HEAD."))
+	dialog.ConfirmLabel = " Cherry-pick "
+	p.cherryPickModal = dialog.ToModal()
+	p.cherryPickCommit = commit
+	p.viewMode = ViewModeConfirmCherryPick
+	return nil
+}
+
+// closeCherryPick dismisses the picker or confirmation and returns to the
+// status view.
+func (p *Plugin) closeCherryPick() {
+	p.cherryPickModal = nil
+	p.cherryPickCommit = nil
+	p.cherryPickBranches = nil
+	p.cherryPickSource = ""
+	p.cherryPickCommits = nil
+	p.viewMode = ViewModeStatus
+	p.clearCherryPickPickerModal()
+}
+
+// cancelCherryPickConfirm returns from the confirmation to the commit list.
+func (p *Plugin) cancelCherryPickConfirm() {
+	p.cherryPickModal = nil
+	p.cherryPickCommit = nil
+	p.viewMode = ViewModeCherryPickPicker
+}
+
+// executeCherryPick closes the confirmation and runs the cherry-pick.
+func (p *Plugin) executeCherryPick() tea.Cmd {
+	commit := p.cherryPickCommit
+	p.closeCherryPick()
+	if commit == nil {
+		return nil
+	}
+	return p.doCherryPick(commit.Hash, commit.ShortHash)
+}
+
+// doCherryPick runs git cherry-pick asynchronously. An empty pick leaves
+// git mid-sequence, so it is aborted to restore a clean state.
+func (p *Plugin) doCherryPick(hash, shortHash string) tea.Cmd {
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		output, err := ExecuteCherryPick(workDir, hash)
+		outcome := classifyCherryPick(output, err)
+		if outcome == CherryPickEmpty {
+			_ = AbortCherryPick(workDir)
+		}
+		return CherryPickDoneMsg{ShortHash: shortHash, Outcome: outcome, Err: err}
+	}
+}
+
+// handleCherryPickDone reports the result, routing conflicts into the
+// conflict resolution modal.
+func (p *Plugin) handleCherryPickDone(m CherryPickDoneMsg) tea.Cmd {
+	switch m.Outcome {
+	case CherryPickApplied:
+		return tea.Batch(
+			msg.ShowToast("Cherry-picked "+m.ShortHash, 2*time.Second),
+			p.refresh(),
+			p.loadRecentCommits(),
+		)
+	case CherryPickEmpty:
+		return msg.ShowToast("Nothing to cherry-pick: "+m.ShortHash+" is already applied", 3*time.Second)
+	case CherryPickConflict:
+		p.pullConflictType = "cherry-pick"
+		p.pullConflictFiles = GetConflictedFiles(p.repoRoot)
+		p.pullConflictResolved = nil
+		p.pullConflictIdx = 0
+		p.viewMode = ViewModePullConflict
+		p.clearPullConflictModal()
+		return p.refresh()
+	}
+	p.showErrorModal("Cherry-pick Failed", m.Err)
+	return nil
+}
+
+// updateConfirmCherryPick handles key events in the cherry-pick confirmation.
+func (p *Plugin) updateConfirmCherryPick(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	if p.cherryPickModal == nil {
+		p.closeCherryPick()
+		return p, nil
+	}
+
+	// Quick confirm shortcut
+	switch msg.String() {
+	case "y", "Y":
+		return p, p.executeCherryPick()
+	}
+
+	action, cmd := p.cherryPickModal.HandleKey(msg)
+	switch action {
+	case "confirm":
+		return p, p.executeCherryPick()
+	case "cancel":
+		p.cancelCherryPickConfirm()
+	}
+	return p, cmd
+}
+
+// handleCherryPickMouse handles mouse events for the cherry-pick confirmation.
+func (p *Plugin) handleCherryPickMouse(msg tea.MouseMsg) (plugin.Plugin, tea.Cmd) {
+	if p.cherryPickModal == nil {
+		return p, nil
+	}
+
+	switch p.cherryPickModal.HandleMouse(msg, p.mouseHandler) {
+	case "confirm":
+		return p, p.executeCherryPick()
+	case "cancel":
+		p.cancelCherryPickConfirm()
+	}
+	return p, nil
+}
+
+// renderConfirmCherryPick renders the cherry-pick confirmation overlay.
+func (p *Plugin) renderConfirmCherryPick() string {
+	background := p.renderThreePaneView()
+	if p.cherryPickModal == nil {
+		return background
+	}
+	modalContent := p.cherryPickModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
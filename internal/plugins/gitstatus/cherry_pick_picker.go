@@ -0,0 +1,288 @@
+package gitstatus
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/modal"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+const (
+	cherryPickBranchPrefix = "cherry-pick-branch-"
+	cherryPickCommitPrefix = "cherry-pick-commit-"
+	cherryPickMaxVisible   = 10
+	cherryPickHistoryMax   = 50
+)
+
+// CherryPickBranchesLoadedMsg is sent when the branches the cherry-pick
+// picker can take commits from are loaded.
+type CherryPickBranchesLoadedMsg struct {
+	Epoch    uint64 // Epoch when request was issued (for stale detection)
+	Branches []*Branch
+	Err      error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m CherryPickBranchesLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// CherryPickCommitsLoadedMsg is sent when the commits a branch has that HEAD
+// lacks are loaded.
+type CherryPickCommitsLoadedMsg struct {
+	Epoch   uint64 // Epoch when request was issued (for stale detection)
+	Branch  string
+	Commits []*Commit
+	Err     error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m CherryPickCommitsLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// GetCherryPickCandidates returns the non-merge commits reachable from branch
+// but not from HEAD, newest first. Commits HEAD already contains are never
+// offered, since picking them would be a no-op.
+func GetCherryPickCandidates(workDir, branch string, limit int) ([]*Commit, error) {
+	return GetCommitHistoryFiltered(workDir, HistoryFilterOpts{
+		Range:    "HEAD.." + branch,
+		NoMerges: true,
+		Limit:    limit,
+	})
+}
+
+// cherryPickSourceBranches drops the current branch, which has nothing HEAD
+// lacks.
+func cherryPickSourceBranches(branches []*Branch) []*Branch {
+	sources := make([]*Branch, 0, len(branches))
+	for _, b := range branches {
+		if !b.IsCurrent {
+			sources = append(sources, b)
+		}
+	}
+	return sources
+}
+
+func parseCherryPickItem(id, prefix string) (int, bool) {
+	if !strings.HasPrefix(id, prefix) {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(strings.TrimPrefix(id, prefix))
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
+// openCherryPickPicker asks which branch to cherry-pick from.
+func (p *Plugin) openCherryPickPicker() tea.Cmd {
+	p.cherryPickBranches = nil
+	p.cherryPickBranchesLoaded = false
+	p.cherryPickSource = ""
+	p.cherryPickErr = nil
+	p.cherryPickIdx = 0
+	p.viewMode = ViewModeCherryPickPicker
+	p.clearCherryPickPickerModal()
+
+	epoch := p.ctx.Epoch
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		branches, err := GetBranches(workDir, true)
+		return CherryPickBranchesLoadedMsg{Epoch: epoch, Branches: cherryPickSourceBranches(branches), Err: err}
+	}
+}
+
+// setCherryPickBranches fills the picker once branches load.
+func (p *Plugin) setCherryPickBranches(branches []*Branch, err error) {
+	if p.cherryPickSource != "" {
+		return
+	}
+	p.cherryPickBranches = branches
+	p.cherryPickErr = err
+	p.cherryPickBranchesLoaded = true
+	p.cherryPickIdx = 0
+	p.clearCherryPickPickerModal()
+}
+
+// selectCherryPickBranch loads the commits of the branch at idx that HEAD
+// doesn't have.
+func (p *Plugin) selectCherryPickBranch(idx int) tea.Cmd {
+	if idx < 0 || idx >= len(p.cherryPickBranches) {
+		return nil
+	}
+	branch := p.cherryPickBranches[idx].Name
+	p.cherryPickSource = branch
+	p.cherryPickCommits = nil
+	p.cherryPickCommitsLoaded = false
+	p.cherryPickErr = nil
+	p.cherryPickBranchIdx = idx
+	p.cherryPickIdx = 0
+	p.clearCherryPickPickerModal()
+
+	epoch := p.ctx.Epoch
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		commits, err := GetCherryPickCandidates(workDir, branch, cherryPickHistoryMax)
+		return CherryPickCommitsLoadedMsg{Epoch: epoch, Branch: branch, Commits: commits, Err: err}
+	}
+}
+
+// setCherryPickCommits fills the commit list once it loads.
+func (p *Plugin) setCherryPickCommits(branch string, commits []*Commit, err error) {
+	if branch != p.cherryPickSource {
+		return
+	}
+	p.cherryPickCommits = commits
+	p.cherryPickErr = err
+	p.cherryPickCommitsLoaded = true
+	p.cherryPickIdx = 0
+	p.clearCherryPickPickerModal()
+}
+
+// backCherryPickPicker returns from the commit list to the branch list, or
+// closes the picker when already there.
+func (p *Plugin) backCherryPickPicker() {
+	if p.cherryPickSource == "" {
+		p.closeCherryPick()
+		return
+	}
+	p.cherryPickSource = ""
+	p.cherryPickCommits = nil
+	p.cherryPickErr = nil
+	p.cherryPickIdx = p.cherryPickBranchIdx
+	p.clearCherryPickPickerModal()
+}
+
+// selectCherryPickCommit asks for confirmation before applying the commit.
+func (p *Plugin) selectCherryPickCommit(idx int) tea.Cmd {
+	if idx < 0 || idx >= len(p.cherryPickCommits) {
+		return nil
+	}
+	return p.confirmCherryPick(p.cherryPickCommits[idx])
+}
+
+func (p *Plugin) clearCherryPickPickerModal() {
+	p.cherryPickPickerModal = nil
+	p.cherryPickPickerWidth = 0
+}
+
+// handleCherryPickPickerAction acts on a modal action from the picker.
+func (p *Plugin) handleCherryPickPickerAction(action string) tea.Cmd {
+	if idx, ok := parseCherryPickItem(action, cherryPickBranchPrefix); ok {
+		return p.selectCherryPickBranch(idx)
+	}
+	if idx, ok := parseCherryPickItem(action, cherryPickCommitPrefix); ok {
+		return p.selectCherryPickCommit(idx)
+	}
+	if action == "cancel" {
+		p.backCherryPickPicker()
+	}
+	return nil
+}
+
+// updateCherryPickPicker handles keys in the cherry-pick branch/commit picker.
+func (p *Plugin) updateCherryPickPicker(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	p.ensureCherryPickPickerModal()
+	if p.cherryPickPickerModal == nil {
+		return p, nil
+	}
+
+	if msg.String() == "q" {
+		p.closeCherryPick()
+		return p, nil
+	}
+
+	action, cmd := p.cherryPickPickerModal.HandleKey(msg)
+	if action != "" {
+		return p, p.handleCherryPickPickerAction(action)
+	}
+	return p, cmd
+}
+
+// handleCherryPickPickerMouse processes mouse events in the cherry-pick picker.
+func (p *Plugin) handleCherryPickPickerMouse(msg tea.MouseMsg) (plugin.Plugin, tea.Cmd) {
+	p.ensureCherryPickPickerModal()
+	if p.cherryPickPickerModal == nil {
+		return p, nil
+	}
+
+	action := p.cherryPickPickerModal.HandleMouse(msg, p.mouseHandler)
+	if action != "" {
+		return p, p.handleCherryPickPickerAction(action)
+	}
+	return p, nil
+}
+
+// ensureCherryPickPickerModal builds/rebuilds the cherry-pick picker modal.
+func (p *Plugin) ensureCherryPickPickerModal() {
+	modalW := ui.ModalWidthMedium
+	if modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < pullMenuMinWidth {
+		modalW = pullMenuMinWidth
+	}
+
+	if p.cherryPickPickerModal != nil && p.cherryPickPickerWidth == modalW {
+		return
+	}
+	p.cherryPickPickerWidth = modalW
+
+	if p.cherryPickSource == "" {
+		m := modal.New("Cherry-pick from",
+			modal.WithWidth(modalW),
+			modal.WithHints(false),
+		)
+		switch {
+		case !p.cherryPickBranchesLoaded:
+			m.AddSection(modal.Text("Loading branches..."))
+		case p.cherryPickErr != nil:
+			m.AddSection(modal.Text("Cannot list branches: " + p.cherryPickErr.Error()))
+		case len(p.cherryPickBranches) == 0:
+			m.AddSection(modal.Text("No other branches to cherry-pick from."))
+		default:
+			items := make([]modal.ListItem, len(p.cherryPickBranches))
+			for i, b := range p.cherryPickBranches {
+				items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", cherryPickBranchPrefix, i), Label: b.Name}
+			}
+			m.AddSection(modal.List("cherry-pick-branches", items, &p.cherryPickIdx, modal.WithMaxVisible(cherryPickMaxVisible)))
+		}
+		p.cherryPickPickerModal = m
+		return
+	}
+
+	m := modal.New("Cherry-pick from "+truncateDiffPath(p.cherryPickSource, modalW-24),
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	)
+	switch {
+	case !p.cherryPickCommitsLoaded:
+		m.AddSection(modal.Text("Loading commits..."))
+	case p.cherryPickErr != nil:
+		m.AddSection(modal.Text("Cannot load commits: " + p.cherryPickErr.Error()))
+	case len(p.cherryPickCommits) == 0:
+		m.AddSection(modal.Text("HEAD already has every commit on this branch."))
+	default:
+		items := make([]modal.ListItem, len(p.cherryPickCommits))
+		for i, c := range p.cherryPickCommits {
+			label := fmt.Sprintf("%s %s · %s", c.ShortHash, c.Subject, RelativeTime(c.Date))
+			items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", cherryPickCommitPrefix, i), Label: label}
+		}
+		m.AddSection(modal.List("cherry-pick-commits", items, &p.cherryPickIdx, modal.WithMaxVisible(cherryPickMaxVisible)))
+	}
+	p.cherryPickPickerModal = m
+}
+
+// renderCherryPickPicker renders the cherry-pick picker over the status view.
+func (p *Plugin) renderCherryPickPicker() string {
+	background := p.renderThreePaneView()
+
+	p.ensureCherryPickPickerModal()
+	if p.cherryPickPickerModal == nil {
+		return background
+	}
+
+	modalContent := p.cherryPickPickerModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
@@ -0,0 +1,182 @@
+package gitstatus
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/marcus/sidecar/internal/testutil"
+)
+
+func TestClassifyCherryPick(t *testing.T) {
+	exit1 := errors.New("exit status 1")
+	tests := []struct {
+		name   string
+		output string
+		err    error
+		want   CherryPickOutcome
+	}{
+		{
+			name:   "applied",
+			output: "[main 1a2b3c4] Fix login redirect\n 1 file changed, 2 insertions(+)",
+			want:   CherryPickApplied,
+		},
+		{
+			name: "content conflict",
+			output: "Auto-merging main.go\nCONFLICT (content): Merge conflict in main.go\n" +
+				"error: could not apply 1a2b3c4... Fix login redirect\n" +
+				"hint: After resolving the conflicts, mark them with\nhint: \"git add/rm <pathspec>\", then run\nhint: \"git cherry-pick --continue\".",
+			err:  exit1,
+			want: CherryPickConflict,
+		},
+		{
+			name:   "modify/delete conflict",
+			output: "CONFLICT (modify/delete): old.go deleted in HEAD and modified in 1a2b3c4.",
+			err:    exit1,
+			want:   CherryPickConflict,
+		},
+		{
+			name: "already applied",
+			output: "On branch main\nYou are currently cherry-picking commit 1a2b3c4.\n\n" +
+				"nothing to commit, working tree clean\n" +
+				"The previous cherry-pick is now empty, possibly due to conflict resolution.\n" +
+				"If you wish to commit it anyway, use:\n\n    git commit --allow-empty",
+			err:  exit1,
+			want: CherryPickEmpty,
+		},
+		{
+			name:   "dirty worktree",
+			output: "error: your local changes would be overwritten by cherry-pick.\nhint: commit your changes or stash them to proceed.\nfatal: cherry-pick failed",
+			err:    exit1,
+			want:   CherryPickFailed,
+		},
+		{
+			name:   "merge commit",
+			output: "error: commit 1a2b3c4 is a merge but no -m option was given.\nfatal: cherry-pick failed",
+			err:    exit1,
+			want:   CherryPickFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifyCherryPick(tt.output, tt.err); got != tt.want {
+				t.Errorf("classifyCherryPick() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecuteCherryPick_Outcomes(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git("init", "-q", "-b", "main")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test")
+	write("a.txt", "one\n")
+	git("add", "a.txt")
+	git("commit", "-q", "-m", "init")
+
+	git("checkout", "-q", "-b", "feature")
+	write("b.txt", "feature\n")
+	git("add", "b.txt")
+	git("commit", "-q", "-m", "add b")
+	addB := git("rev-parse", "HEAD")
+	write("a.txt", "feature\n")
+	git("commit", "-q", "-am", "edit a")
+	editA := git("rev-parse", "HEAD")
+	git("checkout", "-q", "main")
+
+	output, err := ExecuteCherryPick(dir, addB)
+	if got := classifyCherryPick(output, err); got != CherryPickApplied {
+		t.Fatalf("first pick = %d (%v), want applied", got, err)
+	}
+
+	output, err = ExecuteCherryPick(dir, addB)
+	if got := classifyCherryPick(output, err); got != CherryPickEmpty {
+		t.Fatalf("repeat pick = %d (%v), want empty", got, err)
+	}
+	if err := AbortCherryPick(dir); err != nil {
+		t.Fatalf("AbortCherryPick after empty pick: %v", err)
+	}
+
+	write("a.txt", "main\n")
+	git("commit", "-q", "-am", "edit a on main")
+	output, err = ExecuteCherryPick(dir, editA)
+	if got := classifyCherryPick(output, err); got != CherryPickConflict {
+		t.Fatalf("conflicting pick = %d (%v), want conflict", got, err)
+	}
+	if files := GetConflictedFiles(dir); len(files) != 1 || files[0] != "a.txt" {
+		t.Errorf("GetConflictedFiles() = %v, want [a.txt]", files)
+	}
+	if err := AbortCherryPick(dir); err != nil {
+		t.Fatalf("AbortCherryPick: %v", err)
+	}
+	if status := git("status", "--porcelain"); status != "" {
+		t.Errorf("worktree not clean after abort:\n%s", status)
+	}
+}
+
+func TestGetCherryPickCandidates(t *testing.T) {
+	dir, git := testutil.NewGitRepo(t)
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a.txt", "one\n")
+	git("add", "a.txt")
+	git("commit", "-q", "-m", "init")
+	git("checkout", "-q", "-b", "feature")
+	write("b.txt", "feature\n")
+	git("add", "b.txt")
+	git("commit", "-q", "-m", "add b")
+	write("c.txt", "feature\n")
+	git("add", "c.txt")
+	git("commit", "-q", "-m", "add c")
+	git("checkout", "-q", "main")
+
+	commits, err := GetCherryPickCandidates(dir, "feature", 10)
+	if err != nil {
+		t.Fatalf("GetCherryPickCandidates: %v", err)
+	}
+	var subjects []string
+	for _, c := range commits {
+		subjects = append(subjects, c.Subject)
+	}
+	if got := strings.Join(subjects, ","); got != "add c,add b" {
+		t.Errorf("candidates = %q, want %q (commits on HEAD excluded)", got, "add c,add b")
+	}
+
+	git("merge", "-q", "--ff-only", "feature")
+	if commits, err := GetCherryPickCandidates(dir, "feature", 10); err != nil || len(commits) != 0 {
+		t.Errorf("after merge: candidates = %d (%v), want none", len(commits), err)
+	}
+}
+
+func TestCherryPickSourceBranches(t *testing.T) {
+	branches := []*Branch{{Name: "main", IsCurrent: true}, {Name: "feature"}, {Name: "origin/fix", IsRemote: true}}
+	got := cherryPickSourceBranches(branches)
+	if len(got) != 2 || got[0].Name != "feature" || got[1].Name != "origin/fix" {
+		t.Errorf("cherryPickSourceBranches() = %v, want feature and origin/fix", got)
+	}
+}
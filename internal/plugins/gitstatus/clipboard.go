@@ -55,6 +55,66 @@ func (p *Plugin) getCurrentCommit() *Commit {
 	return nil
 }
 
+// copyDiffLineReference copies a "path:Lnn" reference to the line at the top
+// of a diff view to clipboard.
+func copyDiffLineReference(diff *ParsedDiff, path string, scroll int, sideBySide bool) tea.Cmd {
+	line, ok := diffLineAtRow(diff, scroll, sideBySide)
+	if !ok {
+		return msg.ShowToast("No diff line to reference", 2*time.Second)
+	}
+
+	ref := formatLineReference(diff, path, line)
+	if err := clipboard.WriteAll(ref); err != nil {
+		return msg.ShowToast("Copy failed: "+err.Error(), 2*time.Second)
+	}
+	return msg.ShowToast("Yanked: "+ref, 2*time.Second)
+}
+
+// diffLineAtRow returns the diff line shown at rendered row of a diff view,
+// counting rows the way hunkAtLine does. A hunk header resolves to the
+// hunk's first line; a side-by-side pair resolves to its new-file side.
+func diffLineAtRow(diff *ParsedDiff, row int, sideBySide bool) (DiffLine, bool) {
+	if diff == nil || row < 0 {
+		return DiffLine{}, false
+	}
+	start := 0
+	for _, hunk := range diff.Hunks {
+		if sideBySide {
+			pairs := groupLinesForSideBySide(hunk.Lines)
+			if row <= start+len(pairs) && len(pairs) > 0 {
+				pair := pairs[max(row-start-1, 0)]
+				if pair.right != nil {
+					return *pair.right, true
+				}
+				return *pair.left, true
+			}
+			start += len(pairs) + 1
+			continue
+		}
+		if row <= start+len(hunk.Lines) && len(hunk.Lines) > 0 {
+			return hunk.Lines[max(row-start-1, 0)], true
+		}
+		start += len(hunk.Lines) + 1
+	}
+	return DiffLine{}, false
+}
+
+// formatLineReference formats line as "path:Lnn" using its new-file line
+// number. Removed lines only exist in the old file, so they reference the
+// old path and line number, marked "(old)".
+func formatLineReference(diff *ParsedDiff, path string, line DiffLine) string {
+	if line.Type == LineRemove {
+		if diff.OldFile != "" && diff.OldFile != "/dev/null" {
+			path = diff.OldFile
+		}
+		return fmt.Sprintf("%s:L%d (old)", path, line.OldLineNo)
+	}
+	if diff.NewFile != "" && diff.NewFile != "/dev/null" {
+		path = diff.NewFile
+	}
+	return fmt.Sprintf("%s:L%d", path, line.NewLineNo)
+}
+
 // formatCommitAsMarkdown formats a commit as markdown for clipboard.
 func formatCommitAsMarkdown(commit *Commit) string {
 	var sb strings.Builder
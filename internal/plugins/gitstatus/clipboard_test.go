@@ -167,3 +167,75 @@ func TestGetCurrentCommit(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffLineAtRow(t *testing.T) {
+	diff, err := ParseUnifiedDiff(`diff --git a/main.go b/main.go
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,3 @@
+ package main
+-var a = 1
++var a = 2
+ var b = 3
+@@ -10,2 +10,3 @@ func main() {
+ 	x := 1
++	y := 2
+ 	z := 3`)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		row        int
+		sideBySide bool
+		want       string
+		ok         bool
+	}{
+		{"first hunk header", 0, false, "main.go:L1", true},
+		{"removed line", 2, false, "main.go:L2 (old)", true},
+		{"added line", 3, false, "main.go:L2", true},
+		{"context line", 4, false, "main.go:L3", true},
+		{"second hunk header", 5, false, "main.go:L10", true},
+		{"added line in second hunk", 7, false, "main.go:L11", true},
+		{"context after add", 8, false, "main.go:L12", true},
+		{"past the end", 9, false, "", false},
+		{"side-by-side pair prefers new side", 2, true, "main.go:L2", true},
+		{"side-by-side context", 3, true, "main.go:L3", true},
+		{"side-by-side second hunk add", 6, true, "main.go:L11", true},
+		{"side-by-side past the end", 8, true, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			line, ok := diffLineAtRow(diff, tt.row, tt.sideBySide)
+			if ok != tt.ok {
+				t.Fatalf("diffLineAtRow(%d) ok = %v, want %v", tt.row, ok, tt.ok)
+			}
+			if !ok {
+				return
+			}
+			if got := formatLineReference(diff, "fallback.go", line); got != tt.want {
+				t.Errorf("reference = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatLineReference_Renames(t *testing.T) {
+	diff := &ParsedDiff{OldFile: "old/name.go", NewFile: "new/name.go"}
+
+	if got := formatLineReference(diff, "x.go", DiffLine{Type: LineAdd, NewLineNo: 4}); got != "new/name.go:L4" {
+		t.Errorf("added line reference = %q", got)
+	}
+	if got := formatLineReference(diff, "x.go", DiffLine{Type: LineRemove, OldLineNo: 9}); got != "old/name.go:L9 (old)" {
+		t.Errorf("removed line reference = %q", got)
+	}
+
+	deleted := &ParsedDiff{OldFile: "gone.go", NewFile: "/dev/null"}
+	if got := formatLineReference(deleted, "gone.go", DiffLine{Type: LineRemove, OldLineNo: 1}); got != "gone.go:L1 (old)" {
+		t.Errorf("deleted file reference = %q", got)
+	}
+	if got := formatLineReference(&ParsedDiff{}, "entry.go", DiffLine{Type: LineContext, OldLineNo: 2, NewLineNo: 3}); got != "entry.go:L3" {
+		t.Errorf("fallback path reference = %q", got)
+	}
+}
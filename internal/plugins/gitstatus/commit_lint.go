@@ -0,0 +1,51 @@
+package gitstatus
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Conventional limits for commit message lines.
+const (
+	commitSubjectMaxLen = 50
+	commitBodyMaxLen    = 72
+)
+
+// CommitLintWarning is a style problem found in a commit message.
+type CommitLintWarning struct {
+	Line    int // 1-based line number in the message
+	Message string
+}
+
+// LintCommitMessage checks the subject and body line lengths of message.
+// Warnings are advisory; they never block a commit. Comment lines (#) are
+// checked too, since git commit -m keeps them in the message.
+func LintCommitMessage(message string) []CommitLintWarning {
+	var warnings []CommitLintWarning
+	subjectSeen := false
+	for i, line := range strings.Split(message, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		n := utf8.RuneCountInString(line)
+		if !subjectSeen {
+			if line == "" {
+				continue
+			}
+			subjectSeen = true
+			if n > commitSubjectMaxLen {
+				warnings = append(warnings, CommitLintWarning{
+					Line:    i + 1,
+					Message: fmt.Sprintf("Subject is %d chars (max %d)", n, commitSubjectMaxLen),
+				})
+			}
+			continue
+		}
+		if n > commitBodyMaxLen {
+			warnings = append(warnings, CommitLintWarning{
+				Line:    i + 1,
+				Message: fmt.Sprintf("Line %d is %d chars (max %d)", i+1, n, commitBodyMaxLen),
+			})
+		}
+	}
+	return warnings
+}
@@ -0,0 +1,65 @@
+package gitstatus
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLintCommitMessage(t *testing.T) {
+	long := func(n int) string { return strings.Repeat("x", n) }
+
+	tests := []struct {
+		name    string
+		message string
+		want    []CommitLintWarning
+	}{
+		{
+			name:    "empty",
+			message: "",
+		},
+		{
+			name:    "within limits",
+			message: long(50) + "\n\n" + long(72) + "\n" + long(10),
+		},
+		{
+			name:    "long subject",
+			message: long(51),
+			want:    []CommitLintWarning{{Line: 1, Message: "Subject is 51 chars (max 50)"}},
+		},
+		{
+			name:    "long body lines",
+			message: "fix: short\n\n" + long(73) + "\nok\n" + long(80),
+			want: []CommitLintWarning{
+				{Line: 3, Message: "Line 3 is 73 chars (max 72)"},
+				{Line: 5, Message: "Line 5 is 80 chars (max 72)"},
+			},
+		},
+		{
+			name:    "counts runes not bytes",
+			message: strings.Repeat("é", 50),
+		},
+		{
+			name:    "ignores trailing whitespace",
+			message: long(50) + "   \t",
+		},
+		{
+			name:    "leading blanks are skipped",
+			message: "\n\n" + long(60),
+			want:    []CommitLintWarning{{Line: 3, Message: "Subject is 60 chars (max 50)"}},
+		},
+		{
+			name:    "comment lines are checked",
+			message: "# " + long(80),
+			want:    []CommitLintWarning{{Line: 1, Message: "Subject is 82 chars (max 50)"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LintCommitMessage(tt.message); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LintCommitMessage() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package gitstatus
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// defaultCommitTemplate is read from the repository root when
+// commit.template is not configured.
+const defaultCommitTemplate = ".gitmessage"
+
+// commitTemplatePath returns the configured commit.template path, falling
+// back to .gitmessage in the repository root. Relative paths resolve against
+// workDir and a leading ~/ against the home directory.
+func commitTemplatePath(workDir string) string {
+	cmd := exec.Command("git", "config", "--get", "commit.template")
+	cmd.Dir = workDir
+	output, err := cmd.Output()
+	path := strings.TrimSpace(string(output))
+	if err != nil || path == "" {
+		return filepath.Join(workDir, defaultCommitTemplate)
+	}
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[2:])
+		}
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workDir, path)
+	}
+	return path
+}
+
+// LoadCommitTemplate returns the commit template for the repository with
+// comment lines removed, or "" if there is none.
+func LoadCommitTemplate(workDir string) string {
+	data, err := os.ReadFile(commitTemplatePath(workDir))
+	if err != nil {
+		return ""
+	}
+	return stripCommitComments(string(data))
+}
+
+// stripCommitComments drops # comment lines and trailing blank lines, as git
+// does when it cleans up an edited message. Trailing spaces are kept so a
+// "feat: " prefix leaves the cursor ready to type.
+func stripCommitComments(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+// applyCommitTemplate pre-fills the commit message with the repository's
+// template and places the cursor at the end of the subject line.
+func (p *Plugin) applyCommitTemplate() {
+	p.commitTemplate = LoadCommitTemplate(p.repoRoot)
+	if p.commitTemplate == "" {
+		return
+	}
+	p.commitMessage.SetValue(p.commitTemplate)
+	for i := len(p.commitTemplate); i > 0 && p.commitMessage.Line() > 0; i-- {
+		p.commitMessage.CursorUp()
+	}
+	p.commitMessage.CursorEnd()
+}
+
+// commitMessageIsTemplate reports whether the message is still the
+// untouched template it was pre-filled with.
+func (p *Plugin) commitMessageIsTemplate() bool {
+	return p.commitTemplate != "" &&
+		strings.TrimSpace(p.commitMessage.Value()) == strings.TrimSpace(p.commitTemplate)
+}
@@ -0,0 +1,70 @@
+package gitstatus
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestStripCommitComments(t *testing.T) {
+	content := "feat: \r\n\r\n# Why is this change needed?\r\nRefs: \r\n\r\n# Lines starting with # are ignored\r\n\r\n"
+	if got, want := stripCommitComments(content), "feat: \n\nRefs: "; got != want {
+		t.Errorf("stripCommitComments() = %q, want %q", got, want)
+	}
+}
+
+func TestLoadCommitTemplate(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git("init", "-q")
+	// Keep a global/system commit.template from leaking into the test
+	git("config", "commit.template", "")
+
+	if got := LoadCommitTemplate(dir); got != "" {
+		t.Errorf("no template: got %q, want empty", got)
+	}
+
+	write(".gitmessage", "# comment\nfix: ")
+	if got := LoadCommitTemplate(dir); got != "fix: " {
+		t.Errorf(".gitmessage fallback: got %q, want %q", got, "fix: ")
+	}
+
+	write("template.txt", "feat: \n")
+	git("config", "commit.template", "template.txt")
+	if got := LoadCommitTemplate(dir); got != "feat: " {
+		t.Errorf("commit.template: got %q, want %q", got, "feat: ")
+	}
+}
+
+func TestCommitMessageIsTemplate(t *testing.T) {
+	p := &Plugin{}
+	p.initCommitTextarea()
+	p.commitMessage.SetValue("feat: ")
+	if p.commitMessageIsTemplate() {
+		t.Error("no template loaded: want false")
+	}
+
+	p.commitTemplate = "feat: "
+	if !p.commitMessageIsTemplate() {
+		t.Error("untouched template: want true")
+	}
+	p.commitMessage.SetValue("feat: add thing")
+	if p.commitMessageIsTemplate() {
+		t.Error("edited template: want false")
+	}
+}
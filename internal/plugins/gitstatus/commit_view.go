@@ -42,6 +42,7 @@ func (p *Plugin) ensureCommitModal() {
 		AddSection(p.commitStagedSection()).
 		AddSection(modal.Spacer()).
 		AddSection(modal.Textarea(commitMessageID, &p.commitMessage, 4)).
+		AddSection(p.commitLintSection()).
 		AddSection(modal.When(p.showCommitAmendToggle, modal.CheckboxDisplay("Amend last commit", &p.commitAmend, "ctrl+a"))).
 		AddSection(p.commitStatusSection()).
 		AddSection(modal.Buttons(
@@ -153,6 +154,22 @@ func (p *Plugin) commitStagedSection() modal.Section {
 	}, nil)
 }
 
+// commitLintSection shows live, non-blocking hints for the message: line
+// lengths, and a template that hasn't been edited yet.
+func (p *Plugin) commitLintSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		warnings := LintCommitMessage(p.commitMessage.Value())
+		lines := make([]string, 0, len(warnings)+1)
+		if p.commitMessageIsTemplate() {
+			lines = append(lines, styles.StatusModified.Render("⚠ Message is still the unedited template"))
+		}
+		for _, w := range warnings {
+			lines = append(lines, styles.StatusModified.Render("⚠ "+w.Message))
+		}
+		return modal.RenderedSection{Content: strings.Join(lines, "\n")}
+	}, nil)
+}
+
 func (p *Plugin) commitStatusSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		lines := make([]string, 0, 2)
@@ -0,0 +1,147 @@
+package gitstatus
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// amendCheck is the result of checking whether the last commit can be amended.
+type amendCheck int
+
+const (
+	amendAllowed    amendCheck = iota
+	amendNoCommits             // Nothing to amend
+	amendLastPushed            // Last commit is on the upstream; amending rewrites published history
+)
+
+// checkAmend decides whether amending the last commit is allowed outright,
+// impossible, or needs confirmation because the commit is already pushed.
+// commits are most-recent first.
+func checkAmend(commits []*Commit, ps *PushStatus) amendCheck {
+	if len(commits) == 0 {
+		return amendNoCommits
+	}
+	if ps != nil && ps.IsCommitPushed(commits[0].Hash) {
+		return amendLastPushed
+	}
+	return amendAllowed
+}
+
+// startAmend enters amend mode after checking the guard. fromCommit is set
+// when amend is toggled from inside the commit modal, so the message being
+// typed is kept.
+func (p *Plugin) startAmend(fromCommit bool) tea.Cmd {
+	switch checkAmend(p.recentCommits, p.pushStatus) {
+	case amendNoCommits:
+		return msg.ShowToast("No commits to amend", 2*time.Second)
+	case amendLastPushed:
+		p.amendFromCommit = fromCommit
+		p.buildAmendConfirmModal()
+		p.viewMode = ViewModeConfirmAmend
+		return nil
+	}
+	p.enterAmendMode(fromCommit)
+	return nil
+}
+
+// enterAmendMode opens the commit modal in amend mode, pre-filled with the
+// last commit's message unless a message is already being typed. An
+// untouched template counts as no message.
+func (p *Plugin) enterAmendMode(fromCommit bool) {
+	if !fromCommit {
+		p.initCommitTextarea()
+	}
+	p.viewMode = ViewModeCommit
+	p.commitAmend = true
+	// Invalidate modal cache to rebuild with new state
+	p.commitModal = nil
+	p.commitModalWidthCache = 0
+	if strings.TrimSpace(p.commitMessage.Value()) == "" || p.commitMessageIsTemplate() {
+		p.commitMessage.SetValue(getLastCommitMessage(p.repoRoot))
+	}
+}
+
+// buildAmendConfirmModal creates the warning shown before amending a pushed commit.
+func (p *Plugin) buildAmendConfirmModal() {
+	upstream := "the remote"
+	if p.pushStatus != nil && p.pushStatus.UpstreamBranch != "" {
+		upstream = p.pushStatus.UpstreamBranch
+	}
+
+	dialog := ui.NewConfirmDialog("Amend Pushed Commit?",
+		"The last commit is already pushed to "+styles.Subtitle.Render(upstream)+".\n"+
+			styles.Muted.Render("Amending rewrites it and will require a force push."))
+	dialog.ConfirmLabel = " Amend "
+	dialog.BorderColor = styles.Warning
+	p.amendConfirmModal = dialog.ToModal()
+}
+
+// closeAmendConfirm returns to where amend was requested from.
+func (p *Plugin) closeAmendConfirm() {
+	p.amendConfirmModal = nil
+	if p.amendFromCommit {
+		p.viewMode = ViewModeCommit
+	} else {
+		p.viewMode = ViewModeStatus
+	}
+}
+
+// confirmAmend proceeds with amending a pushed commit.
+func (p *Plugin) confirmAmend() {
+	p.amendConfirmModal = nil
+	p.enterAmendMode(p.amendFromCommit)
+}
+
+// updateConfirmAmend handles key events in the amend confirmation modal.
+func (p *Plugin) updateConfirmAmend(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	if p.amendConfirmModal == nil {
+		p.buildAmendConfirmModal()
+	}
+
+	// Quick confirm shortcut
+	switch msg.String() {
+	case "y", "Y":
+		p.confirmAmend()
+		return p, nil
+	}
+
+	action, cmd := p.amendConfirmModal.HandleKey(msg)
+	switch action {
+	case "confirm":
+		p.confirmAmend()
+	case "cancel":
+		p.closeAmendConfirm()
+	}
+	return p, cmd
+}
+
+// handleAmendConfirmMouse handles mouse events for the amend confirmation modal.
+func (p *Plugin) handleAmendConfirmMouse(msg tea.MouseMsg) (plugin.Plugin, tea.Cmd) {
+	if p.amendConfirmModal == nil {
+		return p, nil
+	}
+
+	switch p.amendConfirmModal.HandleMouse(msg, p.mouseHandler) {
+	case "confirm":
+		p.confirmAmend()
+	case "cancel":
+		p.closeAmendConfirm()
+	}
+	return p, nil
+}
+
+// renderConfirmAmend renders the amend confirmation modal overlay.
+func (p *Plugin) renderConfirmAmend() string {
+	background := p.renderThreePaneView()
+	if p.amendConfirmModal == nil {
+		p.buildAmendConfirmModal()
+	}
+	modalContent := p.amendConfirmModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
@@ -0,0 +1,53 @@
+package gitstatus
+
+import "testing"
+
+func TestCheckAmend(t *testing.T) {
+	head := []*Commit{{Hash: "aaaaaaaaaaaa"}, {Hash: "bbbbbbbbbbbb"}}
+
+	tests := []struct {
+		name    string
+		commits []*Commit
+		status  *PushStatus
+		want    amendCheck
+	}{
+		{
+			name:    "no commits",
+			commits: nil,
+			status:  &PushStatus{HasUpstream: true},
+			want:    amendNoCommits,
+		},
+		{
+			name:    "no push status",
+			commits: head,
+			status:  nil,
+			want:    amendAllowed,
+		},
+		{
+			name:    "no upstream",
+			commits: head,
+			status:  &PushStatus{HasUpstream: false},
+			want:    amendAllowed,
+		},
+		{
+			name:    "last commit unpushed",
+			commits: head,
+			status:  &PushStatus{HasUpstream: true, Ahead: 1, UnpushedHashes: []string{"aaaaaaaaaaaa"}},
+			want:    amendAllowed,
+		},
+		{
+			name:    "last commit pushed",
+			commits: head,
+			status:  &PushStatus{HasUpstream: true, UpstreamBranch: "origin/main"},
+			want:    amendLastPushed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkAmend(tt.commits, tt.status); got != tt.want {
+				t.Errorf("checkAmend() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+package gitstatus
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ConflictChoice is how a conflict block has been resolved.
+type ConflictChoice int
+
+const (
+	ConflictUnresolved ConflictChoice = iota // Markers kept
+	ConflictOurs                             // Keep the current branch's lines
+	ConflictTheirs                           // Keep the incoming lines
+	ConflictBoth                             // Keep ours followed by theirs
+)
+
+// ConflictSegment is a run of lines in a conflicted file: either text shared
+// by both sides or a block between <<<<<<< and >>>>>>> markers.
+type ConflictSegment struct {
+	IsConflict  bool
+	Lines       []string // Shared text (non-conflict segments)
+	Ours        []string
+	Base        []string // Common ancestor lines (diff3 style only)
+	Theirs      []string
+	OursLabel   string   // Text after <<<<<<< (e.g. "HEAD")
+	TheirsLabel string   // Text after >>>>>>> (e.g. "origin/main")
+	Raw         []string // Original block lines including markers
+	Choice      ConflictChoice
+}
+
+// Output returns the lines the segment contributes to the file.
+func (s *ConflictSegment) Output() []string {
+	if !s.IsConflict {
+		return s.Lines
+	}
+	switch s.Choice {
+	case ConflictOurs:
+		return s.Ours
+	case ConflictTheirs:
+		return s.Theirs
+	case ConflictBoth:
+		both := make([]string, 0, len(s.Ours)+len(s.Theirs))
+		both = append(both, s.Ours...)
+		return append(both, s.Theirs...)
+	default:
+		return s.Raw
+	}
+}
+
+// ConflictFile is a conflicted file split into segments.
+type ConflictFile struct {
+	Segments []*ConflictSegment
+}
+
+// Blocks returns the conflict segments in file order.
+func (f *ConflictFile) Blocks() []*ConflictSegment {
+	var blocks []*ConflictSegment
+	for _, seg := range f.Segments {
+		if seg.IsConflict {
+			blocks = append(blocks, seg)
+		}
+	}
+	return blocks
+}
+
+// Unresolved returns the number of conflict blocks still marked.
+func (f *ConflictFile) Unresolved() int {
+	n := 0
+	for _, seg := range f.Segments {
+		if seg.IsConflict && seg.Choice == ConflictUnresolved {
+			n++
+		}
+	}
+	return n
+}
+
+// Content returns the file content with each block's resolution applied.
+// Unresolved blocks keep their markers.
+func (f *ConflictFile) Content() string {
+	var lines []string
+	for _, seg := range f.Segments {
+		lines = append(lines, seg.Output()...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// conflictMarker reports whether line is a 7-character conflict marker of
+// ch, returning the label that follows it.
+func conflictMarker(line string, ch byte) (string, bool) {
+	if len(line) < 7 {
+		return "", false
+	}
+	for i := 0; i < 7; i++ {
+		if line[i] != ch {
+			return "", false
+		}
+	}
+	rest := line[7:]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\r' {
+		return "", false
+	}
+	return strings.TrimSpace(rest), true
+}
+
+// ParseConflictFile splits content into shared text and conflict blocks.
+// Both the merge style (ours/theirs) and diff3 style (with a ||||||| base
+// section) are recognized. Joining the segments' Output reproduces content
+// exactly while every block is unresolved.
+func ParseConflictFile(content string) (*ConflictFile, error) {
+	const (
+		inText = iota
+		inOurs
+		inBase
+		inTheirs
+	)
+
+	f := &ConflictFile{}
+	text := &ConflictSegment{}
+	var block *ConflictSegment
+	state := inText
+	startLine := 0
+
+	for i, line := range strings.Split(content, "\n") {
+		switch state {
+		case inText:
+			if label, ok := conflictMarker(line, '<'); ok {
+				if len(text.Lines) > 0 {
+					f.Segments = append(f.Segments, text)
+				}
+				text = &ConflictSegment{}
+				block = &ConflictSegment{IsConflict: true, OursLabel: label, Raw: []string{line}}
+				state = inOurs
+				startLine = i + 1
+				continue
+			}
+			text.Lines = append(text.Lines, line)
+			continue
+
+		case inOurs:
+			if _, ok := conflictMarker(line, '|'); ok {
+				state = inBase
+			} else if _, ok := conflictMarker(line, '='); ok {
+				state = inTheirs
+			} else {
+				block.Ours = append(block.Ours, line)
+			}
+
+		case inBase:
+			if _, ok := conflictMarker(line, '='); ok {
+				state = inTheirs
+			} else {
+				block.Base = append(block.Base, line)
+			}
+
+		case inTheirs:
+			if label, ok := conflictMarker(line, '>'); ok {
+				block.TheirsLabel = label
+				block.Raw = append(block.Raw, line)
+				f.Segments = append(f.Segments, block)
+				block = nil
+				state = inText
+				continue
+			}
+			block.Theirs = append(block.Theirs, line)
+		}
+		block.Raw = append(block.Raw, line)
+	}
+
+	if state != inText {
+		return nil, fmt.Errorf("unterminated conflict starting at line %d", startLine)
+	}
+	if len(text.Lines) > 0 {
+		f.Segments = append(f.Segments, text)
+	}
+	return f, nil
+}
+
+// ReadConflictFile reads and parses a conflicted file in the worktree.
+func ReadConflictFile(workDir, path string) (*ConflictFile, error) {
+	data, err := os.ReadFile(filepath.Join(workDir, path))
+	if err != nil {
+		return nil, err
+	}
+	return ParseConflictFile(string(data))
+}
+
+// WriteConflictFile writes the current resolution of f back to path,
+// preserving the file's permissions.
+func WriteConflictFile(workDir, path string, f *ConflictFile) error {
+	fullPath := filepath.Join(workDir, path)
+	info, err := os.Stat(fullPath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fullPath, []byte(f.Content()), info.Mode().Perm())
+}
+
+// MarkConflictResolved stages path so git treats its conflict as resolved.
+func MarkConflictResolved(workDir, path string) error {
+	cmd := exec.Command("git", "add", "--", path)
+	cmd.Dir = workDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+package gitstatus
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/app"
+	"github.com/marcus/sidecar/internal/mouse"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+const (
+	// pullConflictFilePrefix prefixes conflict file list item IDs.
+	pullConflictFilePrefix = "pull-conflict-file:"
+
+	// conflictContextRows is how many rows are kept above a block when
+	// jumping to it.
+	conflictContextRows = 3
+)
+
+// conflictRowKind classifies a row in the conflict resolution view.
+type conflictRowKind int
+
+const (
+	conflictRowText     conflictRowKind = iota // Shared text
+	conflictRowHeader                          // Block header
+	conflictRowConflict                        // Ours/theirs lines of an unresolved block
+	conflictRowResolved                        // Lines kept by a resolved block
+)
+
+// conflictRow is one display row: left is ours, right is theirs.
+type conflictRow struct {
+	kind  conflictRowKind
+	block int
+	left  string
+	right string
+}
+
+// buildConflictRows lays out f for side-by-side display and returns the rows
+// along with the row index of each block's header.
+func buildConflictRows(f *ConflictFile) ([]conflictRow, []int) {
+	var rows []conflictRow
+	var headers []int
+	block := 0
+	for _, seg := range f.Segments {
+		if !seg.IsConflict {
+			for _, line := range seg.Lines {
+				rows = append(rows, conflictRow{kind: conflictRowText, block: -1, left: line, right: line})
+			}
+			continue
+		}
+
+		headers = append(headers, len(rows))
+		rows = append(rows, conflictRow{kind: conflictRowHeader, block: block})
+		if seg.Choice == ConflictUnresolved {
+			n := len(seg.Ours)
+			if len(seg.Theirs) > n {
+				n = len(seg.Theirs)
+			}
+			for i := 0; i < n; i++ {
+				row := conflictRow{kind: conflictRowConflict, block: block}
+				if i < len(seg.Ours) {
+					row.left = seg.Ours[i]
+				}
+				if i < len(seg.Theirs) {
+					row.right = seg.Theirs[i]
+				}
+				rows = append(rows, row)
+			}
+		} else {
+			for _, line := range seg.Output() {
+				rows = append(rows, conflictRow{kind: conflictRowResolved, block: block, left: line, right: line})
+			}
+		}
+		block++
+	}
+	return rows, headers
+}
+
+// pullConflictFileAction returns the path of a conflict file list item action.
+func pullConflictFileAction(action string) (string, bool) {
+	return strings.CutPrefix(action, pullConflictFilePrefix)
+}
+
+// openConflictResolve loads a conflicted file into the resolution view.
+func (p *Plugin) openConflictResolve(path string) (plugin.Plugin, tea.Cmd) {
+	f, err := ReadConflictFile(p.repoRoot, path)
+	if err != nil {
+		return p, func() tea.Msg {
+			return app.ToastMsg{Message: "Cannot open " + path + ": " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+	p.conflictPath = path
+	p.conflictFile = f
+	p.conflictBlock = 0
+	p.conflictScroll = 0
+	p.jumpToConflictBlock(p.firstUnresolvedBlock(0))
+	p.viewMode = ViewModeConflictResolve
+	return p, nil
+}
+
+// closeConflictResolve returns to the pull conflict modal.
+func (p *Plugin) closeConflictResolve() {
+	p.conflictPath = ""
+	p.conflictFile = nil
+	p.viewMode = ViewModePullConflict
+	p.clearPullConflictModal()
+}
+
+// firstUnresolvedBlock returns the first unresolved block at or after from,
+// wrapping around, or from when every block is resolved.
+func (p *Plugin) firstUnresolvedBlock(from int) int {
+	blocks := p.conflictFile.Blocks()
+	for i := 0; i < len(blocks); i++ {
+		idx := (from + i) % len(blocks)
+		if blocks[idx].Choice == ConflictUnresolved {
+			return idx
+		}
+	}
+	return from
+}
+
+// jumpToConflictBlock selects a block and scrolls it into view with a few
+// rows of context above.
+func (p *Plugin) jumpToConflictBlock(idx int) {
+	_, headers := buildConflictRows(p.conflictFile)
+	if idx < 0 || idx >= len(headers) {
+		return
+	}
+	p.conflictBlock = idx
+	p.conflictScroll = headers[idx] - conflictContextRows
+	p.clampConflictScroll()
+}
+
+// conflictVisibleRows returns how many rows fit below the view's header.
+func (p *Plugin) conflictVisibleRows() int {
+	// Panel border (2) + title and separator (2)
+	n := p.height - 4
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+func (p *Plugin) clampConflictScroll() {
+	rows, _ := buildConflictRows(p.conflictFile)
+	maxScroll := len(rows) - p.conflictVisibleRows()
+	if p.conflictScroll > maxScroll {
+		p.conflictScroll = maxScroll
+	}
+	if p.conflictScroll < 0 {
+		p.conflictScroll = 0
+	}
+}
+
+// resolveConflictBlock applies choice to the selected block, writes the file,
+// and moves on to the next unresolved block.
+func (p *Plugin) resolveConflictBlock(choice ConflictChoice) tea.Cmd {
+	blocks := p.conflictFile.Blocks()
+	if p.conflictBlock >= len(blocks) {
+		return nil
+	}
+	block := blocks[p.conflictBlock]
+	prev := block.Choice
+	block.Choice = choice
+	if err := WriteConflictFile(p.repoRoot, p.conflictPath, p.conflictFile); err != nil {
+		block.Choice = prev
+		return func() tea.Msg {
+			return app.ToastMsg{Message: "Write failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+	if choice != ConflictUnresolved {
+		p.jumpToConflictBlock(p.firstUnresolvedBlock(p.conflictBlock))
+	} else {
+		p.jumpToConflictBlock(p.conflictBlock)
+	}
+	return nil
+}
+
+// markConflictResolved stages the file once every block is resolved and
+// returns to the conflict modal with the remaining files.
+func (p *Plugin) markConflictResolved() (plugin.Plugin, tea.Cmd) {
+	if n := p.conflictFile.Unresolved(); n > 0 {
+		return p, func() tea.Msg {
+			return app.ToastMsg{Message: fmt.Sprintf("%d conflict(s) left in this file", n), Duration: 2 * time.Second}
+		}
+	}
+	path := p.conflictPath
+	if err := MarkConflictResolved(p.repoRoot, path); err != nil {
+		return p, func() tea.Msg {
+			return app.ToastMsg{Message: "git add failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+	p.pullConflictResolved = append(p.pullConflictResolved, path)
+	p.pullConflictFiles = GetConflictedFiles(p.repoRoot)
+	p.pullConflictIdx = 0
+	p.closeConflictResolve()
+	return p, tea.Batch(p.refresh(), func() tea.Msg {
+		return app.ToastMsg{Message: "Marked " + path + " resolved", Duration: 2 * time.Second}
+	})
+}
+
+// updateConflictResolve handles keys in the conflict resolution view.
+func (p *Plugin) updateConflictResolve(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	if p.conflictFile == nil {
+		p.closeConflictResolve()
+		return p, nil
+	}
+	blocks := len(p.conflictFile.Blocks())
+
+	switch msg.String() {
+	case "esc", "q":
+		p.closeConflictResolve()
+		return p, nil
+
+	case "n", "]":
+		if blocks > 0 {
+			p.jumpToConflictBlock((p.conflictBlock + 1) % blocks)
+		}
+	case "N", "[":
+		if blocks > 0 {
+			p.jumpToConflictBlock((p.conflictBlock - 1 + blocks) % blocks)
+		}
+
+	case "j", "down":
+		p.conflictScroll++
+		p.clampConflictScroll()
+	case "k", "up":
+		p.conflictScroll--
+		p.clampConflictScroll()
+	case "ctrl+d":
+		p.conflictScroll += p.conflictVisibleRows() / 2
+		p.clampConflictScroll()
+	case "ctrl+u":
+		p.conflictScroll -= p.conflictVisibleRows() / 2
+		p.clampConflictScroll()
+	case "g":
+		p.conflictScroll = 0
+	case "G":
+		p.conflictScroll = 1 << 30
+		p.clampConflictScroll()
+
+	case "o":
+		return p, p.resolveConflictBlock(ConflictOurs)
+	case "t":
+		return p, p.resolveConflictBlock(ConflictTheirs)
+	case "b":
+		return p, p.resolveConflictBlock(ConflictBoth)
+	case "u":
+		return p, p.resolveConflictBlock(ConflictUnresolved)
+
+	case "m":
+		return p.markConflictResolved()
+	}
+	return p, nil
+}
+
+// handleConflictResolveMouse scrolls the conflict view with the wheel.
+func (p *Plugin) handleConflictResolveMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	action := p.mouseHandler.HandleMouse(msg)
+	if action.Type == mouse.ActionScrollUp || action.Type == mouse.ActionScrollDown {
+		p.conflictScroll += action.Delta
+		p.clampConflictScroll()
+	}
+	return p, nil
+}
+
+// renderConflictResolve renders the full-screen side-by-side conflict view.
+func (p *Plugin) renderConflictResolve() string {
+	paneHeight := p.height - 2
+	contentWidth := p.width - 4
+	if contentWidth < 20 {
+		contentWidth = 20
+	}
+
+	p.mouseHandler.Clear()
+	p.mouseHandler.HitMap.AddRect(regionDiffModal, 0, 0, p.width, p.height, nil)
+
+	f := p.conflictFile
+	blocks := f.Blocks()
+
+	var sb strings.Builder
+	title := styles.Title.Render("Resolve: ") + p.conflictPath
+	status := fmt.Sprintf(" conflict %d/%d · %d unresolved", p.conflictBlock+1, len(blocks), f.Unresolved())
+	if len(blocks) == 0 {
+		status = " no conflict markers · m to mark resolved"
+	} else if f.Unresolved() == 0 {
+		status = fmt.Sprintf(" %d/%d resolved · m to mark resolved", len(blocks), len(blocks))
+	}
+	sb.WriteString(truncateStyledLine(title+styles.Muted.Render(status), contentWidth))
+	sb.WriteString("\n")
+	sb.WriteString(styles.Muted.Render(strings.Repeat("━", contentWidth)))
+	sb.WriteString("\n")
+
+	rows, _ := buildConflictRows(f)
+	visible := p.conflictVisibleRows()
+	colWidth := (contentWidth - 3) / 2
+	end := p.conflictScroll + visible
+	if end > len(rows) {
+		end = len(rows)
+	}
+	for i := p.conflictScroll; i < end; i++ {
+		sb.WriteString(p.renderConflictRow(rows[i], blocks, colWidth, contentWidth))
+		sb.WriteString("\n")
+	}
+
+	return p.wrapDiffContent(sb.String(), paneHeight)
+}
+
+// renderConflictRow renders one row of the conflict view.
+func (p *Plugin) renderConflictRow(row conflictRow, blocks []*ConflictSegment, colWidth, width int) string {
+	if row.kind == conflictRowHeader {
+		block := blocks[row.block]
+		label := fmt.Sprintf("── Conflict %d: ours (%s) │ theirs (%s) ", row.block+1, block.OursLabel, block.TheirsLabel)
+		switch block.Choice {
+		case ConflictOurs:
+			label = fmt.Sprintf("── Conflict %d: ✓ kept ours ", row.block+1)
+		case ConflictTheirs:
+			label = fmt.Sprintf("── Conflict %d: ✓ kept theirs ", row.block+1)
+		case ConflictBoth:
+			label = fmt.Sprintf("── Conflict %d: ✓ kept both ", row.block+1)
+		}
+		if pad := width - ansi.StringWidth(label); pad > 0 {
+			label += strings.Repeat("─", pad)
+		}
+		label = truncateStyledLine(label, width)
+		if row.block == p.conflictBlock {
+			return styles.Title.Render(label)
+		}
+		return styles.Muted.Render(label)
+	}
+
+	left := conflictCell(row.left, colWidth)
+	right := conflictCell(row.right, colWidth)
+	switch row.kind {
+	case conflictRowConflict:
+		left = styles.DiffRemove.Render(left)
+		right = styles.DiffAdd.Render(right)
+	case conflictRowResolved:
+		left = styles.StatusStaged.Render(left)
+		right = styles.StatusStaged.Render(right)
+	}
+	return left + styles.Muted.Render(" │ ") + right
+}
+
+// conflictCell fits a line of file content into a column.
+func conflictCell(line string, width int) string {
+	line = strings.TrimSuffix(line, "\r")
+	line = strings.ReplaceAll(line, "\t", "    ")
+	line = ansi.Truncate(line, width, "")
+	return line + strings.Repeat(" ", width-lipgloss.Width(line))
+}
@@ -0,0 +1,170 @@
+package gitstatus
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const twoConflicts = `package main
+
+<<<<<<< HEAD
+func greet() string { return "hi" }
+=======
+func greet() string { return "hello" }
+func extra() {}
+>>>>>>> origin/main
+
+func main() {
+<<<<<<< HEAD
+	greet()
+=======
+>>>>>>> origin/main
+}
+`
+
+func TestParseConflictFile(t *testing.T) {
+	f, err := ParseConflictFile(twoConflicts)
+	if err != nil {
+		t.Fatalf("ParseConflictFile() error = %v", err)
+	}
+
+	blocks := f.Blocks()
+	if len(blocks) != 2 {
+		t.Fatalf("got %d blocks, want 2", len(blocks))
+	}
+	if len(f.Segments) != 5 {
+		t.Errorf("got %d segments, want 5 (text, block, text, block, text)", len(f.Segments))
+	}
+
+	first := blocks[0]
+	if first.OursLabel != "HEAD" || first.TheirsLabel != "origin/main" {
+		t.Errorf("labels = %q/%q, want HEAD/origin/main", first.OursLabel, first.TheirsLabel)
+	}
+	if want := []string{`func greet() string { return "hi" }`}; !reflect.DeepEqual(first.Ours, want) {
+		t.Errorf("ours = %q, want %q", first.Ours, want)
+	}
+	if want := []string{`func greet() string { return "hello" }`, "func extra() {}"}; !reflect.DeepEqual(first.Theirs, want) {
+		t.Errorf("theirs = %q, want %q", first.Theirs, want)
+	}
+
+	second := blocks[1]
+	if len(second.Ours) != 1 || len(second.Theirs) != 0 {
+		t.Errorf("second block ours/theirs = %q/%q, want one line / empty", second.Ours, second.Theirs)
+	}
+
+	if f.Unresolved() != 2 {
+		t.Errorf("Unresolved() = %d, want 2", f.Unresolved())
+	}
+	if got := f.Content(); got != twoConflicts {
+		t.Errorf("unresolved Content() does not round-trip:\n%s", got)
+	}
+}
+
+func TestConflictFileResolve(t *testing.T) {
+	tests := []struct {
+		name   string
+		choice ConflictChoice
+		want   string
+	}{
+		{"ours", ConflictOurs, "a\nmine\nz\n"},
+		{"theirs", ConflictTheirs, "a\ntheirs 1\ntheirs 2\nz\n"},
+		{"both", ConflictBoth, "a\nmine\ntheirs 1\ntheirs 2\nz\n"},
+	}
+
+	content := "a\n<<<<<<< HEAD\nmine\n=======\ntheirs 1\ntheirs 2\n>>>>>>> feature\nz\n"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := ParseConflictFile(content)
+			if err != nil {
+				t.Fatalf("ParseConflictFile() error = %v", err)
+			}
+			f.Blocks()[0].Choice = tt.choice
+			if got := f.Content(); got != tt.want {
+				t.Errorf("Content() = %q, want %q", got, tt.want)
+			}
+			if f.Unresolved() != 0 {
+				t.Errorf("Unresolved() = %d, want 0", f.Unresolved())
+			}
+		})
+	}
+}
+
+func TestParseConflictFileDiff3(t *testing.T) {
+	content := "<<<<<<< ours\nnew A\n||||||| base\nold\n=======\nnew B\n>>>>>>> theirs"
+	f, err := ParseConflictFile(content)
+	if err != nil {
+		t.Fatalf("ParseConflictFile() error = %v", err)
+	}
+	block := f.Blocks()[0]
+	if !reflect.DeepEqual(block.Ours, []string{"new A"}) ||
+		!reflect.DeepEqual(block.Base, []string{"old"}) ||
+		!reflect.DeepEqual(block.Theirs, []string{"new B"}) {
+		t.Errorf("block = ours %q base %q theirs %q", block.Ours, block.Base, block.Theirs)
+	}
+	block.Choice = ConflictTheirs
+	if got := f.Content(); got != "new B" {
+		t.Errorf("Content() = %q, want %q", got, "new B")
+	}
+}
+
+func TestParseConflictFileIgnoresLookalikes(t *testing.T) {
+	// Setext headings and longer marker runs are not conflict markers
+	content := "Title\n=======\n<<<<<<<< not a marker\n>>>>>>>>\n"
+	f, err := ParseConflictFile(content)
+	if err != nil {
+		t.Fatalf("ParseConflictFile() error = %v", err)
+	}
+	if n := len(f.Blocks()); n != 0 {
+		t.Errorf("got %d blocks, want 0", n)
+	}
+	if f.Content() != content {
+		t.Error("Content() does not round-trip")
+	}
+}
+
+func TestParseConflictFileCRLF(t *testing.T) {
+	content := "<<<<<<< HEAD\r\nmine\r\n=======\r\ntheirs\r\n>>>>>>> other\r\n"
+	f, err := ParseConflictFile(content)
+	if err != nil {
+		t.Fatalf("ParseConflictFile() error = %v", err)
+	}
+	block := f.Blocks()[0]
+	if block.OursLabel != "HEAD" || block.TheirsLabel != "other" {
+		t.Errorf("labels = %q/%q", block.OursLabel, block.TheirsLabel)
+	}
+	block.Choice = ConflictOurs
+	if got := f.Content(); got != "mine\r\n" {
+		t.Errorf("Content() = %q, want %q", got, "mine\r\n")
+	}
+}
+
+func TestParseConflictFileUnterminated(t *testing.T) {
+	content := "ok\n<<<<<<< HEAD\nmine\n=======\ntheirs\n"
+	_, err := ParseConflictFile(content)
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("ParseConflictFile() error = %v, want unterminated conflict at line 2", err)
+	}
+}
+
+func TestBuildConflictRows(t *testing.T) {
+	f, err := ParseConflictFile("a\n<<<<<<< HEAD\nmine\n=======\nt1\nt2\n>>>>>>> x\nz")
+	if err != nil {
+		t.Fatalf("ParseConflictFile() error = %v", err)
+	}
+
+	rows, headers := buildConflictRows(f)
+	if !reflect.DeepEqual(headers, []int{1}) {
+		t.Fatalf("headers = %v, want [1]", headers)
+	}
+	// a, header, mine|t1, |t2, z
+	if len(rows) != 5 || rows[2].left != "mine" || rows[2].right != "t1" || rows[3].left != "" || rows[3].right != "t2" {
+		t.Errorf("unexpected rows: %+v", rows)
+	}
+
+	f.Blocks()[0].Choice = ConflictTheirs
+	rows, _ = buildConflictRows(f)
+	if len(rows) != 5 || rows[2].kind != conflictRowResolved || rows[2].left != "t1" {
+		t.Errorf("unexpected resolved rows: %+v", rows)
+	}
+}
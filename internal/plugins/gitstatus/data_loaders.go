@@ -22,7 +22,11 @@ func (p *Plugin) loadDiff(path string, staged bool, status FileStatus) tea.Cmd {
 			return ErrorMsg{Err: err}
 		}
 
-		return DiffLoadedMsg{Epoch: epoch, Content: rawDiff, Raw: rawDiff}
+		msg := DiffLoadedMsg{Epoch: epoch, Content: rawDiff, Raw: rawDiff}
+		if IsBinaryDiff(rawDiff) {
+			msg.Sizes = fileBinarySizes(workDir, path, staged, status)
+		}
+		return msg
 	}
 }
 
@@ -44,6 +48,9 @@ func (p *Plugin) loadInlineDiff(path string, staged bool, status FileStatus) tea
 			return InlineDiffLoadedMsg{Epoch: epoch, File: path, Raw: "", Parsed: nil}
 		}
 		parsed, _ := ParseUnifiedDiff(rawDiff)
+		if parsed != nil && parsed.Binary {
+			parsed.Sizes = fileBinarySizes(workDir, path, staged, status)
+		}
 		return InlineDiffLoadedMsg{Epoch: epoch, File: path, Raw: rawDiff, Parsed: parsed}
 	}
 }
@@ -104,7 +111,7 @@ func (p *Plugin) loadFolderDiff(entry *FileEntry) tea.Cmd {
 	epoch := p.ctx.Epoch
 	workDir := p.repoRoot
 	folderPath := entry.Path
-	children := entry.Children
+	children := entry.Files()
 	return func() tea.Msg {
 		rawDiff, err := GetFolderDiff(workDir, children)
 		if err != nil {
@@ -119,7 +126,7 @@ func (p *Plugin) loadFolderDiff(entry *FileEntry) tea.Cmd {
 func (p *Plugin) loadFullFolderDiff(entry *FileEntry) tea.Cmd {
 	epoch := p.ctx.Epoch
 	workDir := p.repoRoot
-	children := entry.Children
+	children := entry.Files()
 	return func() tea.Msg {
 		rawDiff, err := GetFolderDiff(workDir, children)
 		if err != nil {
@@ -141,7 +148,15 @@ func (p *Plugin) loadCommitFileDiff(hash, path, parentHash string) tea.Cmd {
 			return ErrorMsg{Err: err}
 		}
 
-		return DiffLoadedMsg{Epoch: epoch, Content: rawDiff, Raw: rawDiff}
+		msg := DiffLoadedMsg{Epoch: epoch, Content: rawDiff, Raw: rawDiff}
+		if IsBinaryDiff(rawDiff) {
+			if parentHash != "" {
+				msg.Sizes = GetBinaryDiffSizes(workDir, "diff", parentHash, hash, "--", path)
+			} else {
+				msg.Sizes = GetBinaryDiffSizes(workDir, "show", "--format=", hash, "--", path)
+			}
+		}
+		return msg
 	}
 }
 
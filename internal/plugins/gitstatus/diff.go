@@ -5,6 +5,8 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 )
 
@@ -137,6 +139,50 @@ func GetNewFileDiff(workDir, path string) (string, error) {
 	return strings.TrimSuffix(sb.String(), "\n"), nil
 }
 
+// binaryStatRegex matches the size change git --stat reports for a binary
+// file, e.g. "Bin 1234 -> 5678 bytes".
+var binaryStatRegex = regexp.MustCompile(`Bin (\d+) -> (\d+) bytes`)
+
+// parseBinaryStat extracts binary file sizes from git --stat output.
+func parseBinaryStat(stat string) *BinarySizes {
+	m := binaryStatRegex.FindStringSubmatch(stat)
+	if m == nil {
+		return nil
+	}
+	oldSize, _ := strconv.ParseInt(m[1], 10, 64)
+	newSize, _ := strconv.ParseInt(m[2], 10, 64)
+	return &BinarySizes{Old: oldSize, New: newSize}
+}
+
+// GetBinaryDiffSizes runs a git diff or show command with --stat added and
+// returns the binary file sizes it reports, or nil if there are none.
+func GetBinaryDiffSizes(workDir string, args ...string) *BinarySizes {
+	args = append([]string{args[0], "--stat"}, args[1:]...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = workDir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+	return parseBinaryStat(string(output))
+}
+
+// fileBinarySizes returns the sizes for a binary working tree or index
+// change. Untracked files are compared against nothing.
+func fileBinarySizes(workDir, path string, staged bool, status FileStatus) *BinarySizes {
+	if status == StatusUntracked {
+		info, err := os.Stat(filepath.Join(workDir, path))
+		if err != nil {
+			return nil
+		}
+		return &BinarySizes{New: info.Size()}
+	}
+	if staged {
+		return GetBinaryDiffSizes(workDir, "diff", "--cached", "--", path)
+	}
+	return GetBinaryDiffSizes(workDir, "diff", "--", path)
+}
+
 // isBinaryContent checks if content appears to be binary.
 // Returns true if content contains null bytes or a high ratio of non-printable chars.
 func isBinaryContent(content []byte) bool {
@@ -155,8 +201,9 @@ func isBinaryContent(content []byte) bool {
 	return false
 }
 
-// GetFolderDiff creates a concatenated diff for all files in an untracked folder.
-// Each file gets a header with its path, followed by its content as additions.
+// GetFolderDiff creates a concatenated diff for all files in a folder.
+// Untracked files are shown as all additions; tracked files use git diff
+// against the index or HEAD depending on whether the entry is staged.
 func GetFolderDiff(workDir string, files []*FileEntry) (string, error) {
 	var sb strings.Builder
 
@@ -165,7 +212,13 @@ func GetFolderDiff(workDir string, files []*FileEntry) (string, error) {
 			sb.WriteString("\n")
 		}
 
-		fileDiff, err := GetNewFileDiff(workDir, file.Path)
+		var fileDiff string
+		var err error
+		if file.Status == StatusUntracked {
+			fileDiff, err = GetNewFileDiff(workDir, file.Path)
+		} else {
+			fileDiff, err = GetDiff(workDir, file.Path, file.Staged)
+		}
 		if err != nil {
 			// Write error placeholder for this file
 			sb.WriteString(fmt.Sprintf("diff --git a/%s b/%s\n", file.Path, file.Path))
@@ -4,6 +4,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // LineType represents the type of a diff line.
@@ -28,6 +29,7 @@ type DiffLine struct {
 	NewLineNo int // 0 means not applicable
 	Content   string
 	WordDiff  []WordSegment
+	NoNewline bool // Followed by "\ No newline at end of file"
 }
 
 // Hunk represents a diff hunk.
@@ -45,16 +47,45 @@ type ParsedDiff struct {
 	OldFile string
 	NewFile string
 	Binary  bool
+	Sizes   *BinarySizes // Binary file sizes, when known
 	Hunks   []Hunk
 }
 
+// BinarySizes holds a binary file's size in bytes before and after a change.
+type BinarySizes struct {
+	Old int64
+	New int64
+}
+
+// isBinaryMarker reports whether a diff line says the file is binary: git's
+// "Binary files a/x and b/x differ", the "Binary file x" line of an untracked
+// file's diff, or the header of a --binary patch.
+func isBinaryMarker(line string) bool {
+	return strings.HasPrefix(line, "Binary file") || line == "GIT binary patch"
+}
+
+// IsBinaryDiff reports whether raw diff text is for a binary file, either
+// because git marked it so or because it contains NUL bytes.
+func IsBinaryDiff(raw string) bool {
+	if strings.IndexByte(raw, 0) >= 0 {
+		return true
+	}
+	for _, line := range strings.Split(raw, "\n") {
+		if isBinaryMarker(line) {
+			return true
+		}
+	}
+	return false
+}
+
 // FileDiffInfo holds a parsed diff with rendering position info.
 type FileDiffInfo struct {
-	Diff       *ParsedDiff
-	StartLine  int // Line position where this file starts in rendered output
-	EndLine    int // Line position where this file ends
-	Additions  int // Number of added lines
-	Deletions  int // Number of deleted lines
+	Diff      *ParsedDiff
+	StartLine int    // Line position where this file starts in rendered output
+	EndLine   int    // Line position where this file ends
+	Additions int    // Number of added lines
+	Deletions int    // Number of deleted lines
+	Raw       string // Unparsed diff text for this file
 }
 
 // MultiFileDiff holds multiple file diffs with navigation info.
@@ -92,6 +123,7 @@ func ParseMultiFileDiff(diff string) *MultiFileDiff {
 			Diff:      parsed,
 			Additions: additions,
 			Deletions: deletions,
+			Raw:       fileDiff,
 		})
 	}
 
@@ -172,7 +204,7 @@ func ParseUnifiedDiff(diff string) (*ParsedDiff, error) {
 
 	for _, line := range lines {
 		switch {
-		case strings.HasPrefix(line, "Binary files"):
+		case isBinaryMarker(line), strings.IndexByte(line, 0) >= 0:
 			parsed.Binary = true
 			return parsed, nil
 
@@ -265,7 +297,10 @@ func ParseUnifiedDiff(diff string) (*ParsedDiff, error) {
 				newLineNo++
 
 			case '\\':
-				// "\ No newline at end of file" - skip
+				// "\ No newline at end of file" - applies to the previous line
+				if n := len(currentHunk.Lines); n > 0 {
+					currentHunk.Lines[n-1].NoNewline = true
+				}
 
 			default:
 				// Treat as context if unrecognized
@@ -290,78 +325,176 @@ func ParseUnifiedDiff(diff string) (*ParsedDiff, error) {
 	return parsed, nil
 }
 
+// wordDiffMinSimilarity is the token overlap a removed/added line pair needs
+// before only the changed words are highlighted. Less similar pairs are
+// treated as unrelated lines and highlighted whole.
+const wordDiffMinSimilarity = 0.6
+
+// wordDiffMaxCells bounds the token LCS table so minified or very long lines
+// don't cost more than they're worth.
+const wordDiffMaxCells = 1 << 20
+
 // computeWordDiffs computes word-level diffs for a hunk.
+// A run of removed lines followed by a run of added lines is paired line by
+// line; each pair that is similar enough gets word-level segments.
 func computeWordDiffs(hunk *Hunk) {
 	lines := hunk.Lines
-	for i := 0; i < len(lines); i++ {
-		if lines[i].Type == LineRemove && i+1 < len(lines) && lines[i+1].Type == LineAdd {
-			// Found a remove/add pair - compute word diff
-			oldWords := tokenize(lines[i].Content)
-			newWords := tokenize(lines[i+1].Content)
-
-			// Simple word-by-word comparison
-			lines[i].WordDiff = computeWordSegments(oldWords, newWords, false)
-			lines[i+1].WordDiff = computeWordSegments(newWords, oldWords, true)
-			i++ // Skip the add line
+	for i := 0; i < len(lines); {
+		if lines[i].Type != LineRemove {
+			i++
+			continue
+		}
+		removeStart := i
+		for i < len(lines) && lines[i].Type == LineRemove {
+			i++
+		}
+		addStart := i
+		for i < len(lines) && lines[i].Type == LineAdd {
+			i++
+		}
+
+		pairs := min(addStart-removeStart, i-addStart)
+		for j := 0; j < pairs; j++ {
+			oldLine, newLine := &lines[removeStart+j], &lines[addStart+j]
+			oldSegs, newSegs, similarity := diffTokens(oldLine.Content, newLine.Content)
+			if similarity < wordDiffMinSimilarity {
+				continue
+			}
+			oldLine.WordDiff = oldSegs
+			newLine.WordDiff = newSegs
 		}
 	}
 }
 
-// tokenize splits a line into words and whitespace tokens.
+// tokenize splits a line into word, whitespace, and punctuation tokens.
+// Words are runs of letters, digits, and underscores; whitespace runs are
+// kept together; every other rune is its own token.
 func tokenize(s string) []string {
 	var tokens []string
-	var current strings.Builder
-	inWord := false
+	start := 0
+	prevClass := -1
+
+	for i, r := range s {
+		class := tokenClass(r)
+		if i > start && (class != prevClass || class == tokenPunct) {
+			tokens = append(tokens, s[start:i])
+			start = i
+		}
+		prevClass = class
+	}
+	if start < len(s) {
+		tokens = append(tokens, s[start:])
+	}
+	return tokens
+}
 
-	for _, r := range s {
-		isWord := r != ' ' && r != '\t'
-		if isWord {
-			if !inWord && current.Len() > 0 {
-				tokens = append(tokens, current.String())
-				current.Reset()
+const (
+	tokenWord = iota
+	tokenSpace
+	tokenPunct
+)
+
+// tokenClass returns the token class of r for tokenize.
+func tokenClass(r rune) int {
+	switch {
+	case r == ' ' || r == '\t':
+		return tokenSpace
+	case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+		return tokenWord
+	default:
+		return tokenPunct
+	}
+}
+
+// diffTokens computes a token-level diff between two lines.
+// Returns segments for each side, where IsChange marks tokens missing from the
+// other side, and a similarity score (0-1) based on shared non-whitespace tokens.
+func diffTokens(oldText, newText string) (oldSegs, newSegs []WordSegment, similarity float64) {
+	a, b := tokenize(oldText), tokenize(newText)
+	if len(a)*len(b) > wordDiffMaxCells {
+		return nil, nil, 0
+	}
+
+	// Longest common subsequence table, filled from the end
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
 			}
-			current.WriteRune(r)
-			inWord = true
-		} else {
-			if inWord && current.Len() > 0 {
-				tokens = append(tokens, current.String())
-				current.Reset()
+		}
+	}
+
+	aMatched := make([]bool, len(a))
+	bMatched := make([]bool, len(b))
+	shared := 0
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			aMatched[i], bMatched[j] = true, true
+			if strings.TrimSpace(a[i]) != "" {
+				shared++
 			}
-			current.WriteRune(r)
-			inWord = false
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
 		}
 	}
-	if current.Len() > 0 {
-		tokens = append(tokens, current.String())
+
+	total := countWordTokens(a) + countWordTokens(b)
+	if total == 0 {
+		similarity = 1
+	} else {
+		similarity = float64(2*shared) / float64(total)
 	}
-	return tokens
+	return buildWordSegments(a, aMatched), buildWordSegments(b, bMatched), similarity
 }
 
-// computeWordSegments computes word segments by comparing tokens.
-func computeWordSegments(source, target []string, isAdd bool) []WordSegment {
-	if len(source) == 0 {
+// countWordTokens returns the number of non-whitespace tokens.
+func countWordTokens(tokens []string) int {
+	n := 0
+	for _, t := range tokens {
+		if strings.TrimSpace(t) != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// buildWordSegments merges tokens into segments of changed and unchanged text.
+// Unmatched whitespace is only highlighted when it sits between two changed
+// tokens, so a changed span reads as one block.
+func buildWordSegments(tokens []string, matched []bool) []WordSegment {
+	if len(tokens) == 0 {
 		return nil
 	}
 
-	// Build a set of target words for quick lookup
-	targetSet := make(map[string]bool)
-	for _, t := range target {
-		targetSet[t] = true
+	changed := make([]bool, len(tokens))
+	for i, t := range tokens {
+		changed[i] = !matched[i] && strings.TrimSpace(t) != ""
+	}
+	for i := 1; i < len(tokens)-1; i++ {
+		if !matched[i] && !changed[i] && changed[i-1] && changed[i+1] {
+			changed[i] = true
+		}
 	}
 
 	var segments []WordSegment
-	for _, word := range source {
-		isChange := !targetSet[word]
-		// Whitespace is never highlighted as changed
-		if strings.TrimSpace(word) == "" {
-			isChange = false
+	for i, t := range tokens {
+		if n := len(segments); n > 0 && segments[n-1].IsChange == changed[i] {
+			segments[n-1].Text += t
+			continue
 		}
-		segments = append(segments, WordSegment{
-			Text:     word,
-			IsChange: isChange,
-		})
+		segments = append(segments, WordSegment{Text: t, IsChange: changed[i]})
 	}
-
 	return segments
 }
 
@@ -1,6 +1,7 @@
 package gitstatus
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -85,6 +86,54 @@ func TestParseUnifiedDiff_BinaryFile(t *testing.T) {
 	}
 }
 
+func TestIsBinaryDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want bool
+	}{
+		{"git marker", "diff --git a/logo.png b/logo.png\nindex 1a2b3c4..5d6e7f8 100644\nBinary files a/logo.png and b/logo.png differ", true},
+		{"new binary", "diff --git a/app.bin b/app.bin\nnew file mode 100644\nBinary files /dev/null and b/app.bin differ", true},
+		{"untracked marker", "diff --git a/app.bin b/app.bin\nnew file mode 100644\nBinary file app.bin", true},
+		{"binary patch", "diff --git a/a.bin b/a.bin\nGIT binary patch\nliteral 12\nzcmZ", true},
+		{"NUL bytes", "--- a/data\n+++ b/data\n@@ -1 +1 @@\n-ab\x00c\n+abc", true},
+		{"text diff", "--- a/main.go\n+++ b/main.go\n@@ -1 +1 @@\n-old\n+new", false},
+		{"added line mentioning binaries", "--- a/notes.md\n+++ b/notes.md\n@@ -0,0 +1 @@\n+Binary files differ here", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBinaryDiff(tt.raw); got != tt.want {
+				t.Errorf("IsBinaryDiff() = %v, want %v", got, tt.want)
+			}
+			parsed, _ := ParseUnifiedDiff(tt.raw)
+			if parsed.Binary != tt.want {
+				t.Errorf("ParseUnifiedDiff().Binary = %v, want %v", parsed.Binary, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseBinaryStat(t *testing.T) {
+	stat := " logo.png | Bin 1234 -> 5678 bytes\n 1 file changed, 0 insertions(+), 0 deletions(-)\n"
+	got := parseBinaryStat(stat)
+	if got == nil || got.Old != 1234 || got.New != 5678 {
+		t.Errorf("parseBinaryStat() = %+v, want {1234 5678}", got)
+	}
+	if got := parseBinaryStat(" main.go | 2 +-\n"); got != nil {
+		t.Errorf("parseBinaryStat() of a text stat = %+v, want nil", got)
+	}
+
+	summary := RenderLineDiff(&ParsedDiff{Binary: true}, 80, 0, 10, 0, nil, false, true)
+	if !strings.Contains(summary, "Binary file changed") || strings.Contains(summary, "bytes") {
+		t.Errorf("summary without sizes = %q", summary)
+	}
+	summary = RenderLineDiff(&ParsedDiff{Binary: true, Sizes: &BinarySizes{Old: 10, New: 20}}, 80, 0, 10, 0, nil, false, true)
+	if !strings.Contains(summary, "Binary file changed (old 10 bytes → new 20 bytes)") {
+		t.Errorf("summary with sizes = %q", summary)
+	}
+}
+
 func TestParseUnifiedDiff_LineTypes(t *testing.T) {
 	// Note: no trailing newline to avoid empty context line
 	diff := `--- a/file.txt
@@ -187,6 +236,139 @@ func TestTokenize(t *testing.T) {
 	}
 }
 
+func TestTokenize_Punctuation(t *testing.T) {
+	got := tokenize("foo(a, b_c)")
+	want := []string{"foo", "(", "a", ",", " ", "b_c", ")"}
+	if len(got) != len(want) {
+		t.Fatalf("tokenize = %q, want %q", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("token[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+// changedText joins the changed segments, separated by "|".
+func changedText(segs []WordSegment) string {
+	var parts []string
+	for _, s := range segs {
+		if s.IsChange {
+			parts = append(parts, s.Text)
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+// segmentText joins all segment text back into the original line.
+func segmentText(segs []WordSegment) string {
+	var sb strings.Builder
+	for _, s := range segs {
+		sb.WriteString(s.Text)
+	}
+	return sb.String()
+}
+
+func TestDiffTokens(t *testing.T) {
+	tests := []struct {
+		name        string
+		oldText     string
+		newText     string
+		wantOld     string // changed spans on the old side
+		wantNew     string // changed spans on the new side
+		wantSimilar bool   // similarity >= wordDiffMinSimilarity
+	}{
+		{
+			name:        "renamed variable",
+			oldText:     "total := count + offset",
+			newText:     "total := size + offset",
+			wantOld:     "count",
+			wantNew:     "size",
+			wantSimilar: true,
+		},
+		{
+			name:        "changed argument",
+			oldText:     "return render(ctx, width, height)",
+			newText:     "return render(ctx, maxWidth, height)",
+			wantOld:     "width",
+			wantNew:     "maxWidth",
+			wantSimilar: true,
+		},
+		{
+			name:        "pure insertion",
+			oldText:     "call(a, b)",
+			newText:     "call(a, b, c)",
+			wantOld:     "",
+			wantNew:     ", c",
+			wantSimilar: true,
+		},
+		{
+			name:        "pure deletion",
+			oldText:     "if err != nil && done {",
+			newText:     "if err != nil {",
+			wantOld:     "&& done",
+			wantNew:     "",
+			wantSimilar: true,
+		},
+		{
+			name:        "unrelated lines",
+			oldText:     "fmt.Println(hello)",
+			newText:     "return nil, errors.New(x)",
+			wantSimilar: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSegs, newSegs, similarity := diffTokens(tc.oldText, tc.newText)
+			if got := similarity >= wordDiffMinSimilarity; got != tc.wantSimilar {
+				t.Fatalf("similarity = %.2f, want similar=%v", similarity, tc.wantSimilar)
+			}
+			if segmentText(oldSegs) != tc.oldText || segmentText(newSegs) != tc.newText {
+				t.Fatalf("segments don't reassemble the input: %q / %q", segmentText(oldSegs), segmentText(newSegs))
+			}
+			if !tc.wantSimilar {
+				return
+			}
+			if got := changedText(oldSegs); got != tc.wantOld {
+				t.Errorf("old changed = %q, want %q", got, tc.wantOld)
+			}
+			if got := changedText(newSegs); got != tc.wantNew {
+				t.Errorf("new changed = %q, want %q", got, tc.wantNew)
+			}
+		})
+	}
+}
+
+func TestParseUnifiedDiff_WordDiffPairs(t *testing.T) {
+	diff := `--- a/main.go
++++ b/main.go
+@@ -1,3 +1,3 @@
+-x := compute(a, b)
+-fmt.Println("starting server")
++x := compute(a, c)
++return errors.New("bad")
+ done()`
+
+	parsed, err := ParseUnifiedDiff(diff)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := parsed.Hunks[0].Lines
+
+	// Similar pair (first remove / first add) gets word segments
+	if lines[0].WordDiff == nil || lines[2].WordDiff == nil {
+		t.Error("expected word diff for similar remove/add pair")
+	}
+	// Dissimilar pair (second remove / second add) is highlighted whole
+	if lines[1].WordDiff != nil || lines[3].WordDiff != nil {
+		t.Error("expected no word diff for dissimilar remove/add pair")
+	}
+	if lines[4].WordDiff != nil {
+		t.Error("expected no word diff for context line")
+	}
+}
+
 func TestParsedDiff_TotalLines(t *testing.T) {
 	// No trailing newline
 	diff := `--- a/file.txt
@@ -227,3 +409,59 @@ func TestParsedDiff_MaxLineNumber(t *testing.T) {
 		t.Errorf("MaxLineNumber() = %d, want 102", max)
 	}
 }
+
+func TestParseUnifiedDiff_MultiHunkLineNumbers(t *testing.T) {
+	diff := `diff --git a/main.go b/main.go
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,3 @@
+ package main
+-var a = 1
++var a = 2
+ var b = 3
+@@ -10,3 +10,4 @@ func main() {
+ 	x := 1
++	y := 2
+ 	z := 3
+-	w := 4
++	w := 5`
+	parsed, err := ParseUnifiedDiff(diff)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff: %v", err)
+	}
+	if len(parsed.Hunks) != 2 {
+		t.Fatalf("expected 2 hunks, got %d", len(parsed.Hunks))
+	}
+
+	type nums struct {
+		typ      LineType
+		old, new int
+	}
+	want := [][]nums{
+		{
+			{LineContext, 1, 1},
+			{LineRemove, 2, 0},
+			{LineAdd, 0, 2},
+			{LineContext, 3, 3},
+		},
+		{
+			{LineContext, 10, 10},
+			{LineAdd, 0, 11},
+			{LineContext, 11, 12},
+			{LineRemove, 12, 0},
+			{LineAdd, 0, 13},
+		},
+	}
+	for hi, hunk := range parsed.Hunks {
+		if len(hunk.Lines) != len(want[hi]) {
+			t.Fatalf("hunk %d: expected %d lines, got %d", hi, len(want[hi]), len(hunk.Lines))
+		}
+		for li, line := range hunk.Lines {
+			w := want[hi][li]
+			if line.Type != w.typ || line.OldLineNo != w.old || line.NewLineNo != w.new {
+				t.Errorf("hunk %d line %d: got type=%v old=%d new=%d, want type=%v old=%d new=%d",
+					hi, li, line.Type, line.OldLineNo, line.NewLineNo, w.typ, w.old, w.new)
+			}
+		}
+	}
+}
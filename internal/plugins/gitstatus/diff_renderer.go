@@ -42,14 +42,23 @@ var (
 			Bold(true)
 )
 
-// RenderLineDiff renders a parsed diff in unified line-by-line format with line numbers.
+// binarySummary describes a binary file change, with its sizes when known.
+func binarySummary(sizes *BinarySizes) string {
+	if sizes == nil {
+		return "Binary file changed"
+	}
+	return fmt.Sprintf("Binary file changed (old %d bytes → new %d bytes)", sizes.Old, sizes.New)
+}
+
+// RenderLineDiff renders a parsed diff in unified line-by-line format.
 // horizontalOffset scrolls the content horizontally (0 = no scroll).
 // highlighter is optional - if nil, no syntax highlighting is applied.
 // wrapEnabled wraps long lines instead of truncating them.
-func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffset int, highlighter *SyntaxHighlighter, wrapEnabled bool) string {
+// lineNumbers shows a gutter with each line's old and new line numbers.
+func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffset int, highlighter *SyntaxHighlighter, wrapEnabled, lineNumbers bool) string {
 	if diff == nil || diff.Binary {
 		if diff != nil && diff.Binary {
-			return styles.Muted.Render(" Binary file differs")
+			return styles.Muted.Render(" " + binarySummary(diff.Sizes))
 		}
 		return styles.Muted.Render(" No diff content")
 	}
@@ -59,10 +68,14 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 	rendered := 0
 
 	// Calculate line number width based on max line number
-	maxLineNo := diff.MaxLineNumber()
-	lineNoWidth := len(fmt.Sprintf("%d", maxLineNo))
-	if lineNoWidth < 4 {
-		lineNoWidth = 4
+	lineNoWidth := 0
+	gutterWidth := 0
+	if lineNumbers {
+		lineNoWidth = len(fmt.Sprintf("%d", diff.MaxLineNumber()))
+		if lineNoWidth < 4 {
+			lineNoWidth = 4
+		}
+		gutterWidth = lineNoWidth*2 + 3 // Two line numbers + separators
 	}
 
 	lineNoStyle := lipgloss.NewStyle().
@@ -70,7 +83,7 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 		Width(lineNoWidth).
 		Align(lipgloss.Right)
 
-	contentWidth := width - (lineNoWidth*2 + 4) // Two line numbers + separators
+	contentWidth := width - (gutterWidth + 1)
 	isFirstHunk := true
 
 	for _, hunk := range diff.Hunks {
@@ -121,29 +134,20 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 			}
 
 			// Format line numbers
-			oldNo := " "
-			newNo := " "
-			if line.OldLineNo > 0 {
-				oldNo = fmt.Sprintf("%d", line.OldLineNo)
+			var lineNos, lineNosPad string
+			if lineNumbers {
+				oldNo, newNo := lineNumberLabels(line)
+				lineNos = fmt.Sprintf("%s %s │",
+					lineNoStyle.Render(oldNo),
+					lineNoStyle.Render(newNo))
+				lineNosPad = strings.Repeat(" ", lineNoWidth*2+2) + "│" // blank line numbers for continuation rows
 			}
-			if line.NewLineNo > 0 {
-				newNo = fmt.Sprintf("%d", line.NewLineNo)
-			}
-
-			lineNos := fmt.Sprintf("%s %s │ ",
-				lineNoStyle.Render(oldNo),
-				lineNoStyle.Render(newNo))
 
-			// Render content with appropriate style
-			var content string
 			if wrapEnabled {
-				// Pass large width to avoid premature truncation; wrapping is done below
-				content = renderDiffContent(line, contentWidth*10, highlighter)
-				// Wrap long lines using lipgloss Width
-				wrapped := lipgloss.NewStyle().Width(contentWidth).Render(content)
-				wrappedLines := strings.Split(wrapped, "\n")
-				lineNosPad := strings.Repeat(" ", lineNoWidth*2+4) // blank padding for continuation lines
-				for wi, wl := range wrappedLines {
+				// The +/- marker takes the separator's padding column and is
+				// repeated on every continuation row.
+				rows := wrapDiffContent(line.Content, line.WordDiff, line.Type, contentWidth+1, highlighter)
+				for wi, row := range rows {
 					if rendered >= maxLines {
 						break
 					}
@@ -152,13 +156,14 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 					} else {
 						sb.WriteString(lineNosPad)
 					}
-					sb.WriteString(wl)
+					sb.WriteString(row)
 					sb.WriteString("\n")
 					rendered++
 				}
 			} else {
-				content = renderDiffContentWithOffset(line, contentWidth, horizontalOffset, highlighter)
+				content := renderDiffContentWithOffset(line, contentWidth, horizontalOffset, highlighter)
 				sb.WriteString(lineNos)
+				sb.WriteString(" ")
 				sb.WriteString(content)
 				sb.WriteString("\n")
 				rendered++
@@ -173,13 +178,26 @@ func RenderLineDiff(diff *ParsedDiff, width, startLine, maxLines, horizontalOffs
 	return sb.String()
 }
 
+// lineNumberLabels returns a line's old and new numbers for the gutter,
+// blank on the side where the line doesn't exist.
+func lineNumberLabels(line DiffLine) (oldNo, newNo string) {
+	oldNo, newNo = " ", " "
+	if line.OldLineNo > 0 {
+		oldNo = fmt.Sprintf("%d", line.OldLineNo)
+	}
+	if line.NewLineNo > 0 {
+		newNo = fmt.Sprintf("%d", line.NewLineNo)
+	}
+	return oldNo, newNo
+}
+
 // RenderSideBySide renders a parsed diff in side-by-side format.
 // highlighter is optional - if nil, no syntax highlighting is applied.
 // wrapEnabled wraps long lines instead of truncating them.
 func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOffset int, highlighter *SyntaxHighlighter, wrapEnabled bool) string {
 	if diff == nil || diff.Binary {
 		if diff != nil && diff.Binary {
-			return styles.Muted.Render(" Binary file differs")
+			return styles.Muted.Render(" " + binarySummary(diff.Sizes))
 		}
 		return styles.Muted.Render(" No diff content")
 	}
@@ -239,10 +257,7 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 				if pair.left.OldLineNo > 0 {
 					leftLineNo = fmt.Sprintf("%d", pair.left.OldLineNo)
 				}
-				if wrapEnabled {
-					// Pass large width to avoid premature truncation; caller wraps via lipgloss.Width()
-					leftRendered = renderSideBySideContent(pair.left.Content, pair.left.Type, contentWidth*10, highlighter)
-				} else {
+				if !wrapEnabled {
 					// Highlight full content first to preserve syntax context, then apply offset
 					leftRendered = renderSideBySideContent(pair.left.Content, pair.left.Type, contentWidth+horizontalOffset, highlighter)
 					if horizontalOffset > 0 {
@@ -258,10 +273,7 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 				if pair.right.NewLineNo > 0 {
 					rightLineNo = fmt.Sprintf("%d", pair.right.NewLineNo)
 				}
-				if wrapEnabled {
-					// Pass large width to avoid premature truncation; caller wraps via lipgloss.Width()
-					rightRendered = renderSideBySideContent(pair.right.Content, pair.right.Type, contentWidth*10, highlighter)
-				} else {
+				if !wrapEnabled {
 					// Highlight full content first to preserve syntax context, then apply offset
 					rightRendered = renderSideBySideContent(pair.right.Content, pair.right.Type, contentWidth+horizontalOffset, highlighter)
 					if horizontalOffset > 0 {
@@ -272,11 +284,14 @@ func RenderSideBySide(diff *ParsedDiff, width, startLine, maxLines, horizontalOf
 
 			if wrapEnabled {
 				// Wrap both sides and align heights
-				wrapStyle := lipgloss.NewStyle().Width(contentWidth)
-				leftWrapped := wrapStyle.Render(leftRendered)
-				rightWrapped := wrapStyle.Render(rightRendered)
-				leftLines := strings.Split(leftWrapped, "\n")
-				rightLines := strings.Split(rightWrapped, "\n")
+				leftLines := []string{""}
+				if pair.left != nil {
+					leftLines = wrapDiffContent(pair.left.Content, nil, pair.left.Type, contentWidth, highlighter)
+				}
+				rightLines := []string{""}
+				if pair.right != nil {
+					rightLines = wrapDiffContent(pair.right.Content, nil, pair.right.Type, contentWidth, highlighter)
+				}
 				maxH := len(leftLines)
 				if len(rightLines) > maxH {
 					maxH = len(rightLines)
@@ -422,18 +437,21 @@ func renderDiffContent(line DiffLine, maxWidth int, highlighter *SyntaxHighlight
 		baseStyle = styles.DiffContext
 	}
 
-	// If we have word diff data, use it (word diff takes priority over syntax)
+	// If we have word diff data, use it (word diff takes priority over syntax).
+	// Unchanged text keeps the subtle line background; changed spans get a brighter one.
 	if len(line.WordDiff) > 0 {
+		lineStyle := baseStyle.Background(styles.DiffAddBg)
+		changeStyle := wordDiffAddStyle.Background(styles.DiffAddEmphasisBg)
+		if line.Type == LineRemove {
+			lineStyle = baseStyle.Background(styles.DiffRemoveBg)
+			changeStyle = wordDiffRemoveStyle.Background(styles.DiffRemoveEmphasisBg)
+		}
 		var sb strings.Builder
 		for _, segment := range line.WordDiff {
 			if segment.IsChange {
-				if line.Type == LineAdd {
-					sb.WriteString(wordDiffAddStyle.Render(segment.Text))
-				} else {
-					sb.WriteString(wordDiffRemoveStyle.Render(segment.Text))
-				}
+				sb.WriteString(changeStyle.Render(segment.Text))
 			} else {
-				sb.WriteString(baseStyle.Render(segment.Text))
+				sb.WriteString(lineStyle.Render(segment.Text))
 			}
 		}
 		content := sb.String()
@@ -699,7 +717,7 @@ func RenderMultiFileDiff(mfd *MultiFileDiff, mode DiffViewMode, width, startLine
 		}
 
 		// Render file's diff content
-		fileContent := renderSingleFileDiff(file.Diff, mode, width, startLine-currentLine, maxLines-rendered, horizontalOffset, highlighter, wrapEnabled)
+		fileContent := renderSingleFileDiff(file.Diff, mode, width, startLine-currentLine, maxLines-rendered, horizontalOffset, highlighter, wrapEnabled, true)
 		fileLines := strings.Split(fileContent, "\n")
 
 		for _, line := range fileLines {
@@ -734,7 +752,7 @@ func RenderMultiFileDiff(mfd *MultiFileDiff, mode DiffViewMode, width, startLine
 }
 
 // renderSingleFileDiff renders a single file's diff without the file header.
-func renderSingleFileDiff(diff *ParsedDiff, mode DiffViewMode, width, startLine, maxLines, horizontalOffset int, highlighter *SyntaxHighlighter, wrapEnabled bool) string {
+func renderSingleFileDiff(diff *ParsedDiff, mode DiffViewMode, width, startLine, maxLines, horizontalOffset int, highlighter *SyntaxHighlighter, wrapEnabled, lineNumbers bool) string {
 	if startLine < 0 {
 		startLine = 0
 	}
@@ -745,7 +763,7 @@ func renderSingleFileDiff(diff *ParsedDiff, mode DiffViewMode, width, startLine,
 	if mode == DiffViewSideBySide {
 		return RenderSideBySide(diff, width, startLine, maxLines, horizontalOffset, highlighter, wrapEnabled)
 	}
-	return RenderLineDiff(diff, width, startLine, maxLines, horizontalOffset, highlighter, wrapEnabled)
+	return RenderLineDiff(diff, width, startLine, maxLines, horizontalOffset, highlighter, wrapEnabled, lineNumbers)
 }
 
 // TotalLines returns the total number of rendered lines for a multi-file diff.
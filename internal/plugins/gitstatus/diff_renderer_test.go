@@ -3,10 +3,12 @@ package gitstatus
 import (
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/x/ansi"
 )
 
 func TestRenderLineDiff_EmptyDiff(t *testing.T) {
-	result := RenderLineDiff(nil, 80, 0, 20, 0, nil, false)
+	result := RenderLineDiff(nil, 80, 0, 20, 0, nil, false, true)
 	if !strings.Contains(result, "No diff content") {
 		t.Error("expected 'No diff content' message for nil diff")
 	}
@@ -14,7 +16,7 @@ func TestRenderLineDiff_EmptyDiff(t *testing.T) {
 
 func TestRenderLineDiff_BinaryFile(t *testing.T) {
 	diff := &ParsedDiff{Binary: true}
-	result := RenderLineDiff(diff, 80, 0, 20, 0, nil, false)
+	result := RenderLineDiff(diff, 80, 0, 20, 0, nil, false, true)
 	if !strings.Contains(result, "Binary") {
 		t.Error("expected 'Binary' message for binary diff")
 	}
@@ -39,7 +41,7 @@ func TestRenderLineDiff_BasicOutput(t *testing.T) {
 		},
 	}
 
-	result := RenderLineDiff(diff, 80, 0, 20, 0, nil, false)
+	result := RenderLineDiff(diff, 80, 0, 20, 0, nil, false, true)
 
 	if result == "" {
 		t.Error("RenderLineDiff returned empty string")
@@ -234,13 +236,13 @@ func TestRenderLineDiff_WithHorizontalOffset(t *testing.T) {
 	}
 
 	// Without offset - should show full content
-	result0 := RenderLineDiff(diff, 80, 0, 20, 0, nil, false)
+	result0 := RenderLineDiff(diff, 80, 0, 20, 0, nil, false, true)
 	if !strings.Contains(result0, "0123456789") {
 		t.Error("expected full content when offset=0")
 	}
 
 	// With offset=5 - should skip first 5 chars
-	result5 := RenderLineDiff(diff, 80, 0, 20, 5, nil, false)
+	result5 := RenderLineDiff(diff, 80, 0, 20, 5, nil, false, true)
 	if strings.Contains(result5, "01234") {
 		t.Error("offset=5 should hide first 5 chars")
 	}
@@ -249,7 +251,7 @@ func TestRenderLineDiff_WithHorizontalOffset(t *testing.T) {
 	}
 
 	// With very large offset - should handle gracefully
-	result100 := RenderLineDiff(diff, 80, 0, 20, 100, nil, false)
+	result100 := RenderLineDiff(diff, 80, 0, 20, 100, nil, false, true)
 	if result100 == "" {
 		t.Error("large offset should not crash, should return something")
 	}
@@ -332,7 +334,7 @@ func TestRenderLineDiff_WrapEnabled(t *testing.T) {
 		},
 	}
 
-	result := RenderLineDiff(diff, 40, 0, 50, 0, nil, true)
+	result := RenderLineDiff(diff, 40, 0, 50, 0, nil, true, true)
 	if result == "" {
 		t.Fatal("wrap=true returned empty")
 	}
@@ -358,8 +360,8 @@ func TestRenderLineDiff_WrapVsTruncate(t *testing.T) {
 		},
 	}
 
-	truncated := RenderLineDiff(diff, 40, 0, 50, 0, nil, false)
-	wrapped := RenderLineDiff(diff, 40, 0, 50, 0, nil, true)
+	truncated := RenderLineDiff(diff, 40, 0, 50, 0, nil, false, true)
+	wrapped := RenderLineDiff(diff, 40, 0, 50, 0, nil, true, true)
 
 	truncLines := strings.Count(truncated, "\n")
 	wrapLines := strings.Count(wrapped, "\n")
@@ -410,7 +412,7 @@ func TestRenderLineDiff_WrapRespectsMaxLines(t *testing.T) {
 	}
 
 	maxLines := 3
-	result := RenderLineDiff(diff, 40, 0, maxLines, 0, nil, true)
+	result := RenderLineDiff(diff, 40, 0, maxLines, 0, nil, true, true)
 	lineCount := strings.Count(result, "\n")
 	if lineCount > maxLines {
 		t.Errorf("output has %d lines, should not exceed maxLines=%d", lineCount, maxLines)
@@ -437,11 +439,11 @@ func TestRenderLineDiff_WithWrapEnabled(t *testing.T) {
 	}
 
 	// Without wrap - should truncate
-	resultNoWrap := RenderLineDiff(diff, 80, 0, 20, 0, nil, false)
+	resultNoWrap := RenderLineDiff(diff, 80, 0, 20, 0, nil, false, true)
 	linesNoWrap := strings.Split(strings.TrimSpace(resultNoWrap), "\n")
 	
 	// With wrap - should create multiple lines
-	resultWrap := RenderLineDiff(diff, 80, 0, 20, 0, nil, true)
+	resultWrap := RenderLineDiff(diff, 80, 0, 20, 0, nil, true, true)
 	linesWrap := strings.Split(strings.TrimSpace(resultWrap), "\n")
 	
 	// Wrapped version should have more lines
@@ -469,7 +471,7 @@ func TestRenderLineDiff_WrapWithEmptyLines(t *testing.T) {
 		},
 	}
 
-	result := RenderLineDiff(diff, 80, 0, 20, 0, nil, true)
+	result := RenderLineDiff(diff, 80, 0, 20, 0, nil, true, true)
 	if result == "" {
 		t.Error("expected non-empty result with wrap enabled")
 	}
@@ -534,7 +536,7 @@ func TestRenderLineDiff_WrapVeryLongLine(t *testing.T) {
 		},
 	}
 
-	result := RenderLineDiff(diff, 80, 0, 50, 0, nil, true)
+	result := RenderLineDiff(diff, 80, 0, 50, 0, nil, true, true)
 	lines := strings.Split(strings.TrimSpace(result), "\n")
 	
 	// Should wrap into many lines
@@ -542,3 +544,54 @@ func TestRenderLineDiff_WrapVeryLongLine(t *testing.T) {
 		t.Errorf("expected at least 10 wrapped lines for 1500 char content, got %d", len(lines))
 	}
 }
+
+func TestLineNumberLabels(t *testing.T) {
+	tests := []struct {
+		line     DiffLine
+		old, new string
+	}{
+		{DiffLine{Type: LineContext, OldLineNo: 4, NewLineNo: 5}, "4", "5"},
+		{DiffLine{Type: LineRemove, OldLineNo: 7}, "7", " "},
+		{DiffLine{Type: LineAdd, NewLineNo: 12}, " ", "12"},
+	}
+	for _, tt := range tests {
+		oldNo, newNo := lineNumberLabels(tt.line)
+		if oldNo != tt.old || newNo != tt.new {
+			t.Errorf("lineNumberLabels(%+v) = (%q, %q), want (%q, %q)", tt.line, oldNo, newNo, tt.old, tt.new)
+		}
+	}
+}
+
+func TestRenderLineDiff_LineNumberGutterToggle(t *testing.T) {
+	diff := &ParsedDiff{
+		OldFile: "test.go",
+		NewFile: "test.go",
+		Hunks: []Hunk{
+			{
+				OldStart: 120,
+				OldCount: 1,
+				NewStart: 120,
+				NewCount: 1,
+				Lines: []DiffLine{
+					{Type: LineRemove, OldLineNo: 120, Content: "old"},
+					{Type: LineAdd, NewLineNo: 120, Content: "new"},
+				},
+			},
+		},
+	}
+
+	withNumbers := ansi.Strip(RenderLineDiff(diff, 80, 0, 20, 0, nil, false, true))
+	if !strings.Contains(withNumbers, "120") || !strings.Contains(withNumbers, "│") {
+		t.Errorf("expected line number gutter, got:\n%s", withNumbers)
+	}
+
+	withoutNumbers := ansi.Strip(RenderLineDiff(diff, 80, 0, 20, 0, nil, false, false))
+	if strings.Contains(withoutNumbers, "│") {
+		t.Errorf("expected no line number gutter, got:\n%s", withoutNumbers)
+	}
+	for _, line := range strings.Split(withoutNumbers, "\n")[1:] {
+		if strings.Contains(line, "120") {
+			t.Errorf("expected no line numbers on diff lines, got %q", line)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package gitstatus
+
+import (
+	"strings"
+	"unicode"
+)
+
+// IsWhitespaceOnly reports whether every change in the hunk is a change of
+// whitespace within lines, the way git diff -w sees it: the removed and added
+// lines match once all whitespace is stripped. Adding or removing blank lines
+// still counts as a change.
+func (h Hunk) IsWhitespaceOnly() bool {
+	var removed, added []string
+	for _, line := range h.Lines {
+		switch line.Type {
+		case LineRemove:
+			removed = append(removed, stripWhitespace(line.Content))
+		case LineAdd:
+			added = append(added, stripWhitespace(line.Content))
+		}
+	}
+	if len(removed) == 0 || len(removed) != len(added) {
+		return false
+	}
+	for i := range removed {
+		if removed[i] != added[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// stripWhitespace removes all whitespace from s.
+func stripWhitespace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// WithoutWhitespaceOnlyHunks returns a copy of the diff without the hunks
+// whose only changes are whitespace. The diff itself is returned when there
+// is nothing to drop.
+func (p *ParsedDiff) WithoutWhitespaceOnlyHunks() *ParsedDiff {
+	if p == nil {
+		return nil
+	}
+	hunks := make([]Hunk, 0, len(p.Hunks))
+	for _, hunk := range p.Hunks {
+		if !hunk.IsWhitespaceOnly() {
+			hunks = append(hunks, hunk)
+		}
+	}
+	if len(hunks) == len(p.Hunks) {
+		return p
+	}
+	filtered := *p
+	filtered.Hunks = hunks
+	return &filtered
+}
+
+// filterDiff applies the ignore-whitespace toggle to a freshly parsed diff.
+func (p *Plugin) filterDiff(parsed *ParsedDiff) *ParsedDiff {
+	if p.diffIgnoreSpace {
+		return parsed.WithoutWhitespaceOnlyHunks()
+	}
+	return parsed
+}
+
+// reparseFullDiff re-parses the full-screen diff from its raw text, so the
+// ignore-whitespace toggle applies without reloading, and keeps the scroll
+// position within the result.
+func (p *Plugin) reparseFullDiff() {
+	var sizes *BinarySizes
+	if p.parsedDiff != nil {
+		sizes = p.parsedDiff.Sizes
+	}
+	parsed, _ := ParseUnifiedDiff(p.diffRaw)
+	if parsed != nil && parsed.Binary {
+		parsed.Sizes = sizes
+	}
+	p.parsedDiff = p.filterDiff(parsed)
+
+	if p.parsedDiff != nil {
+		maxScroll := p.parsedDiff.TotalLines() - (p.height - 2)
+		if maxScroll < 0 {
+			maxScroll = 0
+		}
+		if p.diffScroll > maxScroll {
+			p.diffScroll = maxScroll
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package gitstatus
+
+import "testing"
+
+func TestHunkIsWhitespaceOnly(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []DiffLine
+		want  bool
+	}{
+		{
+			name: "reindented line",
+			lines: []DiffLine{
+				{Type: LineContext, Content: "func main() {"},
+				{Type: LineRemove, Content: "    x := 1"},
+				{Type: LineAdd, Content: "\tx := 1"},
+			},
+			want: true,
+		},
+		{
+			name: "spacing inside line and trailing space",
+			lines: []DiffLine{
+				{Type: LineRemove, Content: "a  =  b "},
+				{Type: LineRemove, Content: "c(d,e)"},
+				{Type: LineAdd, Content: "a = b"},
+				{Type: LineAdd, Content: "c(d, e)"},
+			},
+			want: true,
+		},
+		{
+			name: "content change",
+			lines: []DiffLine{
+				{Type: LineRemove, Content: "x := 1"},
+				{Type: LineAdd, Content: "x := 2"},
+			},
+			want: false,
+		},
+		{
+			name: "whitespace change mixed with content change",
+			lines: []DiffLine{
+				{Type: LineRemove, Content: "  a"},
+				{Type: LineRemove, Content: "b"},
+				{Type: LineAdd, Content: "a"},
+				{Type: LineAdd, Content: "c"},
+			},
+			want: false,
+		},
+		{
+			name: "added blank line",
+			lines: []DiffLine{
+				{Type: LineContext, Content: "a"},
+				{Type: LineAdd, Content: ""},
+			},
+			want: false,
+		},
+		{
+			name: "line joined",
+			lines: []DiffLine{
+				{Type: LineRemove, Content: "foo("},
+				{Type: LineRemove, Content: "  bar)"},
+				{Type: LineAdd, Content: "foo(bar)"},
+			},
+			want: false,
+		},
+		{
+			name:  "context only",
+			lines: []DiffLine{{Type: LineContext, Content: "a"}},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Hunk{Lines: tt.lines}).IsWhitespaceOnly(); got != tt.want {
+				t.Errorf("IsWhitespaceOnly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutWhitespaceOnlyHunks(t *testing.T) {
+	diff, err := ParseUnifiedDiff(`diff --git a/main.go b/main.go
+--- a/main.go
++++ b/main.go
+@@ -1,2 +1,2 @@
+ package main
+-var  a = 1
++var a = 1
+@@ -10,2 +10,2 @@ func main() {
+ 	x := 1
+-	y := 2
++	y := 3`)
+	if err != nil {
+		t.Fatalf("ParseUnifiedDiff: %v", err)
+	}
+
+	filtered := diff.WithoutWhitespaceOnlyHunks()
+	if len(filtered.Hunks) != 1 || filtered.Hunks[0].OldStart != 10 {
+		t.Fatalf("expected only the hunk at line 10 to remain, got %+v", filtered.Hunks)
+	}
+	if len(diff.Hunks) != 2 {
+		t.Errorf("original diff was modified: %d hunks", len(diff.Hunks))
+	}
+	if filtered.OldFile != "main.go" || filtered.NewFile != "main.go" {
+		t.Errorf("file names not preserved: %q -> %q", filtered.OldFile, filtered.NewFile)
+	}
+
+	// Nothing to drop returns the diff itself
+	if again := filtered.WithoutWhitespaceOnlyHunks(); again != filtered {
+		t.Error("expected the same diff when no hunks are whitespace-only")
+	}
+	if (*ParsedDiff)(nil).WithoutWhitespaceOnlyHunks() != nil {
+		t.Error("expected nil for a nil diff")
+	}
+}
+
+func TestReparseFullDiffTogglesWhitespace(t *testing.T) {
+	p := &Plugin{height: 40}
+	p.diffRaw = `diff --git a/a.go b/a.go
+--- a/a.go
++++ b/a.go
+@@ -1,1 +1,1 @@
+-  a
++a`
+	p.reparseFullDiff()
+	if len(p.parsedDiff.Hunks) != 1 {
+		t.Fatalf("expected 1 hunk with whitespace shown, got %d", len(p.parsedDiff.Hunks))
+	}
+
+	p.diffIgnoreSpace = true
+	p.diffScroll = 5
+	p.reparseFullDiff()
+	if len(p.parsedDiff.Hunks) != 0 {
+		t.Errorf("expected whitespace-only hunk hidden, got %d hunks", len(p.parsedDiff.Hunks))
+	}
+	if p.diffScroll != 0 {
+		t.Errorf("diffScroll = %d, want clamped to 0", p.diffScroll)
+	}
+}
@@ -0,0 +1,116 @@
+package gitstatus
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// diffLineSegments splits diff content into styled runs, using the same
+// precedence as renderDiffContent: word diff, then syntax, then line style.
+func diffLineSegments(content string, wordDiff []WordSegment, lineType LineType, highlighter *SyntaxHighlighter) []HighlightSegment {
+	var baseStyle lipgloss.Style
+	switch lineType {
+	case LineAdd:
+		baseStyle = styles.DiffAdd.Background(styles.DiffAddBg)
+	case LineRemove:
+		baseStyle = styles.DiffRemove.Background(styles.DiffRemoveBg)
+	default:
+		baseStyle = styles.DiffContext
+	}
+
+	if len(wordDiff) > 0 {
+		changeStyle := wordDiffAddStyle.Background(styles.DiffAddEmphasisBg)
+		if lineType == LineRemove {
+			changeStyle = wordDiffRemoveStyle.Background(styles.DiffRemoveEmphasisBg)
+		}
+		segs := make([]HighlightSegment, 0, len(wordDiff))
+		for _, seg := range wordDiff {
+			style := baseStyle
+			if seg.IsChange {
+				style = changeStyle
+			}
+			segs = append(segs, HighlightSegment{Text: seg.Text, Style: style})
+		}
+		return segs
+	}
+
+	if highlighter != nil {
+		if highlighted := highlighter.HighlightLine(content); len(highlighted) > 0 {
+			segs := make([]HighlightSegment, 0, len(highlighted))
+			for _, seg := range highlighted {
+				segs = append(segs, HighlightSegment{Text: seg.Text, Style: blendSyntaxWithDiff(seg.Style, lineType)})
+			}
+			return segs
+		}
+	}
+
+	return []HighlightSegment{{Text: content, Style: baseStyle}}
+}
+
+// wrapStyledSegments breaks styled runs into rows of at most width cells.
+// Each row is rendered on its own so styling survives on continuation rows,
+// which a plain word-wrap of pre-rendered ANSI output does not guarantee.
+func wrapStyledSegments(segs []HighlightSegment, width int) []string {
+	if width < 1 {
+		width = 1
+	}
+
+	var rows []string
+	var row strings.Builder
+	rowWidth := 0
+
+	flush := func() {
+		rows = append(rows, row.String())
+		row.Reset()
+		rowWidth = 0
+	}
+
+	for _, seg := range segs {
+		var run strings.Builder
+		for _, r := range seg.Text {
+			w := ansi.StringWidth(string(r))
+			if rowWidth+w > width && rowWidth > 0 {
+				if run.Len() > 0 {
+					row.WriteString(seg.Style.Render(run.String()))
+					run.Reset()
+				}
+				flush()
+			}
+			run.WriteRune(r)
+			rowWidth += w
+		}
+		if run.Len() > 0 {
+			row.WriteString(seg.Style.Render(run.String()))
+		}
+	}
+	if row.Len() > 0 || len(rows) == 0 {
+		flush()
+	}
+	return rows
+}
+
+// diffMarker returns the styled +/- gutter marker for a line type.
+func diffMarker(lineType LineType) string {
+	switch lineType {
+	case LineAdd:
+		return styles.DiffAdd.Render("+")
+	case LineRemove:
+		return styles.DiffRemove.Render("-")
+	default:
+		return " "
+	}
+}
+
+// wrapDiffContent renders diff content as rows of at most width cells, each
+// led by the line's +/- marker so continuation rows stay attributable.
+func wrapDiffContent(content string, wordDiff []WordSegment, lineType LineType, width int, highlighter *SyntaxHighlighter) []string {
+	marker := diffMarker(lineType)
+	rows := wrapStyledSegments(diffLineSegments(content, wordDiff, lineType, highlighter), width-1)
+	for i, row := range rows {
+		rows[i] = marker + row
+	}
+	return rows
+}
@@ -0,0 +1,100 @@
+package gitstatus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestWrapDiffContent_MarkerOnEveryRow(t *testing.T) {
+	content := strings.Repeat("abcdefghij", 5) // 50 cells
+	wordDiff := []WordSegment{
+		{Text: content[:20], IsChange: false},
+		{Text: content[20:], IsChange: true},
+	}
+
+	for _, tc := range []struct {
+		lineType LineType
+		marker   string
+	}{
+		{LineAdd, "+"},
+		{LineRemove, "-"},
+		{LineContext, " "},
+	} {
+		rows := wrapDiffContent(content, wordDiff, tc.lineType, 16, nil)
+		if len(rows) != 4 { // 15 content cells per row
+			t.Fatalf("type %v: got %d rows, want 4", tc.lineType, len(rows))
+		}
+
+		var joined strings.Builder
+		for i, row := range rows {
+			plain := ansi.Strip(row)
+			if !strings.HasPrefix(plain, tc.marker) {
+				t.Errorf("type %v row %d = %q, want %q marker", tc.lineType, i, plain, tc.marker)
+			}
+			if w := ansi.StringWidth(plain); w > 16 {
+				t.Errorf("type %v row %d width = %d, want <= 16", tc.lineType, i, w)
+			}
+			joined.WriteString(strings.TrimPrefix(plain, tc.marker))
+		}
+		if joined.String() != content {
+			t.Errorf("type %v: rows rejoin to %q, want %q", tc.lineType, joined.String(), content)
+		}
+	}
+}
+
+func TestWrapStyledSegments_RestylesContinuationRows(t *testing.T) {
+	// A transform makes styling visible without a color profile: every
+	// piece rendered with the style is bracketed.
+	bracket := lipgloss.NewStyle().Transform(func(s string) string { return "[" + s + "]" })
+	segs := []HighlightSegment{
+		{Text: "func", Style: bracket},
+		{Text: " main()", Style: lipgloss.NewStyle()},
+	}
+
+	rows := wrapStyledSegments(segs, 3)
+	want := []string{"[fun]", "[c] m", "ain", "()"}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows %q, want %d", len(rows), rows, len(want))
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d = %q, want %q", i, rows[i], want[i])
+		}
+	}
+}
+
+func TestWrapStyledSegments_Empty(t *testing.T) {
+	rows := wrapStyledSegments(nil, 10)
+	if len(rows) != 1 || rows[0] != "" {
+		t.Errorf("got %q, want a single empty row", rows)
+	}
+}
+
+func TestRenderLineDiff_WrapKeepsMarker(t *testing.T) {
+	diff := &ParsedDiff{
+		Hunks: []Hunk{{
+			OldStart: 1, OldCount: 0, NewStart: 1, NewCount: 1,
+			Lines: []DiffLine{
+				{Type: LineAdd, NewLineNo: 1, Content: strings.Repeat("x", 60)},
+			},
+		}},
+	}
+
+	result := RenderLineDiff(diff, 30, 0, 50, 0, nil, true, true)
+	lines := strings.Split(strings.TrimRight(result, "\n"), "\n")[1:] // skip hunk header
+	if len(lines) < 3 {
+		t.Fatalf("expected wrapped rows, got %q", lines)
+	}
+	for i, line := range lines {
+		plain := ansi.Strip(line)
+		if !strings.Contains(plain, "│+") {
+			t.Errorf("row %d = %q, want │+ gutter", i, plain)
+		}
+		if w := ansi.StringWidth(plain); w > 30 {
+			t.Errorf("row %d width = %d, want <= 30", i, w)
+		}
+	}
+}
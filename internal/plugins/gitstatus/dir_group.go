@@ -0,0 +1,228 @@
+package gitstatus
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/state"
+)
+
+// Files returns the file entries under e: e itself for a file, or every
+// file below it for a folder.
+func (e *FileEntry) Files() []*FileEntry {
+	if !e.IsFolder {
+		return []*FileEntry{e}
+	}
+	var files []*FileEntry
+	for _, child := range e.Children {
+		files = append(files, child.Files()...)
+	}
+	return files
+}
+
+// fileLeaves expands folder entries into their files.
+func fileLeaves(entries []*FileEntry) []*FileEntry {
+	var files []*FileEntry
+	for _, entry := range entries {
+		files = append(files, entry.Files()...)
+	}
+	return files
+}
+
+// dirNode is a directory in the hierarchy built by groupByDirectory.
+type dirNode struct {
+	dirs  map[string]*dirNode
+	files []*FileEntry
+}
+
+func (n *dirNode) child(name string) *dirNode {
+	if n.dirs == nil {
+		n.dirs = make(map[string]*dirNode)
+	}
+	c, ok := n.dirs[name]
+	if !ok {
+		c = &dirNode{}
+		n.dirs[name] = c
+	}
+	return c
+}
+
+// groupByDirectory builds a folder hierarchy from a flat file list. Each
+// directory becomes a folder entry (Path ending in "/") whose Children are
+// its subfolders followed by its files. A directory holding nothing but a
+// single subdirectory is merged into it, so deep common prefixes collapse
+// into one row like "internal/plugins/". Files are copied so callers can
+// set display fields without touching the tree's entries.
+func groupByDirectory(entries []*FileEntry) []*FileEntry {
+	root := &dirNode{}
+	for _, file := range fileLeaves(entries) {
+		node := root
+		parts := strings.Split(file.Path, "/")
+		for _, dir := range parts[:len(parts)-1] {
+			node = node.child(dir)
+		}
+		node.files = append(node.files, file)
+	}
+	return root.entries("")
+}
+
+func (n *dirNode) entries(prefix string) []*FileEntry {
+	names := make([]string, 0, len(n.dirs))
+	for name := range n.dirs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]*FileEntry, 0, len(names)+len(n.files))
+	for _, name := range names {
+		dir := n.dirs[name]
+		dirPath := prefix + name + "/"
+		// Merge chains of single-subdirectory folders into one row
+		for len(dir.files) == 0 && len(dir.dirs) == 1 {
+			for sub, only := range dir.dirs {
+				dirPath += sub + "/"
+				dir = only
+			}
+		}
+		folder := &FileEntry{
+			Path:     dirPath,
+			Label:    strings.TrimPrefix(dirPath, prefix),
+			IsFolder: true,
+			Children: dir.entries(dirPath),
+		}
+		setFolderStatus(folder)
+		out = append(out, folder)
+	}
+
+	files := make([]*FileEntry, len(n.files))
+	for i, f := range n.files {
+		file := *f
+		file.Label = path.Base(f.Path)
+		files[i] = &file
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+	return append(out, files...)
+}
+
+// setFolderStatus derives a folder row's status from its files: the shared
+// status when uniform, otherwise modified. The folder counts as staged only
+// when every file is staged.
+func setFolderStatus(folder *FileEntry) {
+	files := folder.Files()
+	if len(files) == 0 {
+		return
+	}
+	folder.Status = files[0].Status
+	folder.Staged = true
+	for _, f := range files {
+		if f.Status != folder.Status {
+			folder.Status = StatusModified
+		}
+		if !f.Staged {
+			folder.Staged = false
+		}
+		if f.Unstaged {
+			folder.Unstaged = true
+		}
+		folder.DiffStats.Additions += f.DiffStats.Additions
+		folder.DiffStats.Deletions += f.DiffStats.Deletions
+	}
+}
+
+// flattenDirGroups lists the rows of a folder hierarchy in display order,
+// skipping the contents of collapsed folders. Folder keys are qualified by
+// section so the same directory can be collapsed independently in Staged and
+// Modified.
+func flattenDirGroups(nodes []*FileEntry, section string, depth int, collapsed map[string]bool) []*FileEntry {
+	var rows []*FileEntry
+	for _, node := range nodes {
+		node.Depth = depth
+		rows = append(rows, node)
+		if !node.IsFolder {
+			continue
+		}
+		node.groupKey = section + ":" + node.Path
+		node.IsExpanded = !collapsed[node.groupKey]
+		if node.IsExpanded {
+			rows = append(rows, flattenDirGroups(node.Children, section, depth+1, collapsed)...)
+		}
+	}
+	return rows
+}
+
+// SetGrouped switches between the flat file list and directory grouping.
+func (t *FileTree) SetGrouped(grouped bool) {
+	t.grouped = grouped
+}
+
+// Grouped reports whether files are grouped by directory.
+func (t *FileTree) Grouped() bool {
+	return t.grouped
+}
+
+// ToggleFolder expands or collapses a folder row. Directory groups are
+// rebuilt on every call, so their state is kept on the tree by key and
+// survives refreshes.
+func (t *FileTree) ToggleFolder(entry *FileEntry) {
+	if entry.groupKey == "" {
+		entry.IsExpanded = !entry.IsExpanded
+		return
+	}
+	if t.collapsed == nil {
+		t.collapsed = make(map[string]bool)
+	}
+	if t.collapsed[entry.groupKey] {
+		delete(t.collapsed, entry.groupKey)
+	} else {
+		t.collapsed[entry.groupKey] = true
+	}
+}
+
+// sectionRows returns the display rows for one sidebar section: the filtered
+// entries as-is, or grouped into directories when grouping is on.
+func (t *FileTree) sectionRows(section string, entries []*FileEntry) []*FileEntry {
+	if !t.grouped {
+		return filterFileEntries(entries, t.filter)
+	}
+	files := filterFileEntries(fileLeaves(entries), t.filter)
+	return flattenDirGroups(groupByDirectory(files), section, 0, t.collapsed)
+}
+
+// StagedRows returns the display rows for the Staged section.
+func (t *FileTree) StagedRows() []*FileEntry {
+	return t.sectionRows("staged", t.Staged)
+}
+
+// ModifiedRows returns the display rows for the Modified section.
+func (t *FileTree) ModifiedRows() []*FileEntry {
+	return t.sectionRows("modified", t.Modified)
+}
+
+// UntrackedRows returns the display rows for the Untracked section.
+func (t *FileTree) UntrackedRows() []*FileEntry {
+	return t.sectionRows("untracked", t.Untracked)
+}
+
+// toggleFileGrouping switches the sidebar between the flat list and
+// directory grouping, keeping the cursor on the selected file.
+func (p *Plugin) toggleFileGrouping() tea.Cmd {
+	p.tree.SetGrouped(!p.tree.Grouped())
+	_ = state.SetGitFilesGrouped(p.tree.Grouped())
+	p.followSelectedEntry()
+	p.restoreFollowedEntry()
+	p.ensureCursorVisible()
+	return p.autoLoadPreview(false)
+}
+
+// followSelectedEntry makes the next restoreFollowedEntry keep the cursor on
+// the previewed file. Grouped rows shift as folders appear and disappear,
+// so the cursor follows the file rather than its index.
+func (p *Plugin) followSelectedEntry() {
+	if !p.tree.Grouped() || p.followEntryPath != "" || p.previewCommit != nil || p.selectedDiffFile == "" {
+		return
+	}
+	p.followEntryPath = p.selectedDiffFile
+	p.followEntryStaged = p.selectedDiffStaged
+}
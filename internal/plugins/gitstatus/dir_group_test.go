@@ -0,0 +1,110 @@
+package gitstatus
+
+import (
+	"reflect"
+	"testing"
+)
+
+// rowLabels renders rows as indented labels for compact comparison.
+func rowLabels(rows []*FileEntry) []string {
+	var out []string
+	for _, r := range rows {
+		label := r.Label
+		for i := 0; i < r.Depth; i++ {
+			label = "  " + label
+		}
+		out = append(out, label)
+	}
+	return out
+}
+
+func TestGroupByDirectory(t *testing.T) {
+	entries := []*FileEntry{
+		{Path: "main.go", Status: StatusModified},
+		{Path: "internal/plugins/gitstatus/tree.go", Status: StatusModified, DiffStats: DiffStats{Additions: 3, Deletions: 1}},
+		{Path: "internal/plugins/gitstatus/dir_group.go", Status: StatusAdded, DiffStats: DiffStats{Additions: 10}},
+		{Path: "internal/plugins/files/view.go", Status: StatusModified},
+		{Path: "docs/a/b/guide.md", Status: StatusAdded},
+	}
+
+	groups := groupByDirectory(entries)
+	got := rowLabels(flattenDirGroups(groups, "modified", 0, nil))
+	want := []string{
+		"docs/a/b/",
+		"  guide.md",
+		"internal/plugins/",
+		"  files/",
+		"    view.go",
+		"  gitstatus/",
+		"    dir_group.go",
+		"    tree.go",
+		"main.go",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("rows = %q, want %q", got, want)
+	}
+
+	gitstatus := groups[1].Children[1]
+	if gitstatus.Path != "internal/plugins/gitstatus/" {
+		t.Fatalf("folder path = %q", gitstatus.Path)
+	}
+	if gitstatus.Status != StatusModified {
+		t.Errorf("mixed folder status = %q, want modified", gitstatus.Status)
+	}
+	if gitstatus.DiffStats.Additions != 13 || gitstatus.DiffStats.Deletions != 1 {
+		t.Errorf("folder stats = %+v, want +13 -1", gitstatus.DiffStats)
+	}
+	if groups[0].Status != StatusAdded {
+		t.Errorf("uniform folder status = %q, want added", groups[0].Status)
+	}
+	if entries[1].Label != "" {
+		t.Error("grouping modified the tree's entries")
+	}
+}
+
+func TestGroupByDirectoryExpandsUntrackedFolders(t *testing.T) {
+	entries := []*FileEntry{
+		{Path: "web/", IsFolder: true, Status: StatusUntracked, Children: []*FileEntry{
+			{Path: "web/app.ts", Status: StatusUntracked},
+			{Path: "web/index.html", Status: StatusUntracked},
+		}},
+	}
+
+	got := rowLabels(flattenDirGroups(groupByDirectory(entries), "untracked", 0, nil))
+	want := []string{"web/", "  app.ts", "  index.html"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %q, want %q", got, want)
+	}
+}
+
+func TestFileTreeToggleFolderSurvivesRefresh(t *testing.T) {
+	tree := &FileTree{
+		Staged:   []*FileEntry{{Path: "cmd/main.go", Staged: true}},
+		Modified: []*FileEntry{{Path: "cmd/main.go"}, {Path: "cmd/util.go"}, {Path: "README.md"}},
+	}
+	tree.SetGrouped(true)
+
+	rows := tree.ModifiedRows()
+	if len(rows) != 4 {
+		t.Fatalf("ModifiedRows() = %q, want 4 rows", rowLabels(rows))
+	}
+	tree.ToggleFolder(rows[0])
+
+	// A refresh replaces the entries; the collapsed state is kept by key
+	tree.Modified = []*FileEntry{{Path: "cmd/main.go"}, {Path: "cmd/util.go"}, {Path: "README.md"}}
+	got := rowLabels(tree.ModifiedRows())
+	if want := []string{"cmd/", "README.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("collapsed rows = %q, want %q", got, want)
+	}
+	if rows := tree.StagedRows(); len(rows) != 2 || !rows[0].IsExpanded {
+		t.Errorf("collapsing in Modified affected Staged: %q", rowLabels(rows))
+	}
+	if n := len(tree.AllEntries()); n != 4 {
+		t.Errorf("AllEntries() has %d rows, want 4", n)
+	}
+
+	tree.ToggleFolder(tree.ModifiedRows()[0])
+	if n := len(tree.ModifiedRows()); n != 4 {
+		t.Errorf("expanded ModifiedRows() has %d rows, want 4", n)
+	}
+}
@@ -0,0 +1,199 @@
+package gitstatus
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/app"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// matchesFileFilter reports whether filePath passes the sidebar filter.
+// The query is a whitespace-separated list of terms. Terms containing glob
+// characters (*?[) match the full path or the base name; other terms are
+// case-insensitive substrings. A leading "!" negates a term. A path passes
+// when it matches any positive term (or there are none) and no negated term.
+func matchesFileFilter(query, filePath string) bool {
+	hasInclude := false
+	included := false
+	for _, term := range strings.Fields(query) {
+		negate := strings.HasPrefix(term, "!")
+		if negate {
+			term = term[1:]
+			if term == "" {
+				continue
+			}
+		}
+		matched := fileFilterTermMatches(term, filePath)
+		if negate {
+			if matched {
+				return false
+			}
+			continue
+		}
+		hasInclude = true
+		if matched {
+			included = true
+		}
+	}
+	return !hasInclude || included
+}
+
+func fileFilterTermMatches(term, filePath string) bool {
+	term = strings.ToLower(term)
+	filePath = strings.ToLower(filePath)
+	if strings.ContainsAny(term, "*?[") {
+		if ok, _ := path.Match(term, filePath); ok {
+			return true
+		}
+		ok, _ := path.Match(term, path.Base(filePath))
+		return ok
+	}
+	return strings.Contains(filePath, term)
+}
+
+// filterFileEntries returns the entries that pass the filter. Untracked
+// folder entries are kept when any child passes.
+func filterFileEntries(entries []*FileEntry, query string) []*FileEntry {
+	if strings.TrimSpace(query) == "" {
+		return entries
+	}
+	var out []*FileEntry
+	for _, entry := range entries {
+		if entry.IsFolder {
+			if len(filterFileEntries(entry.Children, query)) > 0 {
+				out = append(out, entry)
+			}
+			continue
+		}
+		if matchesFileFilter(query, entry.Path) {
+			out = append(out, entry)
+		}
+	}
+	return out
+}
+
+// SetFilter narrows AllEntries and the Visible* lists to matching paths.
+// An empty query shows everything.
+func (t *FileTree) SetFilter(query string) {
+	t.filter = query
+}
+
+// Filter returns the active filter query.
+func (t *FileTree) Filter() string {
+	return t.filter
+}
+
+// VisibleStaged returns staged entries that pass the filter.
+func (t *FileTree) VisibleStaged() []*FileEntry {
+	return filterFileEntries(t.Staged, t.filter)
+}
+
+// VisibleModified returns modified entries that pass the filter.
+func (t *FileTree) VisibleModified() []*FileEntry {
+	return filterFileEntries(t.Modified, t.filter)
+}
+
+// VisibleUntracked returns untracked entries that pass the filter.
+func (t *FileTree) VisibleUntracked() []*FileEntry {
+	return filterFileEntries(t.Untracked, t.filter)
+}
+
+// VisibleFiles returns the files under entry that pass the filter, so
+// folder actions skip files the filter hides.
+func (t *FileTree) VisibleFiles(entry *FileEntry) []*FileEntry {
+	return filterFileEntries(entry.Files(), t.filter)
+}
+
+// VisibleUnstagedFiles returns the modified and untracked files that pass
+// the filter, with folders expanded.
+func (t *FileTree) VisibleUnstagedFiles() []*FileEntry {
+	files := fileLeaves(t.Modified)
+	files = append(files, fileLeaves(t.Untracked)...)
+	return filterFileEntries(files, t.filter)
+}
+
+// openFileFilter starts editing the sidebar file filter.
+func (p *Plugin) openFileFilter() {
+	p.fileFilterMode = true
+}
+
+// setFileFilter applies query to the file list and keeps the cursor in
+// bounds of the narrowed list.
+func (p *Plugin) setFileFilter(query string) tea.Cmd {
+	p.tree.SetFilter(query)
+	p.cursor = 0
+	p.scrollOff = 0
+	return p.autoLoadPreview(false)
+}
+
+// updateFileFilter handles key events while the file filter is being edited.
+// Filtering is live; enter keeps the filter and esc clears it.
+func (p *Plugin) updateFileFilter(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	key := msg.String()
+	query := p.tree.Filter()
+
+	switch key {
+	case "esc":
+		p.fileFilterMode = false
+		return p, p.setFileFilter("")
+
+	case "enter":
+		p.fileFilterMode = false
+		return p, nil
+
+	case "backspace":
+		if query != "" {
+			return p, p.setFileFilter(query[:len(query)-1])
+		}
+		return p, nil
+
+	default:
+		// Append printable characters (including space between terms)
+		if len(key) == 1 && key[0] >= 32 && key[0] < 127 {
+			return p, p.setFileFilter(query + key)
+		}
+		return p, nil
+	}
+}
+
+// stageVisibleFiles stages the unstaged files the filter shows, leaving
+// hidden files alone.
+func (p *Plugin) stageVisibleFiles() tea.Cmd {
+	files := p.tree.VisibleUnstagedFiles()
+	if len(files) == 0 {
+		return nil
+	}
+	for _, f := range files {
+		if err := p.tree.StageFile(f.Path); err != nil {
+			return tea.Batch(p.refresh(), func() tea.Msg {
+				return app.ToastMsg{Message: "Stage failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+			})
+		}
+	}
+	msg := fmt.Sprintf("Staged %d filtered files", len(files))
+	return tea.Batch(p.refresh(), p.loadRecentCommits(), func() tea.Msg {
+		return app.ToastMsg{Message: msg, Duration: 2 * time.Second}
+	})
+}
+
+// fileFilterVisible reports whether the sidebar shows the filter line.
+func (p *Plugin) fileFilterVisible() bool {
+	return p.fileFilterMode || p.tree.Filter() != ""
+}
+
+// renderFileFilterLine renders the filter query and match count shown above
+// the file sections.
+func (p *Plugin) renderFileFilterLine() string {
+	matched := len(p.tree.VisibleStaged()) + len(p.tree.VisibleModified()) + len(p.tree.VisibleUntracked())
+
+	line := styles.Muted.Render("Filter: ") + p.tree.Filter()
+	if p.fileFilterMode {
+		line += styles.Muted.Render("_")
+	}
+	return line + styles.Muted.Render(fmt.Sprintf(" (%d/%d)", matched, p.tree.TotalCount()))
+}
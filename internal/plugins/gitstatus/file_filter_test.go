@@ -0,0 +1,137 @@
+package gitstatus
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatchesFileFilter(t *testing.T) {
+	tests := []struct {
+		query string
+		path  string
+		want  bool
+	}{
+		{"", "main.go", true},
+		{"*.go", "main.go", true},
+		{"*.go", "internal/app/update.go", true}, // glob matches base name
+		{"*.go", "README.md", false},
+		{"internal/*/update.go", "internal/app/update.go", true},
+		{"app", "internal/app/update.go", true},
+		{"APP", "internal/app/update.go", true}, // case-insensitive
+		{"!*_test.go", "main.go", true},
+		{"!*_test.go", "main_test.go", false},
+		{"*.go !*_test.go", "tree_test.go", false},
+		{"*.go !*_test.go", "tree.go", true},
+		{"*.go !vendor/", "vendor/x/y.go", false},
+		{"*.md *.go", "docs/guide.md", true},
+		{"*.md *.go", "Makefile", false},
+		{"!", "main.go", true}, // bare negation is ignored
+	}
+
+	for _, tt := range tests {
+		if got := matchesFileFilter(tt.query, tt.path); got != tt.want {
+			t.Errorf("matchesFileFilter(%q, %q) = %v, want %v", tt.query, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestFilterFileEntries(t *testing.T) {
+	entries := []*FileEntry{
+		{Path: "cmd/main.go"},
+		{Path: "cmd/main_test.go"},
+		{Path: "README.md"},
+		{Path: "web/", IsFolder: true, Children: []*FileEntry{
+			{Path: "web/app.ts"},
+			{Path: "web/index.html"},
+		}},
+	}
+
+	paths := func(es []*FileEntry) []string {
+		var out []string
+		for _, e := range es {
+			out = append(out, e.Path)
+		}
+		return out
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", []string{"cmd/main.go", "cmd/main_test.go", "README.md", "web/"}},
+		{"*.go", []string{"cmd/main.go", "cmd/main_test.go"}},
+		{"*.go !*_test.go", []string{"cmd/main.go"}},
+		{"!*.go", []string{"README.md", "web/"}},
+		{"*.ts", []string{"web/"}}, // folder kept for a matching child
+		{"*.rs", nil},
+	}
+
+	for _, tt := range tests {
+		if got := paths(filterFileEntries(entries, tt.query)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("filterFileEntries(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestFileTreeAllEntriesHonorsFilter(t *testing.T) {
+	folder := &FileEntry{Path: "web/", IsFolder: true, IsExpanded: true, Children: []*FileEntry{
+		{Path: "web/app.go"},
+		{Path: "web/style.css"},
+	}}
+	tree := &FileTree{
+		Staged:    []*FileEntry{{Path: "a.go"}, {Path: "b.md"}},
+		Modified:  []*FileEntry{{Path: "c.go"}},
+		Untracked: []*FileEntry{folder},
+	}
+
+	tree.SetFilter("*.go")
+	var got []string
+	for _, e := range tree.AllEntries() {
+		got = append(got, e.Path)
+	}
+	want := []string{"a.go", "c.go", "web/", "web/app.go"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AllEntries() = %v, want %v", got, want)
+	}
+	if tree.TotalCount() != 4 {
+		t.Errorf("TotalCount() = %d, want unfiltered 4", tree.TotalCount())
+	}
+
+	tree.SetFilter("")
+	if n := len(tree.AllEntries()); n != 6 {
+		t.Errorf("unfiltered AllEntries() has %d entries, want 6", n)
+	}
+}
+
+func TestFileTreeVisibleFilesHonorFilter(t *testing.T) {
+	folder := &FileEntry{Path: "web/", IsFolder: true, Children: []*FileEntry{
+		{Path: "web/app.go"},
+		{Path: "web/style.css"},
+	}}
+	tree := &FileTree{
+		Staged:    []*FileEntry{{Path: "a.go"}},
+		Modified:  []*FileEntry{{Path: "c.go"}, {Path: "d.md"}},
+		Untracked: []*FileEntry{folder},
+	}
+
+	paths := func(es []*FileEntry) []string {
+		var out []string
+		for _, e := range es {
+			out = append(out, e.Path)
+		}
+		return out
+	}
+
+	tree.SetFilter("*.go")
+	if got, want := paths(tree.VisibleFiles(folder)), []string{"web/app.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("VisibleFiles(web/) = %v, want %v", got, want)
+	}
+	if got, want := paths(tree.VisibleUnstagedFiles()), []string{"c.go", "web/app.go"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("VisibleUnstagedFiles() = %v, want %v", got, want)
+	}
+
+	tree.SetFilter("")
+	if got, want := paths(tree.VisibleUnstagedFiles()), []string{"c.go", "d.md", "web/app.go", "web/style.css"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unfiltered VisibleUnstagedFiles() = %v, want %v", got, want)
+	}
+}
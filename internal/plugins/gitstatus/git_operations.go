@@ -24,7 +24,7 @@ func (p *Plugin) doCommit(message string) tea.Cmd {
 func (p *Plugin) doAmend(message string) tea.Cmd {
 	workDir := p.repoRoot
 	return func() tea.Msg {
-		hash, err := ExecuteAmend(workDir, message)
+		hash, err := ExecuteCommitAmend(workDir, message)
 		if err != nil {
 			return CommitErrorMsg{Err: err}
 		}
@@ -101,6 +101,33 @@ func (p *Plugin) doStashApply() tea.Cmd {
 	}
 }
 
+// doStashApplyRef applies a specific stash without removing it.
+func (p *Plugin) doStashApplyRef(ref string) tea.Cmd {
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		err := StashApply(workDir, ref)
+		return StashResultMsg{Operation: "apply", Ref: ref, Err: err}
+	}
+}
+
+// doStashPopRef pops a specific stash.
+func (p *Plugin) doStashPopRef(ref string) tea.Cmd {
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		err := StashPopRef(workDir, ref)
+		return StashResultMsg{Operation: "pop", Ref: ref, Err: err}
+	}
+}
+
+// doStashDrop removes a specific stash without applying it.
+func (p *Plugin) doStashDrop(ref string) tea.Cmd {
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		err := StashDrop(workDir, ref)
+		return StashResultMsg{Operation: "drop", Ref: ref, Err: err}
+	}
+}
+
 // doFetch fetches from remote.
 func (p *Plugin) doFetch() tea.Cmd {
 	workDir := p.repoRoot
@@ -117,7 +144,23 @@ func (p *Plugin) doFetch() tea.Cmd {
 func (p *Plugin) doPull() tea.Cmd {
 	workDir := p.repoRoot
 	return func() tea.Msg {
-		output, err := ExecutePull(workDir)
+		output, err := ExecutePull(workDir, "", "")
+		if err != nil {
+			return PullErrorMsg{Err: err, Strategy: "merge"}
+		}
+		return PullSuccessMsg{Output: output}
+	}
+}
+
+// doPullRef pulls a specific remote branch with merge. The tracked upstream
+// is pulled with a plain git pull so its configured behavior applies.
+func (p *Plugin) doPullRef(ref PullRef) tea.Cmd {
+	if ref.Upstream {
+		return p.doPull()
+	}
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		output, err := ExecutePull(workDir, ref.Remote, ref.Branch)
 		if err != nil {
 			return PullErrorMsg{Err: err, Strategy: "merge"}
 		}
@@ -167,9 +210,12 @@ func (p *Plugin) doAbortPull() tea.Cmd {
 	conflictType := p.pullConflictType
 	return func() tea.Msg {
 		var err error
-		if conflictType == "rebase" {
+		switch conflictType {
+		case "rebase":
 			err = AbortRebase(workDir)
-		} else {
+		case "cherry-pick":
+			err = AbortCherryPick(workDir)
+		default:
 			err = AbortMerge(workDir)
 		}
 		if err != nil {
@@ -205,6 +251,6 @@ func (p *Plugin) doDiscard(entry *FileEntry) tea.Cmd {
 		if err != nil {
 			return ErrorMsg{Err: err}
 		}
-		return RefreshDoneMsg{}
+		return RefreshDoneMsg{HeadHash: getHeadHash(workDir)}
 	}
 }
@@ -314,10 +314,12 @@ func GetCommitHistoryWithPushStatusOffset(workDir string, limit, skip int) ([]*C
 
 // HistoryFilterOpts holds options for filtered commit queries.
 type HistoryFilterOpts struct {
-	Author string // Filter by author (--author)
-	Path   string // Filter by file path (-- <path>)
-	Limit  int
-	Skip   int
+	Author   string // Filter by author (--author)
+	Path     string // Filter by file path (-- <path>)
+	Range    string // Revision range (e.g. HEAD..feature); empty means HEAD
+	NoMerges bool   // Skip merge commits (--no-merges)
+	Limit    int
+	Skip     int
 }
 
 // GetCommitHistoryFiltered fetches commits with filters applied.
@@ -328,6 +330,9 @@ func GetCommitHistoryFiltered(workDir string, opts HistoryFilterOpts) ([]*Commit
 	if opts.Author != "" {
 		args = append(args, "--author="+opts.Author)
 	}
+	if opts.NoMerges {
+		args = append(args, "--no-merges")
+	}
 
 	if opts.Limit > 0 {
 		args = append(args, "-n", strconv.Itoa(opts.Limit))
@@ -336,6 +341,9 @@ func GetCommitHistoryFiltered(workDir string, opts HistoryFilterOpts) ([]*Commit
 		args = append(args, "--skip", strconv.Itoa(opts.Skip))
 	}
 
+	if opts.Range != "" {
+		args = append(args, opts.Range)
+	}
 	if opts.Path != "" {
 		args = append(args, "--", opts.Path)
 	}
@@ -0,0 +1,185 @@
+package gitstatus
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/app"
+)
+
+// hunkAtLine returns the index of the hunk shown at rendered row line of the
+// inline diff pane (a header row plus one row per line or side-by-side
+// pair), or -1 if there is no hunk there.
+func hunkAtLine(diff *ParsedDiff, line int, sideBySide bool) int {
+	if diff == nil {
+		return -1
+	}
+	row := 0
+	for i, hunk := range diff.Hunks {
+		rows := len(hunk.Lines)
+		if sideBySide {
+			rows = len(groupLinesForSideBySide(hunk.Lines))
+		}
+		row += rows + 1 // +1 for hunk header
+		if line < row {
+			return i
+		}
+	}
+	return -1
+}
+
+// buildHunkPatch reconstructs a patch containing only hunk idx of diff, for
+// git apply. Because the other hunks are not applied, the start line of the
+// side being written is recomputed from the side being read: the old side
+// when applying forward, the new side when reverse is set.
+func buildHunkPatch(diff *ParsedDiff, idx int, reverse bool) (string, error) {
+	if diff == nil || idx < 0 || idx >= len(diff.Hunks) {
+		return "", errors.New("no hunk selected")
+	}
+	if diff.Binary {
+		return "", errors.New("cannot stage hunks of binary files")
+	}
+	if diff.OldFile == "/dev/null" || diff.NewFile == "/dev/null" {
+		return "", errors.New("stage new or deleted files as a whole")
+	}
+	hunk := diff.Hunks[idx]
+
+	oldStart, newStart := hunk.OldStart, hunk.NewStart
+	if reverse {
+		oldStart = shiftedStart(newStart, hunk.NewCount, hunk.OldCount)
+	} else {
+		newStart = shiftedStart(oldStart, hunk.OldCount, hunk.NewCount)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- a/%s\n+++ b/%s\n", diff.OldFile, diff.NewFile)
+	fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@%s\n", oldStart, hunk.OldCount, newStart, hunk.NewCount, hunk.Header)
+
+	// Emit exactly the lines the header counts; the parser may have picked
+	// up a trailing empty line from the end of the diff text.
+	oldSeen, newSeen := 0, 0
+	for _, line := range hunk.Lines {
+		if oldSeen >= hunk.OldCount && newSeen >= hunk.NewCount {
+			break
+		}
+		switch line.Type {
+		case LineAdd:
+			sb.WriteString("+")
+			newSeen++
+		case LineRemove:
+			sb.WriteString("-")
+			oldSeen++
+		default:
+			sb.WriteString(" ")
+			oldSeen++
+			newSeen++
+		}
+		sb.WriteString(line.Content)
+		sb.WriteString("\n")
+		if line.NoNewline {
+			sb.WriteString("\\ No newline at end of file\n")
+		}
+	}
+	return sb.String(), nil
+}
+
+// shiftedStart returns the start line on the other side of a hunk applied on
+// its own: the same line, moved past an empty range (count 0 means the hunk
+// inserts after start rather than at it).
+func shiftedStart(start, count, otherCount int) int {
+	if count == 0 {
+		start++
+	}
+	if otherCount == 0 {
+		start--
+	}
+	return start
+}
+
+// ApplyPatchToIndex applies a patch to the index only (git apply --cached),
+// reversed when unstaging.
+func ApplyPatchToIndex(workDir, patch string, reverse bool) error {
+	args := []string{"apply", "--cached"}
+	if reverse {
+		args = append(args, "--reverse")
+	}
+	args = append(args, "-")
+	cmd := exec.Command("git", args...)
+	cmd.Dir = workDir
+	cmd.Stdin = strings.NewReader(patch)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// stageFocusedHunk stages (or unstages) the hunk at the top of the inline
+// diff pane. Unstaged entries can stage hunks; staged entries can unstage them.
+func (p *Plugin) stageFocusedHunk(unstage bool) tea.Cmd {
+	verb := "Stage"
+	if unstage {
+		verb = "Unstage"
+	}
+	fail := func(err error) tea.Cmd {
+		return func() tea.Msg {
+			return app.ToastMsg{Message: verb + " hunk failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+		}
+	}
+
+	entries := p.tree.AllEntries()
+	if p.cursor >= len(entries) || p.diffPaneParsedDiff == nil {
+		return nil
+	}
+	entry := entries[p.cursor]
+	if entry.IsFolder || entry.Status == StatusUntracked {
+		return fail(errors.New("stage untracked files as a whole"))
+	}
+	if entry.Staged != unstage {
+		return nil // Diff shown is from the other side of the index
+	}
+
+	idx := hunkAtLine(p.diffPaneParsedDiff, p.diffPaneScroll, p.diffPaneViewMode == DiffViewSideBySide)
+	patch, err := buildHunkPatch(p.diffPaneParsedDiff, idx, unstage)
+	if err != nil {
+		return fail(err)
+	}
+	if err := ApplyPatchToIndex(p.repoRoot, patch, unstage); err != nil {
+		return fail(err)
+	}
+
+	// Keep the cursor on this file's entry once the refreshed tree arrives
+	p.followEntryPath = entry.Path
+	p.followEntryStaged = entry.Staged
+	return tea.Batch(p.refresh(), p.loadRecentCommits())
+}
+
+// restoreFollowedEntry moves the cursor to the entry recorded by
+// stageFocusedHunk, falling back to the same path on the other side of the
+// index once all of its hunks have moved.
+func (p *Plugin) restoreFollowedEntry() {
+	if p.followEntryPath == "" {
+		return
+	}
+	path, staged := p.followEntryPath, p.followEntryStaged
+	p.followEntryPath = ""
+
+	fallback := -1
+	for i, e := range p.tree.AllEntries() {
+		if e.Path != path {
+			continue
+		}
+		if e.Staged == staged {
+			p.cursor = i
+			return
+		}
+		fallback = i
+	}
+	if fallback >= 0 {
+		p.cursor = fallback
+	}
+}
@@ -0,0 +1,181 @@
+package gitstatus
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const twoHunkDiff = `diff --git a/main.go b/main.go
+index 1111111..2222222 100644
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,4 @@ package main
+ line1
++added
+ line2
+ line3
+@@ -10,4 +11,3 @@ func main() {
+ line10
+-line11
+ line12
+ line13
+`
+
+func TestBuildHunkPatch_SecondHunk(t *testing.T) {
+	diff, _ := ParseUnifiedDiff(twoHunkDiff)
+
+	got, err := buildHunkPatch(diff, 1, false)
+	if err != nil {
+		t.Fatalf("buildHunkPatch: %v", err)
+	}
+	// Applied alone, the first hunk's added line is absent so the new side
+	// starts where the old side does.
+	want := "--- a/main.go\n+++ b/main.go\n" +
+		"@@ -10,4 +10,3 @@ func main() {\n" +
+		" line10\n-line11\n line12\n line13\n"
+	if got != want {
+		t.Errorf("patch mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestBuildHunkPatch_ReverseKeepsNewStart(t *testing.T) {
+	diff, _ := ParseUnifiedDiff(twoHunkDiff)
+
+	got, err := buildHunkPatch(diff, 1, true)
+	if err != nil {
+		t.Fatalf("buildHunkPatch: %v", err)
+	}
+	if !strings.Contains(got, "@@ -11,4 +11,3 @@") {
+		t.Errorf("expected old start derived from new start for reverse patch, got:\n%s", got)
+	}
+}
+
+func TestBuildHunkPatch_NoNewlineAtEOF(t *testing.T) {
+	diff, _ := ParseUnifiedDiff(`--- a/f.txt
++++ b/f.txt
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++c
+\ No newline at end of file
+`)
+
+	got, err := buildHunkPatch(diff, 0, false)
+	if err != nil {
+		t.Fatalf("buildHunkPatch: %v", err)
+	}
+	want := "--- a/f.txt\n+++ b/f.txt\n@@ -1,2 +1,2 @@\n" +
+		" a\n-b\n\\ No newline at end of file\n+c\n\\ No newline at end of file\n"
+	if got != want {
+		t.Errorf("patch mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestBuildHunkPatch_PureInsertionAndDeletion(t *testing.T) {
+	insert, _ := ParseUnifiedDiff("--- a/f\n+++ b/f\n@@ -0,0 +1,2 @@\n+x\n+y\n@@ -5,0 +8,1 @@\n+z\n")
+	if got, _ := buildHunkPatch(insert, 1, false); !strings.Contains(got, "@@ -5,0 +6,1 @@") {
+		t.Errorf("insertion after line 5 should start at new line 6, got:\n%s", got)
+	}
+
+	del, _ := ParseUnifiedDiff("--- a/f\n+++ b/f\n@@ -3,2 +7,0 @@\n-a\n-b\n")
+	if got, _ := buildHunkPatch(del, 0, false); !strings.Contains(got, "@@ -3,2 +2,0 @@") {
+		t.Errorf("deletion should leave new start before the removed range, got:\n%s", got)
+	}
+}
+
+func TestBuildHunkPatch_Errors(t *testing.T) {
+	diff, _ := ParseUnifiedDiff(twoHunkDiff)
+	if _, err := buildHunkPatch(diff, 5, false); err == nil {
+		t.Error("expected error for out of range hunk")
+	}
+
+	newFile, _ := ParseUnifiedDiff("--- /dev/null\n+++ b/new.txt\n@@ -0,0 +1 @@\n+hi\n")
+	if _, err := buildHunkPatch(newFile, 0, false); err == nil {
+		t.Error("expected error for new file")
+	}
+}
+
+func TestHunkAtLine(t *testing.T) {
+	diff, _ := ParseUnifiedDiff(twoHunkDiff)
+	// Hunk 0: header + 4 lines (rows 0-4); hunk 1: header + 4 lines (+1 trailing empty)
+	tests := []struct {
+		line int
+		want int
+	}{
+		{0, 0}, {4, 0}, {5, 1}, {9, 1}, {100, -1},
+	}
+	for _, tt := range tests {
+		if got := hunkAtLine(diff, tt.line, false); got != tt.want {
+			t.Errorf("hunkAtLine(%d) = %d, want %d", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestApplyPatchToIndex_SingleHunk(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return string(out)
+	}
+	git("init", "-q")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test")
+
+	var lines []string
+	for i := 1; i <= 20; i++ {
+		lines = append(lines, "line"+itoa(i))
+	}
+	path := filepath.Join(dir, "f.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "f.txt")
+	git("commit", "-q", "-m", "init")
+
+	lines[1] = "changed2\nextra" // Shifts later hunks by a line
+	lines[17] = "changed18"
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, _ := ParseUnifiedDiff(git("diff", "f.txt"))
+	if len(diff.Hunks) != 2 {
+		t.Fatalf("expected 2 hunks, got %d", len(diff.Hunks))
+	}
+	patch, err := buildHunkPatch(diff, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ApplyPatchToIndex(dir, patch, false); err != nil {
+		t.Fatalf("stage hunk: %v", err)
+	}
+	staged := git("diff", "--cached")
+	if !strings.Contains(staged, "+changed18") || strings.Contains(staged, "changed2") {
+		t.Errorf("expected only second hunk staged, got:\n%s", staged)
+	}
+
+	// Unstage it again from the cached diff
+	cached, _ := ParseUnifiedDiff(staged)
+	patch, err = buildHunkPatch(cached, 0, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ApplyPatchToIndex(dir, patch, true); err != nil {
+		t.Fatalf("unstage hunk: %v", err)
+	}
+	if out := git("diff", "--cached"); out != "" {
+		t.Errorf("expected nothing staged after unstage, got:\n%s", out)
+	}
+}
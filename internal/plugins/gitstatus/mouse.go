@@ -77,7 +77,7 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) (*Plugin, tea.Cmd) {
 				if entry.IsFolder {
 					p.cursor = idx
 					p.ensureCursorVisible()
-					entry.IsExpanded = !entry.IsExpanded
+					p.tree.ToggleFolder(entry)
 					return p, p.autoLoadDiff()
 				}
 			}
@@ -163,7 +163,7 @@ func (p *Plugin) handleMouseDoubleClick(action mouse.MouseAction) (*Plugin, tea.
 		// Double-click in diff pane when on a file - open full-screen diff
 		if !p.cursorOnCommit() {
 			entries := p.tree.AllEntries()
-			if p.cursor < len(entries) {
+			if p.cursor < len(entries) && entries[p.cursor].Submodule == nil {
 				entry := entries[p.cursor]
 				p.diffReturnMode = p.viewMode
 				p.viewMode = ViewModeDiff
@@ -391,6 +391,13 @@ func (p *Plugin) handleCommitMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 
 // handleBranchPickerMouse processes mouse events in the branch picker modal.
 func (p *Plugin) handleBranchPickerMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	if p.branchCreating {
+		return p.handleBranchCreateMouse(msg)
+	}
+	if p.branchDeleteModal != nil {
+		return p.handleBranchDeleteMouse(msg)
+	}
+
 	p.ensureBranchPickerModal()
 	if p.branchPickerModal == nil {
 		return p, nil
@@ -434,7 +441,7 @@ func (p *Plugin) handlePullMenuMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 		p.viewMode = p.pullMenuReturnMode
 		p.clearPullModal()
 		return p, nil
-	case pullMenuOptionMerge, pullMenuOptionRebase, pullMenuOptionFFOnly, pullMenuOptionAutostash:
+	case pullMenuOptionMerge, pullMenuOptionRebase, pullMenuOptionFFOnly, pullMenuOptionAutostash, pullMenuOptionFrom:
 		plug, cmd := p.executePullMenuAction(action)
 		return plug.(*Plugin), cmd
 	}
@@ -476,6 +483,10 @@ func (p *Plugin) handlePullConflictMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
 	}
 
 	action := p.pullConflictModal.HandleMouse(msg, p.mouseHandler)
+	if path, ok := pullConflictFileAction(action); ok {
+		plug, cmd := p.openConflictResolve(path)
+		return plug.(*Plugin), cmd
+	}
 	switch action {
 	case pullConflictAbortID:
 		plug, cmd := p.abortPullConflict()
@@ -2,20 +2,20 @@ package gitstatus
 
 import (
 	"fmt"
-	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/textarea"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/marcus/sidecar/internal/app"
 	"github.com/marcus/sidecar/internal/modal"
 	"github.com/marcus/sidecar/internal/mouse"
+	"github.com/marcus/sidecar/internal/msg"
 	"github.com/marcus/sidecar/internal/plugin"
-	"github.com/marcus/sidecar/internal/plugins/filebrowser"
 	"github.com/marcus/sidecar/internal/state"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/ui"
@@ -31,16 +31,25 @@ const (
 type ViewMode int
 
 const (
-	ViewModeStatus          ViewMode = iota // Current file list (three-pane layout)
-	ViewModeDiff                            // Full-screen diff view
-	ViewModeCommit                          // Commit message editor
-	ViewModePushMenu                        // Push options popup menu
-	ViewModePullMenu                        // Pull options popup menu
-	ViewModeConfirmDiscard                  // Confirm discard changes modal
-	ViewModeBranchPicker                    // Branch selection modal
-	ViewModeConfirmStashPop                 // Confirm stash pop modal
-	ViewModePullConflict                    // Pull conflict resolution modal
-	ViewModeError                           // Generic error modal for git operation failures
+	ViewModeStatus            ViewMode = iota // Current file list (three-pane layout)
+	ViewModeDiff                              // Full-screen diff view
+	ViewModeCommit                            // Commit message editor
+	ViewModePushMenu                          // Push options popup menu
+	ViewModePullMenu                          // Pull options popup menu
+	ViewModeConfirmDiscard                    // Confirm discard changes modal
+	ViewModeBranchPicker                      // Branch selection modal
+	ViewModeConfirmStashPop                   // Confirm stash pop modal
+	ViewModePullConflict                      // Pull conflict resolution modal
+	ViewModeError                             // Generic error modal for git operation failures
+	ViewModeConfirmAmend                      // Confirm amending an already-pushed commit
+	ViewModeConfirmUndoCommit                 // Confirm soft-resetting the last commit
+	ViewModeStashPicker                       // Stash list modal (apply/pop/drop)
+	ViewModeConflictResolve                   // Side-by-side conflict marker resolution
+	ViewModePullRefPicker                     // Remote branch picker for pulling a specific ref
+	ViewModeConfirmCherryPick                 // Confirm cherry-picking a commit onto HEAD
+	ViewModeCherryPickPicker                  // Branch and commit picker for cherry-picking onto HEAD
+	ViewModeRestorePicker                     // Commit picker for restoring a file from history
+	ViewModeConfirmRestore                    // Confirm overwriting a file with a past version
 )
 
 // FocusPane represents which pane is active in the three-pane view.
@@ -79,12 +88,20 @@ type Plugin struct {
 	moreCommitsAvailable bool      // Whether more commits are available to load
 
 	// Inline diff state (for three-pane view)
-	selectedDiffFile    string       // File being previewed in diff pane
-	forceNextDiffReload bool         // Bypass dedup on next autoLoadDiff call
-	diffPaneScroll      int          // Vertical scroll for inline diff
-	diffPaneHorizScroll int          // Horizontal scroll for inline diff
-	diffPaneParsedDiff  *ParsedDiff  // Parsed diff for inline view
-	diffPaneViewMode    DiffViewMode // Unified or side-by-side for inline diff
+	selectedDiffFile    string                // File being previewed in diff pane
+	selectedDiffStaged  bool                  // Whether selectedDiffFile is the staged entry
+	forceNextDiffReload bool                  // Bypass dedup on next autoLoadDiff call
+	followEntryPath     string                // Entry to reselect after the next refresh (hunk staging)
+	followEntryStaged   bool                  // Side of the index of followEntryPath
+	diffPaneScroll      int                   // Vertical scroll for inline diff
+	diffPaneHorizScroll int                   // Horizontal scroll for inline diff
+	diffPaneParsedDiff  *ParsedDiff           // Parsed diff for inline view
+	diffPaneSubmodule   *SubmoduleChange      // Set when the previewed entry is a submodule
+	diffPaneViewMode    DiffViewMode          // Unified or side-by-side for inline diff
+	fileBlames          map[string]*FileBlame // Last-commit summaries by path (nil value: no history)
+	fileBlamePending    map[string]bool       // Paths with a summary request in flight
+	fileBlameGen        int                   // Bumped on invalidation to drop in-flight results
+	fileBlameHead       string                // HEAD the cached summaries belong to
 
 	// Commit preview state (for three-pane view when on commit)
 	previewCommit       *Commit // Commit being previewed in right pane
@@ -105,6 +122,8 @@ type Plugin struct {
 	diffReturnMode      ViewMode     // View mode to return to on esc
 	diffLoaded          bool         // True once diff load completes (distinguishes loading vs empty)
 	diffWrapEnabled     bool         // Wrap long lines instead of truncating
+	diffLineNumbers     bool         // Show old/new line numbers in unified diffs
+	diffIgnoreSpace     bool         // Hide hunks whose only changes are whitespace
 	diffBackWidth       int          // Width of back button for hit region (set during render)
 
 	// Push status state
@@ -123,13 +142,29 @@ type Plugin struct {
 	pullMenuReturnMode ViewMode     // Mode to return to when pull menu closes
 	pullModal          *modal.Modal // Modal instance for pull menu
 	pullModalWidth     int          // Cached modal width
-	pullSelectedIdx    int          // 0=merge, 1=rebase, 2=ff-only, 3=autostash
+	pullSelectedIdx    int          // 0=merge, 1=rebase, 2=ff-only, 3=autostash, 4=from ref
+
+	// Pull ref picker state
+	pullRefs          []PullRef
+	pullRefsLoaded    bool
+	pullRefsErr       error
+	pullRefIdx        int
+	pullRefModal      *modal.Modal
+	pullRefModalWidth int
 
 	// Pull conflict state
-	pullConflictFiles []string // Conflicted files from failed pull
-	pullConflictType  string   // "merge" or "rebase"
-	pullConflictModal *modal.Modal
-	pullConflictWidth int
+	pullConflictFiles    []string // Conflicted files from failed pull
+	pullConflictResolved []string // Files marked resolved from the conflict modal
+	pullConflictType     string   // "merge", "rebase", or "cherry-pick"
+	pullConflictIdx      int      // Selected file in the conflict modal
+	pullConflictModal    *modal.Modal
+	pullConflictWidth    int
+
+	// Conflict resolution view state
+	conflictPath   string        // File being resolved
+	conflictFile   *ConflictFile // Parsed conflict blocks of conflictPath
+	conflictBlock  int           // Selected conflict block
+	conflictScroll int           // First visible row
 
 	// View dimensions
 	width  int
@@ -141,6 +176,7 @@ type Plugin struct {
 
 	// Commit state
 	commitMessage         textarea.Model
+	commitTemplate        string // Template the message was pre-filled with
 	commitError           string
 	commitInProgress      bool
 	commitAmend           bool // true when amending last commit
@@ -169,6 +205,49 @@ type Plugin struct {
 	stashPopItem  *Stash       // Stash being confirmed for pop
 	stashPopModal *modal.Modal // Modal instance for stash pop confirmation
 
+	// Stash picker state
+	stashes          []*Stash // Entries shown in the stash picker
+	stashesLoaded    bool     // Distinguishes "loading" from an empty stash list
+	stashCursor      int
+	stashReturnMode  ViewMode
+	stashPickerModal *modal.Modal
+	stashPickerWidth int
+	stashDropRef     string       // Stash pending drop confirmation
+	stashDropModal   *modal.Modal // Drop confirmation inside the stash picker
+
+	// Amend confirmation state (last commit already pushed)
+	amendConfirmModal *modal.Modal
+	amendFromCommit   bool // Amend was toggled from the commit modal
+
+	// Undo commit confirmation state
+	undoCommitModal   *modal.Modal
+	undoCommitSubject string
+
+	// Cherry-pick picker and confirmation state
+	cherryPickBranches       []*Branch // Branches other than the current one
+	cherryPickBranchesLoaded bool
+	cherryPickSource         string    // Branch whose commits are listed; "" while choosing a branch
+	cherryPickCommits        []*Commit // Commits on cherryPickSource that HEAD lacks
+	cherryPickCommitsLoaded  bool
+	cherryPickErr            error
+	cherryPickIdx            int
+	cherryPickBranchIdx      int // Branch list position to restore when going back
+	cherryPickPickerModal    *modal.Modal
+	cherryPickPickerWidth    int
+	cherryPickModal          *modal.Modal
+	cherryPickCommit         *Commit
+
+	// Restore-from-commit state
+	restorePath          string    // File being restored
+	restoreCommits       []*Commit // Commits that touched restorePath
+	restoreCommitsLoaded bool
+	restoreCommitsErr    error
+	restoreIdx           int
+	restoreModal         *modal.Modal
+	restoreModalWidth    int
+	restoreCommit        *Commit // Commit pending confirmation
+	restoreConfirmModal  *modal.Modal
+
 	// Syntax highlighting
 	syntaxHighlighter     *SyntaxHighlighter // Cached highlighter for current file
 	syntaxHighlighterFile string             // File the highlighter was created for
@@ -179,6 +258,25 @@ type Plugin struct {
 	branchReturnMode  ViewMode  // Mode to return to when modal closes
 	branchPickerModal *modal.Modal
 	branchPickerWidth int
+	branchShowRemote  bool   // Include remote-only branches in the picker
+	branchFilter      string // Type-to-filter query in the picker
+
+	// New branch prompt (ctrl+n in the branch picker)
+	branchCreating           bool
+	branchNameInput          textinput.Model
+	branchCreateFromSelected bool // Start from the highlighted branch instead of HEAD
+	branchCreateError        string
+	branchCreateModal        *modal.Modal
+	branchCreateModalWidth   int
+
+	// Branch delete confirmation (ctrl+d in the branch picker)
+	branchDeleteName  string
+	branchDeleteForce bool // Confirming -D after -d refused an unmerged branch
+	branchDeleteModal *modal.Modal
+
+	// Status-line spinner shown while a push, fetch or pull runs
+	remoteSpinner        ui.Spinner
+	remoteSpinnerTicking bool
 
 	// Fetch/Pull state
 	fetchInProgress bool
@@ -198,6 +296,9 @@ type Plugin struct {
 	historyFilterPath   string // Filter by file path
 	filteredCommits     []*Commit
 
+	// Sidebar file filter (/ on files); the query itself lives on the tree
+	fileFilterMode bool // True while the filter is being edited
+
 	// Path filter input state
 	pathFilterMode  bool   // True when path input modal is open
 	pathFilterInput string // Current path input
@@ -289,6 +390,7 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	}
 	p.showCommitGraph = state.GetGitGraphEnabled()
 	p.diffWrapEnabled = state.GetLineWrapEnabled()
+	p.diffLineNumbers = state.GetDiffLineNumbers()
 
 	// Resolve git repo root (works from any subdirectory).
 	// If no repo exists, keep plugin active in a dedicated "no repo" state.
@@ -302,6 +404,7 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	p.hasRepo = true
 	p.repoRoot = root
 	p.tree = NewFileTree(root)
+	p.tree.SetGrouped(state.GetGitFilesGrouped())
 
 	return nil
 }
@@ -339,6 +442,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if p.pathFilterMode {
 			return p.updatePathFilter(msg)
 		}
+		if p.fileFilterMode {
+			return p.updateFileFilter(msg)
+		}
 		switch p.viewMode {
 		case ViewModeStatus:
 			return p.updateStatus(msg)
@@ -350,14 +456,32 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p.updatePushMenu(msg)
 		case ViewModePullMenu:
 			return p.updatePullMenu(msg)
+		case ViewModePullRefPicker:
+			return p.updatePullRefPicker(msg)
 		case ViewModePullConflict:
 			return p.updatePullConflict(msg)
+		case ViewModeConflictResolve:
+			return p.updateConflictResolve(msg)
 		case ViewModeConfirmDiscard:
 			return p.updateConfirmDiscard(msg)
 		case ViewModeConfirmStashPop:
 			return p.updateConfirmStashPop(msg)
+		case ViewModeConfirmAmend:
+			return p.updateConfirmAmend(msg)
+		case ViewModeConfirmUndoCommit:
+			return p.updateConfirmUndoCommit(msg)
+		case ViewModeCherryPickPicker:
+			return p.updateCherryPickPicker(msg)
+		case ViewModeConfirmCherryPick:
+			return p.updateConfirmCherryPick(msg)
+		case ViewModeRestorePicker:
+			return p.updateRestorePicker(msg)
+		case ViewModeConfirmRestore:
+			return p.updateConfirmRestore(msg)
 		case ViewModeBranchPicker:
 			return p.updateBranchPicker(msg)
+		case ViewModeStashPicker:
+			return p.updateStashPicker(msg)
 		case ViewModeError:
 			return p.updateErrorModal(msg)
 		}
@@ -374,18 +498,36 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p.handleDiffMouse(msg)
 		case ViewModeBranchPicker:
 			return p.handleBranchPickerMouse(msg)
+		case ViewModeStashPicker:
+			return p.handleStashPickerMouse(msg)
 		case ViewModeCommit:
 			return p.handleCommitMouse(msg)
 		case ViewModePushMenu:
 			return p.handlePushMenuMouse(msg)
 		case ViewModePullMenu:
 			return p.handlePullMenuMouse(msg)
+		case ViewModePullRefPicker:
+			return p.handlePullRefPickerMouse(msg)
 		case ViewModePullConflict:
 			return p.handlePullConflictMouse(msg)
+		case ViewModeConflictResolve:
+			return p.handleConflictResolveMouse(msg)
 		case ViewModeConfirmDiscard:
 			return p.handleDiscardMouse(msg)
 		case ViewModeConfirmStashPop:
 			return p.handleStashPopMouse(msg)
+		case ViewModeConfirmAmend:
+			return p.handleAmendConfirmMouse(msg)
+		case ViewModeConfirmUndoCommit:
+			return p.handleUndoCommitMouse(msg)
+		case ViewModeCherryPickPicker:
+			return p.handleCherryPickPickerMouse(msg)
+		case ViewModeConfirmCherryPick:
+			return p.handleCherryPickMouse(msg)
+		case ViewModeRestorePicker:
+			return p.handleRestorePickerMouse(msg)
+		case ViewModeConfirmRestore:
+			return p.handleConfirmRestoreMouse(msg)
 		case ViewModeError:
 			return p.handleErrorModalMouse(msg)
 		}
@@ -429,6 +571,9 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		if p.inNoRepoMode() {
 			return p, nil
 		}
+		p.syncFileBlameHead(msg.HeadHash)
+		p.followSelectedEntry()
+		p.restoreFollowedEntry()
 		// Clamp cursor to valid range if files changed
 		maxCursor := p.totalSelectableItems() - 1
 		if maxCursor < 0 {
@@ -453,6 +598,10 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		// Always parse diff for built-in rendering (even if delta is available)
 		// This allows toggling between delta and built-in rendering at runtime
 		p.parsedDiff, _ = ParseUnifiedDiff(msg.Raw)
+		if p.parsedDiff != nil && p.parsedDiff.Binary {
+			p.parsedDiff.Sizes = msg.Sizes
+		}
+		p.parsedDiff = p.filterDiff(p.parsedDiff)
 		return p, nil
 
 	case CommitSuccessMsg:
@@ -476,7 +625,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		// Only update if this is still the selected file
 		if msg.File == p.selectedDiffFile {
-			p.diffPaneParsedDiff = msg.Parsed
+			p.diffPaneParsedDiff = p.filterDiff(msg.Parsed)
 			// Clamp scroll to new content length (diff may have shrunk after stage/unstage)
 			if p.diffPaneParsedDiff != nil {
 				lines := countParsedDiffLines(p.diffPaneParsedDiff)
@@ -491,6 +640,13 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		return p, nil
 
+	case FileBlameLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil // Ignore stale message from previous project
+		}
+		p.setFileBlame(msg)
+		return p, nil
+
 	case RecentCommitsLoadedMsg:
 		if plugin.IsStale(p.ctx, msg) {
 			return p, nil // Ignore stale message from previous project
@@ -630,6 +786,15 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		return p, nil
 
+	case remoteSpinnerTickMsg:
+		if !p.remoteInProgress() {
+			p.remoteSpinner.Stop()
+			p.remoteSpinnerTicking = false
+			return p, nil
+		}
+		p.remoteSpinner.Tick()
+		return p, remoteSpinnerTick()
+
 	case PushSuccessMsg:
 		p.pushInProgress = false
 		p.pushError = ""
@@ -661,6 +826,15 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				return app.ToastMsg{Message: toastMsg, Duration: 3 * time.Second, IsError: true}
 			}
 		}
+		// Dropping keeps the picker open on the updated list; apply/pop close it
+		reload := p.refresh()
+		if p.viewMode == ViewModeStashPicker {
+			if msg.Operation == "drop" {
+				reload = p.loadStashes()
+			} else {
+				p.closeStashPicker()
+			}
+		}
 		// Show success toast and refresh
 		var toastMsg string
 		switch msg.Operation {
@@ -668,11 +842,13 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			toastMsg = "Stashed changes"
 		case "apply":
 			toastMsg = "Stash applied"
+		case "drop":
+			toastMsg = "Dropped " + msg.Ref
 		default:
 			toastMsg = "Stash popped"
 		}
 		return p, tea.Batch(
-			p.refresh(),
+			reload,
 			p.loadRecentCommits(),
 			func() tea.Msg {
 				return app.ToastMsg{Message: toastMsg, Duration: 2 * time.Second}
@@ -684,22 +860,84 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			return p, nil // Ignore stale message from previous project
 		}
 		p.branches = msg.Branches
-		// Position cursor on current branch
-		for i, b := range p.branches {
-			if b.IsCurrent {
-				p.branchCursor = i
-				break
-			}
+		// Position cursor on the best match, or the current branch when unfiltered
+		p.setBranchFilter(p.branchFilter)
+		return p, nil
+
+	case PullRefsLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		if p.viewMode == ViewModePullRefPicker {
+			p.setPullRefs(msg.Refs, msg.Err)
+		}
+		return p, nil
+
+	case CherryPickBranchesLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		if p.viewMode == ViewModeCherryPickPicker {
+			p.setCherryPickBranches(msg.Branches, msg.Err)
+		}
+		return p, nil
+
+	case CherryPickCommitsLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		if p.viewMode == ViewModeCherryPickPicker {
+			p.setCherryPickCommits(msg.Branch, msg.Commits, msg.Err)
+		}
+		return p, nil
+
+	case FileCommitsLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		if p.viewMode == ViewModeRestorePicker {
+			p.setRestoreCommits(msg.Path, msg.Commits, msg.Err)
+		}
+		return p, nil
+
+	case StashListLoadedMsg:
+		if plugin.IsStale(p.ctx, msg) {
+			return p, nil
+		}
+		if p.viewMode == ViewModeStashPicker {
+			p.setStashes(msg.Stashes)
 		}
 		return p, nil
 
 	case BranchSwitchSuccessMsg:
 		// Branch switched, close picker and refresh
-		p.viewMode = p.branchReturnMode
-		p.branches = nil
-		p.clearBranchPickerModal()
+		p.closeBranchPicker()
 		return p, tea.Batch(p.refresh(), p.loadRecentCommits())
 
+	case UndoCommitDoneMsg:
+		if msg.Err != nil {
+			p.showErrorModal("Undo Commit Failed", msg.Err)
+			return p, nil
+		}
+		// Drop the undone commit so mergeRecentCommits doesn't keep it in the tail
+		if len(p.recentCommits) > 0 {
+			p.recentCommits = p.recentCommits[1:]
+		}
+		return p, tea.Batch(undoCommitToast(msg.Subject), p.refresh(), p.loadRecentCommits())
+
+	case CherryPickDoneMsg:
+		return p, p.handleCherryPickDone(msg)
+
+	case RestoreFileDoneMsg:
+		return p, p.handleRestoreFileDone(msg)
+
+	case BranchDeletedMsg:
+		return p, tea.Batch(branchDeletedToast(msg.Branch), p.loadBranches())
+
+	case BranchNotMergedMsg:
+		p.openBranchDelete(msg.Branch, true)
+		return p, nil
+
 	case BranchErrorMsg:
 		p.showErrorModal("Branch Error", msg.Err)
 		return p, nil
@@ -733,6 +971,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 				p.pullConflictType = "merge"
 			}
 			p.pullConflictFiles = GetConflictedFiles(p.repoRoot)
+			p.pullConflictResolved = nil
+			p.pullConflictIdx = 0
 			if len(p.pullConflictFiles) > 0 {
 				p.viewMode = ViewModePullConflict
 				p.clearPullConflictModal()
@@ -749,6 +989,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 
 	case PullAbortedMsg:
 		p.pullConflictFiles = nil
+		p.pullConflictResolved = nil
 		p.pullConflictType = ""
 		p.pullError = ""
 		return p, tea.Batch(p.refresh(), p.loadRecentCommits())
@@ -879,14 +1120,32 @@ func (p *Plugin) View(width, height int) string {
 			content = p.renderPushMenu()
 		case ViewModePullMenu:
 			content = p.renderPullMenu()
+		case ViewModePullRefPicker:
+			content = p.renderPullRefPicker()
 		case ViewModePullConflict:
 			content = p.renderPullConflict()
+		case ViewModeConflictResolve:
+			content = p.renderConflictResolve()
 		case ViewModeConfirmDiscard:
 			content = p.renderConfirmDiscard()
 		case ViewModeConfirmStashPop:
 			content = p.renderConfirmStashPop()
+		case ViewModeConfirmAmend:
+			content = p.renderConfirmAmend()
+		case ViewModeConfirmUndoCommit:
+			content = p.renderConfirmUndoCommit()
+		case ViewModeCherryPickPicker:
+			content = p.renderCherryPickPicker()
+		case ViewModeConfirmCherryPick:
+			content = p.renderConfirmCherryPick()
+		case ViewModeRestorePicker:
+			content = p.renderRestorePicker()
+		case ViewModeConfirmRestore:
+			content = p.renderConfirmRestore()
 		case ViewModeBranchPicker:
 			content = p.renderBranchPicker()
+		case ViewModeStashPicker:
+			content = p.renderStashPicker()
 		case ViewModeError:
 			content = p.renderErrorModal()
 		default:
@@ -927,12 +1186,14 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "unstage-file", Name: "Unstage", Description: "Remove file from staging area", Category: plugin.CategoryGit, Context: "git-status", Priority: 1},
 		{ID: "commit", Name: "Commit", Description: "Open commit message editor", Category: plugin.CategoryGit, Context: "git-status", Priority: 1},
 		{ID: "amend", Name: "Amend", Description: "Amend last commit", Category: plugin.CategoryGit, Context: "git-status", Priority: 3},
+		{ID: "undo-commit", Name: "Uncommit", Description: "Undo last commit, keeping changes staged", Category: plugin.CategoryGit, Context: "git-status", Priority: 4},
 		{ID: "show-diff", Name: "Diff", Description: "View file changes", Category: plugin.CategoryView, Context: "git-status", Priority: 2},
 		{ID: "stage-all", Name: "Stage all", Description: "Stage all modified files", Category: plugin.CategoryGit, Context: "git-status", Priority: 2},
 		{ID: "unstage-all", Name: "Unstage all", Description: "Unstage all files", Category: plugin.CategoryGit, Context: "git-status", Priority: 2},
 		{ID: "push", Name: "Push", Description: "Push commits to remote", Category: plugin.CategoryGit, Context: "git-status", Priority: 2},
 		{ID: "open-file", Name: "Open", Description: "Open file in editor", Category: plugin.CategoryActions, Context: "git-status", Priority: 3},
 		{ID: "discard-changes", Name: "Discard", Description: "Discard changes to file", Category: plugin.CategoryGit, Context: "git-status", Priority: 3},
+		{ID: "restore-from-commit", Name: "Restore", Description: "Restore file from an earlier commit", Category: plugin.CategoryGit, Context: "git-status", Priority: 4},
 		{ID: "branch-picker", Name: "Branch", Description: "Switch branch", Category: plugin.CategoryGit, Context: "git-status", Priority: 3},
 		{ID: "fetch", Name: "Fetch", Description: "Fetch from remote", Category: plugin.CategoryGit, Context: "git-status", Priority: 3},
 		{ID: "pull", Name: "Pull", Description: "Pull from remote", Category: plugin.CategoryGit, Context: "git-status", Priority: 3},
@@ -940,6 +1201,9 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "stash", Name: "Stash", Description: "Stash changes", Category: plugin.CategoryGit, Context: "git-status", Priority: 4},
 		{ID: "stash-pop", Name: "Pop", Description: "Pop latest stash", Category: plugin.CategoryGit, Context: "git-status", Priority: 4},
 		{ID: "stash-apply", Name: "Apply", Description: "Apply latest stash", Category: plugin.CategoryGit, Context: "git-status", Priority: 4},
+		{ID: "toggle-grouping", Name: "Tree", Description: "Group files by directory", Category: plugin.CategoryView, Context: "git-status", Priority: 5},
+		{ID: "filter-files", Name: "Filter", Description: "Filter files by glob or substring", Category: plugin.CategorySearch, Context: "git-status", Priority: 4},
+		{ID: "stash-list", Name: "Stashes", Description: "Browse stashes", Category: plugin.CategoryGit, Context: "git-status", Priority: 4},
 		{ID: "open-in-file-browser", Name: "Browse", Description: "Open file in file browser", Category: plugin.CategoryNavigation, Context: "git-status", Priority: 4},
 		{ID: "open-in-github", Name: "GitHub", Description: "Open commit in GitHub", Category: plugin.CategoryActions, Context: "git-status", Priority: 4},
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "git-status", Priority: 5},
@@ -957,6 +1221,7 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "yank-commit", Name: "Yank", Description: "Copy commit as markdown", Category: plugin.CategoryActions, Context: "git-status-commits", Priority: 3},
 		{ID: "yank-id", Name: "YankID", Description: "Copy commit ID", Category: plugin.CategoryActions, Context: "git-status-commits", Priority: 3},
 		{ID: "open-in-github", Name: "GitHub", Description: "Open commit in GitHub", Category: plugin.CategoryActions, Context: "git-status-commits", Priority: 3},
+		{ID: "cherry-pick", Name: "Pick", Description: "Cherry-pick a commit from another branch onto HEAD", Category: plugin.CategoryGit, Context: "git-status-commits", Priority: 3},
 		{ID: "toggle-graph", Name: "Graph", Description: "Toggle commit graph display", Category: plugin.CategoryView, Context: "git-status-commits", Priority: 2},
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "git-status-commits", Priority: 5},
 		// git-history-search context (commit search modal)
@@ -965,6 +1230,9 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "navigate", Name: "Nav", Description: "Move through matches", Category: plugin.CategoryNavigation, Context: "git-history-search", Priority: 2},
 		{ID: "toggle-regex", Name: "Regex", Description: "Toggle regex mode", Category: plugin.CategoryView, Context: "git-history-search", Priority: 3},
 		{ID: "toggle-case", Name: "Case", Description: "Toggle case sensitivity", Category: plugin.CategoryView, Context: "git-history-search", Priority: 3},
+		// git-file-filter context (sidebar file filter input)
+		{ID: "apply-filter", Name: "Apply", Description: "Keep file filter", Category: plugin.CategorySearch, Context: "git-file-filter", Priority: 1},
+		{ID: "cancel", Name: "Clear", Description: "Clear file filter", Category: plugin.CategoryActions, Context: "git-file-filter", Priority: 1},
 		// git-path-filter context (path filter modal)
 		{ID: "apply-filter", Name: "Apply", Description: "Apply path filter", Category: plugin.CategorySearch, Context: "git-path-filter", Priority: 1},
 		{ID: "cancel", Name: "Cancel", Description: "Close path filter", Category: plugin.CategoryActions, Context: "git-path-filter", Priority: 1},
@@ -977,8 +1245,13 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "open-in-file-browser", Name: "Browse", Description: "Open file in file browser", Category: plugin.CategoryNavigation, Context: "git-commit-preview", Priority: 3},
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "git-commit-preview", Priority: 4},
 		// git-status-diff context (inline diff pane)
+		{ID: "stage-hunk", Name: "Stage hunk", Description: "Stage the hunk at the top of the diff", Category: plugin.CategoryGit, Context: "git-status-diff", Priority: 2},
+		{ID: "unstage-hunk", Name: "Unstage hunk", Description: "Unstage the hunk at the top of the diff", Category: plugin.CategoryGit, Context: "git-status-diff", Priority: 2},
 		{ID: "toggle-diff-view", Name: "View", Description: "Toggle unified/split diff view", Category: plugin.CategoryView, Context: "git-status-diff", Priority: 2},
 		{ID: "toggle-wrap", Name: "Wrap", Description: "Toggle line wrapping", Category: plugin.CategoryView, Context: "git-status-diff", Priority: 3},
+		{ID: "toggle-line-numbers", Name: "Numbers", Description: "Toggle diff line numbers", Category: plugin.CategoryView, Context: "git-status-diff", Priority: 4},
+		{ID: "toggle-whitespace", Name: "-w", Description: "Toggle hiding whitespace-only changes", Category: plugin.CategoryView, Context: "git-status-diff", Priority: 4},
+		{ID: "yank-line-ref", Name: "Ref", Description: "Copy path:line reference", Category: plugin.CategoryActions, Context: "git-status-diff", Priority: 4},
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "git-status-diff", Priority: 3},
 		// git-diff context
 		{ID: "close-diff", Name: "Close", Description: "Close diff view", Category: plugin.CategoryView, Context: "git-diff", Priority: 1},
@@ -986,6 +1259,9 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "toggle-sidebar", Name: "Sidebar", Description: "Toggle sidebar visibility", Category: plugin.CategoryView, Context: "git-diff", Priority: 2},
 		{ID: "toggle-diff-view", Name: "View", Description: "Toggle unified/split diff view", Category: plugin.CategoryView, Context: "git-diff", Priority: 3},
 		{ID: "toggle-wrap", Name: "Wrap", Description: "Toggle line wrapping", Category: plugin.CategoryView, Context: "git-diff", Priority: 3},
+		{ID: "toggle-line-numbers", Name: "Numbers", Description: "Toggle diff line numbers", Category: plugin.CategoryView, Context: "git-diff", Priority: 4},
+		{ID: "toggle-whitespace", Name: "-w", Description: "Toggle hiding whitespace-only changes", Category: plugin.CategoryView, Context: "git-diff", Priority: 4},
+		{ID: "yank-line-ref", Name: "Ref", Description: "Copy path:line reference", Category: plugin.CategoryActions, Context: "git-diff", Priority: 4},
 		{ID: "open-in-file-browser", Name: "Browse", Description: "Open file in file browser", Category: plugin.CategoryNavigation, Context: "git-diff", Priority: 4},
 		// git-commit context
 		{ID: "execute-commit", Name: "Commit", Description: "Create commit with message", Category: plugin.CategoryGit, Context: "git-commit", Priority: 1},
@@ -1000,10 +1276,24 @@ func (p *Plugin) Commands() []plugin.Command {
 		{ID: "pull-rebase", Name: "Rebase", Description: "Pull with rebase", Category: plugin.CategoryGit, Context: "git-pull-menu", Priority: 1},
 		{ID: "pull-ff-only", Name: "FF-only", Description: "Pull fast-forward only", Category: plugin.CategoryGit, Context: "git-pull-menu", Priority: 1},
 		{ID: "pull-autostash", Name: "Autostash", Description: "Pull rebase + autostash", Category: plugin.CategoryGit, Context: "git-pull-menu", Priority: 1},
+		{ID: "pull-from", Name: "From", Description: "Pull a specific remote branch", Category: plugin.CategoryGit, Context: "git-pull-menu", Priority: 1},
 		{ID: "cancel", Name: "Cancel", Description: "Cancel", Category: plugin.CategoryNavigation, Context: "git-pull-menu", Priority: 2},
+		// git-pull-ref-picker context
+		{ID: "pull-selected-ref", Name: "Pull", Description: "Pull the selected branch", Category: plugin.CategoryGit, Context: "git-pull-ref-picker", Priority: 1},
+		{ID: "cancel", Name: "Back", Description: "Back to pull menu", Category: plugin.CategoryNavigation, Context: "git-pull-ref-picker", Priority: 2},
 		// git-pull-conflict context
+		{ID: "resolve-conflict", Name: "Resolve", Description: "Resolve selected file's conflicts", Category: plugin.CategoryGit, Context: "git-pull-conflict", Priority: 1},
 		{ID: "abort-pull", Name: "Abort", Description: "Abort merge/rebase", Category: plugin.CategoryGit, Context: "git-pull-conflict", Priority: 1},
 		{ID: "dismiss", Name: "Dismiss", Description: "Dismiss and resolve manually", Category: plugin.CategoryNavigation, Context: "git-pull-conflict", Priority: 2},
+		// git-conflict-resolve context (side-by-side conflict view)
+		{ID: "pick-ours", Name: "Ours", Description: "Keep our side of the conflict", Category: plugin.CategoryGit, Context: "git-conflict-resolve", Priority: 1},
+		{ID: "pick-theirs", Name: "Theirs", Description: "Keep their side of the conflict", Category: plugin.CategoryGit, Context: "git-conflict-resolve", Priority: 1},
+		{ID: "pick-both", Name: "Both", Description: "Keep both sides of the conflict", Category: plugin.CategoryGit, Context: "git-conflict-resolve", Priority: 1},
+		{ID: "next-conflict", Name: "Next", Description: "Jump to next conflict", Category: plugin.CategoryNavigation, Context: "git-conflict-resolve", Priority: 2},
+		{ID: "prev-conflict", Name: "Prev", Description: "Jump to previous conflict", Category: plugin.CategoryNavigation, Context: "git-conflict-resolve", Priority: 3},
+		{ID: "mark-resolved", Name: "Resolved", Description: "Mark file resolved (git add)", Category: plugin.CategoryGit, Context: "git-conflict-resolve", Priority: 2},
+		{ID: "undo-pick", Name: "Undo", Description: "Restore the conflict markers", Category: plugin.CategoryGit, Context: "git-conflict-resolve", Priority: 3},
+		{ID: "back", Name: "Back", Description: "Return to conflict list", Category: plugin.CategoryNavigation, Context: "git-conflict-resolve", Priority: 3},
 		// git-error context (error modal)
 		{ID: "pull-from-error", Name: "Pull", Description: "Pull from remote", Category: plugin.CategoryGit, Context: "git-error", Priority: 1},
 		{ID: "dismiss", Name: "Dismiss", Description: "Dismiss error", Category: plugin.CategoryNavigation, Context: "git-error", Priority: 1},
@@ -1011,6 +1301,29 @@ func (p *Plugin) Commands() []plugin.Command {
 		// git-stash-pop context (stash pop confirmation modal)
 		{ID: "confirm-pop", Name: "Pop", Description: "Confirm stash pop", Category: plugin.CategoryGit, Context: "git-stash-pop", Priority: 1},
 		{ID: "dismiss", Name: "Cancel", Description: "Cancel stash pop", Category: plugin.CategoryNavigation, Context: "git-stash-pop", Priority: 2},
+		// git-amend-confirm context (amending an already-pushed commit)
+		{ID: "confirm-amend", Name: "Amend", Description: "Amend the pushed commit", Category: plugin.CategoryGit, Context: "git-amend-confirm", Priority: 1},
+		{ID: "dismiss", Name: "Cancel", Description: "Cancel amend", Category: plugin.CategoryNavigation, Context: "git-amend-confirm", Priority: 2},
+		// git-stash-picker context (stash list modal)
+		{ID: "stash-apply-selected", Name: "Apply", Description: "Apply selected stash", Category: plugin.CategoryGit, Context: "git-stash-picker", Priority: 1},
+		{ID: "stash-pop-selected", Name: "Pop", Description: "Pop selected stash", Category: plugin.CategoryGit, Context: "git-stash-picker", Priority: 1},
+		{ID: "stash-drop-selected", Name: "Drop", Description: "Drop selected stash", Category: plugin.CategoryGit, Context: "git-stash-picker", Priority: 2},
+		{ID: "dismiss", Name: "Close", Description: "Close stash list", Category: plugin.CategoryNavigation, Context: "git-stash-picker", Priority: 3},
+		// git-undo-commit context (soft reset confirmation)
+		{ID: "confirm-undo-commit", Name: "Undo", Description: "Undo the last commit", Category: plugin.CategoryGit, Context: "git-undo-commit", Priority: 1},
+		{ID: "dismiss", Name: "Cancel", Description: "Keep the commit", Category: plugin.CategoryNavigation, Context: "git-undo-commit", Priority: 2},
+		// git-cherry-pick-picker context (branch and commit picker for cherry-picking)
+		{ID: "select", Name: "Select", Description: "Choose the branch or commit", Category: plugin.CategoryGit, Context: "git-cherry-pick-picker", Priority: 1},
+		{ID: "cancel", Name: "Back", Description: "Back to branches, or close", Category: plugin.CategoryNavigation, Context: "git-cherry-pick-picker", Priority: 2},
+		// git-cherry-pick context (cherry-pick confirmation)
+		{ID: "confirm-cherry-pick", Name: "Cherry-pick", Description: "Apply the commit onto HEAD", Category: plugin.CategoryGit, Context: "git-cherry-pick", Priority: 1},
+		{ID: "dismiss", Name: "Cancel", Description: "Cancel cherry-pick", Category: plugin.CategoryNavigation, Context: "git-cherry-pick", Priority: 2},
+		// git-restore-picker context (commit picker for restoring a file)
+		{ID: "restore-selected-commit", Name: "Restore", Description: "Restore the file from this commit", Category: plugin.CategoryGit, Context: "git-restore-picker", Priority: 1},
+		{ID: "dismiss", Name: "Close", Description: "Close commit picker", Category: plugin.CategoryNavigation, Context: "git-restore-picker", Priority: 2},
+		// git-restore-confirm context (overwrite confirmation)
+		{ID: "confirm-restore", Name: "Restore", Description: "Overwrite the file", Category: plugin.CategoryGit, Context: "git-restore-confirm", Priority: 1},
+		{ID: "dismiss", Name: "Cancel", Description: "Back to commit picker", Category: plugin.CategoryNavigation, Context: "git-restore-confirm", Priority: 2},
 	}
 }
 
@@ -1025,6 +1338,9 @@ func (p *Plugin) FocusContext() string {
 	if p.pathFilterMode {
 		return "git-path-filter"
 	}
+	if p.fileFilterMode {
+		return "git-file-filter"
+	}
 
 	switch p.viewMode {
 	case ViewModeDiff:
@@ -1035,12 +1351,30 @@ func (p *Plugin) FocusContext() string {
 		return "git-push-menu"
 	case ViewModePullMenu:
 		return "git-pull-menu"
+	case ViewModePullRefPicker:
+		return "git-pull-ref-picker"
 	case ViewModePullConflict:
 		return "git-pull-conflict"
+	case ViewModeConflictResolve:
+		return "git-conflict-resolve"
 	case ViewModeError:
 		return "git-error"
 	case ViewModeConfirmStashPop:
 		return "git-stash-pop"
+	case ViewModeConfirmAmend:
+		return "git-amend-confirm"
+	case ViewModeConfirmUndoCommit:
+		return "git-undo-commit"
+	case ViewModeCherryPickPicker:
+		return "git-cherry-pick-picker"
+	case ViewModeConfirmCherryPick:
+		return "git-cherry-pick"
+	case ViewModeRestorePicker:
+		return "git-restore-picker"
+	case ViewModeConfirmRestore:
+		return "git-restore-confirm"
+	case ViewModeStashPicker:
+		return "git-stash-picker"
 	default:
 		if p.activePane == PaneDiff {
 			// Commit preview pane has different context than file diff pane
@@ -1060,7 +1394,8 @@ func (p *Plugin) FocusContext() string {
 // ConsumesTextInput reports whether the plugin is currently in a mode where
 // printable keys should be treated as text input.
 func (p *Plugin) ConsumesTextInput() bool {
-	return p.viewMode == ViewModeCommit || p.historySearchMode || p.pathFilterMode
+	return p.viewMode == ViewModeCommit || p.viewMode == ViewModeBranchPicker ||
+		p.historySearchMode || p.pathFilterMode || p.fileFilterMode
 }
 
 // Diagnostics returns plugin health info.
@@ -1085,11 +1420,12 @@ func (p *Plugin) refresh() tea.Cmd {
 	if !p.hasRepo || p.tree == nil {
 		return nil
 	}
+	workDir := p.repoRoot
 	return func() tea.Msg {
 		if err := p.tree.Refresh(); err != nil {
 			return ErrorMsg{Err: err}
 		}
-		return RefreshDoneMsg{}
+		return RefreshDoneMsg{HeadHash: getHeadHash(workDir)}
 	}
 }
 
@@ -1124,10 +1460,7 @@ func (p *Plugin) listenForWatchEvents() tea.Cmd {
 // openFile opens a file in the default editor.
 func (p *Plugin) openFile(path string) tea.Cmd {
 	return func() tea.Msg {
-		editor := os.Getenv("EDITOR")
-		if editor == "" {
-			editor = "vim"
-		}
+		editor := plugin.DefaultEditor()
 		fullPath := filepath.Join(p.repoRoot, path)
 		return plugin.OpenFileMsg{Editor: editor, Path: fullPath}
 	}
@@ -1138,7 +1471,7 @@ func (p *Plugin) openInFileBrowser(path string) tea.Cmd {
 	return tea.Batch(
 		app.FocusPlugin("file-browser"),
 		func() tea.Msg {
-			return filebrowser.NavigateToFileMsg{Path: path}
+			return msg.NavigateToFileMsg{Path: path}
 		},
 	)
 }
@@ -1193,14 +1526,17 @@ func countLines(s string) int {
 }
 
 // Message types
-type RefreshDoneMsg struct{}
+type RefreshDoneMsg struct {
+	HeadHash string // Commit HEAD pointed at after the refresh
+}
 type WatchEventMsg struct{}
 type WatchStartedMsg struct{ Watcher *Watcher }
 type ErrorMsg struct{ Err error }
 type DiffLoadedMsg struct {
-	Epoch   uint64 // Epoch when request was issued (for stale detection)
-	Content string // Rendered content (may be from delta)
-	Raw     string // Raw diff for built-in rendering
+	Epoch   uint64       // Epoch when request was issued (for stale detection)
+	Content string       // Rendered content (may be from delta)
+	Raw     string       // Raw diff for built-in rendering
+	Sizes   *BinarySizes // Binary file sizes, when the diff is binary
 }
 
 // GetEpoch implements plugin.EpochMessage.
@@ -1285,7 +1621,7 @@ type PushSuccessClearMsg struct{}
 
 // StashResultMsg is sent when a stash operation completes.
 type StashResultMsg struct {
-	Operation string // "push", "pop", or "apply"
+	Operation string // "push", "pop", "apply", or "drop"
 	Ref       string // stash ref for display (e.g. "stash@{0}")
 	Err       error
 }
@@ -1361,12 +1697,40 @@ func (p *Plugin) initCommitTextarea() {
 	p.commitMessage.SetWidth(textareaWidth)
 	p.commitMessage.SetHeight(4)
 	p.commitError = ""
+	p.commitTemplate = ""
 	p.commitButtonFocus = false
 	p.commitButtonHover = false
 	p.commitModal = nil
 	p.commitModalWidthCache = 0
 }
 
+// remoteSpinnerTickMsg advances the push/fetch/pull spinner.
+type remoteSpinnerTickMsg struct{}
+
+// remoteSpinnerTick schedules the next spinner frame.
+func remoteSpinnerTick() tea.Cmd {
+	return tea.Tick(ui.SpinnerInterval, func(time.Time) tea.Msg {
+		return remoteSpinnerTickMsg{}
+	})
+}
+
+// remoteInProgress reports whether a push, fetch or pull is running.
+func (p *Plugin) remoteInProgress() bool {
+	return p.pushInProgress || p.fetchInProgress || p.pullInProgress
+}
+
+// withRemoteSpinner runs a remote operation with the status-line spinner
+// animating until it reports back.
+func (p *Plugin) withRemoteSpinner(op tea.Cmd) tea.Cmd {
+	p.remoteSpinner.Stop()
+	p.remoteSpinner.Start(time.Now())
+	if p.remoteSpinnerTicking {
+		return op
+	}
+	p.remoteSpinnerTicking = true
+	return tea.Batch(op, remoteSpinnerTick())
+}
+
 // clearPushSuccessAfterDelay returns a command that clears the push success indicator after 3 seconds.
 func (p *Plugin) clearPushSuccessAfterDelay() tea.Cmd {
 	return tea.Tick(3*time.Second, func(t time.Time) tea.Msg {
@@ -64,6 +64,7 @@ func (p *Plugin) autoLoadDiff() tea.Cmd {
 	}
 
 	p.selectedDiffFile = entry.Path
+	p.selectedDiffStaged = entry.Staged
 	p.forceNextDiffReload = false
 	if isNewFile {
 		// Only reset scroll when switching to a different file
@@ -73,11 +74,20 @@ func (p *Plugin) autoLoadDiff() tea.Cmd {
 	p.previewCommit = nil
 
 	// Handle folder entries
+	p.diffPaneSubmodule = entry.Submodule
 	if entry.IsFolder {
 		return p.loadFolderDiff(entry)
 	}
+	// Submodules have no line diff; the pane shows the commit change instead
+	if entry.Submodule != nil {
+		p.diffPaneParsedDiff = nil
+		return p.loadFileBlame(entry.Path)
+	}
 
-	return p.loadInlineDiff(entry.Path, entry.Staged, entry.Status)
+	return tea.Batch(
+		p.loadInlineDiff(entry.Path, entry.Staged, entry.Status),
+		p.loadFileBlame(entry.Path),
+	)
 }
 
 // autoLoadCommitPreview triggers loading commit detail for the currently selected commit.
@@ -2,7 +2,6 @@ package gitstatus
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/marcus/sidecar/internal/modal"
 	"github.com/marcus/sidecar/internal/styles"
@@ -14,6 +13,7 @@ const (
 	pullMenuOptionRebase    = "pull-rebase"      // List item ID for rebase strategy
 	pullMenuOptionFFOnly    = "pull-ff-only"     // List item ID for fast-forward only
 	pullMenuOptionAutostash = "pull-autostash"   // List item ID for rebase + autostash
+	pullMenuOptionFrom      = "pull-from"        // List item ID for the remote branch picker
 	pullMenuActionID        = "pull-menu-action" // Primary action (Enter key)
 
 	pullMenuModalWidth = 50 // Default modal width
@@ -44,13 +44,14 @@ func (p *Plugin) ensurePullModal() {
 		{ID: pullMenuOptionRebase, Label: "Pull (rebase)"},
 		{ID: pullMenuOptionFFOnly, Label: "Pull (fast-forward only)"},
 		{ID: pullMenuOptionAutostash, Label: "Pull (rebase + autostash)"},
+		{ID: pullMenuOptionFrom, Label: "Pull from..."},
 	}
 
 	p.pullModal = modal.New("Pull",
 		modal.WithWidth(modalW),
 		modal.WithPrimaryAction(pullMenuActionID),
 	).
-		AddSection(modal.List("pull-options", items, &p.pullSelectedIdx, modal.WithMaxVisible(4)))
+		AddSection(modal.List("pull-options", items, &p.pullSelectedIdx, modal.WithMaxVisible(5)))
 }
 
 // renderPullMenu renders the pull options popup menu.
@@ -115,39 +116,56 @@ func (p *Plugin) renderPullConflict() string {
 func (p *Plugin) pullConflictSummarySection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
 		conflictLabel := "Merge"
-		if p.pullConflictType == "rebase" {
+		switch p.pullConflictType {
+		case "rebase":
 			conflictLabel = "Rebase"
+		case "cherry-pick":
+			conflictLabel = "Cherry-pick"
 		}
-		content := styles.Muted.Render(fmt.Sprintf("%s produced conflicts in %d file(s):", conflictLabel, len(p.pullConflictFiles)))
-		return modal.RenderedSection{Content: content}
+		total := len(p.pullConflictFiles) + len(p.pullConflictResolved)
+		summary := fmt.Sprintf("%s produced conflicts in %d file(s):", conflictLabel, total)
+		if len(p.pullConflictResolved) > 0 {
+			summary = fmt.Sprintf("%s produced conflicts in %d file(s), %d resolved:", conflictLabel, total, len(p.pullConflictResolved))
+		}
+		return modal.RenderedSection{Content: styles.Muted.Render(summary)}
 	}, nil)
 }
 
+// pullConflictFilesSection lists the files that still have conflicts.
+// Selecting one opens it in the conflict resolution view.
 func (p *Plugin) pullConflictFilesSection() modal.Section {
-	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		if len(p.pullConflictFiles) == 0 {
+	if len(p.pullConflictFiles) == 0 {
+		return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+			if len(p.pullConflictResolved) > 0 {
+				return modal.RenderedSection{Content: styles.StatusStaged.Render("  All conflicts resolved.")}
+			}
 			return modal.RenderedSection{Content: styles.Muted.Render("No conflicted files detected.")}
-		}
+		}, nil)
+	}
 
-		var sb strings.Builder
-		maxFiles := 8
-		for i, f := range p.pullConflictFiles {
-			if i >= maxFiles {
-				sb.WriteString(styles.Muted.Render(fmt.Sprintf("  ... and %d more", len(p.pullConflictFiles)-maxFiles)))
-				break
-			}
-			sb.WriteString(styles.StatusModified.Render("  U " + f))
-			if i < len(p.pullConflictFiles)-1 {
-				sb.WriteString("\n")
-			}
-		}
-		return modal.RenderedSection{Content: sb.String()}
-	}, nil)
+	items := make([]modal.ListItem, len(p.pullConflictFiles))
+	for i, f := range p.pullConflictFiles {
+		items[i] = modal.ListItem{ID: pullConflictFilePrefix + f, Label: "U " + f}
+	}
+	if p.pullConflictIdx >= len(items) {
+		p.pullConflictIdx = len(items) - 1
+	}
+	return modal.List("pull-conflict-files", items, &p.pullConflictIdx, modal.WithMaxVisible(8))
 }
 
 func (p *Plugin) pullConflictResolutionSection() modal.Section {
 	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
-		content := styles.Muted.Render("Resolve conflicts in your editor, then commit.")
-		return modal.RenderedSection{Content: content}
+		content := "Press enter to resolve a file side by side,\nor resolve in your editor, then commit."
+		if len(p.pullConflictFiles) == 0 && len(p.pullConflictResolved) > 0 {
+			switch p.pullConflictType {
+			case "rebase":
+				content = "Run git rebase --continue to proceed."
+			case "cherry-pick":
+				content = "Commit to complete the cherry-pick."
+			default:
+				content = "Commit to complete the merge."
+			}
+		}
+		return modal.RenderedSection{Content: styles.Muted.Render(content)}
 	}, nil)
 }
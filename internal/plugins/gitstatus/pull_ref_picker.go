@@ -0,0 +1,188 @@
+package gitstatus
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/modal"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+const (
+	pullRefItemPrefix = "pull-ref-"
+	pullRefMaxVisible = 10
+)
+
+// PullRefsLoadedMsg is sent when the remote branches for the pull ref picker
+// are loaded.
+type PullRefsLoadedMsg struct {
+	Epoch uint64 // Epoch when request was issued (for stale detection)
+	Refs  []PullRef
+	Err   error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m PullRefsLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+func parsePullRefItem(id string) (int, bool) {
+	if !strings.HasPrefix(id, pullRefItemPrefix) {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(strings.TrimPrefix(id, pullRefItemPrefix))
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
+// openPullRefPicker replaces the pull menu with the remote branch picker.
+func (p *Plugin) openPullRefPicker() tea.Cmd {
+	p.clearPullModal()
+	p.pullRefs = nil
+	p.pullRefsLoaded = false
+	p.pullRefIdx = 0
+	p.viewMode = ViewModePullRefPicker
+	p.clearPullRefModal()
+
+	epoch := p.ctx.Epoch
+	workDir := p.repoRoot
+	upstream := ""
+	if p.pushStatus != nil && p.pushStatus.HasUpstream {
+		upstream = p.pushStatus.UpstreamBranch
+	}
+	return func() tea.Msg {
+		refs, err := GetPullRefs(workDir, upstream)
+		return PullRefsLoadedMsg{Epoch: epoch, Refs: refs, Err: err}
+	}
+}
+
+// setPullRefs fills the picker once remote branches load.
+func (p *Plugin) setPullRefs(refs []PullRef, err error) {
+	p.pullRefs = refs
+	p.pullRefsErr = err
+	p.pullRefsLoaded = true
+	p.pullRefIdx = 0
+	p.clearPullRefModal()
+}
+
+// closePullRefPicker returns to the pull menu.
+func (p *Plugin) closePullRefPicker() {
+	p.viewMode = ViewModePullMenu
+	p.clearPullRefModal()
+}
+
+func (p *Plugin) clearPullRefModal() {
+	p.pullRefModal = nil
+	p.pullRefModalWidth = 0
+}
+
+// executePullRef pulls the ref at idx and closes the picker.
+func (p *Plugin) executePullRef(idx int) (plugin.Plugin, tea.Cmd) {
+	if idx < 0 || idx >= len(p.pullRefs) {
+		return p, nil
+	}
+	ref := p.pullRefs[idx]
+	p.viewMode = p.pullMenuReturnMode
+	p.pullInProgress = true
+	p.pullError = ""
+	p.pullSuccess = false
+	p.clearPullRefModal()
+	return p, p.withRemoteSpinner(p.doPullRef(ref))
+}
+
+// updatePullRefPicker handles keys in the pull ref picker.
+func (p *Plugin) updatePullRefPicker(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	p.ensurePullRefModal()
+	if p.pullRefModal == nil {
+		return p, nil
+	}
+
+	if msg.String() == "q" {
+		p.closePullRefPicker()
+		return p, nil
+	}
+
+	action, cmd := p.pullRefModal.HandleKey(msg)
+	if idx, ok := parsePullRefItem(action); ok {
+		return p.executePullRef(idx)
+	}
+	if action == "cancel" {
+		p.closePullRefPicker()
+		return p, nil
+	}
+	return p, cmd
+}
+
+// handlePullRefPickerMouse processes mouse events in the pull ref picker.
+func (p *Plugin) handlePullRefPickerMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	p.ensurePullRefModal()
+	if p.pullRefModal == nil {
+		return p, nil
+	}
+
+	action := p.pullRefModal.HandleMouse(msg, p.mouseHandler)
+	if idx, ok := parsePullRefItem(action); ok {
+		plug, cmd := p.executePullRef(idx)
+		return plug.(*Plugin), cmd
+	}
+	if action == "cancel" {
+		p.closePullRefPicker()
+	}
+	return p, nil
+}
+
+// ensurePullRefModal builds/rebuilds the pull ref picker modal.
+func (p *Plugin) ensurePullRefModal() {
+	modalW := ui.ModalWidthMedium
+	if modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < pullMenuMinWidth {
+		modalW = pullMenuMinWidth
+	}
+
+	if p.pullRefModal != nil && p.pullRefModalWidth == modalW {
+		return
+	}
+	p.pullRefModalWidth = modalW
+
+	m := modal.New("Pull from",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	)
+	switch {
+	case !p.pullRefsLoaded:
+		m.AddSection(modal.Text("Loading remote branches..."))
+	case p.pullRefsErr != nil:
+		m.AddSection(modal.Text("Cannot list remote branches: " + p.pullRefsErr.Error()))
+	case len(p.pullRefs) == 0:
+		m.AddSection(modal.Text("No remote branches. Fetch first with f."))
+	default:
+		items := make([]modal.ListItem, len(p.pullRefs))
+		for i, ref := range p.pullRefs {
+			label := ref.String()
+			if ref.Upstream {
+				label += " (upstream)"
+			}
+			items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", pullRefItemPrefix, i), Label: label}
+		}
+		m.AddSection(modal.List("pull-refs", items, &p.pullRefIdx, modal.WithMaxVisible(pullRefMaxVisible)))
+	}
+	p.pullRefModal = m
+}
+
+// renderPullRefPicker renders the pull ref picker over the status view.
+func (p *Plugin) renderPullRefPicker() string {
+	background := p.renderThreePaneView()
+
+	p.ensurePullRefModal()
+	if p.pullRefModal == nil {
+		return background
+	}
+
+	modalContent := p.pullRefModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
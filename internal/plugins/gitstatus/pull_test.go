@@ -2,6 +2,7 @@ package gitstatus
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -58,3 +59,76 @@ func TestPullErrorMsg_Strategy(t *testing.T) {
 		t.Errorf("PullErrorMsg.Strategy = %q, want %q", msg.Strategy, "rebase")
 	}
 }
+
+func TestParsePullRefs(t *testing.T) {
+	remotes := "origin\nupstream\nupstream/mirror\n"
+	branches := `  origin/HEAD -> origin/main
+  origin/feature/login
+  origin/main
+  upstream/main
+  upstream/mirror/main
+  stale/main
+`
+
+	tests := []struct {
+		name     string
+		upstream string
+		want     []PullRef
+	}{
+		{
+			name:     "upstream first",
+			upstream: "origin/main",
+			want: []PullRef{
+				{Remote: "origin", Branch: "main", Upstream: true},
+				{Remote: "origin", Branch: "feature/login"},
+				{Remote: "upstream", Branch: "main"},
+				{Remote: "upstream/mirror", Branch: "main"},
+			},
+		},
+		{
+			name:     "no upstream",
+			upstream: "",
+			want: []PullRef{
+				{Remote: "origin", Branch: "feature/login"},
+				{Remote: "origin", Branch: "main"},
+				{Remote: "upstream", Branch: "main"},
+				{Remote: "upstream/mirror", Branch: "main"},
+			},
+		},
+		{
+			name:     "upstream not fetched",
+			upstream: "origin/gone",
+			want: []PullRef{
+				{Remote: "origin", Branch: "feature/login"},
+				{Remote: "origin", Branch: "main"},
+				{Remote: "upstream", Branch: "main"},
+				{Remote: "upstream/mirror", Branch: "main"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parsePullRefs(remotes, branches, tt.upstream)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePullRefs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePullRefs_Empty(t *testing.T) {
+	if got := parsePullRefs("", "", ""); len(got) != 0 {
+		t.Errorf("parsePullRefs() with no remotes = %+v, want empty", got)
+	}
+	if got := parsePullRefs("origin\n", "", "origin/main"); len(got) != 0 {
+		t.Errorf("parsePullRefs() with no remote branches = %+v, want empty", got)
+	}
+}
+
+func TestPullRefString(t *testing.T) {
+	ref := PullRef{Remote: "origin", Branch: "feature/login"}
+	if got := ref.String(); got != "origin/feature/login" {
+		t.Errorf("String() = %q, want %q", got, "origin/feature/login")
+	}
+}
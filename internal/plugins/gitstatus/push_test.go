@@ -380,3 +380,31 @@ func TestPopulatePushStatus_NilStatus(t *testing.T) {
 		t.Errorf("Pushed should remain false when status is nil")
 	}
 }
+
+func TestRemoteSpinnerLifecycle(t *testing.T) {
+	p := &Plugin{}
+	p.fetchInProgress = true
+	if cmd := p.withRemoteSpinner(nil); cmd == nil {
+		t.Fatal("starting a remote operation should schedule a spinner tick")
+	}
+	if !p.remoteSpinner.IsActive() || !p.remoteSpinnerTicking {
+		t.Fatal("spinner should run while the fetch is pending")
+	}
+
+	frame := p.remoteSpinner.Frame()
+	_, cmd := p.Update(remoteSpinnerTickMsg{})
+	if cmd == nil {
+		t.Error("tick should reschedule while the fetch is pending")
+	}
+	if p.remoteSpinner.Frame() == frame {
+		t.Error("tick should advance the spinner frame")
+	}
+
+	p.fetchInProgress = false
+	if _, cmd := p.Update(remoteSpinnerTickMsg{}); cmd != nil {
+		t.Error("tick should stop once the operation finishes")
+	}
+	if p.remoteSpinner.IsActive() || p.remoteSpinnerTicking {
+		t.Error("spinner should stop once the operation finishes")
+	}
+}
@@ -17,9 +17,17 @@ func ExecuteFetch(workDir string) (string, error) {
 	return string(output), nil
 }
 
-// ExecutePull runs git pull.
-func ExecutePull(workDir string) (string, error) {
-	cmd := exec.Command("git", "pull")
+// ExecutePull runs git pull. With an empty remote it pulls the tracked
+// upstream; otherwise it pulls branch from remote.
+func ExecutePull(workDir, remote, branch string) (string, error) {
+	args := []string{"pull"}
+	if remote != "" {
+		args = append(args, remote)
+		if branch != "" {
+			args = append(args, branch)
+		}
+	}
+	cmd := exec.Command("git", args...)
 	cmd.Dir = workDir
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -61,6 +69,75 @@ func ExecutePullAutostash(workDir string) (string, error) {
 	return string(output), nil
 }
 
+// PullRef is a remote branch that can be pulled.
+type PullRef struct {
+	Remote   string
+	Branch   string
+	Upstream bool // The current branch's tracked upstream
+}
+
+// String returns the ref as "remote/branch".
+func (r PullRef) String() string {
+	return r.Remote + "/" + r.Branch
+}
+
+// GetPullRefs lists remote branches available to pull, with upstream (e.g.
+// "origin/main") first when it exists.
+func GetPullRefs(workDir, upstream string) ([]PullRef, error) {
+	cmd := exec.Command("git", "remote")
+	cmd.Dir = workDir
+	remotes, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	cmd = exec.Command("git", "branch", "-r")
+	cmd.Dir = workDir
+	branches, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parsePullRefs(string(remotes), string(branches), upstream), nil
+}
+
+// parsePullRefs builds pull candidates from `git remote` and `git branch -r`
+// output. Each remote branch is split at the longest matching remote name,
+// since both remote and branch names may contain slashes. Symbolic refs like
+// "origin/HEAD -> origin/main" are skipped. The upstream ref, if listed, is
+// marked and moved to the front; the rest keep git's order.
+func parsePullRefs(remotesOutput, branchesOutput, upstream string) []PullRef {
+	var remotes []string
+	for _, line := range strings.Split(remotesOutput, "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			remotes = append(remotes, name)
+		}
+	}
+
+	var refs []PullRef
+	for _, line := range strings.Split(branchesOutput, "\n") {
+		name := strings.TrimSpace(line)
+		if name == "" || strings.Contains(name, " -> ") {
+			continue
+		}
+		remote := ""
+		for _, r := range remotes {
+			if strings.HasPrefix(name, r+"/") && len(r) > len(remote) {
+				remote = r
+			}
+		}
+		if remote == "" {
+			continue
+		}
+		ref := PullRef{Remote: remote, Branch: strings.TrimPrefix(name, remote+"/")}
+		if name == upstream {
+			ref.Upstream = true
+			refs = append([]PullRef{ref}, refs...)
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
 // GetConflictedFiles returns a list of files with merge conflicts.
 func GetConflictedFiles(workDir string) []string {
 	cmd := exec.Command("git", "diff", "--name-only", "--diff-filter=U")
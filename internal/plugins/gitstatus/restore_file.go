@@ -0,0 +1,336 @@
+package gitstatus
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/modal"
+	"github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+const (
+	restoreItemPrefix = "restore-commit-"
+	restoreMaxVisible = 10
+	restoreHistoryMax = 50
+)
+
+// commitHashPattern matches abbreviated or full commit hashes. Anything else
+// (refs, revision expressions, option-like strings) is refused.
+var commitHashPattern = regexp.MustCompile(`^[0-9a-fA-F]{4,64}$`)
+
+// FileCommitsLoadedMsg is sent when the history of a file for the restore
+// picker is loaded.
+type FileCommitsLoadedMsg struct {
+	Epoch   uint64 // Epoch when request was issued (for stale detection)
+	Path    string
+	Commits []*Commit
+	Err     error
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m FileCommitsLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+// RestoreFileDoneMsg is sent when a file has been restored from a commit.
+type RestoreFileDoneMsg struct {
+	Path      string
+	ShortHash string
+	Err       error
+}
+
+// restoreFileArgs builds the git arguments to restore path from hash.
+func restoreFileArgs(path, hash string) []string {
+	return []string{"checkout", hash, "--", path}
+}
+
+// verifyCommit checks that hash looks like a commit hash and names a commit
+// in the repository.
+func verifyCommit(workDir, hash string) error {
+	if !commitHashPattern.MatchString(hash) {
+		return fmt.Errorf("invalid commit hash %q", hash)
+	}
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", hash+"^{commit}")
+	cmd.Dir = workDir
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("commit %s not found", hash)
+	}
+	return nil
+}
+
+// RestoreFileFromCommit replaces path in the working tree and index with its
+// content at the given commit (git checkout <hash> -- <path>).
+func RestoreFileFromCommit(workDir, path, hash string) error {
+	if err := verifyCommit(workDir, hash); err != nil {
+		return err
+	}
+	cmd := exec.Command("git", restoreFileArgs(path, hash)...)
+	cmd.Dir = workDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+func parseRestoreItem(id string) (int, bool) {
+	if !strings.HasPrefix(id, restoreItemPrefix) {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(strings.TrimPrefix(id, restoreItemPrefix))
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
+// openRestorePicker shows the commits that touched the file at the cursor.
+func (p *Plugin) openRestorePicker(entry *FileEntry) tea.Cmd {
+	if entry.IsFolder || entry.Submodule != nil {
+		return nil
+	}
+	if entry.Status == StatusUntracked {
+		return msg.ShowToast("Untracked files have no history", 2*time.Second)
+	}
+
+	p.restorePath = entry.Path
+	p.restoreCommits = nil
+	p.restoreCommitsLoaded = false
+	p.restoreCommitsErr = nil
+	p.restoreIdx = 0
+	p.viewMode = ViewModeRestorePicker
+	p.clearRestoreModal()
+
+	epoch := p.ctx.Epoch
+	workDir := p.repoRoot
+	path := entry.Path
+	return func() tea.Msg {
+		commits, err := GetCommitHistoryFiltered(workDir, HistoryFilterOpts{Path: path, Limit: restoreHistoryMax})
+		return FileCommitsLoadedMsg{Epoch: epoch, Path: path, Commits: commits, Err: err}
+	}
+}
+
+// setRestoreCommits fills the picker once the file history loads.
+func (p *Plugin) setRestoreCommits(path string, commits []*Commit, err error) {
+	if path != p.restorePath {
+		return
+	}
+	p.restoreCommits = commits
+	p.restoreCommitsErr = err
+	p.restoreCommitsLoaded = true
+	p.restoreIdx = 0
+	p.clearRestoreModal()
+}
+
+// closeRestore dismisses the picker or confirmation and returns to the status view.
+func (p *Plugin) closeRestore() {
+	p.restorePath = ""
+	p.restoreCommits = nil
+	p.restoreCommit = nil
+	p.restoreConfirmModal = nil
+	p.viewMode = ViewModeStatus
+	p.clearRestoreModal()
+}
+
+func (p *Plugin) clearRestoreModal() {
+	p.restoreModal = nil
+	p.restoreModalWidth = 0
+}
+
+// selectRestoreCommit asks for confirmation before overwriting the file.
+func (p *Plugin) selectRestoreCommit(idx int) {
+	if idx < 0 || idx >= len(p.restoreCommits) {
+		return
+	}
+	commit := p.restoreCommits[idx]
+	dialog := ui.NewConfirmDialog("Restore File?",
+		styles.Subtitle.Render(p.restorePath)+"\n"+
+			"as of "+styles.Subtitle.Render(commit.ShortHash)+" "+commit.Subject+"\n\n"+
+			styles.StatusDeleted.Render("Local changes to this file will be overwritten."))
+	dialog.ConfirmLabel = " Restore "
+	dialog.BorderColor = styles.Warning
+	p.restoreCommit = commit
+	p.restoreConfirmModal = dialog.ToModal()
+	p.viewMode = ViewModeConfirmRestore
+}
+
+// cancelRestoreConfirm returns from the confirmation to the commit picker.
+func (p *Plugin) cancelRestoreConfirm() {
+	p.restoreCommit = nil
+	p.restoreConfirmModal = nil
+	p.viewMode = ViewModeRestorePicker
+}
+
+// executeRestore restores the file from the confirmed commit.
+func (p *Plugin) executeRestore() tea.Cmd {
+	commit := p.restoreCommit
+	path := p.restorePath
+	p.closeRestore()
+	if commit == nil || path == "" {
+		return nil
+	}
+
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		err := RestoreFileFromCommit(workDir, path, commit.Hash)
+		return RestoreFileDoneMsg{Path: path, ShortHash: commit.ShortHash, Err: err}
+	}
+}
+
+// handleRestoreFileDone reports the result and reloads the file list.
+func (p *Plugin) handleRestoreFileDone(m RestoreFileDoneMsg) tea.Cmd {
+	if m.Err != nil {
+		p.showErrorModal("Restore Failed", m.Err)
+		return nil
+	}
+	return tea.Batch(
+		msg.ShowToast(fmt.Sprintf("Restored %s from %s", m.Path, m.ShortHash), 2*time.Second),
+		p.refresh(),
+	)
+}
+
+// updateRestorePicker handles keys in the restore commit picker.
+func (p *Plugin) updateRestorePicker(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	p.ensureRestoreModal()
+	if p.restoreModal == nil {
+		return p, nil
+	}
+
+	if msg.String() == "q" {
+		p.closeRestore()
+		return p, nil
+	}
+
+	action, cmd := p.restoreModal.HandleKey(msg)
+	if idx, ok := parseRestoreItem(action); ok {
+		p.selectRestoreCommit(idx)
+		return p, nil
+	}
+	if action == "cancel" {
+		p.closeRestore()
+		return p, nil
+	}
+	return p, cmd
+}
+
+// handleRestorePickerMouse processes mouse events in the restore commit picker.
+func (p *Plugin) handleRestorePickerMouse(msg tea.MouseMsg) (plugin.Plugin, tea.Cmd) {
+	p.ensureRestoreModal()
+	if p.restoreModal == nil {
+		return p, nil
+	}
+
+	action := p.restoreModal.HandleMouse(msg, p.mouseHandler)
+	if idx, ok := parseRestoreItem(action); ok {
+		p.selectRestoreCommit(idx)
+		return p, nil
+	}
+	if action == "cancel" {
+		p.closeRestore()
+	}
+	return p, nil
+}
+
+// updateConfirmRestore handles keys in the restore confirmation.
+func (p *Plugin) updateConfirmRestore(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	if p.restoreConfirmModal == nil {
+		p.cancelRestoreConfirm()
+		return p, nil
+	}
+
+	// Quick confirm shortcut
+	switch msg.String() {
+	case "y", "Y":
+		return p, p.executeRestore()
+	}
+
+	action, cmd := p.restoreConfirmModal.HandleKey(msg)
+	switch action {
+	case "confirm":
+		return p, p.executeRestore()
+	case "cancel":
+		p.cancelRestoreConfirm()
+	}
+	return p, cmd
+}
+
+// handleConfirmRestoreMouse handles mouse events for the restore confirmation.
+func (p *Plugin) handleConfirmRestoreMouse(msg tea.MouseMsg) (plugin.Plugin, tea.Cmd) {
+	if p.restoreConfirmModal == nil {
+		return p, nil
+	}
+
+	switch p.restoreConfirmModal.HandleMouse(msg, p.mouseHandler) {
+	case "confirm":
+		return p, p.executeRestore()
+	case "cancel":
+		p.cancelRestoreConfirm()
+	}
+	return p, nil
+}
+
+// ensureRestoreModal builds/rebuilds the restore commit picker modal.
+func (p *Plugin) ensureRestoreModal() {
+	modalW := ui.ModalWidthMedium
+	if modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < pullMenuMinWidth {
+		modalW = pullMenuMinWidth
+	}
+
+	if p.restoreModal != nil && p.restoreModalWidth == modalW {
+		return
+	}
+	p.restoreModalWidth = modalW
+
+	m := modal.New("Restore "+truncateDiffPath(p.restorePath, modalW-14)+" from",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	)
+	switch {
+	case !p.restoreCommitsLoaded:
+		m.AddSection(modal.Text("Loading history..."))
+	case p.restoreCommitsErr != nil:
+		m.AddSection(modal.Text("Cannot load history: " + p.restoreCommitsErr.Error()))
+	case len(p.restoreCommits) == 0:
+		m.AddSection(modal.Text("This file has no committed history."))
+	default:
+		items := make([]modal.ListItem, len(p.restoreCommits))
+		for i, c := range p.restoreCommits {
+			label := fmt.Sprintf("%s %s · %s", c.ShortHash, c.Subject, RelativeTime(c.Date))
+			items[i] = modal.ListItem{ID: fmt.Sprintf("%s%d", restoreItemPrefix, i), Label: label}
+		}
+		m.AddSection(modal.List("restore-commits", items, &p.restoreIdx, modal.WithMaxVisible(restoreMaxVisible)))
+	}
+	p.restoreModal = m
+}
+
+// renderRestorePicker renders the restore commit picker over the status view.
+func (p *Plugin) renderRestorePicker() string {
+	background := p.renderThreePaneView()
+
+	p.ensureRestoreModal()
+	if p.restoreModal == nil {
+		return background
+	}
+
+	modalContent := p.restoreModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
+
+// renderConfirmRestore renders the restore confirmation overlay.
+func (p *Plugin) renderConfirmRestore() string {
+	background := p.renderThreePaneView()
+	if p.restoreConfirmModal == nil {
+		return background
+	}
+	modalContent := p.restoreConfirmModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
@@ -0,0 +1,89 @@
+package gitstatus
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/marcus/sidecar/internal/testutil"
+)
+
+func TestRestoreFileArgs(t *testing.T) {
+	tests := []struct {
+		path string
+		hash string
+		want []string
+	}{
+		{"main.go", "1a2b3c4", []string{"checkout", "1a2b3c4", "--", "main.go"}},
+		{"dir/with space.txt", "1a2b3c4d5e6f", []string{"checkout", "1a2b3c4d5e6f", "--", "dir/with space.txt"}},
+		// Paths that look like options stay after the separator
+		{"-rf", "1a2b3c4", []string{"checkout", "1a2b3c4", "--", "-rf"}},
+	}
+	for _, tt := range tests {
+		if got := restoreFileArgs(tt.path, tt.hash); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("restoreFileArgs(%q, %q) = %q, want %q", tt.path, tt.hash, got, tt.want)
+		}
+	}
+}
+
+// initRestoreRepo creates a repo where a.txt goes through "v1" and "v2",
+// then has an uncommitted "local" edit. Returns the dir and the v1 hash.
+func initRestoreRepo(t *testing.T) (string, string) {
+	t.Helper()
+	dir, git := testutil.NewGitRepo(t)
+	write := func(content string) {
+		if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("v1\n")
+	git("add", "a.txt")
+	git("commit", "-q", "-m", "v1")
+	v1 := git("rev-parse", "HEAD")
+	write("v2\n")
+	git("commit", "-q", "-am", "v2")
+	write("local\n")
+	return dir, v1
+}
+
+func TestRestoreFileFromCommit(t *testing.T) {
+	dir, v1 := initRestoreRepo(t)
+
+	if err := RestoreFileFromCommit(dir, "a.txt", v1[:7]); err != nil {
+		t.Fatalf("RestoreFileFromCommit() error = %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "v1\n" {
+		t.Errorf("a.txt = %q, want %q", data, "v1\n")
+	}
+}
+
+func TestRestoreFileFromCommit_RefusesInvalidHash(t *testing.T) {
+	dir, _ := initRestoreRepo(t)
+
+	for _, hash := range []string{
+		"",
+		"abc",              // too short
+		"HEAD~1",           // revision expressions are not hashes
+		"--orphan",         // option-like
+		"1a2b3c4 -- b.txt", // injected arguments
+		"deadbeefdeadbeefdeadbeefdeadbeefdeadbeef", // well-formed but missing
+	} {
+		if err := RestoreFileFromCommit(dir, "a.txt", hash); err == nil {
+			t.Errorf("RestoreFileFromCommit(%q) succeeded, want error", hash)
+		}
+	}
+
+	// The local edit must survive every refused attempt
+	data, err := os.ReadFile(filepath.Join(dir, "a.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "local\n" {
+		t.Errorf("a.txt = %q, want local edit preserved", data)
+	}
+}
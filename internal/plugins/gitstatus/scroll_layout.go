@@ -85,8 +85,7 @@ func (p *Plugin) commitSectionCapacity(visibleHeight int) int {
 	// visibleHeight already excludes the "Files" header lines.
 	linesUsed := 0
 
-	entries := p.tree.AllEntries()
-	if len(entries) == 0 {
+	if p.tree.TotalCount() == 0 {
 		// "Working tree clean"
 		linesUsed++
 	} else {
@@ -100,18 +99,28 @@ func (p *Plugin) commitSectionCapacity(visibleHeight int) int {
 			filesHeight = 3
 		}
 
+		staged := p.tree.StagedRows()
+		modified := p.tree.ModifiedRows()
+		untracked := p.tree.UntrackedRows()
+
 		lineNum := 0
-		if len(p.tree.Staged) > 0 && lineNum < filesHeight {
+		if p.fileFilterVisible() {
+			lineNum++ // filter line
+			if len(staged)+len(modified)+len(untracked) == 0 {
+				lineNum++ // "No files match"
+			}
+		}
+		if len(staged) > 0 && lineNum < filesHeight {
 			lineNum++ // section header
-			for range p.tree.Staged {
+			for range staged {
 				if lineNum >= filesHeight {
 					break
 				}
 				lineNum++
 			}
 		}
-		if len(p.tree.Modified) > 0 && lineNum < filesHeight {
-			if len(p.tree.Staged) > 0 {
+		if len(modified) > 0 && lineNum < filesHeight {
+			if len(staged) > 0 {
 				if lineNum < filesHeight {
 					lineNum++ // blank line between sections
 				}
@@ -119,15 +128,15 @@ func (p *Plugin) commitSectionCapacity(visibleHeight int) int {
 			if lineNum < filesHeight {
 				lineNum++ // section header
 			}
-			for range p.tree.Modified {
+			for range modified {
 				if lineNum >= filesHeight {
 					break
 				}
 				lineNum++
 			}
 		}
-		if len(p.tree.Untracked) > 0 && lineNum < filesHeight {
-			if len(p.tree.Staged) > 0 || len(p.tree.Modified) > 0 {
+		if len(untracked) > 0 && lineNum < filesHeight {
+			if len(staged) > 0 || len(modified) > 0 {
 				if lineNum < filesHeight {
 					lineNum++ // blank line between sections
 				}
@@ -135,7 +144,7 @@ func (p *Plugin) commitSectionCapacity(visibleHeight int) int {
 			if lineNum < filesHeight {
 				lineNum++ // section header
 			}
-			for range p.tree.Untracked {
+			for range untracked {
 				if lineNum >= filesHeight {
 					break
 				}
@@ -3,6 +3,7 @@ package gitstatus
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/marcus/sidecar/internal/styles"
@@ -138,7 +139,7 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 	sb.WriteString("\n\n")
 
 	entries := p.tree.AllEntries()
-	if len(entries) == 0 {
+	if p.tree.TotalCount() == 0 {
 		sb.WriteString(styles.Muted.Render("Working tree clean"))
 		sb.WriteString("\n")
 		currentY++
@@ -158,30 +159,47 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 		var filesSB strings.Builder
 		lineNum := 0
 		globalIdx := 0
+		staged := p.tree.StagedRows()
+		modified := p.tree.ModifiedRows()
+		untracked := p.tree.UntrackedRows()
+
+		// Active filter with match count
+		if p.fileFilterVisible() {
+			filesSB.WriteString(p.renderFileFilterLine())
+			filesSB.WriteString("\n")
+			lineNum++
+			currentY++
+			if len(entries) == 0 {
+				filesSB.WriteString(styles.Muted.Render("No files match"))
+				filesSB.WriteString("\n")
+				lineNum++
+				currentY++
+			}
+		}
 
 		// Staged section
-		if len(p.tree.Staged) > 0 && lineNum < filesHeight {
-			filesSB.WriteString(p.renderSidebarSection("Staged", p.tree.Staged, &lineNum, &globalIdx, filesHeight, &currentY))
+		if len(staged) > 0 && lineNum < filesHeight {
+			filesSB.WriteString(p.renderSidebarSection("Staged", staged, len(p.tree.VisibleStaged()), &lineNum, &globalIdx, filesHeight, &currentY))
 		}
 
 		// Modified section
-		if len(p.tree.Modified) > 0 && lineNum < filesHeight {
-			if len(p.tree.Staged) > 0 {
+		if len(modified) > 0 && lineNum < filesHeight {
+			if len(staged) > 0 {
 				filesSB.WriteString("\n")
 				lineNum++
 				currentY++
 			}
-			filesSB.WriteString(p.renderSidebarSection("Modified", p.tree.Modified, &lineNum, &globalIdx, filesHeight, &currentY))
+			filesSB.WriteString(p.renderSidebarSection("Modified", modified, len(p.tree.VisibleModified()), &lineNum, &globalIdx, filesHeight, &currentY))
 		}
 
 		// Untracked section
-		if len(p.tree.Untracked) > 0 && lineNum < filesHeight {
-			if len(p.tree.Staged) > 0 || len(p.tree.Modified) > 0 {
+		if len(untracked) > 0 && lineNum < filesHeight {
+			if len(staged) > 0 || len(modified) > 0 {
 				filesSB.WriteString("\n")
 				lineNum++
 				currentY++
 			}
-			filesSB.WriteString(p.renderSidebarSection("Untracked", p.tree.Untracked, &lineNum, &globalIdx, filesHeight, &currentY))
+			filesSB.WriteString(p.renderSidebarSection("Untracked", untracked, len(p.tree.VisibleUntracked()), &lineNum, &globalIdx, filesHeight, &currentY))
 		}
 
 		// Render scrollbar alongside files section
@@ -207,15 +225,15 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 
 	// Remote operation status (push/fetch/pull)
 	if p.pushInProgress {
-		sb.WriteString(styles.StatusInProgress.Render("Pushing..."))
+		sb.WriteString(styles.StatusInProgress.Render(p.remoteSpinner.View("Pushing...", time.Now())))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.fetchInProgress {
-		sb.WriteString(styles.StatusInProgress.Render("Fetching..."))
+		sb.WriteString(styles.StatusInProgress.Render(p.remoteSpinner.View("Fetching...", time.Now())))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.pullInProgress {
-		sb.WriteString(styles.StatusInProgress.Render("Pulling..."))
+		sb.WriteString(styles.StatusInProgress.Render(p.remoteSpinner.View("Pulling...", time.Now())))
 		sb.WriteString("\n")
 		currentY++
 	} else if p.pushSuccess {
@@ -267,8 +285,10 @@ func (p *Plugin) renderSidebar(visibleHeight int) string {
 	return sb.String()
 }
 
-// renderSidebarSection renders a file section in the sidebar.
-func (p *Plugin) renderSidebarSection(title string, entries []*FileEntry, lineNum, globalIdx *int, maxLines int, currentY *int) string {
+// renderSidebarSection renders a file section in the sidebar. count is the
+// number of files shown in the header, which differs from len(entries) when
+// directory rows are present.
+func (p *Plugin) renderSidebarSection(title string, entries []*FileEntry, count int, lineNum, globalIdx *int, maxLines int, currentY *int) string {
 	var sb strings.Builder
 
 	// Section header with color based on type
@@ -280,7 +300,7 @@ func (p *Plugin) renderSidebarSection(title string, entries []*FileEntry, lineNu
 		headerStyle = styles.StatusModified
 	}
 
-	sb.WriteString(headerStyle.Render(fmt.Sprintf("%s (%d)", title, len(entries))))
+	sb.WriteString(headerStyle.Render(fmt.Sprintf("%s (%d)", title, count)))
 	sb.WriteString("\n")
 	*lineNum++
 	*currentY++
@@ -331,10 +351,18 @@ func (p *Plugin) renderSidebarEntry(entry *FileEntry, selected bool, maxWidth in
 
 	status := statusStyle.Render(string(entry.Status))
 
+	// Directory grouping: indent under the parent folder and show names
+	// relative to it
+	indent := strings.Repeat("  ", entry.Depth)
+	name := entry.Path
+	if entry.Label != "" {
+		name = entry.Label
+	}
+
 	// Handle folder entries specially
 	if entry.IsFolder {
-		folderName := entry.Path
-		fileCount := len(entry.Children)
+		folderName := name
+		fileCount := len(entry.Files())
 		countStr := fmt.Sprintf("(%d)", fileCount)
 
 		// Only show expand/collapse indicator if folder has children
@@ -347,39 +375,52 @@ func (p *Plugin) renderSidebarEntry(entry *FileEntry, selected bool, maxWidth in
 		}
 
 		// Calculate available width
-		availableWidth := maxWidth - 2 - len(indicator) // status + indicator + spacing
+		availableWidth := maxWidth - 2 - len(indent) - len(indicator) // status + indent + indicator + spacing
 		displayName := folderName
 		if len(folderName)+len(countStr)+1 > availableWidth && availableWidth > 10 {
 			displayName = folderName[:availableWidth-len(countStr)-4] + "…/"
 		}
 
 		if selected {
-			plainLine := fmt.Sprintf("%s %s%s %s", string(entry.Status), indicator, displayName, countStr)
+			plainLine := fmt.Sprintf("%s %s%s%s %s", string(entry.Status), indent, indicator, displayName, countStr)
 			if len(plainLine) < maxWidth {
 				plainLine += strings.Repeat(" ", maxWidth-len(plainLine))
 			}
 			return styles.ListItemSelected.Render(plainLine)
 		}
 
-		return styles.ListItemNormal.Render(fmt.Sprintf("%s %s%s %s", status, indicator, displayName, styles.Muted.Render(countStr)))
+		return styles.ListItemNormal.Render(fmt.Sprintf("%s %s%s%s %s", status, indent, indicator, displayName, styles.Muted.Render(countStr)))
+	}
+
+	// Submodules get an icon and their commit pointer change
+	icon, suffix := "", ""
+	if entry.Submodule != nil {
+		icon = submoduleIcon + " "
+		suffix = " " + entry.Submodule.Summary()
+		if entry.Submodule.Dirty() {
+			suffix += "*"
+		}
 	}
 
 	// Path - truncate if needed
-	path := entry.Path
-	availableWidth := maxWidth - 2 // status + space
-	if len(path) > availableWidth && availableWidth > 3 {
-		path = "…" + path[len(path)-availableWidth+1:]
+	path := name
+	availableWidth := maxWidth - 2 - len(indent) - lipgloss.Width(icon) - lipgloss.Width(suffix) // status + space + indent
+	if availableWidth > 3 {
+		path = ui.TruncateMiddle(path, availableWidth)
 	}
 
 	if selected {
-		plainLine := fmt.Sprintf("%s %s", string(entry.Status), path)
-		if len(plainLine) < maxWidth {
-			plainLine += strings.Repeat(" ", maxWidth-len(plainLine))
+		plainLine := fmt.Sprintf("%s %s%s%s%s", string(entry.Status), indent, icon, path, suffix)
+		if w := lipgloss.Width(plainLine); w < maxWidth {
+			plainLine += strings.Repeat(" ", maxWidth-w)
 		}
 		return styles.ListItemSelected.Render(plainLine)
 	}
 
-	return styles.ListItemNormal.Render(fmt.Sprintf("%s %s", status, path))
+	if entry.Submodule != nil {
+		return styles.ListItemNormal.Render(fmt.Sprintf("%s %s%s%s%s", status, indent, styles.Subtitle.Render(icon), path, styles.Muted.Render(suffix)))
+	}
+	return styles.ListItemNormal.Render(fmt.Sprintf("%s %s%s", status, indent, path))
 }
 
 // renderRecentCommits renders the recent commits section in the sidebar.
@@ -627,6 +668,9 @@ func (p *Plugin) renderDiffPane(visibleHeight int) string {
 	if p.diffPaneViewMode == DiffViewSideBySide {
 		viewModeStr = "split"
 	}
+	if p.diffIgnoreSpace {
+		viewModeStr += " -w"
+	}
 	header := "Diff"
 	if p.selectedDiffFile != "" {
 		header = truncateDiffPath(p.selectedDiffFile, p.diffPaneWidth-20) // Leave room for mode + indicators
@@ -656,13 +700,20 @@ func (p *Plugin) renderDiffPane(visibleHeight int) string {
 
 	header = fmt.Sprintf("%s [%s]%s", header, viewModeStr, scrollIndicator)
 	sb.WriteString(styles.Title.Render(header))
-	sb.WriteString("\n\n")
+	sb.WriteString("\n")
+	sb.WriteString(p.renderFileBlameLine(diffWidth))
+	sb.WriteString("\n")
 
 	if p.selectedDiffFile == "" {
 		sb.WriteString(styles.Muted.Render("Select a file to view diff"))
 		return sb.String()
 	}
 
+	if p.diffPaneSubmodule != nil {
+		sb.WriteString(renderSubmoduleDetail(p.diffPaneSubmodule))
+		return sb.String()
+	}
+
 	if p.diffPaneParsedDiff == nil {
 		sb.WriteString(styles.Muted.Render("Loading diff..."))
 		return sb.String()
@@ -680,7 +731,7 @@ func (p *Plugin) renderDiffPane(visibleHeight int) string {
 	if p.diffPaneViewMode == DiffViewSideBySide {
 		diffContent = RenderSideBySide(p.diffPaneParsedDiff, diffWidth, p.diffPaneScroll, contentHeight, p.diffPaneHorizScroll, highlighter, p.diffWrapEnabled)
 	} else {
-		diffContent = RenderLineDiff(p.diffPaneParsedDiff, diffWidth, p.diffPaneScroll, contentHeight, p.diffPaneHorizScroll, highlighter, p.diffWrapEnabled)
+		diffContent = RenderLineDiff(p.diffPaneParsedDiff, diffWidth, p.diffPaneScroll, contentHeight, p.diffPaneHorizScroll, highlighter, p.diffWrapEnabled, p.diffLineNumbers)
 	}
 	// Force truncate each line to prevent wrapping (skip when wrap is enabled)
 	if !p.diffWrapEnabled {
@@ -869,8 +920,8 @@ func (p *Plugin) renderCommitPreviewFile(file CommitFile, selected bool, maxWidt
 	// Path - truncate if needed
 	path := file.Path
 	pathWidth := maxWidth - 4 // status + spacing
-	if len(path) > pathWidth && pathWidth > 3 {
-		path = "…" + path[len(path)-pathWidth+1:]
+	if pathWidth > 3 {
+		path = ui.TruncateMiddle(path, pathWidth)
 	}
 
 	if selected {
@@ -900,14 +951,8 @@ func truncateStyledLine(s string, maxWidth int) string {
 	return truncateStyledLineCached(s, maxWidth)
 }
 
-// truncateDiffPath shortens a path to fit width (rune-based for Unicode safety).
+// truncateDiffPath shortens a path to fit width, keeping its leading
+// directory and file name.
 func truncateDiffPath(path string, maxWidth int) string {
-	runes := []rune(path)
-	if len(runes) <= maxWidth {
-		return path
-	}
-	if maxWidth < 10 {
-		return string(runes[:maxWidth])
-	}
-	return "…" + string(runes[len(runes)-maxWidth+1:])
+	return ui.TruncateMiddle(path, maxWidth)
 }
@@ -5,15 +5,18 @@ import (
 	"bytes"
 	"os/exec"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Stash represents a single stash entry.
 type Stash struct {
-	Index   int    // stash index (0 = most recent)
-	Ref     string // stash@{0}, stash@{1}, etc.
-	Branch  string // Branch the stash was created on
-	Message string // Stash message
+	Index   int       // stash index (0 = most recent)
+	Ref     string    // stash@{0}, stash@{1}, etc.
+	Branch  string    // Branch the stash was created on
+	Message string    // Stash message
+	Date    time.Time // When the stash was created
 }
 
 // StashList represents the list of stashes.
@@ -21,43 +24,48 @@ type StashList struct {
 	Stashes []*Stash
 }
 
+var (
+	// Pattern: stash@{n}|unix-time|message
+	stashLineRe = regexp.MustCompile(`^stash@\{(\d+)\}\|(\d*)\|(.+)$`)
+	// Message format: "WIP on branch: hash message" or "On branch: message"
+	stashBranchRe = regexp.MustCompile(`^(?:WIP )?[Oo]n ([^:]+): (.+)$`)
+)
+
 // GetStashList retrieves the list of stashes.
 func GetStashList(workDir string) (*StashList, error) {
-	cmd := exec.Command("git", "stash", "list", "--format=%gd|%gs")
+	cmd := exec.Command("git", "stash", "list", "--format=%gd|%ct|%gs")
 	cmd.Dir = workDir
 	output, err := cmd.Output()
 	if err != nil {
 		// No stashes is not an error
 		return &StashList{}, nil
 	}
+	return parseStashList(output), nil
+}
 
+// parseStashList parses `git stash list --format=%gd|%ct|%gs` output.
+func parseStashList(output []byte) *StashList {
 	list := &StashList{}
 	scanner := bufio.NewScanner(bytes.NewReader(output))
 
-	// Pattern: stash@{n}|message
-	// Message format: "WIP on branch: hash message" or "On branch: message"
-	re := regexp.MustCompile(`^stash@\{(\d+)\}\|(.+)$`)
-	branchRe := regexp.MustCompile(`^(?:WIP )?[Oo]n ([^:]+): (.+)$`)
-
 	for scanner.Scan() {
-		line := scanner.Text()
-		matches := re.FindStringSubmatch(line)
-		if len(matches) != 3 {
+		matches := stashLineRe.FindStringSubmatch(scanner.Text())
+		if len(matches) != 4 {
 			continue
 		}
 
-		var idx int
-		_, _ = exec.Command("echo").Output() // dummy to avoid import error
-		idx = len(list.Stashes)
-
+		idx, _ := strconv.Atoi(matches[1])
 		stash := &Stash{
 			Index: idx,
 			Ref:   "stash@{" + matches[1] + "}",
 		}
+		if ts, err := strconv.ParseInt(matches[2], 10, 64); err == nil {
+			stash.Date = time.Unix(ts, 0)
+		}
 
 		// Parse the message for branch name
-		msgPart := matches[2]
-		branchMatches := branchRe.FindStringSubmatch(msgPart)
+		msgPart := matches[3]
+		branchMatches := stashBranchRe.FindStringSubmatch(msgPart)
 		if len(branchMatches) == 3 {
 			stash.Branch = branchMatches[1]
 			stash.Message = branchMatches[2]
@@ -68,7 +76,7 @@ func GetStashList(workDir string) (*StashList, error) {
 		list.Stashes = append(list.Stashes, stash)
 	}
 
-	return list, nil
+	return list
 }
 
 // StashPush creates a new stash with all changes.
@@ -0,0 +1,421 @@
+package gitstatus
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/modal"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+const (
+	stashPickerItemPrefix = "stash-picker-item-"
+)
+
+// StashListLoadedMsg is sent when the stash list for the picker is loaded.
+type StashListLoadedMsg struct {
+	Epoch   uint64 // Epoch when request was issued (for stale detection)
+	Stashes []*Stash
+}
+
+// GetEpoch implements plugin.EpochMessage.
+func (m StashListLoadedMsg) GetEpoch() uint64 { return m.Epoch }
+
+func stashPickerItemID(idx int) string {
+	return fmt.Sprintf("%s%d", stashPickerItemPrefix, idx)
+}
+
+func parseStashPickerItem(id string) (int, bool) {
+	if !strings.HasPrefix(id, stashPickerItemPrefix) {
+		return 0, false
+	}
+	idx, err := strconv.Atoi(strings.TrimPrefix(id, stashPickerItemPrefix))
+	if err != nil {
+		return 0, false
+	}
+	return idx, true
+}
+
+// openStashPicker shows the stash picker and loads the stash list.
+func (p *Plugin) openStashPicker() tea.Cmd {
+	p.stashReturnMode = p.viewMode
+	p.stashes = nil
+	p.stashesLoaded = false
+	p.stashCursor = 0
+	p.viewMode = ViewModeStashPicker
+	p.clearStashPickerModal()
+	return p.loadStashes()
+}
+
+// loadStashes loads the stash list for the picker.
+func (p *Plugin) loadStashes() tea.Cmd {
+	epoch := p.ctx.Epoch
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		list, err := GetStashList(workDir)
+		if err != nil {
+			return StashErrorMsg{Err: err}
+		}
+		return StashListLoadedMsg{Epoch: epoch, Stashes: list.Stashes}
+	}
+}
+
+// setStashes replaces the picker entries, keeping the cursor in range.
+func (p *Plugin) setStashes(stashes []*Stash) {
+	p.stashes = stashes
+	p.stashesLoaded = true
+	if p.stashCursor >= len(stashes) {
+		p.stashCursor = len(stashes) - 1
+	}
+	if p.stashCursor < 0 {
+		p.stashCursor = 0
+	}
+	p.clearStashPickerModal()
+}
+
+// selectedStash returns the highlighted stash, or nil when the list is empty.
+func (p *Plugin) selectedStash() *Stash {
+	if p.stashCursor < 0 || p.stashCursor >= len(p.stashes) {
+		return nil
+	}
+	return p.stashes[p.stashCursor]
+}
+
+// updateStashPicker handles key events in the stash picker modal.
+func (p *Plugin) updateStashPicker(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	if p.stashDropModal != nil {
+		return p.updateStashDrop(msg)
+	}
+
+	p.ensureStashPickerModal()
+	if p.stashPickerModal == nil {
+		return p, nil
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		p.closeStashPicker()
+		return p, nil
+
+	case "j", "down":
+		p.moveStashCursor(1)
+		return p, nil
+
+	case "k", "up":
+		p.moveStashCursor(-1)
+		return p, nil
+
+	case "g", "home":
+		p.stashCursor = 0
+		return p, nil
+
+	case "G", "end":
+		if len(p.stashes) > 0 {
+			p.stashCursor = len(p.stashes) - 1
+		}
+		return p, nil
+
+	case "enter", "a":
+		// Apply selected stash, keeping the entry
+		return p, p.applyStashByIndex(p.stashCursor)
+
+	case "p":
+		// Pop selected stash
+		if stash := p.selectedStash(); stash != nil {
+			return p, p.doStashPopRef(stash.Ref)
+		}
+		return p, nil
+
+	case "d":
+		// Drop selected stash after confirmation
+		if stash := p.selectedStash(); stash != nil {
+			p.openStashDrop(stash)
+		}
+		return p, nil
+	}
+
+	action, cmd := p.stashPickerModal.HandleKey(msg)
+	if action == "cancel" {
+		p.closeStashPicker()
+		return p, nil
+	}
+	if idx, ok := parseStashPickerItem(action); ok {
+		return p, p.applyStashByIndex(idx)
+	}
+
+	return p, cmd
+}
+
+// handleStashPickerMouse processes mouse events in the stash picker modal.
+func (p *Plugin) handleStashPickerMouse(msg tea.MouseMsg) (*Plugin, tea.Cmd) {
+	if p.stashDropModal != nil {
+		action := p.stashDropModal.HandleMouse(msg, p.mouseHandler)
+		return p, p.handleStashDropAction(action)
+	}
+
+	p.ensureStashPickerModal()
+	if p.stashPickerModal == nil {
+		return p, nil
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		p.moveStashCursor(-1)
+		return p, nil
+	case tea.MouseButtonWheelDown:
+		p.moveStashCursor(1)
+		return p, nil
+	}
+
+	action := p.stashPickerModal.HandleMouse(msg, p.mouseHandler)
+	if action == "cancel" {
+		p.closeStashPicker()
+		return p, nil
+	}
+	if idx, ok := parseStashPickerItem(action); ok {
+		return p, p.applyStashByIndex(idx)
+	}
+	return p, nil
+}
+
+func (p *Plugin) applyStashByIndex(idx int) tea.Cmd {
+	if idx < 0 || idx >= len(p.stashes) {
+		return nil
+	}
+	p.stashCursor = idx
+	return p.doStashApplyRef(p.stashes[idx].Ref)
+}
+
+func (p *Plugin) moveStashCursor(delta int) {
+	n := len(p.stashes)
+	if n == 0 {
+		return
+	}
+	newCursor := p.stashCursor + delta
+	if newCursor < 0 {
+		newCursor = 0
+	}
+	if newCursor >= n {
+		newCursor = n - 1
+	}
+	p.stashCursor = newCursor
+}
+
+// openStashDrop shows the drop confirmation for stash.
+func (p *Plugin) openStashDrop(stash *Stash) {
+	message := fmt.Sprintf("Drop %s?", styles.Subtitle.Render(stash.Ref))
+	if stash.Message != "" {
+		message += "\n" + styles.Muted.Render(stash.Message)
+	}
+	message += "\n" + styles.StatusDeleted.Render("The stashed changes will be lost.")
+
+	dialog := ui.NewConfirmDialog("Drop Stash?", message)
+	dialog.ConfirmLabel = " Drop "
+	dialog.BorderColor = styles.Error
+
+	p.stashDropRef = stash.Ref
+	p.stashDropModal = dialog.ToModal()
+}
+
+// closeStashDrop dismisses the drop confirmation.
+func (p *Plugin) closeStashDrop() {
+	p.stashDropRef = ""
+	p.stashDropModal = nil
+}
+
+// updateStashDrop handles key events in the drop confirmation.
+func (p *Plugin) updateStashDrop(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	action, cmd := p.stashDropModal.HandleKey(msg)
+	return p, tea.Batch(cmd, p.handleStashDropAction(action))
+}
+
+func (p *Plugin) handleStashDropAction(action string) tea.Cmd {
+	switch action {
+	case "cancel":
+		p.closeStashDrop()
+	case "confirm":
+		ref := p.stashDropRef
+		p.closeStashDrop()
+		return p.doStashDrop(ref)
+	}
+	return nil
+}
+
+func (p *Plugin) closeStashPicker() {
+	p.viewMode = p.stashReturnMode
+	p.stashes = nil
+	p.stashesLoaded = false
+	p.closeStashDrop()
+	p.clearStashPickerModal()
+}
+
+func (p *Plugin) clearStashPickerModal() {
+	p.stashPickerModal = nil
+	p.stashPickerWidth = 0
+}
+
+// ensureStashPickerModal builds/rebuilds the stash picker modal.
+func (p *Plugin) ensureStashPickerModal() {
+	modalW := p.stashPickerWidthForContent()
+	if p.stashPickerModal != nil && p.stashPickerWidth == modalW {
+		return
+	}
+	p.stashPickerWidth = modalW
+
+	p.stashPickerModal = modal.New("Stashes",
+		modal.WithWidth(modalW),
+		modal.WithHints(false),
+	).
+		AddSection(p.stashPickerListSection()).
+		AddSection(modal.Spacer()).
+		AddSection(p.stashPickerHintsSection())
+}
+
+func (p *Plugin) stashPickerWidthForContent() int {
+	modalW := 60
+	if modalW > p.width-10 {
+		modalW = p.width - 10
+	}
+	if modalW < 20 {
+		modalW = 20
+	}
+	return modalW
+}
+
+func (p *Plugin) stashPickerListSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if !p.stashesLoaded {
+			return modal.RenderedSection{Content: styles.Muted.Render("  Loading stashes...")}
+		}
+		if len(p.stashes) == 0 {
+			return modal.RenderedSection{Content: styles.Muted.Render("  No stashes yet. Press z in the status view to stash changes.")}
+		}
+
+		maxVisible := p.branchPickerMaxVisible()
+		start := 0
+		if p.stashCursor >= maxVisible {
+			start = p.stashCursor - maxVisible + 1
+		}
+		end := start + maxVisible
+		if end > len(p.stashes) {
+			end = len(p.stashes)
+		}
+
+		var sb strings.Builder
+		focusables := make([]modal.FocusableInfo, 0, end-start)
+
+		for i := start; i < end; i++ {
+			itemID := stashPickerItemID(i)
+			selected := i == p.stashCursor
+			hovered := itemID == hoverID
+
+			line := p.renderStashLine(p.stashes[i], contentWidth, selected || hovered)
+			if i > start {
+				sb.WriteString("\n")
+			}
+			sb.WriteString(line)
+
+			focusables = append(focusables, modal.FocusableInfo{
+				ID:      itemID,
+				OffsetX: 0,
+				OffsetY: i - start,
+				Width:   ansi.StringWidth(line),
+				Height:  1,
+			})
+		}
+
+		content := sb.String()
+		if len(p.stashes) > maxVisible {
+			content += "\n\n" + styles.Muted.Render(fmt.Sprintf("  %d/%d stashes", p.stashCursor+1, len(p.stashes)))
+		}
+
+		return modal.RenderedSection{
+			Content:    content,
+			Focusables: focusables,
+		}
+	}, p.stashPickerListUpdate)
+}
+
+func (p *Plugin) stashPickerListUpdate(msg tea.Msg, focusID string) (string, tea.Cmd) {
+	if _, ok := parseStashPickerItem(focusID); !ok {
+		return "", nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return "", nil
+	}
+
+	if keyMsg.String() == "enter" && p.selectedStash() != nil {
+		return stashPickerItemID(p.stashCursor), nil
+	}
+	return "", nil
+}
+
+func (p *Plugin) stashPickerHintsSection() modal.Section {
+	return modal.Custom(func(contentWidth int, focusID, hoverID string) modal.RenderedSection {
+		if len(p.stashes) == 0 {
+			return modal.RenderedSection{Content: styles.Muted.Render("  Esc to close")}
+		}
+		return modal.RenderedSection{Content: styles.Muted.Render("  Enter/a apply, p pop, d drop, j/k to navigate, Esc to close")}
+	}, nil)
+}
+
+// renderStashPicker renders the stash picker modal.
+func (p *Plugin) renderStashPicker() string {
+	background := p.renderThreePaneView()
+
+	if p.stashDropModal != nil {
+		modalContent := p.stashDropModal.Render(p.width, p.height, p.mouseHandler)
+		return ui.OverlayModal(background, modalContent, p.width, p.height)
+	}
+
+	p.ensureStashPickerModal()
+	if p.stashPickerModal == nil {
+		return background
+	}
+
+	modalContent := p.stashPickerModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
+
+// renderStashLine renders a single stash entry: ref, message, branch and age.
+func (p *Plugin) renderStashLine(stash *Stash, width int, highlighted bool) string {
+	ref := fmt.Sprintf("  %-10s", stash.Ref)
+
+	meta := ""
+	if stash.Branch != "" {
+		meta = stash.Branch
+	}
+	if !stash.Date.IsZero() {
+		if meta != "" {
+			meta += ", "
+		}
+		meta += RelativeTime(stash.Date)
+	}
+	if meta != "" {
+		meta = " (" + meta + ")"
+	}
+
+	// Message takes whatever space is left after ref and metadata
+	msgWidth := width - ansi.StringWidth(ref) - ansi.StringWidth(meta) - 1
+	if msgWidth < 10 {
+		msgWidth = 10
+	}
+	message := " " + ansi.Truncate(stash.Message, msgWidth, "…")
+
+	if highlighted {
+		line := ref + message + meta
+		if pad := width - ansi.StringWidth(line); pad > 0 {
+			line += strings.Repeat(" ", pad)
+		}
+		return styles.ListItemSelected.Render(line)
+	}
+
+	return styles.ListItemNormal.Render(styles.Subtitle.Render(ref) + styles.Body.Render(message) + styles.Muted.Render(meta))
+}
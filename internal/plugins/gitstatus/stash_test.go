@@ -0,0 +1,57 @@
+package gitstatus
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseStashList(t *testing.T) {
+	output := []byte(`stash@{0}|1700000000|WIP on main: abc1234 Fix login redirect
+stash@{1}|1699990000|On feature/auth: experiment with tokens
+stash@{2}||custom message without branch
+not a stash line
+`)
+
+	list := parseStashList(output)
+
+	want := []struct {
+		index   int
+		ref     string
+		branch  string
+		message string
+		date    time.Time
+	}{
+		{0, "stash@{0}", "main", "abc1234 Fix login redirect", time.Unix(1700000000, 0)},
+		{1, "stash@{1}", "feature/auth", "experiment with tokens", time.Unix(1699990000, 0)},
+		{2, "stash@{2}", "", "custom message without branch", time.Time{}},
+	}
+	if list.Count() != len(want) {
+		t.Fatalf("got %d stashes, want %d", list.Count(), len(want))
+	}
+	for i, w := range want {
+		s := list.Stashes[i]
+		if s.Index != w.index || s.Ref != w.ref || s.Branch != w.branch || s.Message != w.message {
+			t.Errorf("stash %d = {%d %q %q %q}, want {%d %q %q %q}",
+				i, s.Index, s.Ref, s.Branch, s.Message, w.index, w.ref, w.branch, w.message)
+		}
+		if !s.Date.Equal(w.date) {
+			t.Errorf("stash %d date = %v, want %v", i, s.Date, w.date)
+		}
+	}
+}
+
+func TestParseStashListEmpty(t *testing.T) {
+	if got := parseStashList(nil).Count(); got != 0 {
+		t.Errorf("Count() = %d, want 0", got)
+	}
+}
+
+func TestParseStashPickerItem(t *testing.T) {
+	idx, ok := parseStashPickerItem(stashPickerItemID(3))
+	if !ok || idx != 3 {
+		t.Errorf("parseStashPickerItem = %d, %v; want 3, true", idx, ok)
+	}
+	if _, ok := parseStashPickerItem("branch-picker-item-1"); ok {
+		t.Error("expected branch picker ID to be rejected")
+	}
+}
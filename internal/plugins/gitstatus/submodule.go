@@ -0,0 +1,191 @@
+package gitstatus
+
+import (
+	"os/exec"
+	"strings"
+
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// submoduleIcon marks submodule rows in the sidebar.
+const submoduleIcon = "◈"
+
+// SubmoduleState is the prefix character of a git submodule status line.
+type SubmoduleState byte
+
+const (
+	SubmoduleInSync        SubmoduleState = ' ' // Checked out at the recorded commit
+	SubmoduleOutOfSync     SubmoduleState = '+' // Checked out at a different commit
+	SubmoduleUninitialized SubmoduleState = '-' // Not initialized
+	SubmoduleConflict      SubmoduleState = 'U' // Merge conflicts
+)
+
+// SubmoduleStatus is one line of git submodule status output.
+type SubmoduleStatus struct {
+	State    SubmoduleState
+	Hash     string // Commit checked out in the submodule
+	Path     string
+	Describe string // git describe output, e.g. "heads/main"; empty if absent
+}
+
+// SubmoduleChange describes a submodule entry in the file tree. Submodules
+// are recorded as a commit pointer (mode 160000), so the change is a pair of
+// commits rather than a line diff.
+type SubmoduleChange struct {
+	OldHash          string // Commit before the change
+	NewHash          string // Commit after the change; empty if unknown
+	CommitChanged    bool   // Checked-out commit differs from the index
+	ModifiedContent  bool   // Tracked files inside the submodule are modified
+	UntrackedContent bool   // Submodule has untracked files
+
+	indexHash string // Commit recorded in the index
+}
+
+// GetSubmoduleStatus runs git submodule status for the repository.
+func GetSubmoduleStatus(workDir string) ([]SubmoduleStatus, error) {
+	cmd := exec.Command("git", "submodule", "status")
+	cmd.Dir = workDir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	return parseSubmoduleStatus(string(output)), nil
+}
+
+// parseSubmoduleStatus parses git submodule status output. Each line is a
+// state character, the commit hash, the path and an optional "(describe)".
+func parseSubmoduleStatus(output string) []SubmoduleStatus {
+	var result []SubmoduleStatus
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if len(line) < 2 {
+			continue
+		}
+		rest := line[1:]
+		hash, path, ok := strings.Cut(rest, " ")
+		if !ok || hash == "" || path == "" {
+			continue
+		}
+		status := SubmoduleStatus{
+			State: SubmoduleState(line[0]),
+			Hash:  hash,
+			Path:  path,
+		}
+		if strings.HasSuffix(path, ")") {
+			if idx := strings.LastIndex(path, " ("); idx > 0 {
+				status.Path = path[:idx]
+				status.Describe = path[idx+2 : len(path)-1]
+			}
+		}
+		result = append(result, status)
+	}
+	return result
+}
+
+// parseSubmoduleField builds a SubmoduleChange from the porcelain v2
+// "<sub>" field ("S<c><m><u>"). Returns nil for non-submodule entries.
+func parseSubmoduleField(sub, headHash, indexHash string) *SubmoduleChange {
+	if len(sub) < 4 || sub[0] != 'S' {
+		return nil
+	}
+	return &SubmoduleChange{
+		OldHash:          headHash,
+		NewHash:          indexHash,
+		CommitChanged:    sub[1] == 'C',
+		ModifiedContent:  sub[2] == 'M',
+		UntrackedContent: sub[3] == 'U',
+		indexHash:        indexHash,
+	}
+}
+
+// worktreeChange returns the change between the index and the submodule's
+// checkout. NewHash is filled in by loadSubmoduleHashes.
+func (s *SubmoduleChange) worktreeChange() *SubmoduleChange {
+	c := *s
+	c.OldHash = s.indexHash
+	c.NewHash = ""
+	return &c
+}
+
+// Dirty reports whether the submodule has uncommitted changes of its own.
+func (s *SubmoduleChange) Dirty() bool {
+	return s.ModifiedContent || s.UntrackedContent
+}
+
+// Summary returns the short old→new commit pair, e.g. "a1b2c3d→e4f5a6b".
+// A single hash is shown when the commit is unchanged.
+func (s *SubmoduleChange) Summary() string {
+	oldHash := shortSubmoduleHash(s.OldHash)
+	newHash := shortSubmoduleHash(s.NewHash)
+	if oldHash == newHash {
+		return oldHash
+	}
+	return oldHash + "→" + newHash
+}
+
+// shortSubmoduleHash abbreviates a commit hash. The all-zero hash git uses
+// for added or deleted submodules is shown as "none".
+func shortSubmoduleHash(hash string) string {
+	switch {
+	case hash == "":
+		return "?"
+	case strings.Trim(hash, "0") == "":
+		return "none"
+	case len(hash) > 7:
+		return hash[:7]
+	}
+	return hash
+}
+
+// loadSubmoduleHashes fills in the checked-out commit for unstaged submodule
+// entries, which porcelain status does not report.
+func (t *FileTree) loadSubmoduleHashes() {
+	var pending []*FileEntry
+	for _, e := range t.Modified {
+		if e.Submodule != nil && e.Submodule.NewHash == "" {
+			pending = append(pending, e)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	statuses, err := GetSubmoduleStatus(t.workDir)
+	if err != nil {
+		return
+	}
+	hashes := make(map[string]string, len(statuses))
+	for _, s := range statuses {
+		hashes[s.Path] = s.Hash
+	}
+	for _, e := range pending {
+		e.Submodule.NewHash = hashes[e.Path]
+	}
+}
+
+// renderSubmoduleDetail renders the diff pane body for a submodule entry.
+func renderSubmoduleDetail(s *SubmoduleChange) string {
+	var sb strings.Builder
+	sb.WriteString(styles.Title.Render(submoduleIcon + " Submodule"))
+	sb.WriteString("\n\n")
+	sb.WriteString(styles.Muted.Render("Commit  "))
+	sb.WriteString(styles.Subtitle.Render(s.Summary()))
+	sb.WriteString("\n")
+
+	var content []string
+	if s.ModifiedContent {
+		content = append(content, "modified content")
+	}
+	if s.UntrackedContent {
+		content = append(content, "untracked content")
+	}
+	if len(content) > 0 {
+		sb.WriteString(styles.Muted.Render("Inside  "))
+		sb.WriteString(styles.StatusModified.Render(strings.Join(content, ", ")))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\n")
+	sb.WriteString(styles.Muted.Render("Submodules are stored as a commit pointer, so there is no line diff."))
+	return sb.String()
+}
@@ -0,0 +1,91 @@
+package gitstatus
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSubmoduleStatus(t *testing.T) {
+	output := " 1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b libs/core (v1.2.0)\n" +
+		"+2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c vendor/ui (heads/main)\n" +
+		"-3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d third party/tool\n" +
+		"U0000000000000000000000000000000000000000 conflicted\n" +
+		"\n"
+
+	want := []SubmoduleStatus{
+		{State: SubmoduleInSync, Hash: "1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b", Path: "libs/core", Describe: "v1.2.0"},
+		{State: SubmoduleOutOfSync, Hash: "2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c", Path: "vendor/ui", Describe: "heads/main"},
+		{State: SubmoduleUninitialized, Hash: "3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d", Path: "third party/tool"},
+		{State: SubmoduleConflict, Hash: "0000000000000000000000000000000000000000", Path: "conflicted"},
+	}
+
+	got := parseSubmoduleStatus(output)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseSubmoduleStatus() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseSubmoduleStatus_Invalid(t *testing.T) {
+	for _, input := range []string{"", "+", " abc", "+abc\n"} {
+		if got := parseSubmoduleStatus(input); len(got) != 0 {
+			t.Errorf("parseSubmoduleStatus(%q) = %+v, want none", input, got)
+		}
+	}
+}
+
+func TestParseStatus_Submodule(t *testing.T) {
+	tree := &FileTree{}
+	// Pointer bump staged, checkout moved again and has untracked files
+	output := []byte("1 MM SC.U 160000 160000 160000 aaaaaaaaaa bbbbbbbbbb libs/core\x00" +
+		"1 .M N... 100644 100644 100644 abc abc main.go\x00")
+
+	if err := tree.parseStatus(output); err != nil {
+		t.Fatalf("parseStatus error: %v", err)
+	}
+	if len(tree.Staged) != 1 || len(tree.Modified) != 2 {
+		t.Fatalf("got %d staged, %d modified; want 1, 2", len(tree.Staged), len(tree.Modified))
+	}
+
+	staged := tree.Staged[0].Submodule
+	if staged == nil {
+		t.Fatal("staged entry not detected as submodule")
+	}
+	if staged.OldHash != "aaaaaaaaaa" || staged.NewHash != "bbbbbbbbbb" {
+		t.Errorf("staged hashes = %s→%s, want aaaaaaaaaa→bbbbbbbbbb", staged.OldHash, staged.NewHash)
+	}
+	if !staged.CommitChanged || staged.ModifiedContent || !staged.UntrackedContent {
+		t.Errorf("staged flags = %+v", staged)
+	}
+
+	modified := tree.Modified[0].Submodule
+	if modified == nil || modified == staged {
+		t.Fatal("modified entry should have its own submodule change")
+	}
+	if modified.OldHash != "bbbbbbbbbb" || modified.NewHash != "" {
+		t.Errorf("modified hashes = %s→%s, want bbbbbbbbbb→(unresolved)", modified.OldHash, modified.NewHash)
+	}
+
+	if tree.Modified[1].Submodule != nil {
+		t.Error("regular file detected as submodule")
+	}
+}
+
+func TestSubmoduleChangeSummary(t *testing.T) {
+	tests := []struct {
+		name   string
+		change SubmoduleChange
+		want   string
+	}{
+		{"bump", SubmoduleChange{OldHash: "1a2b3c4d5e6f", NewHash: "9f8e7d6c5b4a"}, "1a2b3c4→9f8e7d6"},
+		{"unchanged", SubmoduleChange{OldHash: "1a2b3c4d5e6f", NewHash: "1a2b3c4d5e6f"}, "1a2b3c4"},
+		{"added", SubmoduleChange{OldHash: "0000000000000000", NewHash: "9f8e7d6c5b4a"}, "none→9f8e7d6"},
+		{"unknown", SubmoduleChange{OldHash: "1a2b3c4d5e6f"}, "1a2b3c4→?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.change.Summary(); got != tt.want {
+				t.Errorf("Summary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -28,6 +28,21 @@ func NewSyntaxHighlighter(filename string) *SyntaxHighlighter {
 			lexer = lexers.Get(ext)
 		}
 	}
+	return newSyntaxHighlighter(lexer)
+}
+
+// NewSyntaxHighlighterForLanguage creates a highlighter for a language name or
+// alias, such as the "go" or "py" of a markdown code fence.
+// Returns nil if no lexer is available for the language.
+func NewSyntaxHighlighterForLanguage(lang string) *SyntaxHighlighter {
+	if lang == "" {
+		return nil
+	}
+	return newSyntaxHighlighter(lexers.Get(lang))
+}
+
+// newSyntaxHighlighter wraps a lexer with the theme's syntax style.
+func newSyntaxHighlighter(lexer chroma.Lexer) *SyntaxHighlighter {
 	if lexer == nil {
 		return nil
 	}
@@ -47,6 +47,19 @@ func TestNewSyntaxHighlighter_NoExtension(t *testing.T) {
 	}
 }
 
+func TestNewSyntaxHighlighterForLanguage(t *testing.T) {
+	for _, lang := range []string{"go", "python", "py", "bash"} {
+		if NewSyntaxHighlighterForLanguage(lang) == nil {
+			t.Errorf("expected highlighter for %q", lang)
+		}
+	}
+	for _, lang := range []string{"", "notalanguage123"} {
+		if NewSyntaxHighlighterForLanguage(lang) != nil {
+			t.Errorf("expected nil highlighter for %q", lang)
+		}
+	}
+}
+
 func TestSyntaxHighlighter_Highlight_GoCode(t *testing.T) {
 	h := NewSyntaxHighlighter("test.go")
 	if h == nil {
@@ -107,7 +120,7 @@ func TestRenderLineDiff_WithHighlighter(t *testing.T) {
 	}
 
 	h := NewSyntaxHighlighter("test.go")
-	result := RenderLineDiff(diff, 80, 0, 20, 0, h, false)
+	result := RenderLineDiff(diff, 80, 0, 20, 0, h, false, true)
 	if result == "" {
 		t.Error("expected non-empty result with highlighter")
 	}
@@ -35,9 +35,15 @@ type FileEntry struct {
 	Unstaged   bool
 	OldPath    string // For renames
 	DiffStats  DiffStats
+	Submodule  *SubmoduleChange // Non-nil for submodule entries (mode 160000)
 	IsExpanded bool
-	IsFolder   bool         // True if this represents an untracked folder
+	IsFolder   bool         // True if this represents an untracked folder or directory group
 	Children   []*FileEntry // Files within this folder (when IsFolder is true)
+
+	// Directory grouping display fields (see groupByDirectory)
+	Label    string // Name relative to the parent folder row
+	Depth    int    // Nesting level under folder rows
+	groupKey string // Collapse-state key for directory group rows
 }
 
 // DiffStats holds addition/deletion counts.
@@ -52,6 +58,9 @@ type FileTree struct {
 	Modified  []*FileEntry
 	Untracked []*FileEntry
 	workDir   string
+	filter    string          // Sidebar path filter applied by AllEntries
+	grouped   bool            // Render files grouped by directory
+	collapsed map[string]bool // Collapsed directory groups, kept across refreshes
 }
 
 // NewFileTree creates an empty file tree for the given work directory.
@@ -79,6 +88,9 @@ func (t *FileTree) Refresh() error {
 	// Get diff stats for all files
 	_ = temp.loadDiffStats() // Non-fatal: continue without stats
 
+	// Resolve checked-out commits for changed submodules
+	temp.loadSubmoduleHashes()
+
 	// Group untracked files by folder
 	temp.groupUntrackedFolders()
 
@@ -90,6 +102,55 @@ func (t *FileTree) Refresh() error {
 	return nil
 }
 
+// PathStatus is the git status of a single changed path.
+type PathStatus struct {
+	Status   FileStatus
+	Staged   bool
+	Unstaged bool
+}
+
+// LoadPathStatuses returns the status of every changed path under workDir,
+// keyed by slash-separated path relative to workDir. Files in untracked
+// folders are listed individually.
+func LoadPathStatuses(workDir string) (map[string]PathStatus, error) {
+	// Status paths are relative to the repo root, which may be above workDir
+	prefixCmd := exec.Command("git", "rev-parse", "--show-prefix")
+	prefixCmd.Dir = workDir
+	prefixOut, err := prefixCmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.TrimSpace(string(prefixOut))
+
+	cmd := exec.Command("git", "status", "--porcelain=v2", "-z", "--untracked-files=all", "--", ".")
+	cmd.Dir = workDir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	temp := &FileTree{workDir: workDir}
+	if err := temp.parseStatus(output); err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]PathStatus)
+	for _, list := range [][]*FileEntry{temp.Staged, temp.Modified, temp.Untracked} {
+		for _, entry := range list {
+			path, ok := strings.CutPrefix(entry.Path, prefix)
+			if !ok || path == "" {
+				continue
+			}
+			// A file in both lists keeps its staged entry, which has both flags
+			if _, seen := statuses[path]; seen {
+				continue
+			}
+			statuses[path] = PathStatus{Status: entry.Status, Staged: entry.Staged, Unstaged: entry.Unstaged}
+		}
+	}
+	return statuses, nil
+}
+
 // parseStatus parses the git status --porcelain=v2 -z output.
 func (t *FileTree) parseStatus(output []byte) error {
 	// Split on null bytes
@@ -162,7 +223,8 @@ func (t *FileTree) parseOrdinaryEntry(line string) *FileEntry {
 	path := fields[8]
 
 	entry := &FileEntry{
-		Path: path,
+		Path:      path,
+		Submodule: parseSubmoduleField(fields[2], fields[6], fields[7]),
 	}
 
 	// X = index status, Y = worktree status
@@ -185,6 +247,11 @@ func (t *FileTree) parseOrdinaryEntry(line string) *FileEntry {
 		}
 	}
 
+	// An unstaged-only submodule change runs from the index to the checkout
+	if entry.Submodule != nil && !entry.Staged {
+		entry.Submodule = entry.Submodule.worktreeChange()
+	}
+
 	return entry
 }
 
@@ -243,6 +310,9 @@ func (t *FileTree) addEntry(entry *FileEntry) {
 			Status:   entry.Status,
 			Unstaged: true,
 		}
+		if entry.Submodule != nil {
+			modEntry.Submodule = entry.Submodule.worktreeChange()
+		}
 		t.Modified = append(t.Modified, modEntry)
 	}
 }
@@ -395,18 +465,18 @@ func (t *FileTree) Summary() string {
 	return strings.Join(parts, ", ")
 }
 
-// AllEntries returns all entries in display order.
+// AllEntries returns all entries that pass the filter, in display order.
 // Folder entries are included, and if expanded, their children follow.
 func (t *FileTree) AllEntries() []*FileEntry {
 	var all []*FileEntry
-	all = append(all, t.Staged...)
-	all = append(all, t.Modified...)
+	all = append(all, t.StagedRows()...)
+	all = append(all, t.ModifiedRows()...)
 
-	// For untracked, handle folder expansion
-	for _, entry := range t.Untracked {
+	// For untracked, handle folder expansion (directory groups are already flattened)
+	for _, entry := range t.UntrackedRows() {
 		all = append(all, entry)
-		if entry.IsFolder && entry.IsExpanded {
-			all = append(all, entry.Children...)
+		if entry.IsFolder && entry.IsExpanded && !t.grouped {
+			all = append(all, filterFileEntries(entry.Children, t.filter)...)
 		}
 	}
 	return all
@@ -496,8 +566,9 @@ func ExecuteCommit(workDir, message string) (string, error) {
 	return parseCommitHash(string(output)), nil
 }
 
-// ExecuteAmend executes a git commit --amend with the given message.
-func ExecuteAmend(workDir, message string) (string, error) {
+// ExecuteCommitAmend replaces the last commit with the staged changes and the
+// given message (git commit --amend). Returns the new commit hash on success.
+func ExecuteCommitAmend(workDir, message string) (string, error) {
 	cmd := exec.Command("git", "commit", "--amend", "-m", message)
 	cmd.Dir = workDir
 	output, err := cmd.CombinedOutput()
@@ -507,6 +578,18 @@ func ExecuteAmend(workDir, message string) (string, error) {
 	return parseCommitHash(string(output)), nil
 }
 
+// ExecuteSoftResetHead removes the last commit while keeping its changes
+// staged (git reset --soft HEAD~1).
+func ExecuteSoftResetHead(workDir string) error {
+	cmd := exec.Command("git", "reset", "--soft", "HEAD~1")
+	cmd.Dir = workDir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return &CommitError{Output: string(output), Err: err}
+	}
+	return nil
+}
+
 // getLastCommitMessage returns the message of the most recent commit.
 func getLastCommitMessage(workDir string) string {
 	cmd := exec.Command("git", "log", "-1", "--format=%B")
@@ -2,6 +2,8 @@ package gitstatus
 
 import (
 	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 )
 
@@ -460,3 +462,61 @@ func TestTotalCount(t *testing.T) {
 		t.Errorf("expected 6, got %d", tree.TotalCount())
 	}
 }
+
+func TestLoadPathStatuses(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	git := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+	write := func(rel, content string) {
+		full := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git("init", "-q")
+	git("config", "user.email", "test@example.com")
+	git("config", "user.name", "Test")
+	write("top.txt", "v1\n")
+	write("sub/a.txt", "v1\n")
+	write("sub/b.txt", "v1\n")
+	git("add", ".")
+	git("commit", "-q", "-m", "init")
+
+	write("top.txt", "v2\n")
+	write("sub/a.txt", "v2\n")
+	write("sub/b.txt", "v2\n")
+	git("add", "sub/b.txt")
+	write("sub/b.txt", "v3\n")
+	write("sub/new/c.txt", "new\n")
+
+	// Paths are relative to the work dir, even below the repo root
+	got, err := LoadPathStatuses(filepath.Join(dir, "sub"))
+	if err != nil {
+		t.Fatalf("LoadPathStatuses() error = %v", err)
+	}
+	want := map[string]PathStatus{
+		"a.txt":     {Status: StatusModified, Unstaged: true},
+		"b.txt":     {Status: StatusModified, Staged: true, Unstaged: true},
+		"new/c.txt": {Status: StatusUntracked, Unstaged: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d statuses %v, want %d", len(got), got, len(want))
+	}
+	for path, w := range want {
+		if got[path] != w {
+			t.Errorf("status[%q] = %+v, want %+v", path, got[path], w)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package gitstatus
+
+import (
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// UndoCommitDoneMsg is sent when the last commit has been soft-reset.
+type UndoCommitDoneMsg struct {
+	Subject string
+	Err     error
+}
+
+// checkUndoCommit returns why HEAD cannot be uncommitted, or nil if a soft
+// reset is safe: not the root commit, not a merge, and not yet pushed.
+func checkUndoCommit(head *Commit) error {
+	switch {
+	case head == nil:
+		return errors.New("no commits to undo")
+	case head.IsMerge:
+		return errors.New("cannot undo a merge commit")
+	case len(head.ParentHashes) == 0:
+		return errors.New("cannot undo the initial commit")
+	case head.Pushed:
+		return errors.New("last commit is already pushed")
+	}
+	return nil
+}
+
+// confirmUndoCommit shows the undo confirmation for HEAD, or a toast
+// explaining why it cannot be undone.
+func (p *Plugin) confirmUndoCommit() tea.Cmd {
+	var head *Commit
+	if len(p.recentCommits) > 0 {
+		head = p.recentCommits[0]
+	}
+	if err := checkUndoCommit(head); err != nil {
+		return msg.ShowToast("Undo commit: "+err.Error(), 2*time.Second)
+	}
+
+	dialog := ui.NewConfirmDialog("Undo Last Commit?",
+		styles.Subtitle.Render(head.ShortHash)+" "+head.Subject+"\n"+
+			styles.Muted.Render("Its changes stay staged (git reset --soft HEAD~1)."))
+	dialog.ConfirmLabel = " Undo "
+	dialog.BorderColor = styles.Warning
+	p.undoCommitModal = dialog.ToModal()
+	p.undoCommitSubject = head.Subject
+	p.viewMode = ViewModeConfirmUndoCommit
+	return nil
+}
+
+// closeUndoCommit dismisses the undo confirmation.
+func (p *Plugin) closeUndoCommit() {
+	p.undoCommitModal = nil
+	p.viewMode = ViewModeStatus
+}
+
+// executeUndoCommit soft-resets HEAD and closes the confirmation.
+func (p *Plugin) executeUndoCommit() tea.Cmd {
+	subject := p.undoCommitSubject
+	p.closeUndoCommit()
+	return p.doSoftReset(subject)
+}
+
+// doSoftReset runs git reset --soft HEAD~1 asynchronously.
+func (p *Plugin) doSoftReset(subject string) tea.Cmd {
+	workDir := p.repoRoot
+	return func() tea.Msg {
+		return UndoCommitDoneMsg{Subject: subject, Err: ExecuteSoftResetHead(workDir)}
+	}
+}
+
+// undoCommitToast confirms a successful undo.
+func undoCommitToast(subject string) tea.Cmd {
+	return msg.ShowToast("Uncommitted: "+subject, 2*time.Second)
+}
+
+// updateConfirmUndoCommit handles key events in the undo commit confirmation.
+func (p *Plugin) updateConfirmUndoCommit(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
+	if p.undoCommitModal == nil {
+		p.closeUndoCommit()
+		return p, nil
+	}
+
+	// Quick confirm shortcut
+	switch msg.String() {
+	case "y", "Y":
+		return p, p.executeUndoCommit()
+	}
+
+	action, cmd := p.undoCommitModal.HandleKey(msg)
+	switch action {
+	case "confirm":
+		return p, p.executeUndoCommit()
+	case "cancel":
+		p.closeUndoCommit()
+	}
+	return p, cmd
+}
+
+// handleUndoCommitMouse handles mouse events for the undo commit confirmation.
+func (p *Plugin) handleUndoCommitMouse(msg tea.MouseMsg) (plugin.Plugin, tea.Cmd) {
+	if p.undoCommitModal == nil {
+		return p, nil
+	}
+
+	switch p.undoCommitModal.HandleMouse(msg, p.mouseHandler) {
+	case "confirm":
+		return p, p.executeUndoCommit()
+	case "cancel":
+		p.closeUndoCommit()
+	}
+	return p, nil
+}
+
+// renderConfirmUndoCommit renders the undo commit confirmation overlay.
+func (p *Plugin) renderConfirmUndoCommit() string {
+	background := p.renderThreePaneView()
+	if p.undoCommitModal == nil {
+		return background
+	}
+	modalContent := p.undoCommitModal.Render(p.width, p.height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, p.width, p.height)
+}
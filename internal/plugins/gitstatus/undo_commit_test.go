@@ -0,0 +1,26 @@
+package gitstatus
+
+import "testing"
+
+func TestCheckUndoCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		head    *Commit
+		wantErr bool
+	}{
+		{"no commits", nil, true},
+		{"local commit", &Commit{Hash: "a", ParentHashes: []string{"p"}}, false},
+		{"pushed commit", &Commit{Hash: "a", ParentHashes: []string{"p"}, Pushed: true}, true},
+		{"merge commit", &Commit{Hash: "a", ParentHashes: []string{"p1", "p2"}, IsMerge: true}, true},
+		{"initial commit", &Commit{Hash: "a"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkUndoCommit(tt.head)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkUndoCommit() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
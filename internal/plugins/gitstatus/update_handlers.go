@@ -136,10 +136,11 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 				stagedCount := len(p.tree.Staged)
 				totalEntries := len(entries)
 
-				// Handle folder entries - stage all children
+				// Handle folder entries - stage the files beneath that
+				// pass the filter
 				if entry.IsFolder {
 					var firstErr error
-					for _, child := range entry.Children {
+					for _, child := range p.tree.VisibleFiles(entry) {
 						if err := p.tree.StageFile(child.Path); err != nil && firstErr == nil {
 							firstErr = err
 						}
@@ -156,12 +157,16 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 						}
 					}
 				}
-				// After staging, move cursor to first unstaged file position
-				newFirstUnstaged := stagedCount + 1
-				if newFirstUnstaged < totalEntries {
-					p.cursor = newFirstUnstaged
-				} else {
-					p.cursor = totalEntries - 1
+				// After staging, move cursor to first unstaged file position.
+				// Grouped rows don't map to that index; the cursor follows the
+				// selection on refresh instead.
+				if !p.tree.Grouped() {
+					newFirstUnstaged := stagedCount + 1
+					if newFirstUnstaged < totalEntries {
+						p.cursor = newFirstUnstaged
+					} else {
+						p.cursor = totalEntries - 1
+					}
 				}
 				return p, tea.Batch(p.refresh(), p.loadRecentCommits())
 			}
@@ -171,9 +176,15 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		if len(entries) > 0 && p.cursor < len(entries) {
 			entry := entries[p.cursor]
 			if entry.Staged {
-				if err := p.tree.UnstageFile(entry.Path); err != nil {
+				var firstErr error
+				for _, file := range entry.Files() {
+					if err := p.tree.UnstageFile(file.Path); err != nil && firstErr == nil {
+						firstErr = err
+					}
+				}
+				if firstErr != nil {
 					return p, func() tea.Msg {
-						return app.ToastMsg{Message: "Unstage failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
+						return app.ToastMsg{Message: "Unstage failed: " + firstErr.Error(), Duration: 3 * time.Second, IsError: true}
 					}
 				}
 				return p, tea.Batch(p.refresh(), p.loadRecentCommits())
@@ -184,6 +195,9 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		// Open full-screen diff view for files
 		if !p.cursorOnCommit() && len(entries) > 0 && p.cursor < len(entries) {
 			entry := entries[p.cursor]
+			if entry.Submodule != nil {
+				return p, appmsg.ShowToast("Submodules have no line diff", 2*time.Second)
+			}
 			p.diffReturnMode = p.viewMode
 			p.viewMode = ViewModeDiff
 			p.diffFile = entry.Path
@@ -214,7 +228,7 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			entry := entries[p.cursor]
 			if entry.IsFolder {
 				// Toggle folder expansion
-				entry.IsExpanded = !entry.IsExpanded
+				p.tree.ToggleFolder(entry)
 				// Reload diff for this folder
 				return p, p.autoLoadDiff()
 			}
@@ -226,7 +240,10 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		return p, tea.Batch(p.refresh(), p.loadRecentCommits())
 
 	case "S":
-		// Stage all files
+		// Stage all files, or only the filtered ones while a filter is active
+		if p.tree.Filter() != "" {
+			return p, p.stageVisibleFiles()
+		}
 		if err := p.tree.StageAll(); err != nil {
 			return p, func() tea.Msg {
 				return app.ToastMsg{Message: "Stage all failed: " + err.Error(), Duration: 3 * time.Second, IsError: true}
@@ -265,19 +282,17 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		if p.tree.HasStagedFiles() {
 			p.viewMode = ViewModeCommit
 			p.initCommitTextarea()
+			p.applyCommitTemplate()
 			return p, nil
 		}
 
 	case "A":
 		// Amend last commit (no staged files required)
-		if len(p.recentCommits) > 0 {
-			p.commitAmend = true
-			p.viewMode = ViewModeCommit
-			p.initCommitTextarea()
-			msg := getLastCommitMessage(p.repoRoot)
-			p.commitMessage.SetValue(msg)
-			return p, nil
-		}
+		return p, p.startAmend(false)
+
+	case "X":
+		// Undo last commit (soft reset), after confirmation
+		return p, p.confirmUndoCommit()
 
 	case "P":
 		// Open push menu (following lazygit convention)
@@ -306,12 +321,19 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			return p, p.openCommitInGitHub()
 		}
 
+	case "C":
+		// Cherry-pick a commit from another branch onto HEAD. The recent
+		// commits are HEAD's own history, so picks come from a branch picker.
+		if p.cursorOnCommit() {
+			return p, p.openCherryPickPicker()
+		}
+
 	case "D":
 		// Discard changes (confirm modal) - only for modified/staged files, not commits
 		if !p.cursorOnCommit() && len(entries) > 0 && p.cursor < len(entries) {
 			entry := entries[p.cursor]
-			// Don't allow discard on untracked folders (would delete)
-			if entry.IsFolder && entry.Status == StatusUntracked {
+			// Don't allow discard on folders (would delete or revert every file beneath)
+			if entry.IsFolder {
 				return p, nil
 			}
 			p.discardFile = entry
@@ -320,6 +342,12 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			p.buildDiscardModal()
 		}
 
+	case "R":
+		// Restore the file from an earlier commit (picker + confirm modal)
+		if !p.cursorOnCommit() && len(entries) > 0 && p.cursor < len(entries) {
+			return p, p.openRestorePicker(entries[p.cursor])
+		}
+
 	case "z":
 		// Stash current changes (if there are any)
 		if p.tree.TotalCount() > 0 {
@@ -334,6 +362,10 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		// Apply latest stash (non-destructive, stash entry preserved)
 		return p, p.doStashApply()
 
+	case "t":
+		// Open stash list picker
+		return p, p.openStashPicker()
+
 	case "b":
 		// Open branch picker
 		p.branchReturnMode = p.viewMode
@@ -359,7 +391,7 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 				p.fetchInProgress = true
 				p.fetchError = ""
 				p.fetchSuccess = false
-				return p, p.doFetch()
+				return p, p.withRemoteSpinner(p.doFetch())
 			}
 		}
 
@@ -385,15 +417,18 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		}
 
 	case "/":
-		// Open history search modal (only when on commits)
-		if p.cursorOnCommit() {
-			if p.historySearchState == nil {
-				p.historySearchState = NewHistorySearchState()
-			}
-			p.historySearchState.Reset()
-			p.historySearchMode = true
+		// On files (or when a filter hides every file): filter the file list
+		if !p.cursorOnCommit() || (len(entries) == 0 && p.tree.Filter() != "") {
+			p.openFileFilter()
 			return p, nil
 		}
+		// On commits: open history search modal
+		if p.historySearchState == nil {
+			p.historySearchState = NewHistorySearchState()
+		}
+		p.historySearchState.Reset()
+		p.historySearchMode = true
+		return p, nil
 
 	case "n":
 		// Next search match (after search committed)
@@ -421,6 +456,14 @@ func (p *Plugin) updateStatus(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 			p.clearSearchState()
 			return p, nil
 		}
+		// Then the sidebar file filter
+		if p.tree.Filter() != "" {
+			return p, p.setFileFilter("")
+		}
+
+	case "T":
+		// Toggle directory grouping of the file list
+		return p, p.toggleFileGrouping()
 
 	case "v":
 		// Toggle commit graph display (only when on commits)
@@ -512,6 +555,14 @@ func (p *Plugin) updateStatusDiffPane(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		// Reset horizontal scroll
 		p.diffPaneHorizScroll = 0
 
+	case "s":
+		// Stage the hunk at the top of the pane
+		return p, p.stageFocusedHunk(false)
+
+	case "u":
+		// Unstage the hunk at the top of the pane
+		return p, p.stageFocusedHunk(true)
+
 	case "v":
 		// Toggle view mode (unified/side-by-side) for inline diff pane
 		if p.diffPaneViewMode == DiffViewUnified {
@@ -527,6 +578,23 @@ func (p *Plugin) updateStatusDiffPane(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		p.diffPaneHorizScroll = 0
 		p.diffPaneScroll = 0
 
+	case "n":
+		// Toggle the line number gutter
+		p.diffLineNumbers = !p.diffLineNumbers
+		_ = state.SetDiffLineNumbers(p.diffLineNumbers)
+
+	case "W":
+		// Toggle hiding whitespace-only hunks; reload keeps the scroll position
+		p.diffIgnoreSpace = !p.diffIgnoreSpace
+		return p, p.autoLoadPreview(true)
+
+	case "Y":
+		// Copy a path:line reference to the line at the top of the pane
+		entries := p.tree.AllEntries()
+		if p.cursor < len(entries) {
+			return p, copyDiffLineReference(p.diffPaneParsedDiff, entries[p.cursor].Path, p.diffPaneScroll, p.diffPaneViewMode == DiffViewSideBySide)
+		}
+
 	case "tab", "shift+tab":
 		// Switch focus to sidebar (if visible)
 		if p.sidebarVisible {
@@ -545,6 +613,9 @@ func (p *Plugin) updateStatusDiffPane(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		entries := p.tree.AllEntries()
 		if len(entries) > 0 && p.cursor < len(entries) {
 			entry := entries[p.cursor]
+			if entry.Submodule != nil {
+				return p, appmsg.ShowToast("Submodules have no line diff", 2*time.Second)
+			}
 			p.diffReturnMode = p.viewMode
 			p.viewMode = ViewModeDiff
 			p.diffFile = entry.Path
@@ -708,6 +779,20 @@ func (p *Plugin) updateDiff(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		p.diffHorizOff = 0
 		p.diffScroll = 0
 
+	case "n":
+		// Toggle the line number gutter
+		p.diffLineNumbers = !p.diffLineNumbers
+		_ = state.SetDiffLineNumbers(p.diffLineNumbers)
+
+	case "W":
+		// Toggle hiding whitespace-only hunks
+		p.diffIgnoreSpace = !p.diffIgnoreSpace
+		p.reparseFullDiff()
+
+	case "Y":
+		// Copy a path:line reference to the line at the top of the view
+		return p, copyDiffLineReference(p.parsedDiff, p.diffFile, p.diffScroll, p.diffViewMode == DiffViewSideBySide)
+
 	case "\\":
 		// Toggle sidebar visibility
 		p.toggleSidebar()
@@ -769,18 +854,17 @@ func (p *Plugin) updateCommit(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 
 	case "ctrl+a":
 		// Toggle amend mode (only if there are commits to amend and staged files)
-		if len(p.recentCommits) > 0 && p.tree.HasStagedFiles() {
-			p.commitAmend = !p.commitAmend
+		if !p.showCommitAmendToggle() {
+			return p, nil
+		}
+		if p.commitAmend {
+			p.commitAmend = false
 			// Invalidate modal cache to rebuild with new state
 			p.commitModal = nil
 			p.commitModalWidthCache = 0
-			// If enabling amend and message is empty, prefill with last commit message
-			if p.commitAmend && strings.TrimSpace(p.commitMessage.Value()) == "" {
-				msg := getLastCommitMessage(p.repoRoot)
-				p.commitMessage.SetValue(msg)
-			}
+			return p, nil
 		}
-		return p, nil
+		return p, p.startAmend(true)
 	}
 
 	wasAmend := p.commitAmend
@@ -888,6 +972,8 @@ func (p *Plugin) updatePullMenu(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		return p.executePullMenuAction(pullMenuOptionFFOnly)
 	case "a":
 		return p.executePullMenuAction(pullMenuOptionAutostash)
+	case "o":
+		return p.executePullMenuAction(pullMenuOptionFrom)
 	}
 
 	action, cmd := p.pullModal.HandleKey(msg)
@@ -897,7 +983,7 @@ func (p *Plugin) updatePullMenu(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 		p.viewMode = p.pullMenuReturnMode
 		p.clearPullModal()
 		return p, nil
-	case pullMenuOptionMerge, pullMenuOptionRebase, pullMenuOptionFFOnly, pullMenuOptionAutostash:
+	case pullMenuOptionMerge, pullMenuOptionRebase, pullMenuOptionFFOnly, pullMenuOptionAutostash, pullMenuOptionFrom:
 		return p.executePullMenuAction(action)
 	case pullMenuActionID:
 		// Primary action (Enter) - execute the currently selected option
@@ -909,6 +995,9 @@ func (p *Plugin) updatePullMenu(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 
 // executePullMenuAction executes the pull menu action by ID.
 func (p *Plugin) executePullMenuAction(actionID string) (plugin.Plugin, tea.Cmd) {
+	if actionID == pullMenuOptionFrom {
+		return p, p.openPullRefPicker()
+	}
 	p.viewMode = p.pullMenuReturnMode
 	p.pullInProgress = true
 	p.pullError = ""
@@ -917,20 +1006,20 @@ func (p *Plugin) executePullMenuAction(actionID string) (plugin.Plugin, tea.Cmd)
 
 	switch actionID {
 	case pullMenuOptionMerge:
-		return p, p.doPull()
+		return p, p.withRemoteSpinner(p.doPull())
 	case pullMenuOptionRebase:
-		return p, p.doPullRebase()
+		return p, p.withRemoteSpinner(p.doPullRebase())
 	case pullMenuOptionFFOnly:
-		return p, p.doPullFFOnly()
+		return p, p.withRemoteSpinner(p.doPullFFOnly())
 	case pullMenuOptionAutostash:
-		return p, p.doPullAutostash()
+		return p, p.withRemoteSpinner(p.doPullAutostash())
 	}
 	return p, nil
 }
 
 // executePullMenuActionByIndex executes the pull menu action by selected index.
 func (p *Plugin) executePullMenuActionByIndex(idx int) (plugin.Plugin, tea.Cmd) {
-	actions := []string{pullMenuOptionMerge, pullMenuOptionRebase, pullMenuOptionFFOnly, pullMenuOptionAutostash}
+	actions := []string{pullMenuOptionMerge, pullMenuOptionRebase, pullMenuOptionFFOnly, pullMenuOptionAutostash, pullMenuOptionFrom}
 	if idx >= 0 && idx < len(actions) {
 		return p.executePullMenuAction(actions[idx])
 	}
@@ -961,6 +1050,9 @@ func (p *Plugin) updatePullConflict(msg tea.KeyMsg) (plugin.Plugin, tea.Cmd) {
 	}
 
 	action, cmd := p.pullConflictModal.HandleKey(msg)
+	if path, ok := pullConflictFileAction(action); ok {
+		return p.openConflictResolve(path)
+	}
 	switch action {
 	case pullConflictAbortID:
 		return p.abortPullConflict()
@@ -979,6 +1071,7 @@ func (p *Plugin) abortPullConflict() (plugin.Plugin, tea.Cmd) {
 func (p *Plugin) dismissPullConflict() (plugin.Plugin, tea.Cmd) {
 	p.viewMode = ViewModeStatus
 	p.pullConflictFiles = nil
+	p.pullConflictResolved = nil
 	p.clearPullConflictModal()
 	return p, p.refresh()
 }
@@ -1004,11 +1097,11 @@ func (p *Plugin) executePushMenuAction(idx int) (plugin.Plugin, tea.Cmd) {
 
 	switch idx {
 	case 0:
-		return p, p.doPush(false)
+		return p, p.withRemoteSpinner(p.doPush(false))
 	case 1:
-		return p, p.doPushForce()
+		return p, p.withRemoteSpinner(p.doPushForce())
 	case 2:
-		return p, p.doPushSetUpstream()
+		return p, p.withRemoteSpinner(p.doPushSetUpstream())
 	}
 	return p, nil
 }
@@ -80,7 +80,7 @@ func (p *Plugin) renderDiffModal() string {
 		} else {
 			// Unified view
 			if p.parsedDiff != nil {
-				sb.WriteString(RenderLineDiff(p.parsedDiff, contentWidth, p.diffScroll, visibleLines, p.diffHorizOff, highlighter, p.diffWrapEnabled))
+				sb.WriteString(RenderLineDiff(p.parsedDiff, contentWidth, p.diffScroll, visibleLines, p.diffHorizOff, highlighter, p.diffWrapEnabled, p.diffLineNumbers))
 			} else {
 				// Fall back to raw diff rendering
 				lines := strings.Split(p.diffRaw, "\n")
@@ -224,7 +224,7 @@ func (p *Plugin) renderFullDiffContent(visibleHeight int) string {
 		}
 	} else {
 		if p.parsedDiff != nil {
-			diffContent = RenderLineDiff(p.parsedDiff, diffWidth, p.diffScroll, contentHeight, p.diffHorizOff, highlighter, p.diffWrapEnabled)
+			diffContent = RenderLineDiff(p.parsedDiff, diffWidth, p.diffScroll, contentHeight, p.diffHorizOff, highlighter, p.diffWrapEnabled, p.diffLineNumbers)
 		}
 	}
 
@@ -283,6 +283,9 @@ func (p *Plugin) renderDiffBreadcrumb(maxWidth int, scrollIndicator string) (str
 	if p.diffViewMode == DiffViewSideBySide {
 		viewModeStr = "side-by-side"
 	}
+	if p.diffIgnoreSpace {
+		viewModeStr += " -w"
+	}
 	modePart := styles.Muted.Render("[" + viewModeStr + "]")
 	modeWidth := lipgloss.Width(modePart) + lipgloss.Width(scrollIndicator)
 
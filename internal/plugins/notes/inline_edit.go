@@ -13,6 +13,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/marcus/sidecar/internal/features"
 	"github.com/marcus/sidecar/internal/msg"
+	"github.com/marcus/sidecar/internal/plugin"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/tty"
 	xterm "golang.org/x/term"
@@ -51,13 +52,7 @@ func (p *Plugin) enterInlineEditMode(noteID string) tea.Cmd {
 	}
 
 	// Get user's editor preference
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		editor = os.Getenv("VISUAL")
-	}
-	if editor == "" {
-		editor = "vim"
-	}
+	editor := plugin.DefaultEditor()
 
 	// Generate a unique session name
 	sessionName := fmt.Sprintf("sidecar-note-edit-%d", time.Now().UnixNano())
@@ -87,8 +82,8 @@ func (p *Plugin) enterInlineEditMode(noteID string) tea.Cmd {
 
 		// Create a detached tmux session with the editor
 		tmuxArgs := []string{"new-session", "-d", "-s", sessionName,
-			"-x", strconv.Itoa(editorW), "-y", strconv.Itoa(editorH), "-e", "TERM=" + term,
-			editor, notePath}
+			"-x", strconv.Itoa(editorW), "-y", strconv.Itoa(editorH), "-e", "TERM=" + term}
+		tmuxArgs = append(tmuxArgs, plugin.EditorArgs(editor, notePath, 0)...)
 
 		cmd := exec.Command("tmux", tmuxArgs...)
 		if err := cmd.Run(); err != nil {
@@ -1113,13 +1113,7 @@ func (p *Plugin) openInExternalEditor() tea.Cmd {
 	p.pendingInlineEditPath = notePath
 
 	return func() tea.Msg {
-		editor := os.Getenv("EDITOR")
-		if editor == "" {
-			editor = os.Getenv("VISUAL")
-		}
-		if editor == "" {
-			editor = "vim"
-		}
+		editor := plugin.DefaultEditor()
 		return plugin.OpenFileMsg{
 			Editor: editor,
 			Path:   notePath,
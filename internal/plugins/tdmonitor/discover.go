@@ -0,0 +1,93 @@
+package tdmonitor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/marcus/sidecar/internal/config"
+)
+
+// tdDirEnv points the plugin at a td project root, overriding discovery.
+const tdDirEnv = "SIDECAR_TD_DIR"
+
+var (
+	tdDBFile   = filepath.Join(".todos", "issues.db") // td's database, relative to the project root
+	tdRootFile = ".td-root"                           // Worktree redirect to the main project's td root
+)
+
+// noDatabaseError reports an explicitly configured location without a td
+// database.
+type noDatabaseError struct {
+	Path   string // Project root that was checked
+	Source string // Where the location came from
+}
+
+func (e *noDatabaseError) Error() string {
+	return fmt.Sprintf("No td database found at %s (from %s).", filepath.Join(e.Path, tdDBFile), e.Source)
+}
+
+// resolveBaseDir finds the td project root for workDir. A location set with
+// SIDECAR_TD_DIR or td-monitor.dbPath wins and must hold a database.
+// Otherwise the nearest ancestor with a database or a .td-root redirect is
+// used. When none is found workDir is returned, leaving td to check the git
+// root and main worktree itself.
+func resolveBaseDir(workDir string, cfg *config.Config) (string, error) {
+	if dir := os.Getenv(tdDirEnv); dir != "" {
+		return explicitBaseDir(absPath(workDir, dir), tdDirEnv)
+	}
+	if cfg != nil {
+		if dbPath := cfg.Plugins.TDMonitor.DBPath; dbPath != "" && filepath.Clean(dbPath) != tdDBFile {
+			return explicitBaseDir(baseDirFromDBPath(absPath(workDir, dbPath)), "td-monitor.dbPath")
+		}
+	}
+	if dir, ok := findBaseDir(workDir); ok {
+		return dir, nil
+	}
+	return workDir, nil
+}
+
+// findBaseDir walks up from dir to the nearest directory holding a td
+// database or a .td-root redirect.
+func findBaseDir(dir string) (string, bool) {
+	dir = filepath.Clean(dir)
+	for {
+		if fileExists(filepath.Join(dir, tdDBFile)) || fileExists(filepath.Join(dir, tdRootFile)) {
+			return dir, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// explicitBaseDir checks that a configured project root holds a database.
+func explicitBaseDir(dir, source string) (string, error) {
+	if !fileExists(filepath.Join(dir, tdDBFile)) {
+		return "", &noDatabaseError{Path: dir, Source: source}
+	}
+	return dir, nil
+}
+
+// baseDirFromDBPath returns the project root for a configured database
+// location, which may name issues.db, its .todos directory, or the root.
+func baseDirFromDBPath(path string) string {
+	if filepath.Base(path) == filepath.Base(tdDBFile) {
+		path = filepath.Dir(path)
+	}
+	if filepath.Base(path) == filepath.Dir(tdDBFile) {
+		path = filepath.Dir(path)
+	}
+	return path
+}
+
+// absPath expands ~ and resolves path relative to workDir.
+func absPath(workDir, path string) string {
+	path = config.ExpandPath(path)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workDir, path)
+	}
+	return filepath.Clean(path)
+}
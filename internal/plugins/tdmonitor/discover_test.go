@@ -0,0 +1,127 @@
+package tdmonitor
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/marcus/sidecar/internal/config"
+	"github.com/marcus/sidecar/internal/plugin"
+)
+
+// makeTDProject creates an empty td database under dir.
+func makeTDProject(t *testing.T, dir string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, ".todos"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, tdDBFile), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolveBaseDir_Discovery(t *testing.T) {
+	t.Setenv(tdDirEnv, "")
+	root := t.TempDir()
+	project := filepath.Join(root, "project")
+	pkg := filepath.Join(project, "services", "api")
+	nested := filepath.Join(project, "services", "api", "internal", "handlers")
+	makeTDProject(t, project)
+	makeTDProject(t, pkg)
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+	worktree := filepath.Join(root, "worktree", "src")
+	if err := os.MkdirAll(worktree, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "worktree", tdRootFile), []byte(project), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		workDir string
+		want    string
+	}{
+		{"project root", project, project},
+		{"subdirectory", filepath.Join(project, "services"), project},
+		{"nearest database wins", nested, pkg},
+		{"td-root redirect", worktree, filepath.Join(root, "worktree")},
+		{"nothing found", root, root},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBaseDir(tt.workDir, config.Default())
+			if err != nil {
+				t.Fatalf("resolveBaseDir() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveBaseDir(%s) = %s, want %s", tt.workDir, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveBaseDir_Overrides(t *testing.T) {
+	root := t.TempDir()
+	project := filepath.Join(root, "project")
+	other := filepath.Join(root, "other")
+	makeTDProject(t, project)
+	makeTDProject(t, other)
+	cfg := config.Default()
+
+	// Config may name the database, its .todos directory, or the root
+	for _, dbPath := range []string{
+		filepath.Join(other, ".todos", "issues.db"),
+		filepath.Join(other, ".todos"),
+		other,
+		filepath.Join("..", "other", ".todos", "issues.db"),
+	} {
+		t.Setenv(tdDirEnv, "")
+		cfg.Plugins.TDMonitor.DBPath = dbPath
+		if got, err := resolveBaseDir(project, cfg); err != nil || got != other {
+			t.Errorf("dbPath %q: got %s, %v; want %s", dbPath, got, err, other)
+		}
+	}
+
+	// The environment beats the config
+	t.Setenv(tdDirEnv, project)
+	if got, err := resolveBaseDir(other, cfg); err != nil || got != project {
+		t.Errorf("env override: got %s, %v; want %s", got, err, project)
+	}
+
+	// An explicit location without a database is an error, not a fallback
+	t.Setenv(tdDirEnv, filepath.Join(root, "missing"))
+	_, err := resolveBaseDir(project, cfg)
+	var noDB *noDatabaseError
+	if !errors.As(err, &noDB) || noDB.Source != tdDirEnv {
+		t.Fatalf("expected noDatabaseError from %s, got %v", tdDirEnv, err)
+	}
+	if !strings.Contains(err.Error(), filepath.Join(root, "missing", ".todos", "issues.db")) {
+		t.Errorf("error should name the checked path: %v", err)
+	}
+}
+
+func TestInitWithMissingConfiguredDatabase(t *testing.T) {
+	t.Setenv(tdDirEnv, filepath.Join(t.TempDir(), "nowhere"))
+
+	p := New()
+	ctx := &plugin.Context{
+		WorkDir: t.TempDir(),
+		Config:  config.Default(),
+		Logger:  slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelError})),
+	}
+	if err := p.Init(ctx); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if p.model != nil || p.setupModal != nil || p.notInstalled != nil {
+		t.Error("a missing configured database should not offer setup")
+	}
+	if view := p.View(80, 24); !strings.Contains(view, tdDirEnv) {
+		t.Errorf("view should explain the override, got %q", view)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/marcus/td/pkg/monitor"
 	"github.com/marcus/sidecar/internal/app"
+	"github.com/marcus/sidecar/internal/config"
 	"github.com/marcus/sidecar/internal/plugin"
 	"github.com/marcus/sidecar/internal/plugins/workspace"
 	"github.com/marcus/sidecar/internal/styles"
@@ -19,7 +20,7 @@ const (
 	pluginName = "td"
 	pluginIcon = "T"
 
-	pollInterval = 2 * time.Second
+	pollInterval = 2 * time.Second // Default when td-monitor.refreshInterval is unset
 )
 
 // Plugin wraps td's monitor TUI as a sidecar plugin.
@@ -40,6 +41,9 @@ type Plugin struct {
 	// tdOnPath tracks whether td binary is available on the system
 	tdOnPath bool
 
+	// dbErr explains why an explicitly configured database couldn't be used
+	dbErr error
+
 	// View dimensions (passed to model on each render)
 	width  int
 	height int
@@ -73,17 +77,26 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	p.model = nil
 	p.notInstalled = nil
 	p.setupModal = nil
+	p.dbErr = nil
 	p.started = false
 
 	// Check if td binary is available on PATH
 	_, err := exec.LookPath("td")
 	p.tdOnPath = err == nil
 
+	// Find the td project root, which may be above the working directory
+	baseDir, err := resolveBaseDir(ctx.WorkDir, ctx.Config)
+	if err != nil {
+		p.ctx.Logger.Debug("td monitor: configured database not found", "error", err)
+		p.dbErr = err
+		return nil
+	}
+
 	// Try to create embedded monitor with custom renderers for gradient borders.
 	// Version is empty for embedded use (not displayed in this context).
 	opts := monitor.EmbeddedOptions{
-		BaseDir:       ctx.WorkDir,
-		Interval:      pollInterval,
+		BaseDir:       baseDir,
+		Interval:      refreshInterval(ctx.Config),
 		Version:       "",
 		PanelRenderer: styles.CreateTDPanelRenderer(),
 		ModalRenderer: styles.CreateTDModalRenderer(),
@@ -115,6 +128,16 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	return nil
 }
 
+// refreshInterval returns how often the monitor re-reads the td database,
+// from td-monitor.refreshInterval in the config. The monitor keeps each
+// panel's selected issue across these reloads.
+func refreshInterval(cfg *config.Config) time.Duration {
+	if cfg == nil || cfg.Plugins.TDMonitor.RefreshInterval <= 0 {
+		return pollInterval
+	}
+	return cfg.Plugins.TDMonitor.RefreshInterval
+}
+
 // Start begins plugin operation.
 func (p *Plugin) Start() tea.Cmd {
 	if p.model == nil {
@@ -292,6 +315,8 @@ func (p *Plugin) View(width, height int) string {
 			content = p.setupModal.View(width, height)
 		} else if p.notInstalled != nil {
 			content = p.notInstalled.View(width, height)
+		} else if p.dbErr != nil {
+			content = p.dbErr.Error() + "\nCheck td-monitor.dbPath in your config or " + tdDirEnv + "."
 		} else {
 			content = "No td database found.\nRun 'td init' to initialize."
 		}
@@ -5,7 +5,9 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/marcus/sidecar/internal/config"
 	"github.com/marcus/sidecar/internal/plugin"
 )
 
@@ -229,3 +231,21 @@ func TestViewWithoutModel(t *testing.T) {
 		t.Error("expected non-empty view")
 	}
 }
+
+func TestRefreshInterval(t *testing.T) {
+	if got := refreshInterval(nil); got != pollInterval {
+		t.Errorf("nil config: got %v, want %v", got, pollInterval)
+	}
+
+	cfg := config.Default()
+	cfg.Plugins.TDMonitor.RefreshInterval = 5 * time.Second
+	if got := refreshInterval(cfg); got != 5*time.Second {
+		t.Errorf("configured: got %v, want 5s", got)
+	}
+
+	// Zero would make the monitor poll in a tight loop
+	cfg.Plugins.TDMonitor.RefreshInterval = 0
+	if got := refreshInterval(cfg); got != pollInterval {
+		t.Errorf("zero: got %v, want %v", got, pollInterval)
+	}
+}
@@ -750,6 +750,8 @@ type AgentPollUnchangedMsg struct {
 	CursorRow     int
 	CursorCol     int
 	CursorVisible bool
+	AltScreen     bool // Pane is on the alternate screen (vim, less)
+	PaneInMode    bool // Pane is in tmux copy mode
 	HasCursor     bool
 	PaneHeight    int // Tmux pane height for cursor offset calculation
 	PaneWidth     int // Tmux pane width for display alignment
@@ -839,9 +841,9 @@ func (p *Plugin) handlePollAgent(worktreeName string) tea.Cmd {
 		// This prevents race conditions where cursor position changes between
 		// output capture and cursor query.
 		var cursorRow, cursorCol, paneHeight, paneWidth int
-		var cursorVisible, hasCursor bool
+		var cursorVisible, altScreen, inMode, hasCursor bool
 		if interactiveCapture && cursorTarget != "" {
-			cursorRow, cursorCol, paneHeight, paneWidth, cursorVisible, hasCursor = queryCursorPositionSync(cursorTarget)
+			cursorRow, cursorCol, paneHeight, paneWidth, cursorVisible, altScreen, inMode, hasCursor = queryCursorPositionSync(cursorTarget)
 		}
 
 		output = trimCapturedOutput(output, maxBytes)
@@ -893,6 +895,8 @@ func (p *Plugin) handlePollAgent(worktreeName string) tea.Cmd {
 				CursorRow:     cursorRow,
 				CursorCol:     cursorCol,
 				CursorVisible: cursorVisible,
+				AltScreen:     altScreen,
+				PaneInMode:    inMode,
 				HasCursor:     hasCursor,
 				PaneHeight:    paneHeight,
 				PaneWidth:     paneWidth,
@@ -907,6 +911,8 @@ func (p *Plugin) handlePollAgent(worktreeName string) tea.Cmd {
 			CursorRow:     cursorRow,
 			CursorCol:     cursorCol,
 			CursorVisible: cursorVisible,
+			AltScreen:     altScreen,
+			PaneInMode:    inMode,
 			HasCursor:     hasCursor,
 			PaneHeight:    paneHeight,
 			PaneWidth:     paneWidth,
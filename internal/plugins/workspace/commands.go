@@ -13,6 +13,8 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "exit-interactive", Name: "Exit", Description: "Exit interactive mode (" + p.getInteractiveExitKey() + ")", Context: "workspace-interactive", Priority: 1},
 			{ID: "copy", Name: "Copy", Description: "Copy selection (" + p.getInteractiveCopyKey() + ")", Context: "workspace-interactive", Priority: 2},
 			{ID: "paste", Name: "Paste", Description: "Paste clipboard (" + p.getInteractivePasteKey() + ")", Context: "workspace-interactive", Priority: 3},
+			{ID: "scroll-up", Name: "Scroll Up", Description: "Scroll output up (" + p.getInteractiveScrollUpKey() + ")", Context: "workspace-interactive", Priority: 4},
+			{ID: "scroll-down", Name: "Scroll Down", Description: "Scroll output down (" + p.getInteractiveScrollDownKey() + ")", Context: "workspace-interactive", Priority: 4},
 		}
 	case ViewModeCreate:
 		return []plugin.Command{
@@ -55,6 +57,11 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "cancel", Name: "Cancel", Description: "Cancel deletion", Context: "workspace-confirm-delete", Priority: 1},
 			{ID: "delete", Name: "Delete", Description: "Confirm deletion", Context: "workspace-confirm-delete", Priority: 2},
 		}
+	case ViewModeSessionDead:
+		return []plugin.Command{
+			{ID: "cancel", Name: "Cancel", Description: "Return to list", Context: "workspace-session-dead", Priority: 1},
+			{ID: "restart", Name: "Restart", Description: "Restart agent", Context: "workspace-session-dead", Priority: 2},
+		}
 	case ViewModeConfirmDeleteShell:
 		return []plugin.Command{
 			{ID: "cancel", Name: "Cancel", Description: "Cancel deletion", Context: "workspace-confirm-delete-shell", Priority: 1},
@@ -81,6 +88,20 @@ func (p *Plugin) Commands() []plugin.Command {
 			{ID: "select", Name: "Jump", Description: "Jump to selected file", Context: "workspace-file-picker", Priority: 2},
 		}
 	default:
+		if p.taskLinkPickMode {
+			return []plugin.Command{
+				{ID: "cancel", Name: "Cancel", Description: "Cancel link selection", Context: "workspace-task-links", Priority: 1},
+			}
+		}
+		if p.outputSearchMode {
+			return []plugin.Command{
+				{ID: "cancel", Name: "Clear", Description: "Clear output search", Context: "workspace-output-search", Priority: 1},
+				{ID: "confirm", Name: "Search", Description: "Confirm search query", Context: "workspace-output-search", Priority: 2},
+				{ID: "next-match", Name: "Next", Description: "Next match", Context: "workspace-output-search", Priority: 3},
+				{ID: "prev-match", Name: "Prev", Description: "Previous match", Context: "workspace-output-search", Priority: 4},
+			}
+		}
+
 		// View toggle label changes based on current mode
 		viewToggleName := "Kanban"
 		if p.viewMode == ViewModeKanban {
@@ -101,6 +122,12 @@ func (p *Plugin) Commands() []plugin.Command {
 					plugin.Command{ID: "prev-tab", Name: "Tab←", Description: "Previous preview tab", Context: "workspace-preview", Priority: 3},
 					plugin.Command{ID: "next-tab", Name: "Tab→", Description: "Next preview tab", Context: "workspace-preview", Priority: 4},
 				)
+				if p.previewTab == PreviewTabOutput {
+					cmds = append(cmds, plugin.Command{ID: "search-output", Name: "Search", Description: "Search agent output", Context: "workspace-preview", Priority: 5})
+				}
+				if p.previewTab == PreviewTabTask && len(p.taskLinks) > 0 {
+					cmds = append(cmds, plugin.Command{ID: "open-link", Name: "Links", Description: "Open a task link in the browser", Context: "workspace-preview", Priority: 5})
+				}
 				// Add diff view toggle when on Diff tab
 				if p.previewTab == PreviewTabDiff {
 					diffViewName := "Split"
@@ -108,6 +135,12 @@ func (p *Plugin) Commands() []plugin.Command {
 						diffViewName = "Unified"
 					}
 					cmds = append(cmds, plugin.Command{ID: "toggle-diff-view", Name: diffViewName, Description: "Toggle unified/side-by-side diff", Context: "workspace-preview", Priority: 5})
+					if p.diffRaw != "" {
+						cmds = append(cmds,
+							plugin.Command{ID: "yank-diff", Name: "Copy", Description: "Copy diff to clipboard", Context: "workspace-preview", Priority: 9},
+							plugin.Command{ID: "yank-file-diff", Name: "Copy file", Description: "Copy current file's diff to clipboard", Context: "workspace-preview", Priority: 10},
+						)
+					}
 					// Add file navigation commands when viewing diff with multiple files
 					if p.multiFileDiff != nil && len(p.multiFileDiff.Files) > 1 {
 						cmds = append(cmds,
@@ -131,7 +164,8 @@ func (p *Plugin) Commands() []plugin.Command {
 						plugin.Command{ID: "attach", Name: "Attach", Description: "Attach to session", Context: "workspace-preview", Priority: 10},
 						plugin.Command{ID: "stop-agent", Name: "Stop", Description: "Stop agent", Context: "workspace-preview", Priority: 11},
 					)
-					if wt.Status == StatusWaiting {
+					// On the diff tab y copies the diff instead of approving
+					if wt.Status == StatusWaiting && p.previewTab != PreviewTabDiff {
 						cmds = append(cmds,
 							plugin.Command{ID: "approve", Name: "Approve", Description: "Approve agent prompt", Context: "workspace-preview", Priority: 12},
 							plugin.Command{ID: "reject", Name: "Reject", Description: "Reject agent prompt", Context: "workspace-preview", Priority: 13},
@@ -252,6 +286,8 @@ func (p *Plugin) FocusContext() string {
 		return "workspace-confirm-delete"
 	case ViewModeConfirmDeleteShell:
 		return "workspace-confirm-delete-shell"
+	case ViewModeSessionDead:
+		return "workspace-session-dead"
 	case ViewModeCommitForMerge:
 		return "workspace-commit-for-merge"
 	case ViewModePromptPicker:
@@ -265,6 +301,12 @@ func (p *Plugin) FocusContext() string {
 	case ViewModeFilePicker:
 		return "workspace-file-picker"
 	default:
+		if p.taskLinkPickMode {
+			return "workspace-task-links"
+		}
+		if p.outputSearchMode {
+			return "workspace-output-search"
+		}
 		if p.activePane == PanePreview {
 			return "workspace-preview"
 		}
@@ -286,6 +328,8 @@ func (p *Plugin) ConsumesTextInput() bool {
 		ViewModeFetchPR:
 		return true
 	default:
-		return false
+		// Link picking reads number keys, which the app would otherwise
+		// use for plugin switching
+		return p.taskLinkPickMode || (p.outputSearchMode && !p.outputSearchCommitted)
 	}
 }
@@ -2,6 +2,7 @@ package workspace
 
 import (
 	"os/exec"
+	"strconv"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -129,7 +130,12 @@ func (p *Plugin) loadCommitStatus(wt *Worktree) tea.Cmd {
 		if err != nil {
 			return CommitStatusLoadedMsg{Epoch: epoch, WorkspaceName: name, Err: err}
 		}
-		return CommitStatusLoadedMsg{Epoch: epoch, WorkspaceName: name, Commits: commits}
+		return CommitStatusLoadedMsg{
+			Epoch:         epoch,
+			WorkspaceName: name,
+			Commits:       commits,
+			Upstream:      getUpstreamStatus(path),
+		}
 	}
 }
 
@@ -250,6 +256,44 @@ func getRemoteTrackingBranch(workdir string) string {
 	return strings.TrimSpace(string(output))
 }
 
+// getUpstreamStatus returns how far HEAD is ahead of and behind its upstream,
+// or nil when the branch has no upstream.
+func getUpstreamStatus(workdir string) *UpstreamStatus {
+	remoteBranch := getRemoteTrackingBranch(workdir)
+	if remoteBranch == "" {
+		return nil
+	}
+	cmd := exec.Command("git", "rev-list", "--left-right", "--count", "@{upstream}...HEAD")
+	cmd.Dir = workdir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+	behind, ahead, ok := parseLeftRightCount(string(output))
+	if !ok {
+		return nil
+	}
+	return &UpstreamStatus{Branch: remoteBranch, Ahead: ahead, Behind: behind}
+}
+
+// parseLeftRightCount parses `git rev-list --left-right --count A...B` output
+// ("<left>\t<right>"), returning the counts unique to A and to B.
+func parseLeftRightCount(output string) (left, right int, ok bool) {
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return 0, 0, false
+	}
+	left, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	right, err = strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return left, right, true
+}
+
 // getUnpushedCommits returns a set of short commit hashes that are in HEAD but not
 // in the remote tracking branch. Uses a single git call instead of per-commit checks.
 func getUnpushedCommits(workdir, remoteBranch string) map[string]bool {
@@ -6,6 +6,9 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/plugins/gitstatus"
 )
 
 func TestMergeBaseHashValidation(t *testing.T) {
@@ -267,3 +270,163 @@ func TestGetWorktreeCommits_WithRemoteTracking(t *testing.T) {
 		}
 	}
 }
+
+func TestParseLeftRightCount(t *testing.T) {
+	tests := []struct {
+		name       string
+		output     string
+		wantLeft   int
+		wantRight  int
+		wantParsed bool
+	}{
+		{"ahead and behind", "1\t3\n", 1, 3, true},
+		{"in sync", "0\t0\n", 0, 0, true},
+		{"space separated", "12 0", 12, 0, true},
+		{"empty output", "", 0, 0, false},
+		{"single field", "4\n", 0, 0, false},
+		{"non-numeric", "x\t2\n", 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			left, right, ok := parseLeftRightCount(tt.output)
+			if left != tt.wantLeft || right != tt.wantRight || ok != tt.wantParsed {
+				t.Errorf("parseLeftRightCount(%q) = %d, %d, %v; want %d, %d, %v",
+					tt.output, left, right, ok, tt.wantLeft, tt.wantRight, tt.wantParsed)
+			}
+		})
+	}
+}
+
+func TestRenderCommitStatusHeader_Upstream(t *testing.T) {
+	p := &Plugin{commitStatusList: []CommitStatusInfo{{Hash: "abc1234", Subject: "Add thing"}}}
+
+	if got := ansi.Strip(p.renderCommitStatusHeader(80)); strings.Contains(got, "↑") || !strings.Contains(got, "Commits (1)") {
+		t.Errorf("expected only the local commit count without an upstream, got:\n%s", got)
+	}
+
+	p.commitStatusUpstream = &UpstreamStatus{Branch: "origin/feature", Ahead: 3, Behind: 1}
+	if got := ansi.Strip(p.renderCommitStatusHeader(80)); !strings.Contains(got, "↑3 ↓1 vs origin/feature") {
+		t.Errorf("expected ahead/behind counts in header, got:\n%s", got)
+	}
+
+	// A branch with nothing of its own still shows what it is behind on
+	p.commitStatusList = nil
+	p.commitStatusUpstream = &UpstreamStatus{Branch: "origin/main", Behind: 2}
+	if got := ansi.Strip(p.renderCommitStatusHeader(80)); !strings.Contains(got, "↑0 ↓2 vs origin/main") {
+		t.Errorf("expected behind count without local commits, got:\n%s", got)
+	}
+
+	p.commitStatusUpstream = nil
+	if got := p.renderCommitStatusHeader(80); got != "" {
+		t.Errorf("expected no header without commits or upstream, got:\n%s", got)
+	}
+}
+
+func TestDiffViewModeForWidth(t *testing.T) {
+	tests := []struct {
+		name      string
+		preferred DiffViewMode
+		width     int
+		want      DiffViewMode
+	}{
+		{"side-by-side wide", DiffViewSideBySide, 120, DiffViewSideBySide},
+		{"side-by-side at minimum", DiffViewSideBySide, minSideBySideWidth, DiffViewSideBySide},
+		{"side-by-side too narrow", DiffViewSideBySide, minSideBySideWidth - 1, DiffViewUnified},
+		{"unified wide", DiffViewUnified, 120, DiffViewUnified},
+		{"unified narrow", DiffViewUnified, 40, DiffViewUnified},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := diffViewModeForWidth(tt.preferred, tt.width); got != tt.want {
+				t.Errorf("diffViewModeForWidth(%v, %d) = %v, want %v", tt.preferred, tt.width, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsedDiffFor_Cache(t *testing.T) {
+	raw := "diff --git a/f.go b/f.go\n--- a/f.go\n+++ b/f.go\n@@ -1,1 +1,1 @@\n-old\n+new\n"
+	p := &Plugin{}
+	p.setDiffRaw(raw)
+
+	first, err := p.parsedDiffFor("wt-a")
+	if err != nil || first == nil {
+		t.Fatalf("parsedDiffFor() = %v, %v", first, err)
+	}
+	for i := 0; i < 3; i++ {
+		again, _ := p.parsedDiffFor("wt-a")
+		if again != first {
+			t.Fatal("expected identical diffRaw to reuse the cached parse")
+		}
+	}
+
+	// Switching worktrees re-parses even if the content matches
+	other, _ := p.parsedDiffFor("wt-b")
+	if other == first {
+		t.Error("expected a fresh parse for a different worktree")
+	}
+
+	// Changed content re-parses
+	p.setDiffRaw(strings.Replace(raw, "+new", "+newer", 1))
+	changed, _ := p.parsedDiffFor("wt-b")
+	if changed == other {
+		t.Error("expected a fresh parse after diffRaw changed")
+	}
+
+	p.clearParsedDiffCache()
+	if p.parsedDiff != nil || p.parsedDiffWorktree != "" {
+		t.Error("expected clearParsedDiffCache to drop the cached parse")
+	}
+}
+
+func TestSummarizeDiff(t *testing.T) {
+	raw := "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1,2 +1,3 @@\n ctx\n-old\n+new\n+more\n" +
+		"diff --git a/b.go b/b.go\n--- a/b.go\n+++ b/b.go\n@@ -1,3 +1,1 @@\n-x\n-y\n keep\n" +
+		"diff --git a/c.go b/c.go\nnew file mode 100644\n--- /dev/null\n+++ b/c.go\n@@ -0,0 +1,1 @@\n+hello\n"
+
+	got := summarizeDiff(gitstatus.ParseMultiFileDiff(raw))
+	want := diffStats{Files: 3, Additions: 3, Deletions: 3}
+	if got != want {
+		t.Errorf("summarizeDiff() = %+v, want %+v", got, want)
+	}
+
+	if got := summarizeDiff(nil); got != (diffStats{}) {
+		t.Errorf("summarizeDiff(nil) = %+v, want zero", got)
+	}
+}
+
+func BenchmarkParsedDiffFor_Cached(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString("diff --git a/f.go b/f.go\n--- a/f.go\n+++ b/f.go\n@@ -1,2000 +1,2000 @@\n")
+	for i := 0; i < 2000; i++ {
+		sb.WriteString("-old line with some content\n+new line with some content\n")
+	}
+	p := &Plugin{}
+	p.setDiffRaw(sb.String())
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.parsedDiffFor("wt")
+	}
+}
+
+func TestFileDiffText(t *testing.T) {
+	fileA := "diff --git a/a.go b/a.go\n--- a/a.go\n+++ b/a.go\n@@ -1,1 +1,1 @@\n-old\n+new\n"
+	fileB := "diff --git a/b.go b/b.go\ndeleted file mode 100644\n--- a/b.go\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-gone\n"
+	mfd := gitstatus.ParseMultiFileDiff(fileA + fileB)
+
+	if got := fileDiffText(mfd, 0); got != fileA {
+		t.Errorf("fileDiffText(0) = %q, want %q", got, fileA)
+	}
+	if got := fileDiffText(mfd, 1); got != fileB {
+		t.Errorf("fileDiffText(1) = %q, want %q", got, fileB)
+	}
+	if got := fileDiffText(mfd, 2); got != "" {
+		t.Errorf("fileDiffText(out of range) = %q, want empty", got)
+	}
+	if got := fileDiffText(nil, 0); got != "" {
+		t.Errorf("fileDiffText(nil) = %q, want empty", got)
+	}
+}
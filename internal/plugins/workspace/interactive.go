@@ -25,6 +25,10 @@ const (
 	// Single Escape is delayed by this amount to detect double-press.
 	doubleEscapeDelay = 150 * time.Millisecond
 
+	// minEscapeDelay and maxEscapeDelay bound the configurable double-escape window.
+	minEscapeDelay = 50 * time.Millisecond
+	maxEscapeDelay = 1000 * time.Millisecond
+
 	// pollingDecayFast is the polling interval during active typing.
 	pollingDecayFast = 50 * time.Millisecond
 
@@ -38,6 +42,10 @@ const (
 	// Allows typing bursts to coalesce into fewer polls, reducing CPU usage.
 	keystrokeDebounce = 20 * time.Millisecond
 
+	// inputCoalesceWindow is how long literal keystrokes are buffered before being
+	// flushed as a single send-keys -l call. Special keys flush immediately.
+	inputCoalesceWindow = 20 * time.Millisecond
+
 	// inactivityMediumThreshold triggers medium polling.
 	inactivityMediumThreshold = 2 * time.Second
 
@@ -55,6 +63,13 @@ const (
 
 	// defaultPasteKey is the default keybinding to paste clipboard in interactive mode.
 	defaultPasteKey = "alt+v"
+
+	// defaultScrollUpKey is the default keybinding to scroll captured output up in interactive mode.
+	// Ctrl+u/Ctrl+d are left alone since shells and editors rely on them.
+	defaultScrollUpKey = "alt+pgup"
+
+	// defaultScrollDownKey is the default keybinding to scroll captured output down in interactive mode.
+	defaultScrollDownKey = "alt+pgdown"
 )
 
 // =============================================================================
@@ -95,10 +110,34 @@ const (
 // If pendingEscape is still true, we forward the single Escape to tmux.
 type escapeTimerMsg struct{}
 
+// inputFlushMsg is sent when the input coalescing window elapses.
+// Any buffered literal input is flushed to tmux.
+type inputFlushMsg struct {
+	Generation int // Timer generation; stale timers are ignored
+}
+
 // InteractiveSessionDeadMsg indicates the tmux session has ended.
 // Sent when send-keys or capture fails with a session/pane not found error.
 type InteractiveSessionDeadMsg struct{}
 
+// InteractiveSendErrorMsg indicates send-keys failed while the session is still alive.
+// Sent after a retry also failed; interactive mode stays active.
+type InteractiveSendErrorMsg struct {
+	Err error
+}
+
+// sendErrorKind classifies a failed tmux send for interactive mode.
+type sendErrorKind int
+
+const (
+	sendErrorNone      sendErrorKind = iota // No error
+	sendErrorTransient                      // Session alive, send failed (pane not ready)
+	sendErrorDead                           // Session or pane is gone
+)
+
+// interactiveSendRetryDelay is how long to wait before retrying a transient send failure.
+const interactiveSendRetryDelay = 30 * time.Millisecond
+
 // getInteractiveExitKey returns the configured exit keybinding for interactive mode.
 // Falls back to defaultExitKey ("ctrl+\") if not configured.
 func (p *Plugin) getInteractiveExitKey() string {
@@ -143,6 +182,48 @@ func (p *Plugin) getInteractivePasteKey() string {
 	return defaultPasteKey
 }
 
+// getInteractiveScrollUpKey returns the configured scroll-up keybinding for interactive mode.
+// Falls back to defaultScrollUpKey ("alt+pgup") if not configured.
+func (p *Plugin) getInteractiveScrollUpKey() string {
+	if p.ctx != nil && p.ctx.Config != nil {
+		if key := p.ctx.Config.Plugins.Workspace.InteractiveScrollUpKey; key != "" {
+			return key
+		}
+	}
+	return defaultScrollUpKey
+}
+
+// getInteractiveScrollDownKey returns the configured scroll-down keybinding for interactive mode.
+// Falls back to defaultScrollDownKey ("alt+pgdown") if not configured.
+func (p *Plugin) getInteractiveScrollDownKey() string {
+	if p.ctx != nil && p.ctx.Config != nil {
+		if key := p.ctx.Config.Plugins.Workspace.InteractiveScrollDownKey; key != "" {
+			return key
+		}
+	}
+	return defaultScrollDownKey
+}
+
+// getInteractiveEscapeDelay returns the configured double-escape window for interactive mode.
+// Falls back to doubleEscapeDelay (150ms) if not configured and clamps to 50-1000ms.
+func (p *Plugin) getInteractiveEscapeDelay() time.Duration {
+	if p.ctx == nil || p.ctx.Config == nil {
+		return doubleEscapeDelay
+	}
+	ms := p.ctx.Config.Plugins.Workspace.TmuxEscapeDelayMs
+	if ms <= 0 {
+		return doubleEscapeDelay
+	}
+	delay := time.Duration(ms) * time.Millisecond
+	if delay < minEscapeDelay {
+		return minEscapeDelay
+	}
+	if delay > maxEscapeDelay {
+		return maxEscapeDelay
+	}
+	return delay
+}
+
 // isSessionDeadError checks if an error indicates the tmux session/pane is gone.
 func isSessionDeadError(err error) bool {
 	if err == nil {
@@ -155,12 +236,58 @@ func isSessionDeadError(err error) bool {
 		strings.Contains(errStr, "pane not found")
 }
 
+// classifySendError determines whether a send failure is transient or the session is dead.
+// sessionAlive is only consulted when the error text doesn't already identify a dead session.
+func classifySendError(err error, sessionAlive func() bool) sendErrorKind {
+	if err == nil {
+		return sendErrorNone
+	}
+	if isSessionDeadError(err) {
+		return sendErrorDead
+	}
+	if sessionAlive != nil && !sessionAlive() {
+		return sendErrorDead
+	}
+	return sendErrorTransient
+}
+
+// sendWithRetry runs send, retrying once after a short delay on a transient failure.
+// Returns the classification of the final attempt and its error.
+func sendWithRetry(send func() error, alive func() bool) (sendErrorKind, error) {
+	err := send()
+	kind := classifySendError(err, alive)
+	if kind != sendErrorTransient {
+		return kind, err
+	}
+	time.Sleep(interactiveSendRetryDelay)
+	err = send()
+	return classifySendError(err, alive), err
+}
+
+// sendErrorMsg converts a classified send failure into the message for the update loop.
+func sendErrorMsg(kind sendErrorKind, err error) tea.Msg {
+	switch kind {
+	case sendErrorDead:
+		return InteractiveSessionDeadMsg{}
+	case sendErrorTransient:
+		return InteractiveSendErrorMsg{Err: err}
+	}
+	return nil
+}
+
 // MapKeyToTmux is a wrapper around tty.MapKeyToTmux for backward compatibility.
 // See tty.MapKeyToTmux for documentation.
 func MapKeyToTmux(msg tea.KeyMsg) (key string, useLiteral bool) {
 	return tty.MapKeyToTmux(msg)
 }
 
+// SendKeySequence sends a scripted key sequence (e.g. "make test<Enter>", "<C-c>")
+// to an agent's tmux session without driving the TUI. Useful for demos and tests.
+// See tty.ParseKeySequence for the syntax.
+func SendKeySequence(sessionName string, keys []string) error {
+	return tty.SendKeySequence(sessionName, keys)
+}
+
 // sendKeyToTmux sends a key to a tmux pane using send-keys.
 // Uses the tmux key name syntax (e.g., "Enter", "C-c", "Up").
 func sendKeyToTmux(sessionName, key string) error {
@@ -175,6 +302,47 @@ func sendLiteralToTmux(sessionName, text string) error {
 	return cmd.Run()
 }
 
+// copyModeCommand returns the tmux copy-mode command for a navigation key
+// pressed while the pane is in copy mode, or false for keys that should be
+// forwarded as usual.
+func copyModeCommand(key string) (string, bool) {
+	switch key {
+	case "j", "down":
+		return "cursor-down", true
+	case "k", "up":
+		return "cursor-up", true
+	case "pgup":
+		return "page-up", true
+	case "pgdown":
+		return "page-down", true
+	case "ctrl+u":
+		return "halfpage-up", true
+	case "ctrl+d":
+		return "halfpage-down", true
+	case "g":
+		return "history-top", true
+	case "G":
+		return "history-bottom", true
+	case "q":
+		return "cancel", true
+	}
+	return "", false
+}
+
+// sendCopyModeCommandCmd runs a copy-mode command in the tmux pane (send-keys -X).
+func sendCopyModeCommandCmd(sessionName, command string) tea.Cmd {
+	return func() tea.Msg {
+		alive := func() bool { return sessionExists(sessionName) }
+		kind, err := sendWithRetry(func() error {
+			return exec.Command("tmux", "send-keys", "-t", sessionName, "-X", command).Run()
+		}, alive)
+		if kind != sendErrorNone {
+			return sendErrorMsg(kind, err)
+		}
+		return nil
+	}
+}
+
 // keySpec describes a key to send to tmux with ordering preserved.
 type keySpec struct {
 	value   string
@@ -183,18 +351,20 @@ type keySpec struct {
 
 // sendInteractiveKeysCmd sends keys to tmux asynchronously (td-c2961e).
 // Keys are sent in order within a single goroutine to prevent reordering.
-// Returns InteractiveSessionDeadMsg if the session has ended.
+// Transient failures are retried once. Returns InteractiveSessionDeadMsg if the
+// session has ended, or InteractiveSendErrorMsg if the retry also failed.
 func sendInteractiveKeysCmd(sessionName string, keys ...keySpec) tea.Cmd {
 	return func() tea.Msg {
+		alive := func() bool { return sessionExists(sessionName) }
 		for _, k := range keys {
-			var err error
-			if k.literal {
-				err = sendLiteralToTmux(sessionName, k.value)
-			} else {
-				err = sendKeyToTmux(sessionName, k.value)
-			}
-			if err != nil && isSessionDeadError(err) {
-				return InteractiveSessionDeadMsg{}
+			kind, err := sendWithRetry(func() error {
+				if k.literal {
+					return sendLiteralToTmux(sessionName, k.value)
+				}
+				return sendKeyToTmux(sessionName, k.value)
+			}, alive)
+			if kind != sendErrorNone {
+				return sendErrorMsg(kind, err)
 			}
 		}
 		return nil
@@ -453,14 +623,17 @@ func (p *Plugin) enterInteractiveMode() tea.Cmd {
 		}
 	}
 	// Initialize interactive state
+	p.previewHorizOffset = 0
 	p.interactiveState = &InteractiveState{
 		Active:        true,
 		TargetPane:    paneID,
 		TargetSession: sessionName,
 		LastKeyTime:   time.Now(),
 		CursorVisible: true, // Assume visible until we get first cursor query result
+		EscapeDelay:   p.getInteractiveEscapeDelay(),
 	}
 	p.selection.Clear()
+	p.exitOutputSearch()
 
 	p.viewMode = ViewModeInteractive
 
@@ -730,58 +903,86 @@ func (p *Plugin) previewResizeTarget() string {
 	return wt.Agent.TmuxSession
 }
 
+// interactiveErrorHint returns a short hint-line suffix describing the last send failure.
+// The failed input was already retried once and then dropped; the hint stays until
+// the next key is sent.
+func (p *Plugin) interactiveErrorHint() string {
+	if p.interactiveState == nil || p.interactiveState.LastError == nil {
+		return ""
+	}
+	errStyle := lipgloss.NewStyle().Foreground(styles.Error)
+	reason := strings.Join(strings.Fields(p.interactiveState.LastError.Error()), " ")
+	return " • " + errStyle.Render("input dropped: "+truncateString(reason, 60))
+}
+
+// copyModeBadge returns a header badge shown while the pane is in tmux copy mode.
+func (p *Plugin) copyModeBadge() string {
+	if p.interactiveState == nil || !p.interactiveState.PaneInMode {
+		return ""
+	}
+	return " " + lipgloss.NewStyle().Foreground(styles.Info).Render("[copy-mode]")
+}
+
 // exitInteractiveMode exits interactive mode and returns to list view.
-func (p *Plugin) exitInteractiveMode() {
+// Returns a command flushing buffered literal input so typed characters
+// aren't lost on exit, or nil if nothing is buffered.
+func (p *Plugin) exitInteractiveMode() tea.Cmd {
+	var flush tea.Cmd
 	if p.interactiveState != nil {
+		if pending := p.interactiveState.takeBufferedInput(); len(pending) > 0 {
+			flush = sendInteractiveKeysCmd(p.interactiveState.TargetSession, pending...)
+		}
 		p.interactiveState.Active = false
 	}
 	p.interactiveState = nil
+	p.previewHorizOffset = 0
 	p.selection.Clear()
 	p.viewMode = ViewModeList
+	return flush
 }
 
 // handleInteractiveKeys processes key input in interactive mode.
 // Returns a tea.Cmd for any async operations needed.
 func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 	if p.interactiveState == nil || !p.interactiveState.Active {
-		p.exitInteractiveMode()
-		return nil
+		return p.exitInteractiveMode()
 	}
 
+	// A new key is a fresh attempt; clear any surfaced send failure
+	p.interactiveState.LastError = nil
+
 	// Check for exit keys
 
 	// Primary exit: Configurable key (default: Ctrl+\)
 	if msg.String() == p.getInteractiveExitKey() {
-		p.exitInteractiveMode()
-		return nil
+		return p.exitInteractiveMode()
 	}
 
 	// Attach shortcut: exit interactive and attach to full session (td-fd68d1)
 	if msg.String() == p.getInteractiveAttachKey() {
-		p.exitInteractiveMode()
+		flush := p.exitInteractiveMode()
 		// Attach to the appropriate session
 		if p.shellSelected {
 			if idx := p.selectedShellIdx; idx >= 0 && idx < len(p.shells) {
-				return p.ensureShellAndAttachByIndex(idx)
+				return tea.Sequence(flush, p.ensureShellAndAttachByIndex(idx))
 			}
 		} else {
 			if wt := p.selectedWorktree(); wt != nil && wt.Agent != nil {
 				p.attachedSession = wt.Name
-				return p.AttachToSession(wt)
+				return tea.Sequence(flush, p.AttachToSession(wt))
 			}
 		}
-		return nil
+		return flush
 	}
 
-	// Secondary exit: Double-Escape with 150ms delay
+	// Secondary exit: Double-Escape within the escape delay (default 150ms)
 	// Per spec: first Escape is delayed to detect double-press
 	if msg.Type == tea.KeyEscape {
 		if p.interactiveState.EscapePressed {
 			// Second Escape within window: exit interactive mode
 			p.interactiveState.EscapePressed = false
 			p.interactiveState.EscapeTimerPending = false // Cancel pending timer
-			p.exitInteractiveMode()
-			return nil
+			return p.exitInteractiveMode()
 		}
 		// First Escape: mark pending and start delay timer
 		// Do NOT forward to tmux yet - wait for timer or next key
@@ -790,7 +991,7 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 		// Timer leak prevention (td-83dc22): only schedule timer if one isn't already pending
 		if !p.interactiveState.EscapeTimerPending {
 			p.interactiveState.EscapeTimerPending = true
-			return tea.Tick(doubleEscapeDelay, func(t time.Time) tea.Msg {
+			return tea.Tick(p.interactiveState.escapeDelay(), func(t time.Time) tea.Msg {
 				return escapeTimerMsg{}
 			})
 		}
@@ -873,6 +1074,16 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 		return p.copyInteractiveSelectionCmd()
 	}
 
+	// Scroll keys page through the captured buffer locally instead of going to tmux
+	switch msg.String() {
+	case p.getInteractiveScrollUpKey():
+		p.scrollInteractiveOutput(p.interactiveScrollPageSize())
+		return nil
+	case p.getInteractiveScrollDownKey():
+		p.scrollInteractiveOutput(-p.interactiveScrollPageSize())
+		return nil
+	}
+
 	if msg.String() == p.getInteractivePasteKey() {
 		p.interactiveState.LastKeyTime = time.Now()
 		if p.previewOffset > 0 {
@@ -880,10 +1091,37 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 			p.autoScrollOutput = true
 			p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot
 		}
+		if pending := p.interactiveState.takeBufferedInput(); len(pending) > 0 {
+			cmds = append(cmds, tea.Sequence(
+				sendInteractiveKeysCmd(p.interactiveState.TargetSession, pending...),
+				p.pasteClipboardToTmuxCmd(),
+			))
+			return tea.Batch(cmds...)
+		}
 		cmds = append(cmds, p.pasteClipboardToTmuxCmd())
 		return tea.Batch(cmds...)
 	}
 
+	// In tmux copy mode, literal input does nothing useful; drive copy-mode
+	// navigation instead so the keys scroll the pane.
+	if p.interactiveState.PaneInMode {
+		if command, ok := copyModeCommand(msg.String()); ok {
+			p.interactiveState.LastKeyTime = time.Now()
+			prefix := p.interactiveState.takeBufferedInput()
+			if pendingEscape {
+				prefix = append(prefix, keySpec{"Escape", false})
+			}
+			sessionName := p.interactiveState.TargetSession
+			if len(prefix) > 0 {
+				return tea.Sequence(
+					sendInteractiveKeysCmd(sessionName, prefix...),
+					sendCopyModeCommandCmd(sessionName, command),
+				)
+			}
+			return sendCopyModeCommandCmd(sessionName, command)
+		}
+	}
+
 	// Update last key time for polling decay
 	p.interactiveState.LastKeyTime = time.Now()
 
@@ -904,23 +1142,16 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 	if isPasteInput(msg) {
 		text := string(msg.Runes)
 		bracketed := p.interactiveState.BracketedPasteEnabled
-		// Send paste async (td-c2961e): escape + paste in order if pending
+		// Send paste async (td-c2961e): buffered input + escape + paste in order if pending
+		prefix := p.interactiveState.takeBufferedInput()
 		if pendingEscape {
-			cmds = append(cmds, func() tea.Msg {
-				if err := sendKeyToTmux(sessionName, "Escape"); err != nil && isSessionDeadError(err) {
-					return InteractiveSessionDeadMsg{}
-				}
-				var err error
-				if bracketed {
-					err = sendBracketedPasteToTmux(sessionName, text)
-				} else {
-					err = sendPasteToTmux(sessionName, text)
-				}
-				if err != nil && isSessionDeadError(err) {
-					return InteractiveSessionDeadMsg{}
-				}
-				return nil
-			})
+			prefix = append(prefix, keySpec{"Escape", false})
+		}
+		if len(prefix) > 0 {
+			cmds = append(cmds, tea.Sequence(
+				sendInteractiveKeysCmd(sessionName, prefix...),
+				sendInteractivePasteInputCmd(sessionName, text, bracketed),
+			))
 		} else {
 			cmds = append(cmds, sendInteractivePasteInputCmd(sessionName, text, bracketed))
 		}
@@ -930,30 +1161,65 @@ func (p *Plugin) handleInteractiveKeys(msg tea.KeyMsg) tea.Cmd {
 
 	// Map key to tmux format and send
 	key, useLiteral := MapKeyToTmux(msg)
-	if key == "" {
-		// Still send pending escape if nothing else to send
-		if pendingEscape {
-			cmds = append(cmds, sendInteractiveKeysCmd(sessionName, keySpec{"Escape", false}))
-			cmds = append(cmds, p.scheduleDebouncedPoll(keystrokeDebounce))
-		}
-		return tea.Batch(cmds...)
-	}
 
-	// Send keys async (td-c2961e): pending escape + key in order within single goroutine
+	// Send keys async (td-c2961e) in order within a single goroutine:
+	// buffered literals, then pending escape, then this key. Literal keys with
+	// nothing ahead of them are buffered and flushed together after
+	// inputCoalesceWindow, so a typing burst becomes one send-keys call.
+	var keys []keySpec
 	if pendingEscape {
-		cmds = append(cmds, sendInteractiveKeysCmd(sessionName,
-			keySpec{"Escape", false},
-			keySpec{key, useLiteral},
-		))
-	} else {
-		cmds = append(cmds, sendInteractiveKeysCmd(sessionName, keySpec{key, useLiteral}))
+		keys = append(keys, p.interactiveState.takeBufferedInput()...)
+		keys = append(keys, keySpec{"Escape", false})
+		if key != "" {
+			keys = append(keys, keySpec{key, useLiteral})
+		}
+	} else if key != "" {
+		keys = p.interactiveState.queueInput(keySpec{key, useLiteral})
 	}
 
-	// Schedule debounced poll to batch rapid keystrokes (td-8a0978)
-	cmds = append(cmds, p.scheduleDebouncedPoll(keystrokeDebounce))
+	if len(keys) > 0 {
+		cmds = append(cmds, sendInteractiveKeysCmd(sessionName, keys...))
+		// Schedule debounced poll to batch rapid keystrokes (td-8a0978)
+		cmds = append(cmds, p.scheduleDebouncedPoll(keystrokeDebounce))
+	}
+	if p.interactiveState.PendingInput != "" && !p.interactiveState.InputFlushPending {
+		p.interactiveState.InputFlushPending = true
+		p.inputFlushGeneration++
+		gen := p.inputFlushGeneration
+		cmds = append(cmds, tea.Tick(inputCoalesceWindow, func(t time.Time) tea.Msg {
+			return inputFlushMsg{Generation: gen}
+		}))
+	}
 	return tea.Batch(cmds...)
 }
 
+// handleInputFlush sends any literal input buffered during the coalescing
+// window. Only the timer of the current generation clears the pending flag,
+// so a stale timer can't let a second one be scheduled while one is in flight.
+func (p *Plugin) handleInputFlush(gen int) tea.Cmd {
+	if p.interactiveState == nil || !p.interactiveState.Active {
+		return nil
+	}
+	if gen != p.inputFlushGeneration {
+		return nil
+	}
+	p.interactiveState.InputFlushPending = false
+	return p.flushBufferedInput()
+}
+
+// flushBufferedInput sends buffered literal input without touching the
+// flush timer, which stays in flight and finds the buffer empty.
+func (p *Plugin) flushBufferedInput() tea.Cmd {
+	pending := p.interactiveState.takeBufferedInput()
+	if len(pending) == 0 {
+		return nil
+	}
+	return tea.Batch(
+		sendInteractiveKeysCmd(p.interactiveState.TargetSession, pending...),
+		p.scheduleDebouncedPoll(keystrokeDebounce),
+	)
+}
+
 // handleEscapeTimer processes the escape delay timer firing.
 // If a single Escape is still pending (no second Escape arrived), forward it to tmux.
 func (p *Plugin) handleEscapeTimer() tea.Cmd {
@@ -966,16 +1232,30 @@ func (p *Plugin) handleEscapeTimer() tea.Cmd {
 
 	if !p.interactiveState.EscapePressed {
 		// Escape was already handled (double-press or another key arrived)
-		return nil
+		return p.flushBufferedInput()
+	}
+
+	// The window is measured from the first Escape; if the timer fired early
+	// relative to the session's delay, wait out the remainder.
+	if !p.interactiveState.EscapeTime.IsZero() {
+		remaining := p.interactiveState.escapeDelay() - time.Since(p.interactiveState.EscapeTime)
+		if remaining > 0 {
+			p.interactiveState.EscapeTimerPending = true
+			return tea.Tick(remaining, func(t time.Time) tea.Msg {
+				return escapeTimerMsg{}
+			})
+		}
 	}
 
 	// Timer fired with pending Escape: forward the single Escape to tmux async (td-c2961e)
 	p.interactiveState.EscapePressed = false
 
 	// Update last key time and poll immediately for better responsiveness (td-babfd9)
+	// Buffered literals were typed before the Escape, so they go first.
 	p.interactiveState.LastKeyTime = time.Now()
+	keys := append(p.interactiveState.takeBufferedInput(), keySpec{"Escape", false})
 	return tea.Batch(
-		sendInteractiveKeysCmd(p.interactiveState.TargetSession, keySpec{"Escape", false}),
+		sendInteractiveKeysCmd(p.interactiveState.TargetSession, keys...),
 		p.pollInteractivePaneImmediate(),
 	)
 }
@@ -1008,26 +1288,91 @@ func (p *Plugin) forwardScrollToTmux(delta int) tea.Cmd {
 	p.lastScrollTime = now
 
 	if delta < 0 {
-		// Scroll up: pause auto-scroll, show older content
-		p.autoScrollOutput = false
-		p.captureScrollBaseLineCount() // td-f7c8be: prevent bounce on poll
-		p.previewOffset++
+		p.scrollInteractiveOutput(1)
 	} else {
-		// Scroll down: show newer content, resume auto-scroll at bottom
-		if p.previewOffset > 0 {
-			p.previewOffset--
-			if p.previewOffset == 0 {
-				p.autoScrollOutput = true
-				p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot
-			}
-		}
+		p.scrollInteractiveOutput(-1)
 	}
 	return nil
 }
 
-// forwardClickToTmux sends a mouse click to the tmux pane.
-// Currently a no-op as full mouse support requires knowing the terminal's mouse mode.
-// This is provided for future extension.
+// scrollInteractiveOutput moves previewOffset by lines (positive = up, toward older output).
+// Leaving the bottom pauses auto-scroll; returning to offset 0 resumes live output.
+func (p *Plugin) scrollInteractiveOutput(lines int) {
+	if lines > 0 && p.previewOffset == 0 {
+		p.autoScrollOutput = false
+		p.captureScrollBaseLineCount() // td-f7c8be: prevent bounce on poll
+	}
+
+	offset := p.previewOffset + lines
+	lineCount := p.scrollBaseLineCount
+	if lineCount == 0 {
+		lineCount = p.selectedOutputLineCount()
+	}
+	if lineCount > 0 {
+		_, visibleHeight := p.calculatePreviewDimensions()
+		offset = clampScrollOffset(offset, lineCount, visibleHeight)
+	} else if offset < 0 {
+		offset = 0
+	}
+	p.previewOffset = offset
+
+	if p.previewOffset == 0 {
+		p.autoScrollOutput = true
+		p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot
+	}
+}
+
+// interactiveScrollPageSize returns how many lines the scroll keys move (half the pane).
+func (p *Plugin) interactiveScrollPageSize() int {
+	_, height := p.calculatePreviewDimensions()
+	if page := height / 2; page > 1 {
+		return page
+	}
+	return 1
+}
+
+// clampScrollOffset limits a from-bottom scroll offset so the view never
+// scrolls past the top of a buffer with lineCount lines.
+func clampScrollOffset(offset, lineCount, visibleHeight int) int {
+	maxOffset := lineCount - visibleHeight
+	if maxOffset < 0 {
+		maxOffset = 0
+	}
+	if offset > maxOffset {
+		return maxOffset
+	}
+	if offset < 0 {
+		return 0
+	}
+	return offset
+}
+
+// followCursorHorizOffset returns the horizontal scroll offset that keeps
+// cursorCol within a view of width columns, moving offset as little as possible.
+func followCursorHorizOffset(offset, cursorCol, width int) int {
+	if width <= 0 || cursorCol < 0 {
+		return 0
+	}
+	if cursorCol < offset {
+		offset = cursorCol
+	}
+	if cursorCol >= offset+width {
+		offset = cursorCol - width + 1
+	}
+	return max(offset, 0)
+}
+
+// interactiveScrollHint returns a "[-N lines]" indicator when interactive
+// output is scrolled up from the live bottom, or "" otherwise.
+func (p *Plugin) interactiveScrollHint() string {
+	if p.previewOffset <= 0 {
+		return ""
+	}
+	return " • " + dimText(fmt.Sprintf("[-%d lines]", p.previewOffset))
+}
+
+// forwardClickToTmux sends a left-button click (press + release) to the tmux pane.
+// Only forwards when the target app has enabled mouse reporting.
 func (p *Plugin) forwardClickToTmux(x, y int) tea.Cmd {
 	if p.interactiveState == nil || !p.interactiveState.Active {
 		return nil
@@ -1035,28 +1380,129 @@ func (p *Plugin) forwardClickToTmux(x, y int) tea.Cmd {
 	if !p.interactiveState.MouseReportingEnabled {
 		return nil
 	}
-	sessionName := p.interactiveState.TargetSession
 	col, row, ok := p.interactiveMouseCoords(x, y)
 	if !ok {
 		return nil
 	}
+	p.interactiveState.LastKeyTime = time.Now()
+	return sendInteractiveMouseCmd(p.interactiveState.TargetSession,
+		sgrMouseEvent{button: 0, col: col, row: row},
+		sgrMouseEvent{button: 0, col: col, row: row, release: true},
+	)
+}
 
-	return func() tea.Msg {
-		if err := sendSGRMouse(sessionName, 0, col, row, false); err != nil {
-			p.exitInteractiveMode()
-			if isSessionDeadError(err) {
-				return InteractiveSessionDeadMsg{}
-			}
-			return nil
+// handleInteractiveMouse forwards mouse events inside the preview pane to the
+// tmux pane as SGR mouse sequences, so TUIs like lazygit or htop receive
+// clicks, drags, and wheel events. Returns handled=false when the event should
+// fall through to the normal mouse handling (app not reporting mouse, event
+// outside the pane, or feature disabled).
+func (p *Plugin) handleInteractiveMouse(msg tea.MouseMsg) (cmd tea.Cmd, handled bool) {
+	if !features.IsEnabled(features.TmuxInteractiveInput.Name) {
+		return nil, false
+	}
+	if p.interactiveState == nil || !p.interactiveState.Active {
+		return nil, false
+	}
+	if !p.interactiveState.MouseReportingEnabled {
+		return nil, false
+	}
+	col, row, ok := p.interactiveMouseCoords(msg.X, msg.Y)
+	if !ok {
+		return nil, false
+	}
+	ev, ok := sgrMouseEventFromMsg(msg, col, row)
+	if !ok {
+		// In-pane event we don't forward (e.g. hover without a button): swallow it
+		// so it doesn't start a selection or exit interactive mode.
+		return nil, true
+	}
+
+	p.activePane = PanePreview
+	p.interactiveState.LastKeyTime = time.Now()
+	return tea.Batch(
+		sendInteractiveMouseCmd(p.interactiveState.TargetSession, ev),
+		p.scheduleDebouncedPoll(keystrokeDebounce),
+	), true
+}
+
+// sgrMouseEvent is a single mouse event in SGR (1006) encoding.
+type sgrMouseEvent struct {
+	button   int
+	col, row int // 1-based pane coordinates
+	release  bool
+}
+
+// SGR mouse button codes and modifier bits.
+const (
+	sgrButtonLeft      = 0
+	sgrButtonMiddle    = 1
+	sgrButtonRight     = 2
+	sgrButtonWheelUp   = 64
+	sgrButtonWheelDown = 65
+	sgrModShift        = 4
+	sgrModAlt          = 8
+	sgrModCtrl         = 16
+	sgrMotion          = 32
+)
+
+// sgrMouseEventFromMsg converts a Bubble Tea mouse message to an SGR event at
+// the given pane coordinates. Returns ok=false for events that have no SGR
+// meaning for the target app (buttonless motion, horizontal wheel).
+func sgrMouseEventFromMsg(msg tea.MouseMsg, col, row int) (sgrMouseEvent, bool) {
+	ev := sgrMouseEvent{col: col, row: row}
+
+	switch msg.Button {
+	case tea.MouseButtonLeft:
+		ev.button = sgrButtonLeft
+	case tea.MouseButtonMiddle:
+		ev.button = sgrButtonMiddle
+	case tea.MouseButtonRight:
+		ev.button = sgrButtonRight
+	case tea.MouseButtonWheelUp:
+		ev.button = sgrButtonWheelUp
+	case tea.MouseButtonWheelDown:
+		ev.button = sgrButtonWheelDown
+	case tea.MouseButtonNone:
+		// X10-style releases don't report which button was released
+		if msg.Action != tea.MouseActionRelease {
+			return ev, false
 		}
-		if err := sendSGRMouse(sessionName, 0, col, row, true); err != nil {
-			p.exitInteractiveMode()
-			if isSessionDeadError(err) {
-				return InteractiveSessionDeadMsg{}
+		ev.button = sgrButtonLeft
+	default:
+		return ev, false
+	}
+
+	switch msg.Action {
+	case tea.MouseActionRelease:
+		ev.release = true
+	case tea.MouseActionMotion:
+		ev.button += sgrMotion
+	}
+
+	if msg.Shift {
+		ev.button += sgrModShift
+	}
+	if msg.Alt {
+		ev.button += sgrModAlt
+	}
+	if msg.Ctrl {
+		ev.button += sgrModCtrl
+	}
+	return ev, true
+}
+
+// sendInteractiveMouseCmd sends SGR mouse events to tmux asynchronously, in order.
+// Returns InteractiveSessionDeadMsg if the session has ended.
+func sendInteractiveMouseCmd(sessionName string, events ...sgrMouseEvent) tea.Cmd {
+	return func() tea.Msg {
+		for _, ev := range events {
+			if err := sendSGRMouse(sessionName, ev.button, ev.col, ev.row, ev.release); err != nil {
+				if isSessionDeadError(err) {
+					return InteractiveSessionDeadMsg{}
+				}
+				return nil
 			}
-			return nil
 		}
-		p.interactiveState.LastKeyTime = time.Now()
 		return nil
 	}
 }
@@ -1073,6 +1519,7 @@ func sendSGRMouse(sessionName string, button, col, row int, release bool) error
 	return sendLiteralToTmux(sessionName, seq)
 }
 
+// interactiveMouseCoords maps screen coordinates to 1-based tmux pane coordinates.
 func (p *Plugin) interactiveMouseCoords(x, y int) (col, row int, ok bool) {
 	if p.width <= 0 || p.height <= 0 {
 		return 0, 0, false
@@ -1081,6 +1528,34 @@ func (p *Plugin) interactiveMouseCoords(x, y int) (col, row int, ok bool) {
 		return 0, 0, false
 	}
 
+	originX, originY := p.interactivePaneOrigin()
+
+	paneWidth, paneHeight := p.calculatePreviewDimensions()
+	if p.interactiveState != nil {
+		if p.interactiveState.PaneWidth > 0 && p.interactiveState.PaneWidth < paneWidth {
+			paneWidth = p.interactiveState.PaneWidth
+		}
+		if p.interactiveState.PaneHeight > 0 && p.interactiveState.PaneHeight < paneHeight {
+			paneHeight = p.interactiveState.PaneHeight
+		}
+	}
+
+	col, row, ok = translateMouseToPane(x, y, originX, originY, paneWidth, paneHeight)
+	if !ok {
+		return 0, 0, false
+	}
+
+	// Account for cursor-following horizontal scroll, staying inside the pane
+	col += p.previewHorizOffset
+	if p.interactiveState != nil && p.interactiveState.PaneWidth > 0 && col > p.interactiveState.PaneWidth {
+		col = p.interactiveState.PaneWidth
+	}
+	return max(col, 1), row, true
+}
+
+// interactivePaneOrigin returns the screen position of the first cell of
+// terminal output in the preview pane.
+func (p *Plugin) interactivePaneOrigin() (x, y int) {
 	previewX := 0
 	if p.sidebarVisible {
 		available := p.width - dividerWidth
@@ -1094,49 +1569,33 @@ func (p *Plugin) interactiveMouseCoords(x, y int) (col, row int, ok bool) {
 		previewX = sidebarW + dividerWidth
 	}
 
-	contentX := previewX + panelOverhead/2
-	contentY := 1
+	x = previewX + panelOverhead/2
+	y = 1
 	if !p.shellSelected {
-		contentY += 2
+		y += 2
 	}
 	if !p.flashPreviewTime.IsZero() && time.Since(p.flashPreviewTime) < flashDuration {
-		contentY++
+		y++
 	}
-	contentY++ // hint line
+	y++ // hint line
+	return x, y
+}
 
-	relX := x - contentX
-	relY := y - contentY
+// translateMouseToPane converts screen coordinates to 1-based pane coordinates
+// given the pane's screen origin and size. Returns ok=false outside the pane.
+func translateMouseToPane(x, y, originX, originY, paneWidth, paneHeight int) (col, row int, ok bool) {
+	relX := x - originX
+	relY := y - originY
 	if relX < 0 || relY < 0 {
 		return 0, 0, false
 	}
-
-	paneWidth, paneHeight := p.calculatePreviewDimensions()
-	if p.interactiveState != nil {
-		if p.interactiveState.PaneWidth > 0 && p.interactiveState.PaneWidth < paneWidth {
-			paneWidth = p.interactiveState.PaneWidth
-		}
-		if p.interactiveState.PaneHeight > 0 && p.interactiveState.PaneHeight < paneHeight {
-			paneHeight = p.interactiveState.PaneHeight
-		}
-	}
-
 	if paneWidth <= 0 || paneHeight <= 0 {
 		return 0, 0, false
 	}
 	if relX >= paneWidth || relY >= paneHeight {
 		return 0, 0, false
 	}
-
-	col = relX + 1
-	row = relY + 1
-	if col > paneWidth {
-		col = paneWidth
-	}
-	if row > paneHeight {
-		row = paneHeight
-	}
-
-	return col, row, true
+	return relX + 1, relY + 1, true
 }
 
 // pollInteractivePane schedules a poll for interactive mode with adaptive timing.
@@ -1237,35 +1696,48 @@ func cursorStyle() lipgloss.Style {
 // getCursorPosition returns the cached cursor position for rendering (td-648af4).
 // This NEVER spawns subprocesses - it only returns cached state updated by
 // queryCursorPositionCmd() which runs asynchronously during polling.
-// Returns the cursor row, column (0-indexed), pane height, and whether the cursor is visible.
+// Returns the cursor row, column (0-indexed), pane height, and whether the cursor overlay
+// should be drawn (false while the pane is on the alternate screen).
 func (p *Plugin) getCursorPosition() (row, col, paneHeight, paneWidth int, visible bool, err error) {
 	if p.interactiveState == nil || !p.interactiveState.Active {
 		return 0, 0, 0, 0, false, nil
 	}
 
+	// Apps on the alternate screen (vim, less) draw their own cursor; skip the overlay.
+	// The cursor is also hidden while scrolled back or in tmux copy mode, since it
+	// belongs to the live bottom.
+	visible = p.interactiveState.CursorVisible && !p.interactiveState.AltScreen &&
+		!p.interactiveState.PaneInMode && p.previewOffset == 0
+
 	// Return cached values - never spawn subprocess from View()
-	return p.interactiveState.CursorRow, p.interactiveState.CursorCol, p.interactiveState.PaneHeight, p.interactiveState.PaneWidth, p.interactiveState.CursorVisible, nil
+	return p.interactiveState.CursorRow, p.interactiveState.CursorCol, p.interactiveState.PaneHeight, p.interactiveState.PaneWidth, visible, nil
 }
 
 // queryCursorPositionSync synchronously queries cursor position for the given target.
 // Used to capture cursor position atomically with output in poll goroutines.
-// Returns row, col (0-indexed), paneHeight, visible, and ok (false if query failed).
+// Returns row, col (0-indexed), paneHeight, visible, altScreen, inMode, and ok (false if query failed).
 // paneHeight is needed to calculate cursor offset when display height differs from pane height.
-func queryCursorPositionSync(target string) (row, col, paneHeight, paneWidth int, visible, ok bool) {
+func queryCursorPositionSync(target string) (row, col, paneHeight, paneWidth int, visible, altScreen, inMode, ok bool) {
 	if target == "" {
-		return 0, 0, 0, 0, false, false
+		return 0, 0, 0, 0, false, false, false, false
 	}
 
 	cmd := exec.Command("tmux", "display-message", "-t", target,
-		"-p", "#{cursor_x},#{cursor_y},#{cursor_flag},#{pane_height},#{pane_width}")
+		"-p", "#{cursor_x},#{cursor_y},#{cursor_flag},#{pane_height},#{pane_width},#{alternate_on},#{pane_in_mode}")
 	output, err := cmd.Output()
 	if err != nil {
-		return 0, 0, 0, 0, false, false
+		return 0, 0, 0, 0, false, false, false, false
 	}
+	return parseCursorQueryOutput(string(output))
+}
 
-	parts := strings.Split(strings.TrimSpace(string(output)), ",")
+// parseCursorQueryOutput parses the display-message output of queryCursorPositionSync:
+// "cursor_x,cursor_y,cursor_flag,pane_height,pane_width,alternate_on,pane_in_mode".
+// Trailing fields are optional; a missing cursor_flag means visible.
+func parseCursorQueryOutput(output string) (row, col, paneHeight, paneWidth int, visible, altScreen, inMode, ok bool) {
+	parts := strings.Split(strings.TrimSpace(output), ",")
 	if len(parts) < 2 {
-		return 0, 0, 0, 0, false, false
+		return 0, 0, 0, 0, false, false, false, false
 	}
 
 	col, _ = strconv.Atoi(parts[0])
@@ -1277,7 +1749,13 @@ func queryCursorPositionSync(target string) (row, col, paneHeight, paneWidth int
 	if len(parts) >= 5 {
 		paneWidth, _ = strconv.Atoi(parts[4])
 	}
-	return row, col, paneHeight, paneWidth, visible, true
+	if len(parts) >= 6 {
+		altScreen = parts[5] == "1"
+	}
+	if len(parts) >= 7 {
+		inMode = parts[6] == "1"
+	}
+	return row, col, paneHeight, paneWidth, visible, altScreen, inMode, true
 }
 
 // renderWithCursor overlays the cursor on content at the specified position.
@@ -1307,18 +1785,17 @@ func renderWithCursor(content string, cursorRow, cursorCol int, visible bool) st
 		}
 		lines[cursorRow] = line + strings.Repeat(" ", padding) + cursorStyle().Render("█")
 	} else {
-		// Use ANSI-aware slicing to preserve escape codes in before/after
-		before := ansi.Cut(line, 0, cursorCol)
-		char := ansi.Cut(line, cursorCol, cursorCol+1)
-		after := ansi.Cut(line, cursorCol+1, lineWidth)
+		// Use ANSI-aware slicing to preserve escape codes in before/after.
+		// Slice around the whole glyph so wide (CJK, emoji) cells aren't split.
+		start, width, char := tty.CursorCell(line, cursorCol)
+		before := ansi.Cut(line, 0, start)
+		after := ansi.Cut(line, start+width, lineWidth)
 
-		// Strip the cursor char to get clean styling
-		charStripped := ansi.Strip(char)
 		// Use a block character for empty/whitespace to make cursor more visible (td-43d37b)
-		if charStripped == "" || charStripped == " " {
-			charStripped = "█"
+		if char == "" || char == " " {
+			char = "█"
 		}
-		lines[cursorRow] = before + cursorStyle().Render(charStripped) + after
+		lines[cursorRow] = before + cursorStyle().Render(char) + after
 	}
 
 	return strings.Join(lines, "\n")
@@ -20,6 +20,7 @@ func (p *Plugin) interactiveColAtX(x, lineIdx int) (int, bool) {
 	if relX < 0 {
 		return 0, false
 	}
+	relX += p.previewHorizOffset // Account for cursor-following horizontal scroll
 
 	buf := p.interactiveOutputBuffer()
 	if buf == nil {
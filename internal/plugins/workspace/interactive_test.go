@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/marcus/sidecar/internal/config"
 	"github.com/marcus/sidecar/internal/plugin"
 	"github.com/marcus/sidecar/internal/tty"
@@ -399,6 +400,46 @@ func TestRenderWithCursor_ANSIWidthCalc(t *testing.T) {
 	}
 }
 
+// TestRenderWithCursor_WideGlyphs tests that CJK and emoji cells under the cursor aren't split
+func TestRenderWithCursor_WideGlyphs(t *testing.T) {
+	// Cells: a(0) 日(1-2) 本(3-4) b(5) 😀(6-7) c(8)
+	line := "a\x1b[31m日本\x1b[0mb😀c"
+	tests := []struct {
+		name      string
+		col       int
+		wantStart int
+		wantGlyph string
+	}{
+		{"CJK left cell", 1, 1, "日"},
+		{"CJK right cell", 2, 1, "日"},
+		{"second CJK", 3, 3, "本"},
+		{"narrow between wide", 5, 5, "b"},
+		{"emoji left cell", 6, 6, "😀"},
+		{"emoji right cell", 7, 6, "😀"},
+		{"narrow after emoji", 8, 8, "c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, _, glyph := tty.CursorCell(line, tt.col)
+			if start != tt.wantStart || glyph != tt.wantGlyph {
+				t.Errorf("CursorCell(%d) = (%d, %q), want (%d, %q)", tt.col, start, glyph, tt.wantStart, tt.wantGlyph)
+			}
+
+			result := renderWithCursor(line, 0, tt.col, true)
+			if got, want := ansi.StringWidth(result), ansi.StringWidth(line); got != want {
+				t.Errorf("width changed: got %d, want %d (result %q)", got, want, result)
+			}
+			if got, want := ansi.Strip(result), ansi.Strip(line); got != want {
+				t.Errorf("glyphs corrupted: got %q, want %q", got, want)
+			}
+			if !strings.Contains(result, "\x1b[31m") || !strings.Contains(result, "\x1b[0m") {
+				t.Errorf("expected surrounding ANSI preserved, got %q", result)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // State Transition Tests (td-2e75f54f)
 // ============================================================================
@@ -439,6 +480,43 @@ func TestExitInteractiveMode_WhenAlreadyExited(t *testing.T) {
 	}
 }
 
+// TestExitInteractiveMode_ReturnsFlushForBufferedInput tests buffered keystrokes
+// are returned as a command rather than sent in the background
+func TestExitInteractiveMode_ReturnsFlushForBufferedInput(t *testing.T) {
+	p := &Plugin{
+		viewMode:         ViewModeInteractive,
+		interactiveState: &InteractiveState{Active: true, TargetSession: "test", PendingInput: "ls"},
+	}
+	if cmd := p.exitInteractiveMode(); cmd == nil {
+		t.Error("expected flush command for buffered input")
+	}
+
+	p.viewMode = ViewModeInteractive
+	p.interactiveState = &InteractiveState{Active: true, TargetSession: "test"}
+	if cmd := p.exitInteractiveMode(); cmd != nil {
+		t.Error("expected nil command with nothing buffered")
+	}
+}
+
+// TestPluginFocusedMsg_ExitsInteractiveWhenUnfocused tests losing focus exits
+// interactive mode and returns the flush for buffered input (td-efd736)
+func TestPluginFocusedMsg_ExitsInteractiveWhenUnfocused(t *testing.T) {
+	p := &Plugin{
+		focused:          true,
+		viewMode:         ViewModeInteractive,
+		interactiveState: &InteractiveState{Active: true, TargetSession: "test", PendingInput: "ls"},
+	}
+	p.SetFocused(false)
+	_, cmd := p.Update(plugin.PluginFocusedMsg{})
+
+	if p.viewMode != ViewModeList || p.interactiveState != nil {
+		t.Errorf("expected interactive mode to exit, viewMode=%v", p.viewMode)
+	}
+	if cmd == nil {
+		t.Error("expected flush command for buffered input")
+	}
+}
+
 // TestExitInteractiveMode_WhenStateInactive tests exitInteractiveMode with inactive state
 func TestExitInteractiveMode_WhenStateInactive(t *testing.T) {
 	p := &Plugin{
@@ -651,6 +729,79 @@ func TestDoubleEscapeDelayConstant(t *testing.T) {
 	}
 }
 
+// TestGetInteractiveEscapeDelay tests the configurable double-escape window
+func TestGetInteractiveEscapeDelay(t *testing.T) {
+	tests := []struct {
+		name string
+		ms   int
+		want time.Duration
+	}{
+		{"unset", 0, doubleEscapeDelay},
+		{"negative", -10, doubleEscapeDelay},
+		{"custom", 400, 400 * time.Millisecond},
+		{"below min", 10, minEscapeDelay},
+		{"above max", 5000, maxEscapeDelay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Default()
+			cfg.Plugins.Workspace.TmuxEscapeDelayMs = tt.ms
+			p := &Plugin{ctx: &plugin.Context{Config: cfg}}
+			if got := p.getInteractiveEscapeDelay(); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if got := (&Plugin{}).getInteractiveEscapeDelay(); got != doubleEscapeDelay {
+		t.Errorf("expected default %v with nil ctx, got %v", doubleEscapeDelay, got)
+	}
+}
+
+// TestHandleEscapeTimer_HonorsCustomDelay tests that an early timer waits out the session delay
+func TestHandleEscapeTimer_HonorsCustomDelay(t *testing.T) {
+	p := &Plugin{
+		interactiveState: &InteractiveState{
+			Active:        true,
+			TargetSession: "test",
+			EscapePressed: true,
+			EscapeTime:    time.Now(),
+			EscapeDelay:   time.Second,
+		},
+	}
+
+	cmd := p.handleEscapeTimer()
+	if cmd == nil {
+		t.Fatal("expected timer to be rescheduled")
+	}
+	if !p.interactiveState.EscapePressed {
+		t.Error("expected Escape to remain pending within custom delay")
+	}
+	if !p.interactiveState.EscapeTimerPending {
+		t.Error("expected EscapeTimerPending after reschedule")
+	}
+
+	// Second Escape inside the custom window still exits
+	p.viewMode = ViewModeInteractive
+	p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyEscape})
+	if p.viewMode != ViewModeList {
+		t.Errorf("expected double Escape within custom delay to exit, got %v", p.viewMode)
+	}
+}
+
+// TestInteractiveState_EscapeDelayDefault tests zero EscapeDelay falls back to the constant
+func TestInteractiveState_EscapeDelayDefault(t *testing.T) {
+	state := &InteractiveState{}
+	if got := state.escapeDelay(); got != doubleEscapeDelay {
+		t.Errorf("expected %v, got %v", doubleEscapeDelay, got)
+	}
+	state.EscapeDelay = 300 * time.Millisecond
+	if got := state.escapeDelay(); got != 300*time.Millisecond {
+		t.Errorf("expected 300ms, got %v", got)
+	}
+}
+
 // ============================================================================
 // InteractiveState Tests (td-2e75f54f)
 // ============================================================================
@@ -771,6 +922,251 @@ func TestViewModeInteractiveAllowsDoubleClick(t *testing.T) {
 	}
 }
 
+// TestClassifySendError tests transient vs dead classification of send failures
+func TestClassifySendError(t *testing.T) {
+	alive := func() bool { return true }
+	dead := func() bool { return false }
+
+	tests := []struct {
+		name  string
+		err   error
+		alive func() bool
+		want  sendErrorKind
+	}{
+		{"no error", nil, alive, sendErrorNone},
+		{"pane not found", fmt.Errorf("can't find pane: %%12"), alive, sendErrorDead},
+		{"no such session", fmt.Errorf("no such session: sidecar-wt"), alive, sendErrorDead},
+		{"generic error with live session", fmt.Errorf("exit status 1"), alive, sendErrorTransient},
+		{"generic error with dead session", fmt.Errorf("exit status 1"), dead, sendErrorDead},
+		{"generic error without probe", fmt.Errorf("exit status 1"), nil, sendErrorTransient},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := classifySendError(tt.err, tt.alive); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestSendWithRetry_RetriesTransientOnce tests a transient failure is retried exactly once
+func TestSendWithRetry_RetriesTransientOnce(t *testing.T) {
+	alive := func() bool { return true }
+
+	calls := 0
+	kind, err := sendWithRetry(func() error {
+		calls++
+		if calls == 1 {
+			return fmt.Errorf("exit status 1")
+		}
+		return nil
+	}, alive)
+	if kind != sendErrorNone || err != nil || calls != 2 {
+		t.Errorf("expected success on retry, got kind=%v err=%v calls=%d", kind, err, calls)
+	}
+
+	calls = 0
+	kind, _ = sendWithRetry(func() error {
+		calls++
+		return fmt.Errorf("exit status 1")
+	}, alive)
+	if kind != sendErrorTransient || calls != 2 {
+		t.Errorf("expected transient after one retry, got kind=%v calls=%d", kind, calls)
+	}
+
+	calls = 0
+	kind, _ = sendWithRetry(func() error {
+		calls++
+		return fmt.Errorf("can't find pane")
+	}, alive)
+	if kind != sendErrorDead || calls != 1 {
+		t.Errorf("expected dead without retry, got kind=%v calls=%d", kind, calls)
+	}
+}
+
+// TestInteractiveSessionDead_WorktreeShowsRestartModal tests a dead agent session prompts for restart
+func TestInteractiveSessionDead_WorktreeShowsRestartModal(t *testing.T) {
+	wt := &Worktree{Name: "feature", Agent: &Agent{TmuxSession: "sidecar-wt-feature"}}
+	p := &Plugin{
+		worktrees:        []*Worktree{wt},
+		viewMode:         ViewModeInteractive,
+		interactiveState: &InteractiveState{Active: true},
+	}
+
+	p.Update(InteractiveSessionDeadMsg{})
+
+	if p.viewMode != ViewModeSessionDead {
+		t.Fatalf("expected ViewModeSessionDead, got %v", p.viewMode)
+	}
+	if p.sessionDeadWorktree != wt {
+		t.Error("expected dead session worktree to be recorded")
+	}
+	if p.interactiveState != nil {
+		t.Error("expected interactive state cleared")
+	}
+
+	p.handleSessionDeadKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.viewMode != ViewModeList || p.sessionDeadWorktree != nil {
+		t.Error("expected esc to dismiss the restart prompt")
+	}
+}
+
+// TestInteractiveSendError_StoresLastError tests a transient failure is surfaced without exiting
+func TestInteractiveSendError_StoresLastError(t *testing.T) {
+	p := &Plugin{
+		viewMode:         ViewModeInteractive,
+		interactiveState: &InteractiveState{Active: true, TargetSession: "test"},
+	}
+
+	p.Update(InteractiveSendErrorMsg{Err: fmt.Errorf("exit status 1")})
+
+	if p.viewMode != ViewModeInteractive {
+		t.Errorf("expected to stay interactive, got %v", p.viewMode)
+	}
+	if p.interactiveState.LastError == nil {
+		t.Fatal("expected LastError to be stored")
+	}
+	if hint := p.interactiveErrorHint(); !strings.Contains(hint, "input dropped: exit status 1") {
+		t.Errorf("expected error hint to show the failure, got %q", hint)
+	}
+
+	p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	if p.interactiveState != nil && p.interactiveState.LastError != nil {
+		t.Error("expected next key to clear LastError")
+	}
+}
+
+// TestQueueInput_PreservesOrderingWithSpecialKeys tests literal runes are coalesced
+// and flushed ahead of interleaved special keys
+func TestQueueInput_PreservesOrderingWithSpecialKeys(t *testing.T) {
+	state := &InteractiveState{}
+
+	input := []keySpec{
+		{"a", true},
+		{"b", true},
+		{"Enter", false},
+		{"c", true},
+		{"Left", false},
+		{"Left", false},
+		{"d", true},
+		{"e", true},
+	}
+
+	var sent []keySpec
+	for _, k := range input {
+		sent = append(sent, state.queueInput(k)...)
+	}
+	sent = append(sent, state.takeBufferedInput()...)
+
+	want := []keySpec{
+		{"ab", true},
+		{"Enter", false},
+		{"c", true},
+		{"Left", false},
+		{"Left", false},
+		{"de", true},
+	}
+	if len(sent) != len(want) {
+		t.Fatalf("expected %d sends, got %d: %v", len(want), len(sent), sent)
+	}
+	for i := range want {
+		if sent[i] != want[i] {
+			t.Errorf("send %d: expected %+v, got %+v", i, want[i], sent[i])
+		}
+	}
+	if state.PendingInput != "" {
+		t.Errorf("expected empty buffer after drain, got %q", state.PendingInput)
+	}
+}
+
+// TestHandleInteractiveKeys_BuffersLiteralInput tests literal keys are buffered and flushed together
+func TestHandleInteractiveKeys_BuffersLiteralInput(t *testing.T) {
+	p := &Plugin{
+		viewMode: ViewModeInteractive,
+		interactiveState: &InteractiveState{
+			Active:        true,
+			TargetSession: "test",
+		},
+	}
+
+	p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")})
+	p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+
+	if p.interactiveState.PendingInput != "hi" {
+		t.Errorf("expected buffered input %q, got %q", "hi", p.interactiveState.PendingInput)
+	}
+	if !p.interactiveState.InputFlushPending {
+		t.Error("expected a flush to be scheduled")
+	}
+
+	if cmd := p.handleInputFlush(p.inputFlushGeneration); cmd == nil {
+		t.Error("expected flush command for buffered input")
+	}
+	if p.interactiveState.PendingInput != "" || p.interactiveState.InputFlushPending {
+		t.Error("expected buffer drained and flush flag cleared")
+	}
+	if cmd := p.handleInputFlush(p.inputFlushGeneration); cmd != nil {
+		t.Error("expected nil flush command with empty buffer")
+	}
+}
+
+// TestHandleEscapeTimer_KeepsInputFlushInFlight tests an escape timer flush
+// doesn't let a second flush timer start while the first is pending
+func TestHandleEscapeTimer_KeepsInputFlushInFlight(t *testing.T) {
+	p := &Plugin{
+		viewMode: ViewModeInteractive,
+		interactiveState: &InteractiveState{
+			Active:        true,
+			TargetSession: "test",
+		},
+	}
+
+	p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	gen := p.inputFlushGeneration
+
+	// The escape timer fires with no Escape pending and flushes the buffer
+	if cmd := p.handleEscapeTimer(); cmd == nil {
+		t.Fatal("expected buffered input to be flushed")
+	}
+	if !p.interactiveState.InputFlushPending {
+		t.Error("expected the flush timer to stay pending")
+	}
+
+	// More typing reuses the timer already in flight
+	p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if p.inputFlushGeneration != gen {
+		t.Errorf("expected no second flush timer, generation %d -> %d", gen, p.inputFlushGeneration)
+	}
+
+	// A timer from an older generation is ignored
+	if cmd := p.handleInputFlush(gen - 1); cmd != nil || p.interactiveState.PendingInput != "b" {
+		t.Error("expected stale flush timer to be ignored")
+	}
+	if cmd := p.handleInputFlush(gen); cmd == nil || p.interactiveState.InputFlushPending {
+		t.Error("expected current flush timer to send input and clear the flag")
+	}
+}
+
+// TestHandleEscapeTimer_FlushesBufferedInputFirst tests buffered input precedes the forwarded Escape
+func TestHandleEscapeTimer_FlushesBufferedInputFirst(t *testing.T) {
+	p := &Plugin{
+		interactiveState: &InteractiveState{
+			Active:        true,
+			TargetSession: "test",
+			PendingInput:  "xy",
+			EscapePressed: true,
+		},
+	}
+
+	if cmd := p.handleEscapeTimer(); cmd == nil {
+		t.Fatal("expected escape to be forwarded")
+	}
+	if p.interactiveState.PendingInput != "" {
+		t.Errorf("expected buffer flushed with escape, got %q", p.interactiveState.PendingInput)
+	}
+}
+
 // TestGetInteractiveExitKey_Default tests default exit key when no config is set
 func TestGetInteractiveExitKey_Default(t *testing.T) {
 	p := &Plugin{ctx: nil}
@@ -862,6 +1258,108 @@ func TestForwardScrollToTmux_ScrollDown(t *testing.T) {
 	}
 }
 
+// TestClampScrollOffset tests that scroll offsets stay within the buffer
+func TestClampScrollOffset(t *testing.T) {
+	tests := []struct {
+		name          string
+		offset        int
+		lineCount     int
+		visibleHeight int
+		expected      int
+	}{
+		{"within range", 10, 100, 20, 10},
+		{"at top", 80, 100, 20, 80},
+		{"past top", 500, 100, 20, 80},
+		{"negative", -5, 100, 20, 0},
+		{"buffer shorter than view", 10, 15, 20, 0},
+		{"empty buffer", 3, 0, 20, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := clampScrollOffset(tt.offset, tt.lineCount, tt.visibleHeight)
+			if got != tt.expected {
+				t.Errorf("clampScrollOffset(%d, %d, %d) = %d, want %d",
+					tt.offset, tt.lineCount, tt.visibleHeight, got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestScrollInteractiveOutput_ClampsToBuffer tests scroll keys against buffer length
+func TestScrollInteractiveOutput_ClampsToBuffer(t *testing.T) {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	buf := NewOutputBuffer(200)
+	buf.Write(strings.Join(lines, "\n"))
+	p := &Plugin{
+		width:            120,
+		height:           40,
+		autoScrollOutput: true,
+		shellSelected:    true,
+		shells:           []*ShellSession{{Agent: &Agent{OutputBuf: buf}}},
+		interactiveState: &InteractiveState{Active: true},
+	}
+	_, visibleHeight := p.calculatePreviewDimensions()
+	maxOffset := buf.LineCount() - visibleHeight
+
+	p.scrollInteractiveOutput(1000)
+	if p.previewOffset != maxOffset {
+		t.Errorf("expected previewOffset clamped to %d, got %d", maxOffset, p.previewOffset)
+	}
+	if p.autoScrollOutput {
+		t.Error("expected autoScrollOutput=false while scrolled back")
+	}
+	if hint := p.interactiveScrollHint(); !strings.Contains(hint, fmt.Sprintf("[-%d lines]", maxOffset)) {
+		t.Errorf("expected scroll indicator, got %q", hint)
+	}
+
+	p.scrollInteractiveOutput(-1000)
+	if p.previewOffset != 0 {
+		t.Errorf("expected previewOffset=0 at bottom, got %d", p.previewOffset)
+	}
+	if !p.autoScrollOutput {
+		t.Error("expected autoScrollOutput=true after returning to bottom")
+	}
+	if hint := p.interactiveScrollHint(); hint != "" {
+		t.Errorf("expected no indicator at bottom, got %q", hint)
+	}
+}
+
+// TestHandleInteractiveKeys_ScrollKeysNotForwarded tests that scroll keys stay local
+func TestHandleInteractiveKeys_ScrollKeysNotForwarded(t *testing.T) {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	buf := NewOutputBuffer(200)
+	buf.Write(strings.Join(lines, "\n"))
+	p := &Plugin{
+		width:            120,
+		height:           40,
+		viewMode:         ViewModeInteractive,
+		autoScrollOutput: true,
+		shellSelected:    true,
+		shells:           []*ShellSession{{Agent: &Agent{OutputBuf: buf}}},
+		interactiveState: &InteractiveState{Active: true, TargetSession: "test"},
+	}
+
+	cmd := p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyPgUp, Alt: true})
+	if cmd != nil {
+		t.Error("expected no command for local scroll key")
+	}
+	if want := p.interactiveScrollPageSize(); p.previewOffset != want {
+		t.Errorf("expected previewOffset=%d after page up, got %d", want, p.previewOffset)
+	}
+
+	p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyPgDown, Alt: true})
+	if p.previewOffset != 0 {
+		t.Errorf("expected previewOffset=0 after page down, got %d", p.previewOffset)
+	}
+}
+
 // TestForwardClickToTmux_ReturnsNil tests that click forwarding returns nil when inactive
 func TestForwardClickToTmux_ReturnsNil(t *testing.T) {
 	p := &Plugin{interactiveState: nil}
@@ -871,6 +1369,247 @@ func TestForwardClickToTmux_ReturnsNil(t *testing.T) {
 	}
 }
 
+// TestTranslateMouseToPane tests screen-to-pane coordinate translation for a known origin
+func TestTranslateMouseToPane(t *testing.T) {
+	const originX, originY, paneW, paneH = 42, 4, 80, 24
+	tests := []struct {
+		name    string
+		x, y    int
+		wantCol int
+		wantRow int
+		wantOK  bool
+	}{
+		{"origin is 1,1", 42, 4, 1, 1, true},
+		{"interior cell", 52, 9, 11, 6, true},
+		{"last cell", 42 + paneW - 1, 4 + paneH - 1, paneW, paneH, true},
+		{"left of pane", 41, 5, 0, 0, false},
+		{"above pane", 50, 3, 0, 0, false},
+		{"right of pane", 42 + paneW, 5, 0, 0, false},
+		{"below pane", 50, 4 + paneH, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			col, row, ok := translateMouseToPane(tt.x, tt.y, originX, originY, paneW, paneH)
+			if ok != tt.wantOK || col != tt.wantCol || row != tt.wantRow {
+				t.Errorf("translateMouseToPane(%d,%d) = (%d,%d,%v), want (%d,%d,%v)",
+					tt.x, tt.y, col, row, ok, tt.wantCol, tt.wantRow, tt.wantOK)
+			}
+		})
+	}
+
+	if _, _, ok := translateMouseToPane(42, 4, originX, originY, 0, 0); ok {
+		t.Error("expected ok=false for zero-size pane")
+	}
+}
+
+// TestInteractiveMouseCoords_HorizOffset tests the cursor-following horizontal
+// scroll is added to forwarded mouse columns
+func TestInteractiveMouseCoords_HorizOffset(t *testing.T) {
+	p := &Plugin{
+		width:            100,
+		height:           40,
+		shellSelected:    true,
+		interactiveState: &InteractiveState{Active: true, PaneWidth: 200, PaneHeight: 30},
+	}
+	originX, originY := p.interactivePaneOrigin()
+	displayWidth, _ := p.calculatePreviewDimensions()
+
+	col, row, ok := p.interactiveMouseCoords(originX+4, originY+2)
+	if !ok || col != 5 || row != 3 {
+		t.Fatalf("no offset: got (%d,%d,%v), want (5,3,true)", col, row, ok)
+	}
+
+	p.previewHorizOffset = 30
+	col, row, ok = p.interactiveMouseCoords(originX+4, originY+2)
+	if !ok || col != 35 || row != 3 {
+		t.Errorf("offset 30: got (%d,%d,%v), want (35,3,true)", col, row, ok)
+	}
+
+	// A stale offset past the pane edge is clamped to the last column
+	p.previewHorizOffset = 500
+	col, _, ok = p.interactiveMouseCoords(originX+displayWidth-1, originY)
+	if !ok || col != 200 {
+		t.Errorf("clamped: got (%d,%v), want (200,true)", col, ok)
+	}
+}
+
+// TestSGRMouseEventFromMsg tests Bubble Tea mouse messages map to SGR button codes
+func TestSGRMouseEventFromMsg(t *testing.T) {
+	tests := []struct {
+		name        string
+		msg         tea.MouseMsg
+		wantButton  int
+		wantRelease bool
+		wantOK      bool
+	}{
+		{"left press", tea.MouseMsg{Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}, 0, false, true},
+		{"left release", tea.MouseMsg{Button: tea.MouseButtonLeft, Action: tea.MouseActionRelease}, 0, true, true},
+		{"right press", tea.MouseMsg{Button: tea.MouseButtonRight, Action: tea.MouseActionPress}, 2, false, true},
+		{"left drag", tea.MouseMsg{Button: tea.MouseButtonLeft, Action: tea.MouseActionMotion}, 32, false, true},
+		{"wheel up", tea.MouseMsg{Button: tea.MouseButtonWheelUp, Action: tea.MouseActionPress}, 64, false, true},
+		{"wheel down", tea.MouseMsg{Button: tea.MouseButtonWheelDown, Action: tea.MouseActionPress}, 65, false, true},
+		{"ctrl click", tea.MouseMsg{Button: tea.MouseButtonLeft, Action: tea.MouseActionPress, Ctrl: true}, 16, false, true},
+		{"buttonless release", tea.MouseMsg{Button: tea.MouseButtonNone, Action: tea.MouseActionRelease}, 0, true, true},
+		{"hover", tea.MouseMsg{Button: tea.MouseButtonNone, Action: tea.MouseActionMotion}, 0, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ev, ok := sgrMouseEventFromMsg(tt.msg, 3, 7)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok=%v, got %v", tt.wantOK, ok)
+			}
+			if !ok {
+				return
+			}
+			if ev.button != tt.wantButton || ev.release != tt.wantRelease {
+				t.Errorf("got button=%d release=%v, want button=%d release=%v",
+					ev.button, ev.release, tt.wantButton, tt.wantRelease)
+			}
+			if ev.col != 3 || ev.row != 7 {
+				t.Errorf("expected coords (3,7), got (%d,%d)", ev.col, ev.row)
+			}
+		})
+	}
+}
+
+// TestHandleInteractiveMouse_RequiresMouseReporting tests events fall through when the app isn't reporting
+func TestHandleInteractiveMouse_RequiresMouseReporting(t *testing.T) {
+	p := &Plugin{
+		width:    120,
+		height:   40,
+		viewMode: ViewModeInteractive,
+		interactiveState: &InteractiveState{
+			Active:        true,
+			TargetSession: "test",
+		},
+		shellSelected: true,
+	}
+
+	msg := tea.MouseMsg{X: 10, Y: 10, Button: tea.MouseButtonLeft, Action: tea.MouseActionPress}
+	if _, handled := p.handleInteractiveMouse(msg); handled {
+		t.Error("expected event to fall through without mouse reporting")
+	}
+
+	p.interactiveState.MouseReportingEnabled = true
+	if cmd, handled := p.handleInteractiveMouse(msg); !handled || cmd == nil {
+		t.Error("expected in-pane event to be forwarded with mouse reporting enabled")
+	}
+}
+
+// TestParseCursorQueryOutput tests parsing of display-message cursor output including alternate_on
+// and pane_in_mode
+func TestParseCursorQueryOutput(t *testing.T) {
+	tests := []struct {
+		name                       string
+		output                     string
+		wantRow, wantCol           int
+		wantHeight, wantWidth      int
+		wantVisible, wantAltScreen bool
+		wantInMode                 bool
+		wantOK                     bool
+	}{
+		{"alternate screen on", "4,10,1,24,80,1\n", 10, 4, 24, 80, true, true, false, true},
+		{"alternate screen off", "4,10,1,24,80,0\n", 10, 4, 24, 80, true, false, false, true},
+		{"hidden cursor on alternate screen", "0,0,0,40,120,1", 0, 0, 40, 120, false, true, false, true},
+		{"pane in copy mode", "4,10,1,24,80,0,1\n", 10, 4, 24, 80, true, false, true, true},
+		{"pane not in mode", "4,10,1,24,80,0,0", 10, 4, 24, 80, true, false, false, true},
+		{"without alternate field", "4,10,1,24,80", 10, 4, 24, 80, true, false, false, true},
+		{"minimal", "3,7", 7, 3, 0, 0, true, false, false, true},
+		{"malformed", "garbage", 0, 0, 0, 0, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row, col, h, w, visible, alt, inMode, ok := parseCursorQueryOutput(tt.output)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok=%v, got %v", tt.wantOK, ok)
+			}
+			if row != tt.wantRow || col != tt.wantCol || h != tt.wantHeight || w != tt.wantWidth {
+				t.Errorf("got row=%d col=%d h=%d w=%d, want row=%d col=%d h=%d w=%d",
+					row, col, h, w, tt.wantRow, tt.wantCol, tt.wantHeight, tt.wantWidth)
+			}
+			if visible != tt.wantVisible {
+				t.Errorf("expected visible=%v, got %v", tt.wantVisible, visible)
+			}
+			if alt != tt.wantAltScreen {
+				t.Errorf("expected altScreen=%v, got %v", tt.wantAltScreen, alt)
+			}
+			if inMode != tt.wantInMode {
+				t.Errorf("expected inMode=%v, got %v", tt.wantInMode, inMode)
+			}
+		})
+	}
+}
+
+// TestCopyModeCommand tests which keys become copy-mode commands while the pane is in copy mode
+func TestCopyModeCommand(t *testing.T) {
+	tests := []struct {
+		key     string
+		want    string
+		wantMap bool
+	}{
+		{"j", "cursor-down", true},
+		{"down", "cursor-down", true},
+		{"k", "cursor-up", true},
+		{"up", "cursor-up", true},
+		{"pgup", "page-up", true},
+		{"pgdown", "page-down", true},
+		{"q", "cancel", true},
+		{"a", "", false},
+		{"enter", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := copyModeCommand(tt.key)
+		if got != tt.want || ok != tt.wantMap {
+			t.Errorf("copyModeCommand(%q) = %q, %v; want %q, %v", tt.key, got, ok, tt.want, tt.wantMap)
+		}
+	}
+}
+
+// TestHandleInteractiveKeys_CopyModeNotBuffered tests navigation keys bypass literal input in copy mode
+func TestHandleInteractiveKeys_CopyModeNotBuffered(t *testing.T) {
+	p := &Plugin{
+		viewMode: ViewModeInteractive,
+		interactiveState: &InteractiveState{
+			Active:        true,
+			TargetSession: "test-session",
+			PaneInMode:    true,
+		},
+	}
+
+	if cmd := p.handleInteractiveKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}); cmd == nil {
+		t.Error("expected a copy-mode command for j")
+	}
+	if len(p.interactiveState.PendingInput) != 0 {
+		t.Errorf("expected j not buffered as literal input in copy mode, got %v", p.interactiveState.PendingInput)
+	}
+	if p.copyModeBadge() == "" {
+		t.Error("expected copy-mode badge while pane is in copy mode")
+	}
+}
+
+// TestGetCursorPosition_AltScreenHidesCursor tests the overlay is skipped on the alternate screen
+func TestGetCursorPosition_AltScreenHidesCursor(t *testing.T) {
+	p := &Plugin{
+		interactiveState: &InteractiveState{
+			Active:        true,
+			CursorVisible: true,
+		},
+	}
+
+	if _, _, _, _, visible, _ := p.getCursorPosition(); !visible {
+		t.Error("expected cursor visible on the normal screen")
+	}
+
+	p.interactiveState.AltScreen = true
+	if _, _, _, _, visible, _ := p.getCursorPosition(); visible {
+		t.Error("expected cursor overlay hidden on the alternate screen")
+	}
+}
+
 // TestDetectBracketedPasteMode_EnabledOnly tests detection when only enable sequence is present
 func TestDetectBracketedPasteMode_EnabledOnly(t *testing.T) {
 	output := "some output\x1b[?2004hmore output"
@@ -1207,3 +1946,36 @@ func TestCalculatePreviewDimensions_WidthConsistency(t *testing.T) {
 		})
 	}
 }
+
+// TestFollowCursorHorizOffset tests the horizontal offset keeps the cursor within the visible width
+func TestFollowCursorHorizOffset(t *testing.T) {
+	tests := []struct {
+		name      string
+		offset    int
+		cursorCol int
+		width     int
+		want      int
+	}{
+		{"cursor visible, no scroll", 0, 10, 40, 0},
+		{"cursor at last visible column", 0, 39, 40, 0},
+		{"cursor past right edge", 0, 40, 40, 1},
+		{"cursor far past right edge", 0, 100, 40, 61},
+		{"cursor within scrolled view", 20, 30, 40, 20},
+		{"cursor before left edge", 20, 5, 40, 5},
+		{"cursor back at start", 20, 0, 40, 0},
+		{"zero width", 10, 5, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := followCursorHorizOffset(tt.offset, tt.cursorCol, tt.width)
+			if got != tt.want {
+				t.Errorf("followCursorHorizOffset(%d, %d, %d) = %d, want %d",
+					tt.offset, tt.cursorCol, tt.width, got, tt.want)
+			}
+			if tt.width > 0 && (tt.cursorCol < got || tt.cursorCol >= got+tt.width) {
+				t.Errorf("cursor %d not within [%d, %d)", tt.cursorCol, got, got+tt.width)
+			}
+		})
+	}
+}
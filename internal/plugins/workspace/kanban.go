@@ -105,9 +105,7 @@ func (p *Plugin) applyKanbanSelectionChange(oldShellSelected bool, oldShellIdx,
 		(p.shellSelected && p.selectedShellIdx != oldShellIdx) ||
 		(!p.shellSelected && p.selectedIdx != oldWorktreeIdx)
 	if selectionChanged {
-		p.previewOffset = 0
-		p.autoScrollOutput = true
-		p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot for new selection
+		p.switchPreviewState(p.worktreeNameAt(oldShellSelected, oldWorktreeIdx))
 		p.taskLoading = false
 		p.exitInteractiveMode()
 		p.saveSelectionState()
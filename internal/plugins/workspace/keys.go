@@ -30,6 +30,8 @@ func (p *Plugin) handleKeyPress(msg tea.KeyMsg) tea.Cmd {
 		return p.handleConfirmDeleteKeys(msg)
 	case ViewModeConfirmDeleteShell:
 		return p.handleConfirmDeleteShellKeys(msg)
+	case ViewModeSessionDead:
+		return p.handleSessionDeadKeys(msg)
 	case ViewModeCommitForMerge:
 		return p.handleCommitForMergeKeys(msg)
 	case ViewModePromptPicker:
@@ -365,7 +367,8 @@ func (p *Plugin) executeDelete() tea.Cmd {
 
 	// Clear preview pane content
 	p.diffContent = ""
-	p.diffRaw = ""
+	p.setDiffRaw("")
+	p.clearParsedDiffCache()
 	p.cachedTaskID = ""
 	p.cachedTask = nil
 
@@ -473,11 +476,80 @@ func (p *Plugin) clearConfirmDeleteShellModal() {
 	p.deleteShellModalWidth = 0
 }
 
+// handleSessionDeadKeys handles keys in the session dead modal.
+func (p *Plugin) handleSessionDeadKeys(msg tea.KeyMsg) tea.Cmd {
+	p.ensureSessionDeadModal()
+	if p.sessionDeadModal == nil {
+		p.viewMode = ViewModeList
+		return nil
+	}
+
+	switch msg.String() {
+	case "esc", "q":
+		return p.cancelSessionDead()
+	case "j", "down", "l", "right":
+		p.sessionDeadModal.HandleKey(tea.KeyMsg{Type: tea.KeyTab})
+		return nil
+	case "k", "up", "h", "left":
+		p.sessionDeadModal.HandleKey(tea.KeyMsg{Type: tea.KeyShiftTab})
+		return nil
+	}
+
+	action, cmd := p.sessionDeadModal.HandleKey(msg)
+	switch action {
+	case "cancel", sessionDeadCancelID:
+		return p.cancelSessionDead()
+	case sessionDeadRestartID:
+		return p.executeSessionDeadRestart()
+	}
+	return cmd
+}
+
+// executeSessionDeadRestart restarts the agent whose interactive session ended.
+func (p *Plugin) executeSessionDeadRestart() tea.Cmd {
+	wt := p.sessionDeadWorktree
+	p.viewMode = ViewModeList
+	p.clearSessionDeadModal()
+	if wt == nil {
+		return nil
+	}
+	// Stop clears the stale agent record (kill-session is a no-op for a dead session)
+	return tea.Sequence(
+		p.StopAgent(wt),
+		func() tea.Msg {
+			return restartAgentMsg{worktree: wt}
+		},
+	)
+}
+
+// cancelSessionDead closes the session dead modal without restarting.
+func (p *Plugin) cancelSessionDead() tea.Cmd {
+	p.viewMode = ViewModeList
+	p.clearSessionDeadModal()
+	return nil
+}
+
+func (p *Plugin) clearSessionDeadModal() {
+	p.sessionDeadWorktree = nil
+	p.sessionDeadModal = nil
+	p.sessionDeadModalWidth = 0
+}
+
 // handleListKeys handles keys in list view (and kanban view).
 func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 	// Clear any deletion warnings on key interaction
 	p.deleteWarnings = nil
 
+	if p.taskLinkPickMode {
+		return p.handleTaskLinkPickKey(msg)
+	}
+
+	if p.outputSearchMode {
+		if cmd, handled := p.handleOutputSearchKey(msg); handled {
+			return cmd
+		}
+	}
+
 	switch msg.String() {
 	case "j", "down":
 		if p.viewMode == ViewModeKanban {
@@ -486,8 +558,7 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 			return nil
 		}
 		if p.activePane == PaneSidebar {
-			p.moveCursor(1)
-			return p.loadSelectedContent()
+			return tea.Batch(p.moveCursor(1), p.loadSelectedContent())
 		}
 		// Scroll down toward newer content (decrease offset from bottom)
 		if p.previewOffset > 0 {
@@ -504,8 +575,7 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 			return nil
 		}
 		if p.activePane == PaneSidebar {
-			p.moveCursor(-1)
-			return p.loadSelectedContent()
+			return tea.Batch(p.moveCursor(-1), p.loadSelectedContent())
 		}
 		// Scroll up toward older content (increase offset from bottom)
 		p.autoScrollOutput = false
@@ -519,21 +589,22 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 		}
 		if p.activePane == PaneSidebar {
 			// Jump to top = select first shell if any, otherwise first worktree
+			var flush tea.Cmd
 			if len(p.shells) > 0 {
 				p.shellSelected = true
 				p.selectedShellIdx = 0
 				// Exit interactive mode when switching selection (td-fc758e88)
-				p.exitInteractiveMode()
+				flush = p.exitInteractiveMode()
 				p.saveSelectionState()
 			} else if len(p.worktrees) > 0 {
 				p.shellSelected = false
 				p.selectedIdx = 0
 				// Exit interactive mode when switching selection (td-fc758e88)
-				p.exitInteractiveMode()
+				flush = p.exitInteractiveMode()
 				p.saveSelectionState()
 			}
 			p.scrollOffset = 0
-			return p.loadSelectedContent()
+			return tea.Batch(flush, p.loadSelectedContent())
 		}
 		// Go to top (oldest content) - pause auto-scroll
 		p.autoScrollOutput = false
@@ -555,10 +626,10 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 				p.shellSelected = false
 				p.selectedIdx = len(p.worktrees) - 1
 				// Exit interactive mode when switching selection (td-fc758e88)
-				p.exitInteractiveMode()
+				flush := p.exitInteractiveMode()
 				p.saveSelectionState()
 				p.ensureVisible()
-				return p.loadSelectedContent()
+				return tea.Batch(flush, p.loadSelectedContent())
 			}
 			// No worktrees, stay on shell
 			return nil
@@ -733,6 +804,11 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 		if p.activePane == PanePreview && p.previewTab == PreviewTabDiff {
 			return p.openFilePicker()
 		}
+	case "/":
+		// Search agent output (when in preview pane on output tab)
+		if p.activePane == PanePreview && p.previewTab == PreviewTabOutput && !p.shellSelected {
+			p.startOutputSearch()
+		}
 	case "r":
 		return func() tea.Msg { return RefreshMsg{} }
 	case "i":
@@ -845,12 +921,20 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 			p.renameShellError = ""
 		}
 	case "y":
+		// In preview pane on diff tab: copy the diff
+		if p.activePane == PanePreview && p.previewTab == PreviewTabDiff && !p.shellSelected {
+			return p.yankDiffToClipboard()
+		}
 		// Approve pending prompt on selected worktree
 		wt := p.selectedWorktree()
 		if wt != nil && wt.Status == StatusWaiting && wt.Agent != nil {
 			return p.Approve(wt)
 		}
 	case "Y":
+		// In preview pane on diff tab: copy the current file's diff
+		if p.activePane == PanePreview && p.previewTab == PreviewTabDiff && !p.shellSelected {
+			return p.yankFileDiffToClipboard()
+		}
 		// Approve all pending prompts
 		return p.ApproveAll()
 	case "N":
@@ -886,6 +970,12 @@ func (p *Plugin) handleListKeys(msg tea.KeyMsg) tea.Cmd {
 		p.fetchPRCursor = 0
 		p.fetchPRError = ""
 		return p.fetchPRList()
+	case "o":
+		// In preview pane on task tab: open a link from the task
+		if p.activePane == PanePreview && p.previewTab == PreviewTabTask && !p.shellSelected {
+			return p.openTaskLinks()
+		}
+		return nil
 	case "m":
 		// In preview pane on task tab: toggle markdown render mode
 		// Otherwise: start merge workflow
@@ -36,6 +36,8 @@ type AgentOutputMsg struct {
 	CursorRow     int
 	CursorCol     int
 	CursorVisible bool
+	AltScreen     bool // Pane is on the alternate screen (vim, less)
+	PaneInMode    bool // Pane is in tmux copy mode
 	HasCursor     bool // True if cursor position was captured
 	PaneHeight    int  // Tmux pane height for cursor offset calculation
 	PaneWidth     int  // Tmux pane width for display alignment
@@ -193,6 +195,7 @@ type CommitStatusLoadedMsg struct {
 	Epoch         uint64 // Epoch when request was issued (for stale detection)
 	WorkspaceName string
 	Commits       []CommitStatusInfo
+	Upstream      *UpstreamStatus // nil when the branch has no upstream
 	Err           error
 }
 
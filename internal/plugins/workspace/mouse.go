@@ -40,6 +40,13 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) tea.Cmd {
 	// mouse activity — see the split-CSI comment in handleInteractiveKeys.
 	p.lastMouseEventTime = time.Now()
 
+	// Forward in-pane mouse events to apps that enabled mouse reporting
+	if p.viewMode == ViewModeInteractive {
+		if cmd, handled := p.handleInteractiveMouse(msg); handled {
+			return cmd
+		}
+	}
+
 	if p.viewMode == ViewModeCreate {
 		return p.handleCreateModalMouse(msg)
 	}
@@ -56,6 +63,10 @@ func (p *Plugin) handleMouse(msg tea.MouseMsg) tea.Cmd {
 		return p.handleConfirmDeleteShellModalMouse(msg)
 	}
 
+	if p.viewMode == ViewModeSessionDead {
+		return p.handleSessionDeadModalMouse(msg)
+	}
+
 	if p.viewMode == ViewModePromptPicker {
 		return p.handlePromptPickerModalMouse(msg)
 	}
@@ -234,6 +245,24 @@ func (p *Plugin) handleConfirmDeleteShellModalMouse(msg tea.MouseMsg) tea.Cmd {
 	return nil
 }
 
+func (p *Plugin) handleSessionDeadModalMouse(msg tea.MouseMsg) tea.Cmd {
+	p.ensureSessionDeadModal()
+	if p.sessionDeadModal == nil {
+		return nil
+	}
+
+	action := p.sessionDeadModal.HandleMouse(msg, p.mouseHandler)
+	switch action {
+	case "":
+		return nil
+	case "cancel", sessionDeadCancelID:
+		return p.cancelSessionDead()
+	case sessionDeadRestartID:
+		return p.executeSessionDeadRestart()
+	}
+	return nil
+}
+
 func (p *Plugin) handleTypeSelectorModalMouse(msg tea.MouseMsg) tea.Cmd {
 	p.ensureTypeSelectorModal()
 	if p.typeSelectorModal == nil {
@@ -466,8 +495,9 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 
 	// Exit interactive mode when clicking outside preview pane (td-80d96956)
 	if p.viewMode == ViewModeInteractive && action.Region.ID != regionPreviewPane {
-		p.exitInteractiveMode()
+		flush := p.exitInteractiveMode()
 		// Continue to handle the click normally
+		return tea.Batch(flush, p.handleMouseClick(action))
 	}
 	if p.viewMode == ViewModeInteractive && action.Region.ID == regionPreviewPane {
 		p.activePane = PanePreview
@@ -518,11 +548,10 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 				shellIdx := -(idx + 1)
 				if shellIdx >= 0 && shellIdx < len(p.shells) {
 					if !p.shellSelected || p.selectedShellIdx != shellIdx {
+						prevName := p.worktreeNameAt(p.shellSelected, p.selectedIdx)
 						p.shellSelected = true
 						p.selectedShellIdx = shellIdx
-						p.previewOffset = 0
-						p.autoScrollOutput = true
-						p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot for new selection
+						p.switchPreviewState(prevName)
 						p.taskLoading = false // Reset task loading on selection change (td-3668584f)
 						// Exit interactive mode when switching selection (td-fc758e88)
 						p.exitInteractiveMode()
@@ -534,11 +563,10 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 			} else if idx >= 0 && idx < len(p.worktrees) {
 				// Worktree clicked
 				if p.shellSelected || p.selectedIdx != idx {
+					prevName := p.worktreeNameAt(p.shellSelected, p.selectedIdx)
 					p.shellSelected = false
 					p.selectedIdx = idx
-					p.previewOffset = 0
-					p.autoScrollOutput = true
-					p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot for new selection
+					p.switchPreviewState(prevName)
 					p.taskLoading = false // Reset task loading on selection change (td-3668584f)
 					// Exit interactive mode when switching selection (td-fc758e88)
 					p.exitInteractiveMode()
@@ -569,6 +597,12 @@ func (p *Plugin) handleMouseClick(action mouse.MouseAction) tea.Cmd {
 				return p.loadTaskDetailsIfNeeded()
 			}
 		}
+	case regionTaskURL:
+		// Click on a link in the Task tab - open it in the browser
+		if url, ok := action.Region.Data.(string); ok && url != "" {
+			p.taskLinkPickMode = false
+			return openInBrowser(url)
+		}
 	case regionKanbanCard:
 		// Click on kanban card - select it
 		if data, ok := action.Region.Data.(kanbanCardData); ok {
@@ -818,7 +852,7 @@ func (p *Plugin) scrollSidebar(delta int) tea.Cmd {
 	oldWorktreeIdx := p.selectedIdx
 
 	// Delegate to moveCursor which handles multi-shell navigation properly
-	p.moveCursor(delta)
+	flush := p.moveCursor(delta)
 
 	// Check if selection actually changed
 	selectionChanged := p.shellSelected != oldShellSelected ||
@@ -826,7 +860,7 @@ func (p *Plugin) scrollSidebar(delta int) tea.Cmd {
 		(!p.shellSelected && p.selectedIdx != oldWorktreeIdx)
 
 	if selectionChanged {
-		return p.loadSelectedContent()
+		return tea.Batch(flush, p.loadSelectedContent())
 	}
 	return nil
 }
@@ -0,0 +1,241 @@
+package workspace
+
+import (
+	"fmt"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/styles"
+	"github.com/marcus/sidecar/internal/ui"
+)
+
+// outputMatch is a search hit in the agent output buffer.
+type outputMatch struct {
+	Line     int // Buffer line index
+	StartCol int // Display column of the first matched cell
+	EndCol   int // Display column of the last matched cell (inclusive)
+}
+
+// findOutputMatches returns case-insensitive matches of query in lines, in
+// buffer order. ANSI codes are stripped and tabs expanded before matching so
+// columns line up with the rendered output.
+func findOutputMatches(lines []string, query string) []outputMatch {
+	if query == "" {
+		return nil
+	}
+	var matches []outputMatch
+	for i, line := range lines {
+		for _, m := range lineMatchCols(line, query) {
+			matches = append(matches, outputMatch{Line: i, StartCol: m[0], EndCol: m[1]})
+		}
+	}
+	return matches
+}
+
+// lineMatchCols returns [start, end] display columns (end inclusive) of each
+// case-insensitive, non-overlapping match of query in a single output line.
+// Matching folds case rune by rune on the original text, since lowercasing
+// can change byte lengths and shift the columns.
+func lineMatchCols(line, query string) [][2]int {
+	plain := []rune(ansi.Strip(ui.ExpandTabs(line, tabStopWidth)))
+	q := []rune(query)
+	if len(q) == 0 || ansi.StringWidth(query) == 0 {
+		return nil
+	}
+
+	var cols [][2]int
+	for i := 0; i+len(q) <= len(plain); {
+		if !runesEqualFold(plain[i:i+len(q)], q) {
+			i++
+			continue
+		}
+		start := ansi.StringWidth(string(plain[:i]))
+		width := ansi.StringWidth(string(plain[i : i+len(q)]))
+		cols = append(cols, [2]int{start, start + width - 1})
+		i += len(q)
+	}
+	return cols
+}
+
+// runesEqualFold reports whether a and b are equal under simple Unicode
+// case folding, like strings.EqualFold for equal-length rune slices.
+func runesEqualFold(a, b []rune) bool {
+	for i := range a {
+		if a[i] == b[i] {
+			continue
+		}
+		r := unicode.SimpleFold(a[i])
+		for r != a[i] && r != b[i] {
+			r = unicode.SimpleFold(r)
+		}
+		if r != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// highlightOutputMatches injects a highlight background behind each query match in line.
+func highlightOutputMatches(line, query string) string {
+	for _, m := range lineMatchCols(line, query) {
+		line = ui.InjectCharacterRangeBackground(line, m[0], m[1])
+	}
+	return line
+}
+
+// outputSearchActive reports whether output search highlights should be rendered.
+func (p *Plugin) outputSearchActive() bool {
+	return p.outputSearchMode && p.outputSearchQuery != ""
+}
+
+// startOutputSearch enters output search mode with an empty query.
+func (p *Plugin) startOutputSearch() {
+	p.outputSearchMode = true
+	p.outputSearchCommitted = false
+	p.outputSearchQuery = ""
+	p.outputSearchMatches = nil
+	p.outputSearchCursor = 0
+}
+
+// exitOutputSearch leaves output search mode and clears the query.
+func (p *Plugin) exitOutputSearch() {
+	p.outputSearchMode = false
+	p.outputSearchCommitted = false
+	p.outputSearchQuery = ""
+	p.outputSearchMatches = nil
+	p.outputSearchCursor = 0
+}
+
+// handleOutputSearchKey handles keys while output search is active.
+// Like the file browser's content search: type a query, Enter to commit,
+// then n/N move between matches. Returns handled=false for keys that
+// should fall through to normal preview handling (e.g. j/k scrolling).
+func (p *Plugin) handleOutputSearchKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	key := msg.String()
+
+	if key == "esc" {
+		p.exitOutputSearch()
+		return nil, true
+	}
+
+	// Phase 1: typing the query
+	if !p.outputSearchCommitted {
+		switch key {
+		case "enter":
+			if p.outputSearchQuery != "" {
+				p.outputSearchCommitted = true
+			}
+		case "backspace":
+			if p.outputSearchQuery != "" {
+				runes := []rune(p.outputSearchQuery)
+				p.outputSearchQuery = string(runes[:len(runes)-1])
+				p.updateOutputMatches()
+			}
+		default:
+			if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+				p.outputSearchQuery += string(msg.Runes)
+				p.updateOutputMatches()
+			}
+		}
+		return nil, true
+	}
+
+	// Phase 2: query committed - n/N navigate, "/" edits a new query
+	switch key {
+	case "n":
+		p.stepOutputMatch(1)
+		return nil, true
+	case "N":
+		p.stepOutputMatch(-1)
+		return nil, true
+	case "/":
+		p.startOutputSearch()
+		return nil, true
+	}
+	return nil, false
+}
+
+// selectedOutputLines returns the selected worktree's output lines, or nil.
+func (p *Plugin) selectedOutputLines() []string {
+	wt := p.selectedWorktree()
+	if wt == nil || wt.Agent == nil || wt.Agent.OutputBuf == nil {
+		return nil
+	}
+	return wt.Agent.OutputBuf.Lines()
+}
+
+// updateOutputMatches recomputes matches for the current query and jumps to
+// the most recent one (closest to the live bottom).
+func (p *Plugin) updateOutputMatches() {
+	p.outputSearchMatches = findOutputMatches(p.selectedOutputLines(), p.outputSearchQuery)
+	p.outputSearchCursor = len(p.outputSearchMatches) - 1
+	if p.outputSearchCursor < 0 {
+		p.outputSearchCursor = 0
+		return
+	}
+	p.scrollToOutputMatch()
+}
+
+// stepOutputMatch moves to the next (delta=1, newer) or previous (delta=-1,
+// older) match, wrapping around. Matches are recomputed first since the
+// buffer may have grown since the last search.
+func (p *Plugin) stepOutputMatch(delta int) {
+	p.outputSearchMatches = findOutputMatches(p.selectedOutputLines(), p.outputSearchQuery)
+	n := len(p.outputSearchMatches)
+	if n == 0 {
+		p.outputSearchCursor = 0
+		return
+	}
+	p.outputSearchCursor = ((p.outputSearchCursor+delta)%n + n) % n
+	p.scrollToOutputMatch()
+}
+
+// scrollToOutputMatch sets previewOffset so the current match is centered.
+func (p *Plugin) scrollToOutputMatch() {
+	if p.outputSearchCursor >= len(p.outputSearchMatches) {
+		return
+	}
+	lineCount := len(p.selectedOutputLines())
+	_, visibleHeight := p.calculatePreviewDimensions()
+	offset := outputMatchOffset(p.outputSearchMatches[p.outputSearchCursor].Line, lineCount, visibleHeight)
+
+	p.resetScrollBaseLineCount()
+	p.previewOffset = offset
+	if offset == 0 {
+		p.autoScrollOutput = true
+		return
+	}
+	p.autoScrollOutput = false
+	p.captureScrollBaseLineCount() // td-f7c8be: keep the match in place while output grows
+}
+
+// outputMatchOffset returns the from-bottom previewOffset that centers line
+// in a view of visibleHeight lines, clamped to the buffer.
+func outputMatchOffset(line, lineCount, visibleHeight int) int {
+	offset := lineCount - line - (visibleHeight+1)/2
+	return clampScrollOffset(offset, lineCount, visibleHeight)
+}
+
+// renderOutputSearchBar renders the search prompt and match position for the hint line.
+func (p *Plugin) renderOutputSearchBar() string {
+	promptStyle := lipgloss.NewStyle().Foreground(styles.Primary).Bold(true)
+	bar := promptStyle.Render("/") + p.outputSearchQuery
+	if !p.outputSearchCommitted {
+		bar += "█"
+	}
+
+	switch {
+	case p.outputSearchQuery == "":
+		return bar
+	case len(p.outputSearchMatches) == 0:
+		return bar + "  " + dimText("no matches")
+	default:
+		status := fmt.Sprintf("%d/%d", p.outputSearchCursor+1, len(p.outputSearchMatches))
+		if p.outputSearchCommitted {
+			status += " • n/N next/prev • esc clear"
+		}
+		return bar + "  " + dimText(status)
+	}
+}
@@ -0,0 +1,193 @@
+package workspace
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestFindOutputMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		query string
+		want  []outputMatch
+	}{
+		{
+			name:  "no match",
+			lines: []string{"building...", "done"},
+			query: "error",
+			want:  nil,
+		},
+		{
+			name:  "empty query",
+			lines: []string{"anything"},
+			query: "",
+			want:  nil,
+		},
+		{
+			name:  "multiple matches across and within lines",
+			lines: []string{"error: a", "ok", "error error"},
+			query: "error",
+			want: []outputMatch{
+				{Line: 0, StartCol: 0, EndCol: 4},
+				{Line: 2, StartCol: 0, EndCol: 4},
+				{Line: 2, StartCol: 6, EndCol: 10},
+			},
+		},
+		{
+			name:  "case insensitive",
+			lines: []string{"Build FAILED"},
+			query: "failed",
+			want:  []outputMatch{{Line: 0, StartCol: 6, EndCol: 11}},
+		},
+		{
+			name:  "case folds non-ASCII runes in place",
+			lines: []string{"İstanbul ÉCHEC", "\u212A8s pod"},
+			query: "échec",
+			want:  []outputMatch{{Line: 0, StartCol: 9, EndCol: 13}},
+		},
+		{
+			name:  "folded rune with a different byte length",
+			lines: []string{"\u212A8s pod k8s"},
+			query: "k8s",
+			want: []outputMatch{
+				{Line: 0, StartCol: 0, EndCol: 2},
+				{Line: 0, StartCol: 8, EndCol: 10},
+			},
+		},
+		{
+			name:  "ANSI colored line matches stripped text",
+			lines: []string{"\x1b[31mfatal\x1b[0m: \x1b[1mboom\x1b[0m"},
+			query: "fatal: boom",
+			want:  []outputMatch{{Line: 0, StartCol: 0, EndCol: 10}},
+		},
+		{
+			name:  "escape code bytes are not matched",
+			lines: []string{"\x1b[31mred\x1b[0m"},
+			query: "31m",
+			want:  nil,
+		},
+		{
+			name:  "columns account for tabs and wide glyphs",
+			lines: []string{"\tx", "日本 x"},
+			query: "x",
+			want: []outputMatch{
+				{Line: 0, StartCol: 8, EndCol: 8},
+				{Line: 1, StartCol: 5, EndCol: 5},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findOutputMatches(tt.lines, tt.query)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findOutputMatches() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutputMatchOffset(t *testing.T) {
+	tests := []struct {
+		name          string
+		line          int
+		lineCount     int
+		visibleHeight int
+		want          int
+	}{
+		{"middle of buffer is centered", 50, 100, 20, 40},
+		{"near bottom clamps to live view", 98, 100, 20, 0},
+		{"near top clamps to oldest page", 2, 100, 20, 80},
+		{"buffer fits in view", 3, 10, 20, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := outputMatchOffset(tt.line, tt.lineCount, tt.visibleHeight); got != tt.want {
+				t.Errorf("outputMatchOffset(%d, %d, %d) = %d, want %d",
+					tt.line, tt.lineCount, tt.visibleHeight, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleOutputSearchKey_Navigation(t *testing.T) {
+	lines := make([]string, 100)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i)
+	}
+	lines[10] = "panic: first"
+	lines[60] = "panic: second"
+	buf := NewOutputBuffer(200)
+	buf.Write(strings.Join(lines, "\n"))
+
+	p := &Plugin{
+		width:            120,
+		height:           40,
+		activePane:       PanePreview,
+		previewTab:       PreviewTabOutput,
+		autoScrollOutput: true,
+		worktrees:        []*Worktree{{Name: "wt", Agent: &Agent{OutputBuf: buf}}},
+	}
+
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	if !p.outputSearchMode || !p.ConsumesTextInput() {
+		t.Fatal("expected '/' to start output search and consume text input")
+	}
+	for _, r := range "panic" {
+		p.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if len(p.outputSearchMatches) != 2 {
+		t.Fatalf("expected 2 matches, got %d", len(p.outputSearchMatches))
+	}
+	if p.outputSearchCursor != 1 {
+		t.Errorf("expected search to start at most recent match, got cursor %d", p.outputSearchCursor)
+	}
+
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if !p.outputSearchCommitted {
+		t.Fatal("expected enter to commit the query")
+	}
+
+	// "N" goes to the older match instead of rejecting
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("N")})
+	if p.outputSearchCursor != 0 {
+		t.Errorf("expected cursor 0 after N, got %d", p.outputSearchCursor)
+	}
+	_, visibleHeight := p.calculatePreviewDimensions()
+	if want := outputMatchOffset(10, buf.LineCount(), visibleHeight); p.previewOffset != want {
+		t.Errorf("expected previewOffset %d, got %d", want, p.previewOffset)
+	}
+	if p.autoScrollOutput {
+		t.Error("expected auto-scroll paused while viewing an older match")
+	}
+
+	// n wraps forward
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if p.outputSearchCursor != 0 {
+		t.Errorf("expected n to wrap to cursor 0, got %d", p.outputSearchCursor)
+	}
+
+	p.handleListKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if p.outputSearchMode || p.outputSearchQuery != "" {
+		t.Error("expected esc to clear output search")
+	}
+}
+
+func TestHighlightOutputMatches_PreservesText(t *testing.T) {
+	line := "\x1b[32mok\x1b[0m then error here"
+	got := highlightOutputMatches(line, "error")
+	if stripped := ansi.Strip(got); stripped != "ok then error here" {
+		t.Errorf("highlight changed visible text: %q", stripped)
+	}
+	if !strings.Contains(got, "\x1b[32m") {
+		t.Errorf("expected original styling preserved, got %q", got)
+	}
+}
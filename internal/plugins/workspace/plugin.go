@@ -62,6 +62,9 @@ const (
 	// Task Link modal regions
 	regionTaskLinkDropdown = "task-link-dropdown"
 
+	// Task tab URL regions
+	regionTaskURL = "task-url"
+
 	// Merge modal element IDs
 	mergeMethodListID      = "merge-method-list"
 	mergeMethodActionID    = "merge-method-action"
@@ -120,6 +123,7 @@ type Plugin struct {
 	scrollOffset     int // Sidebar list scroll offset
 	visibleCount     int // Number of visible list items
 	previewOffset       int
+	previewHorizOffset  int  // Interactive mode horizontal scroll; follows the cursor
 	autoScrollOutput    bool // Auto-scroll output to follow agent (paused when user scrolls up)
 	scrollBaseLineCount int  // Snapshot of lineCount when scroll started (td-f7c8be: prevents bounce on poll)
 	sidebarWidth     int       // Persisted sidebar width
@@ -128,6 +132,9 @@ type Plugin struct {
 	toastMessage     string    // Temporary toast message to display
 	toastTime        time.Time // When toast was triggered
 
+	// Saved preview position per worktree name, restored on selection
+	previewStates map[string]previewState
+
 	// Interactive selection state (preview pane)
 	selection                     ui.SelectionState
 	interactiveCopyPasteHintShown bool
@@ -146,6 +153,10 @@ type Plugin struct {
 	pollGeneration      map[string]int // Per-worktree/shell poll generation counter
 	shellPollGeneration map[string]int // Per-shell poll generation counter
 
+	// Input flush timer generation; only the latest flush timer clears
+	// InteractiveState.InputFlushPending.
+	inputFlushGeneration int
+
 	// Truncation cache to eliminate ANSI parser allocation churn
 	truncateCache *ui.TruncateCache
 
@@ -159,14 +170,29 @@ type Plugin struct {
 	// Diff state
 	diffContent   string
 	diffRaw       string
+	diffRawHash   uint64                   // FNV-1a hash of diffRaw, set by setDiffRaw
 	diffViewMode  DiffViewMode             // Unified or side-by-side
 	multiFileDiff *gitstatus.MultiFileDiff // Parsed multi-file diff with positions
 
+	// Parsed diffRaw cache so renders don't re-parse an unchanged diff
+	parsedDiff         *gitstatus.ParsedDiff
+	parsedDiffErr      error
+	parsedDiffHash     uint64 // diffRawHash of the diffRaw that was parsed
+	parsedDiffWorktree string // Worktree the cached parse belongs to ("" = no cache)
+
+	// Output search state ("/" on the Output tab)
+	outputSearchMode      bool
+	outputSearchCommitted bool // True after Enter confirms query (enables n/N navigation)
+	outputSearchQuery     string
+	outputSearchMatches   []outputMatch
+	outputSearchCursor    int // Index into outputSearchMatches
+
 	// File picker modal state (gf command)
 	filePickerIdx int // Selected file index in picker
 
 	// Commit status header for diff view
 	commitStatusList     []CommitStatusInfo
+	commitStatusUpstream *UpstreamStatus
 	commitStatusWorktree string // Name of worktree for cached status
 
 	// Conflict detection state
@@ -222,9 +248,11 @@ type Plugin struct {
 
 	// Markdown rendering for task view
 	markdownRenderer     *markdown.Renderer
-	taskMarkdownMode     bool     // true = rendered, false = raw
-	taskMarkdownRendered []string // Cached rendered lines
-	taskMarkdownWidth    int      // Width used for cached render
+	taskMarkdownMode     bool       // true = rendered, false = raw
+	taskMarkdownRendered []string   // Cached rendered lines
+	taskLinks            []taskLink // URLs in the last rendered Task tab content
+	taskLinkPickMode     bool       // true while choosing a link by number
+	taskMarkdownWidth    int        // Width used for cached render
 
 	// Merge workflow state
 	mergeState      *MergeWorkflowState
@@ -259,6 +287,11 @@ type Plugin struct {
 	deleteShellModal      *modal.Modal
 	deleteShellModalWidth int
 
+	// Session dead modal state (interactive session ended, offer restart)
+	sessionDeadWorktree   *Worktree
+	sessionDeadModal      *modal.Modal
+	sessionDeadModalWidth int
+
 	// Rename shell modal state
 	renameShellSession    *ShellSession   // Shell being renamed
 	renameShellInput      textinput.Model // Text input for new name
@@ -362,11 +395,9 @@ func (p *Plugin) Icon() string { return pluginIcon }
 func (p *Plugin) IsFocused() bool { return p.focused }
 
 // SetFocused sets the focus state.
+// Losing focus in interactive mode is handled by the PluginFocusedMsg that
+// follows, which can return the command flushing buffered input.
 func (p *Plugin) SetFocused(f bool) {
-	// Exit interactive mode when plugin loses focus (user switched tabs) (td-efd736)
-	if !f && p.viewMode == ViewModeInteractive {
-		p.exitInteractiveMode()
-	}
 	p.focused = f
 }
 
@@ -383,6 +414,7 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 	p.agents = make(map[string]*Agent)
 	p.managedSessions = make(map[string]bool)
 	p.worktrees = make([]*Worktree, 0)
+	p.previewStates = make(map[string]previewState)
 	p.attachedSession = ""
 
 	// Reset poll generation counters (td-83dc22): invalidates any stale timers from previous project
@@ -437,10 +469,16 @@ func (p *Plugin) Init(ctx *plugin.Context) error {
 		ctx.Keymap.RegisterPluginBinding("down", "cursor-down", "workspace-agent-choice")
 		ctx.Keymap.RegisterPluginBinding("up", "cursor-up", "workspace-agent-choice")
 
+		// Session dead modal context
+		ctx.Keymap.RegisterPluginBinding("esc", "cancel", "workspace-session-dead")
+		ctx.Keymap.RegisterPluginBinding("enter", "restart", "workspace-session-dead")
+
 		// Interactive mode context - uses configured keys (td-18098d)
 		ctx.Keymap.RegisterPluginBinding(p.getInteractiveExitKey(), "exit-interactive", "workspace-interactive")
 		ctx.Keymap.RegisterPluginBinding(p.getInteractiveCopyKey(), "copy", "workspace-interactive")
 		ctx.Keymap.RegisterPluginBinding(p.getInteractivePasteKey(), "paste", "workspace-interactive")
+		ctx.Keymap.RegisterPluginBinding(p.getInteractiveScrollUpKey(), "scroll-up", "workspace-interactive")
+		ctx.Keymap.RegisterPluginBinding(p.getInteractiveScrollDownKey(), "scroll-down", "workspace-interactive")
 	}
 
 	// Load saved sidebar width
@@ -646,21 +684,23 @@ func (p *Plugin) captureScrollBaseLineCount() {
 		return // Already captured
 	}
 
-	// Get line count from currently selected worktree or shell
-	var lineCount int
+	if lineCount := p.selectedOutputLineCount(); lineCount > 0 {
+		p.scrollBaseLineCount = lineCount
+	}
+}
+
+// selectedOutputLineCount returns the output buffer line count of the selected worktree or shell.
+func (p *Plugin) selectedOutputLineCount() int {
 	if p.shellSelected {
 		if shell := p.getSelectedShell(); shell != nil && shell.Agent != nil && shell.Agent.OutputBuf != nil {
-			lineCount = shell.Agent.OutputBuf.LineCount()
+			return shell.Agent.OutputBuf.LineCount()
 		}
 	} else {
 		if wt := p.selectedWorktree(); wt != nil && wt.Agent != nil && wt.Agent.OutputBuf != nil {
-			lineCount = wt.Agent.OutputBuf.LineCount()
+			return wt.Agent.OutputBuf.LineCount()
 		}
 	}
-
-	if lineCount > 0 {
-		p.scrollBaseLineCount = lineCount
-	}
+	return 0
 }
 
 // resetScrollBaseLineCount clears the captured line count (td-f7c8be).
@@ -702,6 +742,7 @@ func (p *Plugin) removeWorktreeByName(name string) {
 	for i, wt := range p.worktrees {
 		if wt.Name == name {
 			p.worktrees = append(p.worktrees[:i], p.worktrees[i+1:]...)
+			delete(p.previewStates, name)
 			return
 		}
 	}
@@ -847,7 +888,8 @@ func (p *Plugin) toggleSidebar() {
 
 // moveCursor moves the selection cursor.
 // Navigation order: shells[0], shells[1], ..., worktrees[0], worktrees[1], ...
-func (p *Plugin) moveCursor(delta int) {
+// Returns the command flushing input buffered by an interactive session it exits.
+func (p *Plugin) moveCursor(delta int) tea.Cmd {
 	oldShellSelected := p.shellSelected
 	oldShellIdx := p.selectedShellIdx
 	oldWorktreeIdx := p.selectedIdx
@@ -905,17 +947,17 @@ func (p *Plugin) moveCursor(delta int) {
 	selectionChanged := p.shellSelected != oldShellSelected ||
 		(p.shellSelected && p.selectedShellIdx != oldShellIdx) ||
 		(!p.shellSelected && p.selectedIdx != oldWorktreeIdx)
+	var flush tea.Cmd
 	if selectionChanged {
-		p.previewOffset = 0
-		p.autoScrollOutput = true
-		p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot for new selection
+		p.switchPreviewState(p.worktreeNameAt(oldShellSelected, oldWorktreeIdx))
 		p.taskLoading = false // Reset task loading state for new selection (td-3668584f)
 		// Exit interactive mode when switching selection (td-fc758e88)
-		p.exitInteractiveMode()
+		flush = p.exitInteractiveMode()
 		// Persist selection to disk
 		p.saveSelectionState()
 	}
 	p.ensureVisible()
+	return flush
 }
 
 // ensureVisible adjusts scroll to keep selected item visible.
@@ -945,6 +987,7 @@ func (p *Plugin) ensureVisible() {
 func (p *Plugin) cyclePreviewTab(delta int) tea.Cmd {
 	prevTab := p.previewTab
 	p.previewTab = PreviewTab((int(p.previewTab) + delta + 3) % 3)
+	p.exitOutputSearch()
 	p.previewOffset = 0
 	p.autoScrollOutput = true // Reset auto-scroll when switching tabs
 	p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot when switching tabs
@@ -0,0 +1,59 @@
+package workspace
+
+// previewState is the preview scroll position and tab remembered for a worktree.
+type previewState struct {
+	offset              int
+	autoScroll          bool
+	scrollBaseLineCount int // Keeps a paused view anchored while output grows (td-f7c8be)
+	tab                 PreviewTab
+}
+
+// worktreeNameAt returns the name of the worktree at idx, or "" when a shell
+// is selected or idx is out of range.
+func (p *Plugin) worktreeNameAt(shellSelected bool, idx int) string {
+	if shellSelected || idx < 0 || idx >= len(p.worktrees) {
+		return ""
+	}
+	return p.worktrees[idx].Name
+}
+
+// switchPreviewState saves the current preview position for prevName (the
+// worktree being left, "" for a shell) and restores the saved position for
+// the new selection. Worktrees without saved state and shells start at the
+// bottom with auto-scroll on.
+func (p *Plugin) switchPreviewState(prevName string) {
+	if prevName != "" {
+		if p.previewStates == nil {
+			p.previewStates = make(map[string]previewState)
+		}
+		p.previewStates[prevName] = previewState{
+			offset:              p.previewOffset,
+			autoScroll:          p.autoScrollOutput,
+			scrollBaseLineCount: p.scrollBaseLineCount,
+			tab:                 p.previewTab,
+		}
+	}
+
+	p.exitOutputSearch()
+	p.taskLinkPickMode = false
+
+	wt := p.selectedWorktree()
+	state, ok := previewState{}, false
+	if wt != nil {
+		state, ok = p.previewStates[wt.Name]
+	}
+	if !ok {
+		p.previewOffset = 0
+		p.autoScrollOutput = true
+		p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot for new selection
+		return
+	}
+
+	if p.previewTab == PreviewTabOutput && state.tab != PreviewTabOutput {
+		p.selection.Clear()
+	}
+	p.previewTab = state.tab
+	p.previewOffset = state.offset
+	p.autoScrollOutput = state.autoScroll
+	p.scrollBaseLineCount = state.scrollBaseLineCount
+}
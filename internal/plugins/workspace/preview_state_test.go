@@ -0,0 +1,61 @@
+package workspace
+
+import "testing"
+
+func TestSwitchPreviewState_RoundTrip(t *testing.T) {
+	p := &Plugin{
+		worktrees:        []*Worktree{{Name: "a"}, {Name: "b"}},
+		shells:           []*ShellSession{{TmuxName: "sh"}},
+		previewTab:       PreviewTabOutput,
+		autoScrollOutput: true,
+	}
+
+	// Scroll up in worktree a
+	p.previewOffset = 12
+	p.autoScrollOutput = false
+	p.scrollBaseLineCount = 300
+
+	// Move to b: new worktree starts at the bottom with auto-scroll
+	p.moveCursor(1)
+	if p.selectedIdx != 1 {
+		t.Fatalf("expected worktree b selected, got %d", p.selectedIdx)
+	}
+	if p.previewOffset != 0 || !p.autoScrollOutput || p.scrollBaseLineCount != 0 {
+		t.Errorf("expected default state for b, got offset=%d autoScroll=%v base=%d",
+			p.previewOffset, p.autoScrollOutput, p.scrollBaseLineCount)
+	}
+
+	// Change b's tab, then go back to a
+	p.previewTab = PreviewTabDiff
+	p.previewOffset = 4
+	p.moveCursor(-1)
+	if p.previewOffset != 12 || p.autoScrollOutput || p.scrollBaseLineCount != 300 {
+		t.Errorf("expected a's scroll restored, got offset=%d autoScroll=%v base=%d",
+			p.previewOffset, p.autoScrollOutput, p.scrollBaseLineCount)
+	}
+	if p.previewTab != PreviewTabOutput {
+		t.Errorf("expected a's Output tab restored, got %v", p.previewTab)
+	}
+
+	// Through the shell (no saved state) and back to a
+	p.moveCursor(-1)
+	if !p.shellSelected || p.previewOffset != 0 || !p.autoScrollOutput {
+		t.Errorf("expected shell at bottom, got shell=%v offset=%d", p.shellSelected, p.previewOffset)
+	}
+	p.moveCursor(1)
+	if p.previewOffset != 12 {
+		t.Errorf("expected a's offset after visiting shell, got %d", p.previewOffset)
+	}
+
+	// And b kept its own tab and offset
+	p.moveCursor(1)
+	if p.previewTab != PreviewTabDiff || p.previewOffset != 4 {
+		t.Errorf("expected b's Diff tab at offset 4, got tab=%v offset=%d", p.previewTab, p.previewOffset)
+	}
+
+	// Deleted worktrees drop their saved state
+	p.removeWorktreeByName("a")
+	if _, ok := p.previewStates["a"]; ok {
+		t.Error("expected removed worktree's preview state to be dropped")
+	}
+}
@@ -172,6 +172,8 @@ type (
 		CursorRow     int
 		CursorCol     int
 		CursorVisible bool
+		AltScreen     bool // Pane is on the alternate screen (vim, less)
+		PaneInMode    bool // Pane is in tmux copy mode
 		HasCursor     bool // True if cursor position was captured
 		PaneHeight    int  // Tmux pane height for cursor offset calculation
 		PaneWidth     int  // Tmux pane width for display alignment
@@ -877,9 +879,9 @@ func (p *Plugin) pollShellSessionByName(tmuxName string) tea.Cmd {
 
 		// Capture cursor position atomically with output when in interactive mode.
 		var cursorRow, cursorCol, paneHeight, paneWidth int
-		var cursorVisible, hasCursor bool
+		var cursorVisible, altScreen, inMode, hasCursor bool
 		if interactiveCapture && cursorTarget != "" {
-			cursorRow, cursorCol, paneHeight, paneWidth, cursorVisible, hasCursor = queryCursorPositionSync(cursorTarget)
+			cursorRow, cursorCol, paneHeight, paneWidth, cursorVisible, altScreen, inMode, hasCursor = queryCursorPositionSync(cursorTarget)
 		}
 
 		// Trim to max bytes
@@ -895,6 +897,8 @@ func (p *Plugin) pollShellSessionByName(tmuxName string) tea.Cmd {
 			CursorRow:     cursorRow,
 			CursorCol:     cursorCol,
 			CursorVisible: cursorVisible,
+			AltScreen:     altScreen,
+			PaneInMode:    inMode,
 			HasCursor:     hasCursor,
 			PaneHeight:    paneHeight,
 			PaneWidth:     paneWidth,
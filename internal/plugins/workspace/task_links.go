@@ -0,0 +1,143 @@
+package workspace
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// taskURLRegex matches http(s) URLs in rendered task text.
+var taskURLRegex = regexp.MustCompile("https?://[^\\s<>\"'`()\\[\\]]+")
+
+// taskLink is a URL found in the rendered Task tab content.
+type taskLink struct {
+	Line     int    // Line index within the task content
+	StartCol int    // Display column where the URL starts
+	Width    int    // Display width of the URL
+	URL      string // The URL itself
+}
+
+// extractTaskLinks finds http(s) URLs in rendered lines, ignoring ANSI codes.
+// Trailing sentence punctuation is not treated as part of the URL.
+func extractTaskLinks(lines []string) []taskLink {
+	var links []taskLink
+	for i, line := range lines {
+		plain := ansi.Strip(line)
+		for _, loc := range taskURLRegex.FindAllStringIndex(plain, -1) {
+			url := strings.TrimRight(plain[loc[0]:loc[1]], ".,;:!?")
+			if len(url) <= len("https://") {
+				continue
+			}
+			links = append(links, taskLink{
+				Line:     i,
+				StartCol: ansi.StringWidth(plain[:loc[0]]),
+				Width:    ansi.StringWidth(url),
+				URL:      url,
+			})
+		}
+	}
+	return links
+}
+
+// uniqueTaskURLs returns the distinct URLs in links in order of first appearance.
+// Number keys in link-pick mode index into this list.
+func uniqueTaskURLs(links []taskLink) []string {
+	seen := make(map[string]bool, len(links))
+	var urls []string
+	for _, l := range links {
+		if !seen[l.URL] {
+			seen[l.URL] = true
+			urls = append(urls, l.URL)
+		}
+	}
+	return urls
+}
+
+// maxTaskLinkChoices is the number of links selectable with the 1-9 keys.
+const maxTaskLinkChoices = 9
+
+// openTaskLinks opens the only task link directly, or enters link-pick mode
+// so the user can choose one by number when there are several.
+func (p *Plugin) openTaskLinks() tea.Cmd {
+	urls := uniqueTaskURLs(p.taskLinks)
+	switch len(urls) {
+	case 0:
+		return nil
+	case 1:
+		return openInBrowser(urls[0])
+	}
+	p.taskLinkPickMode = true
+	return nil
+}
+
+// handleTaskLinkPickKey handles keys while choosing a task link by number.
+// Any key other than a valid link number cancels the pick.
+func (p *Plugin) handleTaskLinkPickKey(msg tea.KeyMsg) tea.Cmd {
+	p.taskLinkPickMode = false
+	key := msg.String()
+	if len(key) != 1 || key[0] < '1' || key[0] > '9' {
+		return nil
+	}
+	urls := uniqueTaskURLs(p.taskLinks)
+	idx := int(key[0] - '1')
+	if idx >= len(urls) {
+		return nil
+	}
+	return openInBrowser(urls[idx])
+}
+
+// renderTaskLinkLegend renders the numbered link list shown in link-pick mode.
+func (p *Plugin) renderTaskLinkLegend() string {
+	urls := uniqueTaskURLs(p.taskLinks)
+	lines := []string{dimText("Open link (esc to cancel):")}
+	for i, url := range urls {
+		if i >= maxTaskLinkChoices {
+			lines = append(lines, dimText(fmt.Sprintf("    ... %d more (click to open)", len(urls)-i)))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("[%d] %s", i+1, url))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// taskLinkLegendRows returns how many task content rows fit above the link
+// legend in a Task tab of the given height, so the legend is never clipped.
+func (p *Plugin) taskLinkLegendRows(height int) int {
+	if !p.flashPreviewTime.IsZero() && time.Since(p.flashPreviewTime) < flashDuration {
+		height-- // prependFlashHint adds a line above the content
+	}
+	legend := strings.Count(p.renderTaskLinkLegend(), "\n") + 1
+	return max(height-legend-1, 1) // blank line above the legend
+}
+
+// registerTaskLinkRegions adds click regions for Task tab links.
+// x, y is the screen position of the first task content cell; links are
+// clipped to the maxCols x maxRows content area.
+func (p *Plugin) registerTaskLinkRegions(x, y, maxCols, maxRows int) {
+	if p.shellSelected || p.previewTab != PreviewTabTask {
+		return
+	}
+	if wt := p.selectedWorktree(); wt == nil || wt.IsMain {
+		return
+	}
+	if p.taskLinkPickMode {
+		maxRows = min(maxRows, p.taskLinkLegendRows(maxRows))
+	}
+	if !p.flashPreviewTime.IsZero() && time.Since(p.flashPreviewTime) < flashDuration {
+		y++ // prependFlashHint adds a line above the content
+	}
+	for _, l := range p.taskLinks {
+		if l.Line >= maxRows {
+			break
+		}
+		w := min(l.Width, maxCols-l.StartCol)
+		if w <= 0 {
+			continue
+		}
+		p.mouseHandler.HitMap.AddRect(regionTaskURL, x+l.StartCol, y+l.Line, w, 1, l.URL)
+	}
+}
@@ -0,0 +1,126 @@
+package workspace
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/marcus/sidecar/internal/mouse"
+)
+
+func TestExtractTaskLinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []taskLink
+	}{
+		{
+			name:  "no links",
+			lines: []string{"Task: td-1", "plain text"},
+			want:  nil,
+		},
+		{
+			name:  "multiple links with positions",
+			lines: []string{"See https://example.com/a", "", "docs: http://x.io/b?c=1 and https://y.dev"},
+			want: []taskLink{
+				{Line: 0, StartCol: 4, Width: 21, URL: "https://example.com/a"},
+				{Line: 2, StartCol: 6, Width: 17, URL: "http://x.io/b?c=1"},
+				{Line: 2, StartCol: 28, Width: 13, URL: "https://y.dev"},
+			},
+		},
+		{
+			name:  "trailing punctuation and brackets excluded",
+			lines: []string{"(see https://example.com/x)."},
+			want:  []taskLink{{Line: 0, StartCol: 5, Width: 21, URL: "https://example.com/x"}},
+		},
+		{
+			name:  "ANSI styling ignored for columns",
+			lines: []string{"\x1b[1mLink:\x1b[0m \x1b[4;34mhttps://example.com\x1b[0m"},
+			want:  []taskLink{{Line: 0, StartCol: 6, Width: 19, URL: "https://example.com"}},
+		},
+		{
+			name:  "wide glyphs before link",
+			lines: []string{"日本 https://a.io"},
+			want:  []taskLink{{Line: 0, StartCol: 5, Width: 12, URL: "https://a.io"}},
+		},
+		{
+			name:  "bare scheme ignored",
+			lines: []string{"https:// is not a link"},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := extractTaskLinks(tt.lines)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("extractTaskLinks() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleTaskLinkPickKey(t *testing.T) {
+	links := extractTaskLinks([]string{"https://a.io https://b.io", "https://a.io again"})
+	if urls := uniqueTaskURLs(links); !reflect.DeepEqual(urls, []string{"https://a.io", "https://b.io"}) {
+		t.Fatalf("uniqueTaskURLs() = %v", urls)
+	}
+
+	p := &Plugin{taskLinks: links}
+	if cmd := p.openTaskLinks(); cmd != nil || !p.taskLinkPickMode {
+		t.Fatal("expected multiple links to enter pick mode")
+	}
+	if !p.ConsumesTextInput() {
+		t.Error("expected pick mode to consume number keys")
+	}
+
+	if cmd := p.handleTaskLinkPickKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")}); cmd == nil {
+		t.Error("expected '2' to open the second link")
+	}
+	if p.taskLinkPickMode {
+		t.Error("expected pick mode to end after choosing a link")
+	}
+
+	p.taskLinkPickMode = true
+	if cmd := p.handleTaskLinkPickKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")}); cmd != nil {
+		t.Error("expected out-of-range number to open nothing")
+	}
+	if p.taskLinkPickMode {
+		t.Error("expected out-of-range number to cancel pick mode")
+	}
+}
+
+func TestRenderTaskContentKeepsLegendOnScreen(t *testing.T) {
+	var desc []string
+	for i := 0; i < 40; i++ {
+		desc = append(desc, fmt.Sprintf("line %d", i))
+	}
+	desc = append(desc, "see https://a.io and https://b.io")
+
+	p := &Plugin{
+		worktrees:    []*Worktree{{Name: "wt", TaskID: "td-1"}},
+		cachedTask:   &TaskDetails{ID: "td-1", Title: "Task", Description: strings.Join(desc, "\n")},
+		cachedTaskID: "td-1",
+		mouseHandler: mouse.NewHandler(),
+		previewTab:   PreviewTabTask,
+	}
+	p.taskLinkPickMode = true
+
+	const height = 20
+	lines := strings.Split(p.renderTaskContent(80, height), "\n")
+	if len(lines) > height {
+		t.Fatalf("rendered %d lines, want at most %d so the legend isn't clipped", len(lines), height)
+	}
+	if got := ansi.Strip(lines[len(lines)-1]); got != "[2] https://b.io" {
+		t.Errorf("last line = %q, want the legend's last entry", got)
+	}
+
+	// The links themselves were cut off, so no click regions cover the legend
+	p.registerTaskLinkRegions(0, 0, 80, height)
+	if regions := p.mouseHandler.HitMap.Regions(); len(regions) != 0 {
+		t.Errorf("got %d link regions, want none for links hidden under the legend", len(regions))
+	}
+}
@@ -38,6 +38,7 @@ const (
 	ViewModeFilePicker                     // Diff file picker modal
 	ViewModeInteractive                    // Interactive mode (tmux input passthrough)
 	ViewModeFetchPR                        // Fetch remote PR modal
+	ViewModeSessionDead                    // Interactive session ended (restart prompt)
 )
 
 // FocusPane represents which pane is active in the split view.
@@ -278,6 +279,10 @@ type InteractiveState struct {
 	// EscapeTime is when the first Escape was pressed.
 	EscapeTime time.Time
 
+	// EscapeDelay is the double-escape window for this session, read from
+	// config on entry. Zero means doubleEscapeDelay.
+	EscapeDelay time.Duration
+
 	// CursorRow and CursorCol track the cached cursor position for overlay rendering.
 	// Updated asynchronously via cursorPositionMsg from poll handler (td-648af4).
 	CursorRow int
@@ -287,6 +292,14 @@ type InteractiveState struct {
 	// Updated asynchronously via cursorPositionMsg from poll handler (td-648af4).
 	CursorVisible bool
 
+	// AltScreen indicates the pane is on the alternate screen (#{alternate_on}).
+	// Full-screen apps like vim and less draw their own cursor, so the overlay is skipped.
+	AltScreen bool
+
+	// PaneInMode indicates the pane is in tmux copy mode (#{pane_in_mode}).
+	// Navigation keys are sent as copy-mode commands instead of literal text.
+	PaneInMode bool
+
 	// PaneHeight tracks the tmux pane height for cursor offset calculation.
 	// Used to adjust cursor_y when display height differs from pane height.
 	PaneHeight int
@@ -317,6 +330,47 @@ type InteractiveState struct {
 
 	// LastResizeAt tracks the last time we attempted to resize the tmux pane.
 	LastResizeAt time.Time
+
+	// PendingInput buffers literal keystrokes typed within inputCoalesceWindow
+	// so a burst is sent as a single send-keys -l call.
+	PendingInput string
+
+	// InputFlushPending tracks if an input flush timer is already in flight.
+	InputFlushPending bool
+
+	// LastError is the most recent send-keys failure that survived a retry
+	// while the session was still alive. Shown in the preview hint line.
+	LastError error
+}
+
+// queueInput adds a key to the input coalescing buffer. Literal keys are
+// buffered and nil is returned. A special key flushes the buffer: the
+// returned slice holds the buffered literal followed by the key, in order.
+func (s *InteractiveState) queueInput(k keySpec) []keySpec {
+	if k.literal {
+		s.PendingInput += k.value
+		return nil
+	}
+	return append(s.takeBufferedInput(), k)
+}
+
+// takeBufferedInput drains the input coalescing buffer.
+// Returns nil if nothing is buffered.
+func (s *InteractiveState) takeBufferedInput() []keySpec {
+	if s.PendingInput == "" {
+		return nil
+	}
+	k := keySpec{value: s.PendingInput, literal: true}
+	s.PendingInput = ""
+	return []keySpec{k}
+}
+
+// escapeDelay returns the session's double-escape window, defaulting to doubleEscapeDelay.
+func (s *InteractiveState) escapeDelay() time.Duration {
+	if s.EscapeDelay <= 0 {
+		return doubleEscapeDelay
+	}
+	return s.EscapeDelay
 }
 
 // AgentStatus represents the current status of an agent.
@@ -339,6 +393,13 @@ type GitStats struct {
 	Behind       int // Commits behind base branch
 }
 
+// UpstreamStatus holds how far a branch has diverged from its upstream.
+type UpstreamStatus struct {
+	Branch string // Upstream ref, e.g. "origin/feature"
+	Ahead  int    // Commits in HEAD not in upstream
+	Behind int    // Commits in upstream not in HEAD
+}
+
 // CommitStatusInfo holds commit information with merge/push status.
 type CommitStatusInfo struct {
 	Hash    string // Short commit hash
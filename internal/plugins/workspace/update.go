@@ -29,6 +29,10 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		return p, p.resizeSelectedPaneCmd()
 
 	case app.PluginFocusedMsg:
+		// Exit interactive mode when plugin loses focus (user switched tabs) (td-efd736)
+		if !p.focused && p.viewMode == ViewModeInteractive {
+			return p, p.exitInteractiveMode()
+		}
 		if p.focused {
 			// Poll shell or selected agent when plugin gains focus
 			if shell := p.getSelectedShell(); shell != nil {
@@ -150,7 +154,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		if p.selectedWorktree() != nil && p.selectedWorktree().Name == msg.WorkspaceName {
 			p.diffContent = msg.Content
-			p.diffRaw = msg.Raw
+			p.setDiffRaw(msg.Raw)
 			// Parse multi-file diff for file headers and navigation
 			p.multiFileDiff = gitstatus.ParseMultiFileDiff(msg.Raw)
 			// Also load commit status for this worktree
@@ -167,6 +171,7 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 		if msg.Err == nil && p.selectedWorktree() != nil && p.selectedWorktree().Name == msg.WorkspaceName {
 			p.commitStatusList = msg.Commits
+			p.commitStatusUpstream = msg.Upstream
 			p.commitStatusWorktree = msg.WorkspaceName
 		}
 
@@ -179,11 +184,10 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.worktrees = append(p.worktrees, msg.Worktree)
 
 			// Auto-focus newly created worktree (same pattern as click selection)
+			prevName := p.worktreeNameAt(p.shellSelected, p.selectedIdx)
 			p.shellSelected = false
 			p.selectedIdx = len(p.worktrees) - 1
-			p.previewOffset = 0
-			p.autoScrollOutput = true
-			p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot for new selection
+			p.switchPreviewState(prevName)
 			p.saveSelectionState()
 			p.ensureVisible()
 
@@ -270,11 +274,10 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			for i, wt := range p.worktrees {
 				if wt.Branch == msg.Branch {
 					p.viewMode = ViewModeList
+					prevName := p.worktreeNameAt(p.shellSelected, p.selectedIdx)
 					p.shellSelected = false
 					p.selectedIdx = i
-					p.previewOffset = 0
-					p.autoScrollOutput = true
-					p.resetScrollBaseLineCount()
+					p.switchPreviewState(prevName)
 					p.saveSelectionState()
 					p.ensureVisible()
 					p.clearFetchPRState()
@@ -292,11 +295,10 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.viewMode = ViewModeList
 			p.worktrees = append(p.worktrees, msg.Worktree)
 			// Auto-focus newly fetched worktree
+			prevName := p.worktreeNameAt(p.shellSelected, p.selectedIdx)
 			p.shellSelected = false
 			p.selectedIdx = len(p.worktrees) - 1
-			p.previewOffset = 0
-			p.autoScrollOutput = true
-			p.resetScrollBaseLineCount()
+			p.switchPreviewState(prevName)
 			p.saveSelectionState()
 			p.ensureVisible()
 			p.clearFetchPRState()
@@ -320,7 +322,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		p.deleteWarnings = msg.Warnings
 		// Clear preview pane content to ensure old diff doesn't persist
 		p.diffContent = ""
-		p.diffRaw = ""
+		p.setDiffRaw("")
+		p.clearParsedDiffCache()
 		p.cachedTaskID = ""
 		p.cachedTask = nil
 		// Load diff for newly selected worktree
@@ -411,6 +414,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 					p.interactiveState.CursorRow = msg.CursorRow
 					p.interactiveState.CursorCol = msg.CursorCol
 					p.interactiveState.CursorVisible = msg.CursorVisible
+					p.interactiveState.AltScreen = msg.AltScreen
+					p.interactiveState.PaneInMode = msg.PaneInMode
 					p.interactiveState.PaneHeight = msg.PaneHeight
 					p.interactiveState.PaneWidth = msg.PaneWidth
 				}
@@ -503,6 +508,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 					p.interactiveState.CursorRow = msg.CursorRow
 					p.interactiveState.CursorCol = msg.CursorCol
 					p.interactiveState.CursorVisible = msg.CursorVisible
+					p.interactiveState.AltScreen = msg.AltScreen
+					p.interactiveState.PaneInMode = msg.PaneInMode
 					p.interactiveState.PaneHeight = msg.PaneHeight
 					p.interactiveState.PaneWidth = msg.PaneWidth
 				}
@@ -699,11 +706,10 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 
 		// Add worktree to list and select it
 		p.worktrees = append(p.worktrees, msg.Worktree)
+		prevName := p.worktreeNameAt(p.shellSelected, p.selectedIdx)
 		p.shellSelected = false
 		p.selectedIdx = len(p.worktrees) - 1
-		p.previewOffset = 0
-		p.autoScrollOutput = true
-		p.resetScrollBaseLineCount() // td-f7c8be: clear snapshot for new selection
+		p.switchPreviewState(prevName)
 		p.saveSelectionState()
 		p.ensureVisible()
 
@@ -853,6 +859,8 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 					p.interactiveState.CursorRow = msg.CursorRow
 					p.interactiveState.CursorCol = msg.CursorCol
 					p.interactiveState.CursorVisible = msg.CursorVisible
+					p.interactiveState.AltScreen = msg.AltScreen
+					p.interactiveState.PaneInMode = msg.PaneInMode
 					p.interactiveState.PaneHeight = msg.PaneHeight
 					p.interactiveState.PaneWidth = msg.PaneWidth
 				}
@@ -1294,6 +1302,14 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 			p.interactiveState.CursorVisible = msg.Visible
 		}
 
+	case inputFlushMsg:
+		// Flush literal input buffered during the coalescing window
+		if p.viewMode == ViewModeInteractive {
+			if cmd := p.handleInputFlush(msg.Generation); cmd != nil {
+				cmds = append(cmds, cmd)
+			}
+		}
+
 	case escapeTimerMsg:
 		// Handle escape delay timer for interactive mode double-escape detection
 		if p.viewMode == ViewModeInteractive {
@@ -1304,20 +1320,35 @@ func (p *Plugin) Update(msg tea.Msg) (plugin.Plugin, tea.Cmd) {
 		}
 
 	case InteractiveSessionDeadMsg:
-		// Session ended externally - show notification (td-a1c8456f)
-		p.exitInteractiveMode()
-		p.toastMessage = "Session ended"
-		p.toastTime = time.Now()
+		// Session ended externally - show notification (td-a1c8456f).
+		// Buffered input is dropped; it can't reach a dead session.
+		_ = p.exitInteractiveMode()
 		// Auto-remove dead shell from list (td-b6904e)
 		if p.shellSelected {
+			p.toastMessage = "Session ended"
+			p.toastTime = time.Now()
 			if shell := p.getSelectedShell(); shell != nil {
 				cmds = append(cmds, func() tea.Msg { return ShellSessionDeadMsg{TmuxName: shell.TmuxName} })
 			}
+		} else if wt := p.selectedWorktree(); wt != nil && wt.Agent != nil {
+			// Agent session died: offer to restart instead of silently dropping to the list
+			p.sessionDeadWorktree = wt
+			p.sessionDeadModal = nil
+			p.viewMode = ViewModeSessionDead
+		} else {
+			p.toastMessage = "Session ended"
+			p.toastTime = time.Now()
+		}
+
+	case InteractiveSendErrorMsg:
+		// Send failed twice but the session is alive: stay interactive and surface it
+		if p.interactiveState != nil && p.interactiveState.Active {
+			p.interactiveState.LastError = msg.Err
 		}
 
 	case InteractivePasteResultMsg:
 		if msg.SessionDead {
-			p.exitInteractiveMode()
+			_ = p.exitInteractiveMode() // Buffered input can't reach a dead session
 			p.toastMessage = "Session ended"
 			p.toastTime = time.Now()
 			return p, nil
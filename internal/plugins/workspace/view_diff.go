@@ -1,15 +1,32 @@
 package workspace
 
 import (
+	"fmt"
+	"hash/fnv"
 	"strings"
+	"time"
 
+	"github.com/atotto/clipboard"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/marcus/sidecar/internal/msg"
 	"github.com/marcus/sidecar/internal/plugins/gitstatus"
 	"github.com/marcus/sidecar/internal/styles"
 	"github.com/marcus/sidecar/internal/ui"
 )
 
+// minSideBySideWidth is the narrowest preview width that renders a side-by-side diff.
+// Narrower previews fall back to unified without changing the saved preference.
+const minSideBySideWidth = 80
+
+// diffViewModeForWidth returns the diff mode to render at the given width.
+func diffViewModeForWidth(preferred DiffViewMode, width int) DiffViewMode {
+	if preferred == DiffViewSideBySide && width < minSideBySideWidth {
+		return DiffViewUnified
+	}
+	return preferred
+}
+
 // renderDiffContent renders git diff using the shared diff renderer.
 func (p *Plugin) renderDiffContent(width, height int) string {
 	wt := p.selectedWorktree()
@@ -17,11 +34,20 @@ func (p *Plugin) renderDiffContent(width, height int) string {
 		return dimText("No worktree selected")
 	}
 
-	// Render commit status header if it belongs to current worktree
-	header := ""
+	// Render diff stats and commit status headers (commit status only if it
+	// belongs to the current worktree)
+	var headers []string
+	if p.diffRaw != "" {
+		if stats := p.renderDiffStatsHeader(width); stats != "" {
+			headers = append(headers, stats)
+		}
+	}
 	if p.commitStatusWorktree == wt.Name {
-		header = p.renderCommitStatusHeader(width)
+		if commits := p.renderCommitStatusHeader(width); commits != "" {
+			headers = append(headers, commits)
+		}
 	}
+	header := strings.Join(headers, "\n")
 
 	headerHeight := 0
 	if header != "" {
@@ -41,10 +67,12 @@ func (p *Plugin) renderDiffContent(width, height int) string {
 		contentHeight = 1
 	}
 
+	viewMode := diffViewModeForWidth(p.diffViewMode, width)
+
 	// Use multi-file diff rendering if available
 	if p.multiFileDiff != nil && len(p.multiFileDiff.Files) > 0 {
 		var mode gitstatus.DiffViewMode
-		if p.diffViewMode == DiffViewSideBySide {
+		if viewMode == DiffViewSideBySide {
 			mode = gitstatus.DiffViewSideBySide
 		} else {
 			mode = gitstatus.DiffViewUnified
@@ -57,7 +85,7 @@ func (p *Plugin) renderDiffContent(width, height int) string {
 	}
 
 	// Fallback: Parse the raw diff into structured format (single file)
-	parsed, err := gitstatus.ParseUnifiedDiff(p.diffRaw)
+	parsed, err := p.parsedDiffFor(wt.Name)
 	if err != nil || parsed == nil {
 		// Fallback to basic rendering
 		diffContent := p.renderDiffContentBasicWithHeight(width, contentHeight)
@@ -75,10 +103,10 @@ func (p *Plugin) renderDiffContent(width, height int) string {
 
 	// Render based on view mode
 	var diffContent string
-	if p.diffViewMode == DiffViewSideBySide {
+	if viewMode == DiffViewSideBySide {
 		diffContent = gitstatus.RenderSideBySide(parsed, width, p.previewOffset, contentHeight, 0, highlighter, false)
 	} else {
-		diffContent = gitstatus.RenderLineDiff(parsed, width, p.previewOffset, contentHeight, 0, highlighter, false)
+		diffContent = gitstatus.RenderLineDiff(parsed, width, p.previewOffset, contentHeight, 0, highlighter, false, true)
 	}
 
 	if header != "" {
@@ -87,6 +115,119 @@ func (p *Plugin) renderDiffContent(width, height int) string {
 	return diffContent
 }
 
+// maxDiffStatsFiles is the number of files listed in the diff stats header.
+const maxDiffStatsFiles = 5
+
+// diffStats summarizes the size of a multi-file diff.
+type diffStats struct {
+	Files     int
+	Additions int
+	Deletions int
+}
+
+// summarizeDiff totals the files and added/deleted lines in a parsed diff.
+func summarizeDiff(mfd *gitstatus.MultiFileDiff) diffStats {
+	var s diffStats
+	if mfd == nil {
+		return s
+	}
+	for _, f := range mfd.Files {
+		s.Files++
+		s.Additions += f.Additions
+		s.Deletions += f.Deletions
+	}
+	return s
+}
+
+// renderDiffStatsHeader renders a box summarizing the diff, listing each
+// file's changes when more than one file is present.
+func (p *Plugin) renderDiffStatsHeader(width int) string {
+	stats := summarizeDiff(p.multiFileDiff)
+	if stats.Files == 0 {
+		return ""
+	}
+
+	headerStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.Primary).
+		Padding(0, 1).
+		Width(width - 2)
+
+	titleStyle := lipgloss.NewStyle().Bold(true).Foreground(styles.Primary)
+
+	var sb strings.Builder
+	noun := "files"
+	if stats.Files == 1 {
+		noun = "file"
+	}
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("%d %s", stats.Files, noun)))
+	sb.WriteString("  ")
+	sb.WriteString(styles.DiffAdd.Render(fmt.Sprintf("+%d", stats.Additions)))
+	sb.WriteString(" ")
+	sb.WriteString(styles.DiffRemove.Render(fmt.Sprintf("-%d", stats.Deletions)))
+
+	if stats.Files > 1 {
+		currentIdx := p.multiFileDiff.FileAtLine(p.previewOffset)
+		files := p.multiFileDiff.Files
+		displayCount := min(len(files), maxDiffStatsFiles)
+
+		// Leave room for marker, padding, and the +N -M counts
+		maxNameWidth := max(width-22, 10)
+		for i := 0; i < displayCount; i++ {
+			f := files[i]
+			marker := "  "
+			if i == currentIdx {
+				marker = "▸ "
+			}
+			name := f.FileName()
+			if lipgloss.Width(name) > maxNameWidth {
+				name = p.truncateCache.Truncate(name, maxNameWidth, "...")
+			}
+			sb.WriteString("\n")
+			sb.WriteString(fmt.Sprintf("%s%s %s %s", marker, name,
+				styles.DiffAdd.Render(fmt.Sprintf("+%d", f.Additions)),
+				styles.DiffRemove.Render(fmt.Sprintf("-%d", f.Deletions))))
+		}
+		if len(files) > maxDiffStatsFiles {
+			sb.WriteString("\n")
+			sb.WriteString(dimText(fmt.Sprintf("  ... and %d more", len(files)-maxDiffStatsFiles)))
+		}
+		sb.WriteString("\n")
+		sb.WriteString(dimText("f jump to file • {/} prev/next file"))
+	}
+
+	return headerStyle.Render(sb.String())
+}
+
+// setDiffRaw stores the raw diff along with its content hash, which keys the parse cache.
+func (p *Plugin) setDiffRaw(raw string) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(raw))
+	p.diffRaw = raw
+	p.diffRawHash = h.Sum64()
+}
+
+// parsedDiffFor returns diffRaw parsed as a unified diff. The parse is cached
+// and reused until diffRaw changes or a different worktree is rendered.
+func (p *Plugin) parsedDiffFor(worktree string) (*gitstatus.ParsedDiff, error) {
+	if p.parsedDiffWorktree == worktree && worktree != "" && p.parsedDiffHash == p.diffRawHash {
+		return p.parsedDiff, p.parsedDiffErr
+	}
+
+	p.parsedDiff, p.parsedDiffErr = gitstatus.ParseUnifiedDiff(p.diffRaw)
+	p.parsedDiffHash = p.diffRawHash
+	p.parsedDiffWorktree = worktree
+	return p.parsedDiff, p.parsedDiffErr
+}
+
+// clearParsedDiffCache drops the cached diff parse.
+func (p *Plugin) clearParsedDiffCache() {
+	p.parsedDiff = nil
+	p.parsedDiffErr = nil
+	p.parsedDiffHash = 0
+	p.parsedDiffWorktree = ""
+}
+
 // renderDiffContentBasicWithHeight renders git diff with basic highlighting with explicit height.
 func (p *Plugin) renderDiffContentBasicWithHeight(width, height int) string {
 	lines := splitLines(p.diffContent)
@@ -131,6 +272,52 @@ func (p *Plugin) renderDiffContentBasicWithHeight(width, height int) string {
 	return strings.Join(rendered, "\n")
 }
 
+// currentDiffFileIdx returns the index of the file at the diff scroll
+// position, or -1 when there is no multi-file diff.
+func (p *Plugin) currentDiffFileIdx() int {
+	if p.multiFileDiff == nil || len(p.multiFileDiff.Files) == 0 {
+		return -1
+	}
+	idx := p.multiFileDiff.FileAtLine(p.previewOffset)
+	if idx < 0 {
+		idx = 0
+	}
+	return idx
+}
+
+// fileDiffText returns the raw diff text for one file of a parsed multi-file diff.
+func fileDiffText(mfd *gitstatus.MultiFileDiff, idx int) string {
+	if mfd == nil || idx < 0 || idx >= len(mfd.Files) {
+		return ""
+	}
+	return strings.TrimRight(mfd.Files[idx].Raw, "\n") + "\n"
+}
+
+// yankDiffToClipboard copies the whole worktree diff to the system clipboard.
+func (p *Plugin) yankDiffToClipboard() tea.Cmd {
+	if p.diffRaw == "" {
+		return msg.ShowToast("No diff to copy", 2*time.Second)
+	}
+	if err := clipboard.WriteAll(p.diffRaw); err != nil {
+		return msg.ShowToast("Copy failed: "+err.Error(), 2*time.Second)
+	}
+	return msg.ShowToast("Copied diff to clipboard", 2*time.Second)
+}
+
+// yankFileDiffToClipboard copies the diff of the file at the scroll position
+// to the system clipboard.
+func (p *Plugin) yankFileDiffToClipboard() tea.Cmd {
+	idx := p.currentDiffFileIdx()
+	text := fileDiffText(p.multiFileDiff, idx)
+	if text == "" {
+		return msg.ShowToast("No diff to copy", 2*time.Second)
+	}
+	if err := clipboard.WriteAll(text); err != nil {
+		return msg.ShowToast("Copy failed: "+err.Error(), 2*time.Second)
+	}
+	return msg.ShowToast("Copied "+p.multiFileDiff.Files[idx].FileName()+" diff to clipboard", 2*time.Second)
+}
+
 // jumpToNextFile jumps to the next file in the multi-file diff.
 func (p *Plugin) jumpToNextFile() tea.Cmd {
 	if p.multiFileDiff == nil || len(p.multiFileDiff.Files) <= 1 {
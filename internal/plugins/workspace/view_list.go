@@ -69,6 +69,8 @@ func (p *Plugin) View(width, height int) string {
 		return p.renderConfirmDeleteModal(width, height)
 	case ViewModeConfirmDeleteShell:
 		return p.renderConfirmDeleteShellModal(width, height)
+	case ViewModeSessionDead:
+		return p.renderSessionDeadModal(width, height)
 	case ViewModeCommitForMerge:
 		return p.renderCommitForMergeModal(width, height)
 	case ViewModePromptPicker:
@@ -125,6 +127,9 @@ func (p *Plugin) renderListView(width, height int) string {
 		// Render content using calculated content width (consistent with panel overhead)
 		previewContent := p.renderPreviewContent(contentW, innerHeight)
 
+		// Task tab links sit below the tabs line and its blank spacer
+		p.registerTaskLinkRegions(panelOverhead/2, 3, contentW, innerHeight-2)
+
 		// Check if preview should flash (guard against zero-value time)
 		flashActive := !p.flashPreviewTime.IsZero() && time.Since(p.flashPreviewTime) < flashDuration
 		if flashActive {
@@ -185,6 +190,9 @@ func (p *Plugin) renderListView(width, height int) string {
 	sidebarContent := p.renderSidebarContent(sidebarContentW, innerHeight)
 	previewContent := p.renderPreviewContent(previewContentW, innerHeight)
 
+	// 4. Task tab link regions (registered after rendering, which finds them)
+	p.registerTaskLinkRegions(sidebarW+dividerWidth+panelOverhead/2, 3, previewContentW, innerHeight-2)
+
 	// Check if preview should flash (guard against zero-value time)
 	flashActive := !p.flashPreviewTime.IsZero() && time.Since(p.flashPreviewTime) < flashDuration
 
@@ -302,6 +302,58 @@ const (
 	deleteShellConfirmCancelID = "delete-shell-confirm-cancel"
 )
 
+const (
+	sessionDeadRestartID = "session-dead-restart"
+	sessionDeadCancelID  = "session-dead-cancel"
+)
+
+// renderSessionDeadModal renders the restart prompt shown when an interactive session ends.
+func (p *Plugin) renderSessionDeadModal(width, height int) string {
+	background := p.renderListView(width, height)
+
+	p.ensureSessionDeadModal()
+	if p.sessionDeadModal == nil {
+		return background
+	}
+
+	modalContent := p.sessionDeadModal.Render(width, height, p.mouseHandler)
+	return ui.OverlayModal(background, modalContent, width, height)
+}
+
+// ensureSessionDeadModal builds/rebuilds the session dead modal.
+func (p *Plugin) ensureSessionDeadModal() {
+	if p.sessionDeadWorktree == nil {
+		return
+	}
+
+	modalW := 50
+	if modalW > p.width-4 {
+		modalW = p.width - 4
+	}
+	if modalW < 20 {
+		modalW = 20
+	}
+
+	if p.sessionDeadModal != nil && p.sessionDeadModalWidth == modalW {
+		return
+	}
+	p.sessionDeadModalWidth = modalW
+
+	title := fmt.Sprintf("Session Ended: %s", p.sessionDeadWorktree.Name)
+	p.sessionDeadModal = modal.New(title,
+		modal.WithWidth(modalW),
+		modal.WithVariant(modal.VariantWarning),
+		modal.WithPrimaryAction(sessionDeadRestartID),
+		modal.WithHints(false),
+	).
+		AddSection(modal.Text("The agent's tmux session is no longer running.\nRestart the agent?")).
+		AddSection(modal.Spacer()).
+		AddSection(modal.Buttons(
+			modal.Btn(" Restart ", sessionDeadRestartID),
+			modal.Btn(" Cancel ", sessionDeadCancelID),
+		))
+}
+
 const (
 	commitForMergeInputID   = "commit-for-merge-input"
 	commitForMergeCommitID  = "commit-for-merge-commit"
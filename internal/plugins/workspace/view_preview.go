@@ -226,7 +226,7 @@ func (p *Plugin) renderOutputContent(width, height int) string {
 		interactiveStyle := lipgloss.NewStyle().
 			Foreground(lipgloss.Color(styles.GetCurrentTheme().Colors.Warning)).
 			Bold(true)
-		hint = interactiveStyle.Render("INTERACTIVE") + " " + dimText(p.getInteractiveExitKey()+" exit • "+p.getInteractiveAttachKey()+" attach")
+		hint = interactiveStyle.Render("INTERACTIVE") + p.copyModeBadge() + " " + dimText(p.getInteractiveExitKey()+" exit • "+p.getInteractiveAttachKey()+" attach") + p.interactiveScrollHint() + p.interactiveErrorHint()
 	} else {
 		// Only show "E for interactive" hint if feature flag is enabled
 		detach := getTmuxDetachHint()
@@ -236,6 +236,9 @@ func (p *Plugin) renderOutputContent(width, height int) string {
 			hint = dimText(fmt.Sprintf("t to attach • %s to detach", detach))
 		}
 	}
+	if p.outputSearchMode {
+		hint = p.renderOutputSearchBar()
+	}
 	height-- // Reserve line for hint
 
 	if wt.Agent.OutputBuf == nil {
@@ -267,6 +270,15 @@ func (p *Plugin) renderOutputContent(width, height int) string {
 		displayWidth = paneWidth
 	}
 
+	// Keep the cursor in view when the pane is wider than the preview
+	horizOffset := 0
+	if interactive {
+		if cursorVisible {
+			p.previewHorizOffset = followCursorHorizOffset(p.previewHorizOffset, cursorCol, displayWidth)
+		}
+		horizOffset = p.previewHorizOffset
+	}
+
 	effectiveLineCount := lineCount
 	if p.autoScrollOutput && !interactive {
 		lines := wt.Agent.OutputBuf.Lines()
@@ -332,8 +344,15 @@ func (p *Plugin) renderOutputContent(width, height int) string {
 				displayLine = ui.InjectCharacterRangeBackground(displayLine, startCol, endCol)
 			}
 		}
+		if !interactive && p.outputSearchActive() {
+			displayLine = highlightOutputMatches(displayLine, p.outputSearchQuery)
+		}
 		// Truncate to width
-		displayLine = p.truncateCache.Truncate(displayLine, displayWidth, "")
+		if horizOffset > 0 {
+			displayLine = ansi.Cut(displayLine, horizOffset, horizOffset+displayWidth)
+		} else {
+			displayLine = p.truncateCache.Truncate(displayLine, displayWidth, "")
+		}
 		displayLines = append(displayLines, displayLine)
 	}
 
@@ -360,7 +379,7 @@ func (p *Plugin) renderOutputContent(width, height int) string {
 		} else if paneHeight > 0 && paneHeight < displayHeight {
 			relativeRow = cursorRow + (displayHeight - paneHeight)
 		}
-		relativeCol := cursorCol
+		relativeCol := cursorCol - horizOffset
 
 		// Clamp cursor position to visible area instead of hiding it (td-16bfa6).
 		// This ensures cursor remains visible even during pane size mismatches,
@@ -429,7 +448,7 @@ func (p *Plugin) renderShellOutput(width, height int) string {
 		interactiveStyle := lipgloss.NewStyle().
 			Foreground(lipgloss.Color(styles.GetCurrentTheme().Colors.Warning)).
 			Bold(true)
-		hint = interactiveStyle.Render("INTERACTIVE") + " " + dimText(p.getInteractiveExitKey()+" exit")
+		hint = interactiveStyle.Render("INTERACTIVE") + p.copyModeBadge() + " " + dimText(p.getInteractiveExitKey()+" exit") + p.interactiveScrollHint() + p.interactiveErrorHint()
 	} else {
 		// Only show "E for interactive" hint if feature flag is enabled
 		detach := getTmuxDetachHint()
@@ -470,6 +489,15 @@ func (p *Plugin) renderShellOutput(width, height int) string {
 		displayWidth = paneWidth
 	}
 
+	// Keep the cursor in view when the pane is wider than the preview
+	horizOffset := 0
+	if interactive {
+		if cursorVisible {
+			p.previewHorizOffset = followCursorHorizOffset(p.previewHorizOffset, cursorCol, displayWidth)
+		}
+		horizOffset = p.previewHorizOffset
+	}
+
 	effectiveLineCount := lineCount
 	if p.autoScrollOutput && !interactive {
 		lines := shell.Agent.OutputBuf.Lines()
@@ -532,7 +560,11 @@ func (p *Plugin) renderShellOutput(width, height int) string {
 				displayLine = ui.InjectCharacterRangeBackground(displayLine, startCol, endCol)
 			}
 		}
-		displayLine = p.truncateCache.Truncate(displayLine, displayWidth, "")
+		if horizOffset > 0 {
+			displayLine = ansi.Cut(displayLine, horizOffset, horizOffset+displayWidth)
+		} else {
+			displayLine = p.truncateCache.Truncate(displayLine, displayWidth, "")
+		}
 		displayLines = append(displayLines, displayLine)
 	}
 
@@ -559,7 +591,7 @@ func (p *Plugin) renderShellOutput(width, height int) string {
 		} else if paneHeight > 0 && paneHeight < displayHeight {
 			relativeRow = cursorRow + (displayHeight - paneHeight)
 		}
-		relativeCol := cursorCol
+		relativeCol := cursorCol - horizOffset
 
 		// Clamp cursor position to visible area instead of hiding it (td-16bfa6).
 		// This ensures cursor remains visible even during pane size mismatches,
@@ -661,8 +693,10 @@ func (p *Plugin) renderShellPrimer(width, height int) string {
 }
 
 // renderCommitStatusHeader renders the commit status header for diff view.
+// A branch with no commits of its own still gets a header when it tracks an
+// upstream, so commits it is behind on stay visible.
 func (p *Plugin) renderCommitStatusHeader(width int) string {
-	if len(p.commitStatusList) == 0 {
+	if len(p.commitStatusList) == 0 && p.commitStatusUpstream == nil {
 		return ""
 	}
 
@@ -677,10 +711,17 @@ func (p *Plugin) renderCommitStatusHeader(width int) string {
 	hashStyle := lipgloss.NewStyle().Foreground(styles.Warning)
 	pushedStyle := lipgloss.NewStyle().Foreground(styles.Success)
 	localStyle := lipgloss.NewStyle().Foreground(styles.TextMuted)
+	behindStyle := lipgloss.NewStyle().Foreground(styles.Warning)
 
 	var sb strings.Builder
 	sb.WriteString(titleStyle.Render(fmt.Sprintf("Commits (%d)", len(p.commitStatusList))))
-	sb.WriteString("\n")
+	if up := p.commitStatusUpstream; up != nil {
+		sb.WriteString(" ")
+		sb.WriteString(pushedStyle.Render(fmt.Sprintf("↑%d", up.Ahead)))
+		sb.WriteString(" ")
+		sb.WriteString(behindStyle.Render(fmt.Sprintf("↓%d", up.Behind)))
+		sb.WriteString(dimText(" vs " + up.Branch))
+	}
 
 	// Show up to 5 commits
 	maxCommits := 5
@@ -711,10 +752,8 @@ func (p *Plugin) renderCommitStatusHeader(width int) string {
 		}
 
 		line := fmt.Sprintf("%s %s %s", statusIcon, hashStyle.Render(commit.Hash), subject)
+		sb.WriteString("\n")
 		sb.WriteString(line)
-		if i < displayCount-1 {
-			sb.WriteString("\n")
-		}
 	}
 
 	if len(p.commitStatusList) > maxCommits {
@@ -774,6 +813,7 @@ func (p *Plugin) renderMainWorktreeView(width, height int) string {
 
 // renderTaskContent renders linked task info.
 func (p *Plugin) renderTaskContent(width, height int) string {
+	p.taskLinks = nil
 	wt := p.selectedWorktree()
 	if wt == nil {
 		return dimText("No worktree selected")
@@ -862,5 +902,22 @@ func (p *Plugin) renderTaskContent(width, height int) string {
 		lines = append(lines, dimText(fmt.Sprintf("Updated: %s", task.UpdatedAt)))
 	}
 
-	return strings.Join(lines, "\n")
+	// Plain text entries may contain wrapped newlines, so find links on the
+	// final line layout to keep click regions aligned.
+	content := strings.Join(lines, "\n")
+	contentLines := strings.Split(content, "\n")
+	p.taskLinks = extractTaskLinks(contentLines)
+	if len(p.taskLinks) == 0 {
+		return content
+	}
+
+	contentLines[0] += "  " + dimText("[o] links")
+	if p.taskLinkPickMode {
+		// Cut the task short so the legend below it stays on screen
+		if rows := p.taskLinkLegendRows(height); len(contentLines) > rows {
+			contentLines = contentLines[:rows]
+		}
+		contentLines = append(contentLines, "", p.renderTaskLinkLegend())
+	}
+	return strings.Join(contentLines, "\n")
 }
@@ -5,14 +5,17 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 )
 
 // State holds persistent user preferences.
 type State struct {
-	GitDiffMode       string `json:"gitDiffMode"`                 // "unified" or "side-by-side"
-	WorkspaceDiffMode string `json:"workspaceDiffMode,omitempty"` // "unified" or "side-by-side"
-	GitGraphEnabled   bool   `json:"gitGraphEnabled,omitempty"`   // Show commit graph in sidebar
-	LineWrapEnabled   bool   `json:"lineWrapEnabled,omitempty"`   // Wrap long lines instead of truncating
+	GitDiffMode        string `json:"gitDiffMode"`                  // "unified" or "side-by-side"
+	WorkspaceDiffMode  string `json:"workspaceDiffMode,omitempty"`  // "unified" or "side-by-side"
+	GitGraphEnabled    bool   `json:"gitGraphEnabled,omitempty"`    // Show commit graph in sidebar
+	LineWrapEnabled    bool   `json:"lineWrapEnabled,omitempty"`    // Wrap long lines instead of truncating
+	DiffLineNumbersOff bool   `json:"diffLineNumbersOff,omitempty"` // Hide the line number gutter in unified diffs
+	GitFilesGrouped    bool   `json:"gitFilesGrouped,omitempty"`    // Group sidebar files by directory
 
 	// Pane width preferences (percentage of total width, 0 = use default)
 	FileBrowserTreeWidth   int `json:"fileBrowserTreeWidth,omitempty"`
@@ -28,6 +31,9 @@ type State struct {
 
 	// Worktree state: maps main repo path -> last active worktree path
 	LastWorktreePath map[string]string `json:"lastWorktreePath,omitempty"`
+
+	// Conversations state: when each session was last viewed
+	ConversationsViewed *ConversationsViewState `json:"conversationsViewed,omitempty"`
 }
 
 // FileBrowserTabState holds persistent tab state for the file browser.
@@ -45,7 +51,12 @@ type FileBrowserState struct {
 	ActivePane    string                `json:"activePane,omitempty"`    // "tree" or "preview"
 	PreviewFile   string                `json:"previewFile,omitempty"`   // File being previewed (relative)
 	TreeCursor    int                   `json:"treeCursor,omitempty"`    // Tree cursor position
-	ShowIgnored   *bool                 `json:"showIgnored,omitempty"`   // Whether to show git-ignored files (nil = default true)
+	ShowIgnored   *bool                 `json:"showIgnored,omitempty"`   // Deprecated: replaced by Visibility, still read for older state files
+	Visibility    string                `json:"visibility,omitempty"`    // "all", "hide-ignored" or "hide-hidden"
+	Bookmarks     []string              `json:"bookmarks,omitempty"`     // Bookmarked paths (relative), in the order added
+	SortMode      string                `json:"sortMode,omitempty"`      // "name", "size", "time" or "type"
+	SortDesc      bool                  `json:"sortDesc,omitempty"`      // Sort descending
+	MixDirs       bool                  `json:"mixDirs,omitempty"`       // Sort directories among files instead of first
 	Tabs          []FileBrowserTabState `json:"tabs,omitempty"`
 	ActiveTab     int                   `json:"activeTab,omitempty"`
 }
@@ -64,6 +75,12 @@ type NotesState struct {
 	ShowArchived bool   `json:"showArchived,omitempty"` // Whether to show archived notes
 }
 
+// ConversationsViewState holds when conversations were last viewed.
+type ConversationsViewState struct {
+	Since      time.Time            `json:"since"`                // When tracking started; older sessions count as viewed
+	LastViewed map[string]time.Time `json:"lastViewed,omitempty"` // Session ID -> last viewed time
+}
+
 var (
 	current *State
 	mu      sync.RWMutex
@@ -192,6 +209,27 @@ func SetGitGraphEnabled(enabled bool) error {
 	return Save()
 }
 
+// GetGitFilesGrouped returns whether the git sidebar groups files by directory.
+func GetGitFilesGrouped() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil {
+		return false
+	}
+	return current.GitFilesGrouped
+}
+
+// SetGitFilesGrouped saves the git sidebar directory grouping preference.
+func SetGitFilesGrouped(grouped bool) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	current.GitFilesGrouped = grouped
+	mu.Unlock()
+	return Save()
+}
+
 // GetLineWrapEnabled returns whether line wrapping is enabled.
 func GetLineWrapEnabled() bool {
 	mu.RLock()
@@ -213,6 +251,27 @@ func SetLineWrapEnabled(enabled bool) error {
 	return Save()
 }
 
+// GetDiffLineNumbers returns whether unified diffs show line numbers.
+func GetDiffLineNumbers() bool {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil {
+		return true
+	}
+	return !current.DiffLineNumbersOff
+}
+
+// SetDiffLineNumbers saves the diff line number preference.
+func SetDiffLineNumbers(enabled bool) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	current.DiffLineNumbersOff = !enabled
+	mu.Unlock()
+	return Save()
+}
+
 // GetFileBrowserTreeWidth returns the saved file browser tree pane width.
 // Returns 0 if no preference is saved (use default).
 func GetFileBrowserTreeWidth() int {
@@ -449,3 +508,72 @@ func SetNotesListWidth(width int) error {
 	mu.Unlock()
 	return Save()
 }
+
+// GetConversationsViewState returns a copy of the saved conversation view times.
+func GetConversationsViewState() ConversationsViewState {
+	mu.RLock()
+	defer mu.RUnlock()
+	if current == nil || current.ConversationsViewed == nil {
+		return ConversationsViewState{}
+	}
+	vs := ConversationsViewState{
+		Since:      current.ConversationsViewed.Since,
+		LastViewed: make(map[string]time.Time, len(current.ConversationsViewed.LastViewed)),
+	}
+	for id, t := range current.ConversationsViewed.LastViewed {
+		vs.LastViewed[id] = t
+	}
+	return vs
+}
+
+// SetConversationsViewedSince saves when conversation view tracking started.
+func SetConversationsViewedSince(since time.Time) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	if current.ConversationsViewed == nil {
+		current.ConversationsViewed = &ConversationsViewState{}
+	}
+	current.ConversationsViewed.Since = since
+	mu.Unlock()
+	return Save()
+}
+
+// conversationsViewedRetention is how long last-viewed times are kept.
+const conversationsViewedRetention = 90 * 24 * time.Hour
+
+// prune drops last-viewed times older than the retention window before at.
+// The baseline moves up to the cutoff, so sessions with no activity since
+// then still count as viewed.
+func (vs *ConversationsViewState) prune(at time.Time) {
+	cutoff := at.Add(-conversationsViewedRetention)
+	if vs.Since.Before(cutoff) {
+		vs.Since = cutoff
+	}
+	for id, t := range vs.LastViewed {
+		if t.Before(cutoff) {
+			delete(vs.LastViewed, id)
+		}
+	}
+}
+
+// SetConversationViewed saves when a conversation was last viewed, pruning
+// times that have aged out of the retention window. The map is shared by
+// every project, so it is pruned by age rather than by listed sessions.
+func SetConversationViewed(sessionID string, at time.Time) error {
+	mu.Lock()
+	if current == nil {
+		current = &State{}
+	}
+	if current.ConversationsViewed == nil {
+		current.ConversationsViewed = &ConversationsViewState{Since: at}
+	}
+	if current.ConversationsViewed.LastViewed == nil {
+		current.ConversationsViewed.LastViewed = make(map[string]time.Time)
+	}
+	current.ConversationsViewed.LastViewed[sessionID] = at
+	current.ConversationsViewed.prune(at)
+	mu.Unlock()
+	return Save()
+}
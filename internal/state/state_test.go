@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestInit(t *testing.T) {
@@ -319,6 +320,34 @@ func TestSetGitGraphEnabled(t *testing.T) {
 	current = originalCurrent
 }
 
+func TestSetGitFilesGrouped(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalPath := path
+	originalCurrent := current
+	defer func() {
+		path = originalPath
+		current = originalCurrent
+	}()
+
+	stateFile := filepath.Join(tmpDir, "state.json")
+	path = stateFile
+	current = &State{}
+
+	if GetGitFilesGrouped() {
+		t.Fatal("GetGitFilesGrouped() = true, want false by default")
+	}
+	if err := SetGitFilesGrouped(true); err != nil {
+		t.Fatalf("SetGitFilesGrouped() failed: %v", err)
+	}
+
+	data, _ := os.ReadFile(stateFile)
+	var loaded State
+	_ = json.Unmarshal(data, &loaded)
+	if !loaded.GitFilesGrouped {
+		t.Errorf("saved GitFilesGrouped = %v, want true", loaded.GitFilesGrouped)
+	}
+}
+
 func TestConcurrentAccess(t *testing.T) {
 	tmpDir := t.TempDir()
 	originalPath := path
@@ -754,3 +783,89 @@ func TestSetLineWrapEnabled_InitializesNilState(t *testing.T) {
 		t.Errorf("LineWrapEnabled = %v, want true", current.LineWrapEnabled)
 	}
 }
+
+func TestConversationsViewState(t *testing.T) {
+	tmpDir := t.TempDir()
+	originalPath := path
+	originalCurrent := current
+	defer func() {
+		path = originalPath
+		current = originalCurrent
+	}()
+
+	stateFile := filepath.Join(tmpDir, "state.json")
+	path = stateFile
+	current = nil
+
+	if vs := GetConversationsViewState(); !vs.Since.IsZero() || len(vs.LastViewed) != 0 {
+		t.Errorf("default view state = %+v, want empty", vs)
+	}
+
+	viewed := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := SetConversationViewed("ses_1", viewed); err != nil {
+		t.Fatalf("SetConversationViewed() failed: %v", err)
+	}
+	since := viewed.Add(-time.Hour)
+	if err := SetConversationsViewedSince(since); err != nil {
+		t.Fatalf("SetConversationsViewedSince() failed: %v", err)
+	}
+
+	vs := GetConversationsViewState()
+	if !vs.Since.Equal(since) || !vs.LastViewed["ses_1"].Equal(viewed) {
+		t.Errorf("view state = %+v, want since %v and ses_1 at %v", vs, since, viewed)
+	}
+
+	// The returned map is a copy
+	vs.LastViewed["ses_2"] = viewed
+	if _, ok := GetConversationsViewState().LastViewed["ses_2"]; ok {
+		t.Error("modifying the returned map should not change saved state")
+	}
+
+	// Verify saved to disk
+	data, _ := os.ReadFile(stateFile)
+	var loaded State
+	_ = json.Unmarshal(data, &loaded)
+	if loaded.ConversationsViewed == nil || !loaded.ConversationsViewed.LastViewed["ses_1"].Equal(viewed) {
+		t.Errorf("persisted view state = %+v, want ses_1 at %v", loaded.ConversationsViewed, viewed)
+	}
+}
+
+func TestSetConversationViewedPrunesOldTimes(t *testing.T) {
+	originalPath := path
+	originalCurrent := current
+	defer func() {
+		path = originalPath
+		current = originalCurrent
+	}()
+
+	path = filepath.Join(t.TempDir(), "state.json")
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-conversationsViewedRetention - time.Hour)
+	recent := now.Add(-time.Hour)
+	current = &State{ConversationsViewed: &ConversationsViewState{
+		Since:      old.Add(-time.Hour),
+		LastViewed: map[string]time.Time{"old": old, "recent": recent},
+	}}
+
+	if err := SetConversationViewed("new", now); err != nil {
+		t.Fatalf("SetConversationViewed() failed: %v", err)
+	}
+
+	vs := GetConversationsViewState()
+	if _, ok := vs.LastViewed["old"]; ok {
+		t.Error("expected a time past the retention window to be pruned")
+	}
+	if !vs.LastViewed["recent"].Equal(recent) || !vs.LastViewed["new"].Equal(now) {
+		t.Errorf("view state = %+v, want recent and new kept", vs.LastViewed)
+	}
+	if want := now.Add(-conversationsViewedRetention); !vs.Since.Equal(want) {
+		t.Errorf("Since = %v, want it moved up to the cutoff %v", vs.Since, want)
+	}
+
+	data, _ := os.ReadFile(path)
+	var loaded State
+	_ = json.Unmarshal(data, &loaded)
+	if _, ok := loaded.ConversationsViewed.LastViewed["old"]; ok {
+		t.Error("expected the pruned time to be gone from the saved state")
+	}
+}
@@ -44,6 +44,8 @@ var (
 	LinkColor             = lipgloss.Color("#60A5FA") // Hyperlink color
 	ToastSuccessTextColor = lipgloss.Color("#000000") // Toast success foreground
 	ToastErrorTextColor   = lipgloss.Color("#FFFFFF") // Toast error foreground
+	ToastWarningTextColor = lipgloss.Color("#000000") // Toast warning foreground
+	ToastInfoTextColor    = lipgloss.Color("#FFFFFF") // Toast info foreground
 
 	// Danger button colors
 	DangerLight  = lipgloss.Color("#FCA5A5") // Light red text
@@ -165,6 +167,18 @@ var (
 			Bold(true).
 			Padding(0, 1)
 
+	ToastWarning = lipgloss.NewStyle().
+			Background(Warning).
+			Foreground(ToastWarningTextColor).
+			Bold(true).
+			Padding(0, 1)
+
+	ToastInfo = lipgloss.NewStyle().
+			Background(Info).
+			Foreground(ToastInfoTextColor).
+			Bold(true).
+			Padding(0, 1)
+
 	StatusUntracked = lipgloss.NewStyle().
 			Foreground(TextMuted)
 
@@ -259,6 +273,10 @@ var (
 	// Subtle diff backgrounds for syntax-highlighted lines
 	DiffAddBg    = lipgloss.Color("#0D2818") // Very subtle dark green
 	DiffRemoveBg = lipgloss.Color("#2D1A1A") // Very subtle dark red
+
+	// Brighter backgrounds for the changed words within a modified line
+	DiffAddEmphasisBg    = lipgloss.Color("#0d5337")
+	DiffRemoveEmphasisBg = lipgloss.Color("#672626")
 )
 
 // File browser styles
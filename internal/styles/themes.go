@@ -1,6 +1,8 @@
 package styles
 
 import (
+	"fmt"
+	"reflect"
 	"regexp"
 	"sort"
 	"sync"
@@ -67,6 +69,8 @@ type ColorPalette struct {
 	Link             string `json:"link"`             // Hyperlink color
 	ToastSuccessText string `json:"toastSuccessText"` // Toast success foreground
 	ToastErrorText   string `json:"toastErrorText"`   // Toast error foreground
+	ToastWarningText string `json:"toastWarningText"` // Toast warning foreground
+	ToastInfoText    string `json:"toastInfoText"`    // Toast info foreground
 
 	// Danger button colors (for destructive action buttons)
 	DangerLight  string `json:"dangerLight"`  // Light red for danger button text
@@ -156,6 +160,8 @@ var (
 			Link:             "#60A5FA", // Light blue for links
 			ToastSuccessText: "#000000", // Black on green
 			ToastErrorText:   "#FFFFFF", // White on red
+			ToastWarningText: "#000000", // Black on amber
+			ToastInfoText:    "#FFFFFF", // White on blue
 
 			// Danger button colors
 			DangerLight:  "#FCA5A5",
@@ -233,6 +239,8 @@ var (
 			Link:             "#8BE9FD", // Cyan for links (Dracula)
 			ToastSuccessText: "#282A36", // Dark bg on green
 			ToastErrorText:   "#F8F8F2", // Light on red
+			ToastWarningText: "#282A36", // Dark bg on orange
+			ToastInfoText:    "#282A36", // Dark bg on cyan
 
 			// Danger button colors
 			DangerLight:  "#FFADAD",
@@ -301,6 +309,8 @@ var (
 			Link:             "#66D9EF",
 			ToastSuccessText: "#1B1D1E",
 			ToastErrorText:   "#F8F8F2",
+			ToastWarningText: "#1B1D1E",
+			ToastInfoText:    "#1B1D1E",
 
 			// Danger button colors
 			DangerLight:  "#F8A0B8",
@@ -368,6 +378,8 @@ var (
 			Link:             "#88C0D0",
 			ToastSuccessText: "#2E3440",
 			ToastErrorText:   "#E5E9F0",
+			ToastWarningText: "#2E3440",
+			ToastInfoText:    "#2E3440",
 
 			// Danger button colors
 			DangerLight:  "#D08770",
@@ -435,6 +447,8 @@ var (
 			Link:             "#268BD2",
 			ToastSuccessText: "#FDF6E3",
 			ToastErrorText:   "#FDF6E3",
+			ToastWarningText: "#FDF6E3",
+			ToastInfoText:    "#FDF6E3",
 
 			// Danger button colors
 			DangerLight:  "#E8A0A0",
@@ -502,6 +516,8 @@ var (
 			Link:             "#73DACA",
 			ToastSuccessText: "#15161E",
 			ToastErrorText:   "#C0CAF5",
+			ToastWarningText: "#15161E",
+			ToastInfoText:    "#15161E",
 
 			// Danger button colors
 			DangerLight:  "#F7A8B8",
@@ -735,6 +751,10 @@ func applySingleOverride(palette *ColorPalette, key, value string) {
 		palette.ToastSuccessText = value
 	case "toastErrorText":
 		palette.ToastErrorText = value
+	case "toastWarningText":
+		palette.ToastWarningText = value
+	case "toastInfoText":
+		palette.ToastInfoText = value
 	case "syntaxTheme":
 		palette.SyntaxTheme = value
 	case "markdownTheme":
@@ -796,6 +816,90 @@ func applyFloatOverride(palette *ColorPalette, key string, value float64) {
 	}
 }
 
+// overrideKinds maps each override key, a ColorPalette JSON name, to the
+// kind of value it takes.
+var overrideKinds = func() map[string]reflect.Kind {
+	kinds := make(map[string]reflect.Kind)
+	t := reflect.TypeOf(ColorPalette{})
+	for i := 0; i < t.NumField(); i++ {
+		if name := t.Field(i).Tag.Get("json"); name != "" {
+			kinds[name] = t.Field(i).Type.Kind()
+		}
+	}
+	return kinds
+}()
+
+// OverrideKeys returns the keys accepted in theme overrides in sorted order.
+func OverrideKeys() []string {
+	keys := make([]string, 0, len(overrideKinds))
+	for key := range overrideKinds {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ValidateOverrides describes each override that applying it would skip:
+// unknown keys, invalid hex colors and values of the wrong type. It returns
+// nil when every override is usable.
+func ValidateOverrides(overrides map[string]interface{}) []string {
+	var problems []string
+	for key, value := range overrides {
+		if problem := validateOverride(key, value); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// validateOverride checks a single override, returning "" when it is valid.
+func validateOverride(key string, value interface{}) string {
+	kind, ok := overrideKinds[key]
+	if !ok {
+		return fmt.Sprintf("unknown key %q", key)
+	}
+	switch kind {
+	case reflect.String:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Sprintf("%s: expected a string, got %v", key, value)
+		}
+		isThemeName := key == "syntaxTheme" || key == "markdownTheme" || key == "tabStyle"
+		if !isThemeName && !IsValidHexColor(s) {
+			return fmt.Sprintf("%s: invalid hex color %q", key, s)
+		}
+	case reflect.Slice:
+		var colors []string
+		switch v := value.(type) {
+		case []string:
+			colors = v
+		case []interface{}:
+			for _, item := range v {
+				c, ok := item.(string)
+				if !ok {
+					return fmt.Sprintf("%s: expected a list of colors, got %v", key, value)
+				}
+				colors = append(colors, c)
+			}
+		default:
+			return fmt.Sprintf("%s: expected a list of colors, got %v", key, value)
+		}
+		for _, c := range colors {
+			if !IsValidHexColor(c) {
+				return fmt.Sprintf("%s: invalid hex color %q", key, c)
+			}
+		}
+	case reflect.Float64:
+		switch value.(type) {
+		case float64, int:
+		default:
+			return fmt.Sprintf("%s: expected a number, got %v", key, value)
+		}
+	}
+	return ""
+}
+
 // ApplyThemeColors updates all style package variables from a theme.
 //
 // IMPORTANT: This function is NOT thread-safe for concurrent reads.
@@ -838,6 +942,8 @@ func ApplyThemeColors(theme Theme) {
 	DiffAddBg = lipgloss.Color(c.DiffAddBg)
 	DiffRemoveFg = lipgloss.Color(c.DiffRemoveFg)
 	DiffRemoveBg = lipgloss.Color(c.DiffRemoveBg)
+	DiffAddEmphasisBg = lipgloss.Color(diffEmphasisBg(c.DiffAddBg, c.DiffAddFg))
+	DiffRemoveEmphasisBg = lipgloss.Color(diffEmphasisBg(c.DiffRemoveBg, c.DiffRemoveFg))
 
 	TextHighlight = lipgloss.Color(c.TextHighlight)
 	ButtonHoverColor = lipgloss.Color(c.ButtonHover)
@@ -846,6 +952,18 @@ func ApplyThemeColors(theme Theme) {
 	ToastSuccessTextColor = lipgloss.Color(c.ToastSuccessText)
 	ToastErrorTextColor = lipgloss.Color(c.ToastErrorText)
 
+	// Warning/info toast text (with fallback to the success/error text)
+	if c.ToastWarningText != "" {
+		ToastWarningTextColor = lipgloss.Color(c.ToastWarningText)
+	} else {
+		ToastWarningTextColor = ToastSuccessTextColor
+	}
+	if c.ToastInfoText != "" {
+		ToastInfoTextColor = lipgloss.Color(c.ToastInfoText)
+	} else {
+		ToastInfoTextColor = ToastErrorTextColor
+	}
+
 	// Danger button colors (with defaults)
 	if c.DangerLight != "" {
 		DangerLight = lipgloss.Color(c.DangerLight)
@@ -908,6 +1026,12 @@ func ApplyThemeColors(theme Theme) {
 	rebuildStyles()
 }
 
+// diffEmphasisBg derives the word-diff highlight background by blending a
+// diff line background 30% toward its foreground color.
+func diffEmphasisBg(bg, fg string) string {
+	return RGBToHex(LerpRGB(HexToRGB(bg), HexToRGB(fg), 0.3))
+}
+
 // rebuildStyles recreates all lipgloss styles with current colors
 func rebuildStyles() {
 	// Panel styles
@@ -988,6 +1112,18 @@ func rebuildStyles() {
 		Bold(true).
 		Padding(0, 1)
 
+	ToastWarning = lipgloss.NewStyle().
+		Background(Warning).
+		Foreground(ToastWarningTextColor).
+		Bold(true).
+		Padding(0, 1)
+
+	ToastInfo = lipgloss.NewStyle().
+		Background(Info).
+		Foreground(ToastInfoTextColor).
+		Bold(true).
+		Padding(0, 1)
+
 	StatusUntracked = lipgloss.NewStyle().
 		Foreground(TextMuted)
 
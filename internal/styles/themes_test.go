@@ -1,6 +1,10 @@
 package styles
 
-import "testing"
+import (
+	"slices"
+	"strings"
+	"testing"
+)
 
 func TestIsValidHexColor(t *testing.T) {
 	tests := []struct {
@@ -63,3 +67,101 @@ func TestGetTheme(t *testing.T) {
 		t.Errorf("GetTheme(\"nonexistent\") = %q, want default theme %q", fallback.Name, DefaultTheme.Name)
 	}
 }
+
+func TestApplyGenericOverrides(t *testing.T) {
+	palette := DefaultTheme.Colors
+	applyGenericOverrides(&palette, map[string]interface{}{
+		"primary":              "#112233",
+		"tabStyle":             "per-tab",
+		"tabColors":            []interface{}{"#000001", "#000002"},
+		"gradientBorderAngle":  45,
+		"primry":               "#445566", // Unknown key
+		"secondary":            "blue",    // Not a hex color
+		"gradientBorderActive": []interface{}{"#000003", "nope"},
+	})
+
+	if palette.Primary != "#112233" {
+		t.Errorf("primary = %q, want #112233", palette.Primary)
+	}
+	if palette.TabStyle != "per-tab" {
+		t.Errorf("tabStyle = %q, want per-tab", palette.TabStyle)
+	}
+	if len(palette.TabColors) != 2 || palette.TabColors[1] != "#000002" {
+		t.Errorf("tabColors = %v", palette.TabColors)
+	}
+	if palette.GradientBorderAngle != 45 {
+		t.Errorf("gradientBorderAngle = %v, want 45", palette.GradientBorderAngle)
+	}
+	// Rejected entries keep the theme's values
+	if palette.Secondary != DefaultTheme.Colors.Secondary {
+		t.Errorf("invalid secondary replaced default: %q", palette.Secondary)
+	}
+	if len(palette.GradientBorderActive) != len(DefaultTheme.Colors.GradientBorderActive) {
+		t.Errorf("invalid gradient replaced default: %v", palette.GradientBorderActive)
+	}
+}
+
+func TestValidateOverrides(t *testing.T) {
+	valid := map[string]interface{}{
+		"primary":             "#112233",
+		"syntaxTheme":         "monokai",
+		"tabColors":           []string{"#000001"},
+		"gradientBorderAngle": 30.0,
+	}
+	if problems := ValidateOverrides(valid); problems != nil {
+		t.Errorf("valid overrides reported %v", problems)
+	}
+
+	problems := ValidateOverrides(map[string]interface{}{
+		"primry":              "#112233",
+		"secondary":           "blue",
+		"tabColors":           []interface{}{"#000001", 7},
+		"gradientBorderAngle": "steep",
+	})
+	want := []string{
+		`gradientBorderAngle: expected a number, got steep`,
+		`secondary: invalid hex color "blue"`,
+		`tabColors: expected a list of colors, got [#000001 7]`,
+		`unknown key "primry"`,
+	}
+	if strings.Join(problems, "\n") != strings.Join(want, "\n") {
+		t.Errorf("ValidateOverrides() =\n%s\nwant\n%s", strings.Join(problems, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestOverrideKeys(t *testing.T) {
+	keys := OverrideKeys()
+	for _, key := range []string{"primary", "tabColors", "gradientBorderAngle", "markdownTheme"} {
+		if !slices.Contains(keys, key) {
+			t.Errorf("OverrideKeys() missing %q", key)
+		}
+	}
+	if !slices.IsSorted(keys) {
+		t.Error("OverrideKeys() should be sorted")
+	}
+}
+
+func TestApplyThemeColors_ToastText(t *testing.T) {
+	defer ApplyTheme(DefaultTheme.Name)
+
+	ApplyTheme("dracula")
+	colors := GetTheme("dracula").Colors
+	if string(ToastWarningTextColor) != colors.ToastWarningText {
+		t.Errorf("warning toast text = %q, want %q", ToastWarningTextColor, colors.ToastWarningText)
+	}
+	if string(ToastInfoTextColor) != colors.ToastInfoText {
+		t.Errorf("info toast text = %q, want %q", ToastInfoTextColor, colors.ToastInfoText)
+	}
+
+	// Themes without warning/info text fall back to the success/error text
+	theme := GetTheme("dracula")
+	theme.Colors.ToastWarningText = ""
+	theme.Colors.ToastInfoText = ""
+	ApplyThemeColors(theme)
+	if ToastWarningTextColor != ToastSuccessTextColor {
+		t.Errorf("warning toast text = %q, want success text %q", ToastWarningTextColor, ToastSuccessTextColor)
+	}
+	if ToastInfoTextColor != ToastErrorTextColor {
+		t.Errorf("info toast text = %q, want error text %q", ToastInfoTextColor, ToastErrorTextColor)
+	}
+}
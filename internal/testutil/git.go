@@ -0,0 +1,35 @@
+// Package testutil provides helpers for tests.
+package testutil
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// NewGitRepo initializes an empty git repository on branch main in a temp
+// dir, with a commit identity configured. It returns the directory and a
+// function that runs git there, failing the test on error and returning the
+// trimmed combined output. Tests are skipped when git isn't installed.
+func NewGitRepo(t testing.TB) (dir string, run func(args ...string) string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir = t.TempDir()
+	run = func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	run("init", "-q", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "Test")
+	run("config", "commit.gpgsign", "false")
+	return dir, run
+}
@@ -0,0 +1,52 @@
+package theme
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/marcus/sidecar/internal/config"
+)
+
+// File is a user theme file: a built-in or community base theme with color
+// overrides on top, e.g.
+//
+//	{"name": "dracula", "overrides": {"primary": "#ff79c6"}}
+type File struct {
+	Name      string                 `json:"name"`
+	Community string                 `json:"community,omitempty"`
+	Overrides map[string]interface{} `json:"overrides"`
+}
+
+// LoadFile reads a theme file.
+func LoadFile(path string) (File, error) {
+	var f File
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return f, err
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return f, fmt.Errorf("parse theme file %s: %w", path, err)
+	}
+	return f, nil
+}
+
+// withFile layers a theme file under a theme config: the file supplies the
+// base theme and overrides, and the config's own overrides win.
+func withFile(tc config.ThemeConfig, f File) config.ThemeConfig {
+	if f.Name != "" {
+		tc.Name = f.Name
+	}
+	if f.Community != "" {
+		tc.Community = f.Community
+	}
+	overrides := make(map[string]interface{}, len(f.Overrides)+len(tc.Overrides))
+	for k, v := range f.Overrides {
+		overrides[k] = v
+	}
+	for k, v := range tc.Overrides {
+		overrides[k] = v
+	}
+	tc.Overrides = overrides
+	return tc
+}
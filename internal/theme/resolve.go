@@ -1,6 +1,8 @@
 package theme
 
 import (
+	"log/slog"
+
 	"github.com/marcus/sidecar/internal/community"
 	"github.com/marcus/sidecar/internal/config"
 	"github.com/marcus/sidecar/internal/styles"
@@ -15,25 +17,40 @@ type ResolvedTheme struct {
 
 // ResolveTheme determines the effective theme for a project path.
 // Priority: project.Theme > global UI.Theme > "default".
+// A theme file named by the config supplies the base theme and overrides,
+// with the config's own overrides layered on top. Unreadable files, unknown
+// themes and unusable overrides are logged and skipped.
 func ResolveTheme(cfg *config.Config, projectPath string) ResolvedTheme {
-	resolved := ResolvedTheme{
-		BaseName:      cfg.UI.Theme.Name,
-		CommunityName: cfg.UI.Theme.Community,
-		Overrides:     cfg.UI.Theme.Overrides,
-	}
-
+	tc := cfg.UI.Theme
 	for _, proj := range cfg.Projects.List {
 		if proj.Path == projectPath && proj.Theme != nil {
-			resolved.BaseName = proj.Theme.Name
-			resolved.CommunityName = proj.Theme.Community
-			resolved.Overrides = proj.Theme.Overrides
+			tc = *proj.Theme
 			break
 		}
 	}
 
+	if tc.File != "" {
+		if f, err := LoadFile(tc.File); err != nil {
+			slog.Warn("theme file not loaded", "path", tc.File, "err", err)
+		} else {
+			tc = withFile(tc, f)
+		}
+	}
+
+	resolved := ResolvedTheme{
+		BaseName:      tc.Name,
+		CommunityName: tc.Community,
+		Overrides:     tc.Overrides,
+	}
 	if resolved.BaseName == "" {
 		resolved.BaseName = "default"
 	}
+	if !styles.IsValidTheme(resolved.BaseName) {
+		slog.Warn("unknown theme, using default", "theme", resolved.BaseName)
+	}
+	for _, problem := range styles.ValidateOverrides(resolved.Overrides) {
+		slog.Warn("theme override ignored", "problem", problem)
+	}
 
 	return resolved
 }
@@ -1,6 +1,8 @@
 package theme
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/marcus/sidecar/internal/config"
@@ -240,3 +242,38 @@ func TestApplyResolved(t *testing.T) {
 		}
 	})
 }
+
+func TestResolveThemeFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "theme.json")
+	data := `{"name": "dracula", "overrides": {"primary": "#111111", "accent": "#222222", "bogus": "#333333"}}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{UI: config.UIConfig{Theme: config.ThemeConfig{
+		Name:      "monokai",
+		File:      path,
+		Overrides: map[string]interface{}{"accent": "#444444"},
+	}}}
+	got := ResolveTheme(cfg, "/any")
+	if got.BaseName != "dracula" {
+		t.Errorf("BaseName = %q, want the file's dracula", got.BaseName)
+	}
+	if got.Overrides["primary"] != "#111111" || got.Overrides["accent"] != "#444444" {
+		t.Errorf("overrides = %v, want file primary and config accent", got.Overrides)
+	}
+
+	// Unknown keys are skipped when applied
+	ApplyResolved(got)
+	if th := styles.GetCurrentTheme(); th.Colors.Primary != "#111111" || th.Colors.Accent != "#444444" {
+		t.Errorf("applied primary %q accent %q", th.Colors.Primary, th.Colors.Accent)
+	}
+
+	// A missing file falls back to the config
+	cfg.UI.Theme.File = filepath.Join(dir, "missing.json")
+	got = ResolveTheme(cfg, "/any")
+	if got.BaseName != "monokai" || got.Overrides["accent"] != "#444444" {
+		t.Errorf("missing file: got %+v, want the config theme", got)
+	}
+}
@@ -46,23 +46,46 @@ func RenderWithCursor(content string, cursorRow, cursorCol int, visible bool) st
 		padding := max(cursorCol-lineWidth, 0)
 		lines[cursorRow] = line + strings.Repeat(" ", padding) + CursorStyle().Render("\u2588")
 	} else {
-		// Use ANSI-aware slicing to preserve escape codes in before/after
-		before := ansi.Cut(line, 0, cursorCol)
-		char := ansi.Cut(line, cursorCol, cursorCol+1)
-		after := ansi.Cut(line, cursorCol+1, lineWidth)
+		// Use ANSI-aware slicing to preserve escape codes in before/after.
+		// Slice around the whole glyph so wide (CJK, emoji) cells aren't split.
+		start, width, char := CursorCell(line, cursorCol)
+		before := ansi.Cut(line, 0, start)
+		after := ansi.Cut(line, start+width, lineWidth)
 
-		// Strip the cursor char to get clean styling
-		charStripped := ansi.Strip(char)
 		// Use a block character for empty/whitespace to make cursor more visible
-		if charStripped == "" || charStripped == " " {
-			charStripped = "\u2588"
+		if char == "" || char == " " {
+			char = "\u2588"
 		}
-		lines[cursorRow] = before + CursorStyle().Render(charStripped) + after
+		lines[cursorRow] = before + CursorStyle().Render(char) + after
 	}
 
 	return strings.Join(lines, "\n")
 }
 
+// CursorCell finds the glyph covering display column col in an ANSI-styled line.
+// Returns the glyph's starting column, its cell width (2 for CJK and emoji),
+// and the glyph with escape codes stripped. If col is past the last glyph,
+// returns col, 1, and "".
+func CursorCell(line string, col int) (start, width int, glyph string) {
+	s := ansi.Strip(line)
+	pos := 0
+	for len(s) > 0 {
+		cluster, w := ansi.FirstGraphemeCluster(s, ansi.GraphemeWidth)
+		if cluster == "" {
+			break
+		}
+		s = s[len(cluster):]
+		if w == 0 {
+			continue
+		}
+		if col < pos+w {
+			return pos, w, cluster
+		}
+		pos += w
+	}
+	return col, 1, ""
+}
+
 // QueryCursorPositionSync synchronously queries cursor position for the given target.
 // Used to capture cursor position atomically with output in poll goroutines.
 // Returns row, col (0-indexed), paneHeight, paneWidth, visible, and ok (false if query failed).
@@ -12,30 +12,40 @@ import (
 // For literal characters, returns the character with useLiteral=true.
 func MapKeyToTmux(msg tea.KeyMsg) (key string, useLiteral bool) {
 	switch msg.String() {
+	// Modified arrows use tmux's modifier-prefixed key names so tmux encodes
+	// them for the pane's terminal (S-, C-, M- and combinations).
 	case "shift+up":
-		return "\x1b[1;2A", true
+		return "S-Up", false
 	case "shift+down":
-		return "\x1b[1;2B", true
+		return "S-Down", false
 	case "shift+right":
-		return "\x1b[1;2C", true
+		return "S-Right", false
 	case "shift+left":
-		return "\x1b[1;2D", true
+		return "S-Left", false
 	case "ctrl+up":
-		return "\x1b[1;5A", true
+		return "C-Up", false
 	case "ctrl+down":
-		return "\x1b[1;5B", true
+		return "C-Down", false
 	case "ctrl+right":
-		return "\x1b[1;5C", true
+		return "C-Right", false
 	case "ctrl+left":
-		return "\x1b[1;5D", true
+		return "C-Left", false
+	case "ctrl+shift+up":
+		return "C-S-Up", false
+	case "ctrl+shift+down":
+		return "C-S-Down", false
+	case "ctrl+shift+right":
+		return "C-S-Right", false
+	case "ctrl+shift+left":
+		return "C-S-Left", false
 	case "alt+up":
-		return "\x1b[1;3A", true
+		return "M-Up", false
 	case "alt+down":
-		return "\x1b[1;3B", true
+		return "M-Down", false
 	case "alt+right":
-		return "\x1b[1;3C", true
+		return "M-Right", false
 	case "alt+left":
-		return "\x1b[1;3D", true
+		return "M-Left", false
 	case "shift+tab":
 		return "\x1b[Z", true
 	}
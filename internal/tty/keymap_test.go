@@ -73,6 +73,45 @@ func TestMapKeyToTmux_ArrowKeys(t *testing.T) {
 	}
 }
 
+func TestMapKeyToTmux_ModifiedArrowKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  tea.KeyMsg
+		want string
+	}{
+		{"shift+up", tea.KeyMsg{Type: tea.KeyShiftUp}, "S-Up"},
+		{"shift+down", tea.KeyMsg{Type: tea.KeyShiftDown}, "S-Down"},
+		{"shift+left", tea.KeyMsg{Type: tea.KeyShiftLeft}, "S-Left"},
+		{"shift+right", tea.KeyMsg{Type: tea.KeyShiftRight}, "S-Right"},
+		{"ctrl+up", tea.KeyMsg{Type: tea.KeyCtrlUp}, "C-Up"},
+		{"ctrl+down", tea.KeyMsg{Type: tea.KeyCtrlDown}, "C-Down"},
+		{"ctrl+left", tea.KeyMsg{Type: tea.KeyCtrlLeft}, "C-Left"},
+		{"ctrl+right", tea.KeyMsg{Type: tea.KeyCtrlRight}, "C-Right"},
+		{"ctrl+shift+up", tea.KeyMsg{Type: tea.KeyCtrlShiftUp}, "C-S-Up"},
+		{"ctrl+shift+down", tea.KeyMsg{Type: tea.KeyCtrlShiftDown}, "C-S-Down"},
+		{"ctrl+shift+left", tea.KeyMsg{Type: tea.KeyCtrlShiftLeft}, "C-S-Left"},
+		{"ctrl+shift+right", tea.KeyMsg{Type: tea.KeyCtrlShiftRight}, "C-S-Right"},
+		{"alt+up", tea.KeyMsg{Type: tea.KeyUp, Alt: true}, "M-Up"},
+		{"alt+left", tea.KeyMsg{Type: tea.KeyLeft, Alt: true}, "M-Left"},
+		{"up", tea.KeyMsg{Type: tea.KeyUp}, "Up"},
+		{"down", tea.KeyMsg{Type: tea.KeyDown}, "Down"},
+		{"left", tea.KeyMsg{Type: tea.KeyLeft}, "Left"},
+		{"right", tea.KeyMsg{Type: tea.KeyRight}, "Right"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, literal := MapKeyToTmux(tt.msg)
+			if key != tt.want {
+				t.Errorf("expected key='%s', got '%s'", tt.want, key)
+			}
+			if literal {
+				t.Errorf("expected literal=false for %s", tt.want)
+			}
+		})
+	}
+}
+
 func TestMapKeyToTmux_CtrlKeys(t *testing.T) {
 	tests := []struct {
 		keyType tea.KeyType
@@ -0,0 +1,95 @@
+package tty
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ParseKeySequence converts a scripted key sequence into KeySpecs.
+//
+// Each entry is literal text, optionally containing named keys in angle
+// brackets using tmux key names (the same names MapKeyToTmux produces):
+//
+//	"git status<Enter>"  -> literal "git status", key "Enter"
+//	"<C-c>"              -> key "C-c"
+//	"<S-Up>"             -> key "S-Up"
+//	"<lt>"               -> literal "<"
+//
+// A "<" that doesn't start a well-formed <Name> token is sent literally.
+// Adjacent literal text is merged so it is sent in a single send-keys -l call.
+func ParseKeySequence(keys []string) []KeySpec {
+	var specs []KeySpec
+	var literal strings.Builder
+
+	flushLiteral := func() {
+		if literal.Len() > 0 {
+			specs = append(specs, KeySpec{Value: literal.String(), Literal: true})
+			literal.Reset()
+		}
+	}
+
+	for _, entry := range keys {
+		for len(entry) > 0 {
+			name, rest, ok := cutKeyToken(entry)
+			if !ok {
+				literal.WriteByte(entry[0])
+				entry = entry[1:]
+				continue
+			}
+			if name == "lt" {
+				literal.WriteByte('<')
+			} else {
+				flushLiteral()
+				specs = append(specs, KeySpec{Value: name})
+			}
+			entry = rest
+		}
+	}
+	flushLiteral()
+	return specs
+}
+
+// cutKeyToken reports whether s starts with a <Name> token and returns the
+// name and the remainder. Names are letters, digits, and '-' (e.g. "C-S-Left").
+func cutKeyToken(s string) (name, rest string, ok bool) {
+	if !strings.HasPrefix(s, "<") {
+		return "", s, false
+	}
+	end := strings.IndexByte(s, '>')
+	if end <= 1 {
+		return "", s, false
+	}
+	name = s[1:end]
+	for _, r := range name {
+		isAlnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !isAlnum && r != '-' {
+			return "", s, false
+		}
+	}
+	return name, s[end+1:], true
+}
+
+// SendKeySequence sends a scripted key sequence to a tmux session without a TUI.
+// See ParseKeySequence for the syntax. Every key is attempted in order; the
+// returned error joins all send failures, or is nil if all succeeded.
+func SendKeySequence(sessionName string, keys []string) error {
+	return sendKeySpecs(sessionName, ParseKeySequence(keys), SendKeyToTmux, SendLiteralToTmux)
+}
+
+// sendKeySpecs dispatches each spec to the named-key or literal sender.
+func sendKeySpecs(sessionName string, specs []KeySpec, sendKey, sendLiteral func(sessionName, value string) error) error {
+	var errs []error
+	for _, k := range specs {
+		var err error
+		if k.Literal {
+			err = sendLiteral(sessionName, k.Value)
+		} else {
+			err = sendKey(sessionName, k.Value)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("send %q: %w", k.Value, err))
+		}
+	}
+	return errors.Join(errs...)
+}
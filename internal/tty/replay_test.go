@@ -0,0 +1,118 @@
+package tty
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseKeySequence(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		want []KeySpec
+	}{
+		{
+			name: "literal only",
+			keys: []string{"hello"},
+			want: []KeySpec{{Value: "hello", Literal: true}},
+		},
+		{
+			name: "named key only",
+			keys: []string{"<Enter>"},
+			want: []KeySpec{{Value: "Enter"}},
+		},
+		{
+			name: "text then key",
+			keys: []string{"git status<Enter>"},
+			want: []KeySpec{{Value: "git status", Literal: true}, {Value: "Enter"}},
+		},
+		{
+			name: "modified keys",
+			keys: []string{"<C-c>", "<C-S-Left>", "<M-Up>"},
+			want: []KeySpec{{Value: "C-c"}, {Value: "C-S-Left"}, {Value: "M-Up"}},
+		},
+		{
+			name: "adjacent literals merge across entries",
+			keys: []string{"ab", "cd", "<Tab>"},
+			want: []KeySpec{{Value: "abcd", Literal: true}, {Value: "Tab"}},
+		},
+		{
+			name: "lt escape",
+			keys: []string{"a <lt>b> c"},
+			want: []KeySpec{{Value: "a <b> c", Literal: true}},
+		},
+		{
+			name: "malformed tokens are literal",
+			keys: []string{"x < y", "<>", "<not a key>", "<Enter"},
+			want: []KeySpec{{Value: "x < y<><not a key><Enter", Literal: true}},
+		},
+		{
+			name: "empty",
+			keys: nil,
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseKeySequence(tt.keys)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseKeySequence(%q) = %+v, want %+v", tt.keys, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendKeySpecs_Dispatch(t *testing.T) {
+	var calls []string
+	sendKey := func(session, value string) error {
+		calls = append(calls, session+" key "+value)
+		return nil
+	}
+	sendLiteral := func(session, value string) error {
+		calls = append(calls, session+" literal "+value)
+		return nil
+	}
+
+	specs := ParseKeySequence([]string{"ls<Enter>", "<C-c>"})
+	if err := sendKeySpecs("s1", specs, sendKey, sendLiteral); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"s1 literal ls", "s1 key Enter", "s1 key C-c"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %q, want %q", calls, want)
+	}
+}
+
+func TestSendKeySpecs_AggregatesErrors(t *testing.T) {
+	errBoom := errors.New("boom")
+	calls := 0
+	sendKey := func(session, value string) error {
+		calls++
+		if value == "Enter" {
+			return errBoom
+		}
+		return nil
+	}
+	sendLiteral := func(session, value string) error {
+		calls++
+		return errBoom
+	}
+
+	err := sendKeySpecs("s1", ParseKeySequence([]string{"x<Enter><Tab>"}), sendKey, sendLiteral)
+	if err == nil {
+		t.Fatal("expected aggregated error")
+	}
+	if calls != 3 {
+		t.Errorf("expected all 3 keys attempted, got %d", calls)
+	}
+	if !errors.Is(err, errBoom) {
+		t.Error("expected joined error to wrap the send failure")
+	}
+	if !strings.Contains(err.Error(), `"x"`) || !strings.Contains(err.Error(), `"Enter"`) {
+		t.Errorf("expected both failed keys in error, got %q", err.Error())
+	}
+}
@@ -1,8 +1,16 @@
 package ui
 
 import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/marcus/sidecar/internal/modal"
+	"github.com/marcus/sidecar/internal/mouse"
 	"github.com/marcus/sidecar/internal/styles"
 )
 
@@ -14,8 +22,31 @@ type ConfirmDialog struct {
 	CancelLabel  string         // e.g., " Cancel ", " No "
 	BorderColor  lipgloss.Color // Modal border color
 	Width        int            // Modal width (default 50)
+	Items        []string       // Affected items listed below the message (optional)
+	MaxItems     int            // Items shown before "...and N more" (default 5)
+
+	// SuppressLabel adds a checkbox below the buttons, e.g. "Don't ask
+	// again". Its state is in Suppress and returned by HandleKey.
+	SuppressLabel string
+	Suppress      bool
+
+	// Timeout auto-cancels the dialog after this long, showing a countdown.
+	// The dialog doesn't tick itself: the app calls Tick. Any key or click
+	// stops the countdown.
+	Timeout time.Duration
+
+	modal        *modal.Modal  // Last modal built by ToModal
+	elapsed      time.Duration // Time counted toward Timeout
+	timerStopped bool          // Countdown stopped by user input or expiry
 }
 
+const (
+	// defaultMaxItems is how many items a dialog lists when MaxItems is unset.
+	defaultMaxItems = 5
+	// ConfirmSuppressID is the focus ID of the suppress checkbox.
+	ConfirmSuppressID = "confirm-suppress"
+)
+
 // NewConfirmDialog creates a dialog with sensible defaults.
 func NewConfirmDialog(title, message string) *ConfirmDialog {
 	return &ConfirmDialog{
@@ -28,6 +59,100 @@ func NewConfirmDialog(title, message string) *ConfirmDialog {
 	}
 }
 
+// innerWidth returns the modal's content width, matching the modal's own
+// clamping of Width and its border and padding.
+func (d *ConfirmDialog) innerWidth() int {
+	width := d.Width
+	if width < modal.MinModalWidth {
+		width = modal.MinModalWidth
+	}
+	return width - modal.ModalPadding
+}
+
+// messageLines returns the message wrapped to the modal's inner width,
+// keeping explicit newlines. Words longer than a line are broken.
+func (d *ConfirmDialog) messageLines() []string {
+	return strings.Split(ansi.Wrap(d.Message, d.innerWidth(), ""), "\n")
+}
+
+// itemLines returns the rendered item list, truncated to MaxItems with a
+// trailing "...and N more" line.
+func (d *ConfirmDialog) itemLines() []string {
+	if len(d.Items) == 0 {
+		return nil
+	}
+	limit := d.MaxItems
+	if limit <= 0 {
+		limit = defaultMaxItems
+	}
+	shown := d.Items
+	if len(shown) > limit {
+		shown = shown[:limit]
+	}
+	const bullet = "  • "
+	lines := make([]string, 0, len(shown)+1)
+	for _, item := range shown {
+		lines = append(lines, bullet+TruncateStart(item, d.innerWidth()-ansi.StringWidth(bullet)))
+	}
+	if hidden := len(d.Items) - len(shown); hidden > 0 {
+		lines = append(lines, styles.Muted.Render(fmt.Sprintf("  ...and %d more", hidden)))
+	}
+	return lines
+}
+
+// ContentLineCount returns the number of lines above the buttons: the
+// wrapped message, plus a blank line and the item list when items are set,
+// and a blank line and the countdown while it runs. Long items are
+// truncated rather than wrapped, so each takes one line.
+func (d *ConfirmDialog) ContentLineCount() int {
+	count := len(d.messageLines())
+	if items := d.itemLines(); len(items) > 0 {
+		count += 1 + len(items)
+	}
+	if d.CountdownActive() {
+		count += 2
+	}
+	return count
+}
+
+// CountdownActive reports whether the auto-cancel countdown is running.
+func (d *ConfirmDialog) CountdownActive() bool {
+	return d.Timeout > 0 && !d.timerStopped
+}
+
+// Remaining returns the time left before the dialog auto-cancels.
+func (d *ConfirmDialog) Remaining() time.Duration {
+	if d.elapsed >= d.Timeout {
+		return 0
+	}
+	return d.Timeout - d.elapsed
+}
+
+// Tick advances the countdown by elapsed and returns "cancel" once it runs
+// out. It returns "" while time remains or when no countdown is running.
+func (d *ConfirmDialog) Tick(elapsed time.Duration) string {
+	if !d.CountdownActive() {
+		return ""
+	}
+	d.elapsed += elapsed
+	if d.elapsed < d.Timeout {
+		return ""
+	}
+	d.timerStopped = true
+	return "cancel"
+}
+
+// StopCountdown cancels the auto-cancel countdown.
+func (d *ConfirmDialog) StopCountdown() {
+	d.timerStopped = true
+}
+
+// countdownText renders the time left, rounded up to whole seconds.
+func (d *ConfirmDialog) countdownText() string {
+	secs := int(math.Ceil(d.Remaining().Seconds()))
+	return styles.Muted.Render(fmt.Sprintf("(auto-cancel in %ds)", secs))
+}
+
 // ToModal adapts the dialog configuration into a modal.Modal instance.
 func (d *ConfirmDialog) ToModal() *modal.Modal {
 	variant := modal.VariantDefault
@@ -40,16 +165,64 @@ func (d *ConfirmDialog) ToModal() *modal.Modal {
 		variant = modal.VariantInfo
 	}
 
-	return modal.New(d.Title,
+	m := modal.New(d.Title,
 		modal.WithWidth(d.Width),
 		modal.WithVariant(variant),
 		modal.WithPrimaryAction("confirm"),
 		modal.WithHints(false),
 	).
-		AddSection(modal.Text(d.Message)).
+		AddSection(modal.Text(strings.Join(d.messageLines(), "\n")))
+	if items := d.itemLines(); len(items) > 0 {
+		m.AddSection(modal.Spacer()).
+			AddSection(modal.Text(strings.Join(items, "\n")))
+	}
+	m.AddSection(modal.When(d.CountdownActive, modal.Spacer())).
+		AddSection(modal.When(d.CountdownActive, modal.Custom(func(int, string, string) modal.RenderedSection {
+			return modal.RenderedSection{Content: d.countdownText()}
+		}, nil))).
 		AddSection(modal.Spacer()).
 		AddSection(modal.Buttons(
 			modal.Btn(d.ConfirmLabel, "confirm"),
 			modal.Btn(d.CancelLabel, "cancel"),
 		))
+	// The checkbox follows the buttons so Confirm keeps the initial focus
+	if d.SuppressLabel != "" {
+		m.AddSection(modal.Spacer()).
+			AddSection(modal.Checkbox(ConfirmSuppressID, d.SuppressLabel, &d.Suppress))
+	}
+	d.modal = m
+	return m
+}
+
+// HandleKey routes a key to the dialog's modal, building it if needed, and
+// returns the action with the suppress checkbox state. Space or Enter on
+// the checkbox toggles it without confirming. Any key stops the countdown.
+func (d *ConfirmDialog) HandleKey(msg tea.KeyMsg) (action string, suppress bool, cmd tea.Cmd) {
+	if d.modal == nil {
+		d.ToModal()
+	}
+	d.StopCountdown()
+	if d.modal.FocusedID() == ConfirmSuppressID && msg.String() == "enter" {
+		d.Suppress = !d.Suppress
+		return "", d.Suppress, nil
+	}
+	action, cmd = d.modal.HandleKey(msg)
+	return action, d.Suppress, cmd
+}
+
+// HandleMouse routes a mouse event to the dialog's modal like HandleKey.
+// Clicking the checkbox toggles it.
+func (d *ConfirmDialog) HandleMouse(msg tea.MouseMsg, handler *mouse.Handler) (action string, suppress bool) {
+	if d.modal == nil {
+		d.ToModal()
+	}
+	if msg.Action == tea.MouseActionPress {
+		d.StopCountdown()
+	}
+	action = d.modal.HandleMouse(msg, handler)
+	if action == ConfirmSuppressID {
+		d.Suppress = !d.Suppress
+		return "", d.Suppress
+	}
+	return action, d.Suppress
 }
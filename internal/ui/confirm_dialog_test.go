@@ -1,10 +1,13 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
 )
 
 func TestNewConfirmDialog(t *testing.T) {
@@ -68,3 +71,283 @@ func TestConfirmDialog_ToModalActions(t *testing.T) {
 		t.Errorf("expected cancel action, got %q", action)
 	}
 }
+
+func TestConfirmDialog_ContentLineCount(t *testing.T) {
+	items := func(n int) []string {
+		out := make([]string, n)
+		for i := range out {
+			out[i] = fmt.Sprintf("file%d.go", i)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		message  string
+		items    []string
+		maxItems int
+		want     int
+	}{
+		{"message only", "Delete?", nil, 0, 1},
+		{"multi-line message", "Delete?\n\nThis cannot be undone.", nil, 0, 3},
+		{"few items", "Delete 3 files?", items(3), 0, 1 + 1 + 3},
+		{"exactly default limit", "Delete 5 files?", items(5), 0, 1 + 1 + 5},
+		{"truncated at default", "Delete 8 files?", items(8), 0, 1 + 1 + 5 + 1},
+		{"truncated at custom limit", "Delete 8 files?", items(8), 2, 1 + 1 + 2 + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewConfirmDialog("Delete", tt.message)
+			d.Items = tt.items
+			d.MaxItems = tt.maxItems
+			if got := d.ContentLineCount(); got != tt.want {
+				t.Errorf("ContentLineCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfirmDialog_ToModalWithItems(t *testing.T) {
+	d := NewConfirmDialog("Delete files?", "7 files will be deleted.")
+	d.Items = []string{"a.go", "b.go", "c.go", "d.go", "e.go", "f.go", "g.go"}
+	d.MaxItems = 3
+
+	output := d.ToModal().Render(80, 30, nil)
+
+	for _, want := range []string{"7 files will be deleted.", "a.go", "b.go", "c.go", "...and 4 more", "Confirm", "Cancel"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("render should contain %q", want)
+		}
+	}
+	for _, hidden := range []string{"d.go", "g.go"} {
+		if strings.Contains(output, hidden) {
+			t.Errorf("render should not list %q past MaxItems", hidden)
+		}
+	}
+
+	// Items appear between the message and the buttons
+	msgIdx := strings.Index(output, "7 files")
+	itemIdx := strings.Index(output, "a.go")
+	btnIdx := strings.Index(output, "Confirm")
+	if msgIdx >= itemIdx || itemIdx >= btnIdx {
+		t.Error("items should render below the message and above the buttons")
+	}
+}
+
+func TestConfirmDialog_SuppressFocusCycle(t *testing.T) {
+	d := NewConfirmDialog("Discard changes?", "Changes to main.go will be lost.")
+	d.SuppressLabel = "Don't ask again"
+	m := d.ToModal()
+	output := m.Render(80, 24, nil)
+
+	if !strings.Contains(output, "[ ] Don't ask again") {
+		t.Error("render should contain the unchecked checkbox")
+	}
+
+	// Tab cycles confirm -> cancel -> checkbox -> confirm; shift+tab reverses
+	want := []string{"confirm", "cancel", ConfirmSuppressID, "confirm"}
+	for i, id := range want {
+		if i > 0 {
+			d.HandleKey(tea.KeyMsg{Type: tea.KeyTab})
+		}
+		if got := m.FocusedID(); got != id {
+			t.Fatalf("focus step %d = %q, want %q", i, got, id)
+		}
+	}
+	d.HandleKey(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if got := m.FocusedID(); got != ConfirmSuppressID {
+		t.Errorf("shift+tab from confirm = %q, want checkbox", got)
+	}
+
+	// Without a label there is no checkbox to focus
+	plain := NewConfirmDialog("Delete?", "Sure?")
+	pm := plain.ToModal()
+	pm.Render(80, 24, nil)
+	plain.HandleKey(tea.KeyMsg{Type: tea.KeyTab})
+	plain.HandleKey(tea.KeyMsg{Type: tea.KeyTab})
+	if got := pm.FocusedID(); got != "confirm" {
+		t.Errorf("plain dialog focus after two tabs = %q, want confirm", got)
+	}
+}
+
+func TestConfirmDialog_SuppressFlag(t *testing.T) {
+	d := NewConfirmDialog("Discard changes?", "Changes will be lost.")
+	d.SuppressLabel = "Don't ask again"
+	m := d.ToModal()
+	m.Render(80, 24, nil)
+
+	// Unchecked: confirming reports no suppression
+	action, suppress, _ := d.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if action != "confirm" || suppress {
+		t.Errorf("got (%q, %v), want (confirm, false)", action, suppress)
+	}
+
+	// Space toggles the focused checkbox without an action
+	m.SetFocus(ConfirmSuppressID)
+	action, suppress, _ = d.HandleKey(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if action != "" || !suppress || !d.Suppress {
+		t.Errorf("space on checkbox = (%q, %v), want (\"\", true)", action, suppress)
+	}
+	if !strings.Contains(m.Render(80, 24, nil), "[x] Don't ask again") {
+		t.Error("render should show the checked checkbox")
+	}
+
+	// Enter on the checkbox toggles rather than confirming
+	action, suppress, _ = d.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if action != "" || suppress {
+		t.Errorf("enter on checkbox = (%q, %v), want (\"\", false)", action, suppress)
+	}
+	d.HandleKey(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+
+	// The flag comes back with both confirm and cancel
+	m.SetFocus("confirm")
+	action, suppress, _ = d.HandleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if action != "confirm" || !suppress {
+		t.Errorf("confirm = (%q, %v), want (confirm, true)", action, suppress)
+	}
+	action, suppress, _ = d.HandleKey(tea.KeyMsg{Type: tea.KeyEsc})
+	if action != "cancel" || !suppress {
+		t.Errorf("esc = (%q, %v), want (cancel, true)", action, suppress)
+	}
+}
+
+func TestConfirmDialog_WrappedContentLineCount(t *testing.T) {
+	long := "This will permanently delete the selected worktree along with any uncommitted changes and untracked files it contains."
+
+	tests := []struct {
+		name    string
+		message string
+		width   int
+		want    int
+	}{
+		{"short message", "Delete?", ModalWidthMedium, 1},
+		{"long message at default width", long, ModalWidthMedium, 3}, // 44 columns per line
+		{"long message at narrow width", long, 30, 6},                // 24 columns per line
+		{"width below modal minimum", long, 10, 6},                   // clamped to 30
+		{"explicit newlines", "First line.\n\nThird line.", ModalWidthMedium, 3},
+		{"newlines and wrapping", long + "\n\nThis cannot be undone.", 30, 6 + 1 + 1},
+		{"unbroken word", strings.Repeat("x", 60), 30, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewConfirmDialog("Delete", tt.message)
+			d.Width = tt.width
+			lines := d.messageLines()
+			for _, line := range lines {
+				if w := ansi.StringWidth(line); w > d.innerWidth() {
+					t.Errorf("line %q is %d wide, over %d", line, w, d.innerWidth())
+				}
+			}
+			if got := d.ContentLineCount(); got != tt.want {
+				t.Errorf("ContentLineCount() = %d, want %d (lines %q)", got, tt.want, lines)
+			}
+		})
+	}
+}
+
+func TestConfirmDialog_ToModalWrapsWithinBorder(t *testing.T) {
+	d := NewConfirmDialog("Delete worktree?", strings.Repeat("averyveryverylongpathsegment/", 4)+" will be removed.")
+	d.Width = 40
+	d.Items = []string{strings.Repeat("nested/", 10) + "file.go"}
+
+	output := ansi.Strip(d.ToModal().Render(100, 30, nil))
+	var width int
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if width == 0 {
+			width = ansi.StringWidth(trimmed)
+		}
+		if w := ansi.StringWidth(trimmed); w != width {
+			t.Errorf("modal line %q is %d wide, want %d", trimmed, w, width)
+		}
+	}
+	if !strings.Contains(output, "file.go") {
+		t.Error("truncated item should keep its file name")
+	}
+}
+
+func TestConfirmDialog_CountdownTick(t *testing.T) {
+	d := NewConfirmDialog("Stash changes?", "Stash 3 files?")
+	d.Timeout = 3 * time.Second
+	m := d.ToModal()
+
+	if !d.CountdownActive() {
+		t.Fatal("countdown should run when Timeout is set")
+	}
+	if got := d.ContentLineCount(); got != 1+2 {
+		t.Errorf("ContentLineCount() = %d, want message plus countdown", got)
+	}
+	if out := m.Render(80, 24, nil); !strings.Contains(out, "(auto-cancel in 3s)") {
+		t.Error("render should show the full countdown")
+	}
+
+	steps := []struct {
+		elapsed   time.Duration
+		remaining time.Duration
+		label     string
+		action    string
+	}{
+		{time.Second, 2 * time.Second, "2s", ""},
+		{500 * time.Millisecond, 1500 * time.Millisecond, "2s", ""}, // rounds up
+		{time.Second, 500 * time.Millisecond, "1s", ""},
+		{time.Second, 0, "", "cancel"},
+	}
+	for i, step := range steps {
+		if action := d.Tick(step.elapsed); action != step.action {
+			t.Fatalf("tick %d: action = %q, want %q", i, action, step.action)
+		}
+		if got := d.Remaining(); got != step.remaining {
+			t.Errorf("tick %d: Remaining() = %v, want %v", i, got, step.remaining)
+		}
+		if step.label != "" {
+			if out := m.Render(80, 24, nil); !strings.Contains(out, "(auto-cancel in "+step.label+")") {
+				t.Errorf("tick %d: render should show %s left", i, step.label)
+			}
+		}
+	}
+
+	// Expiry fires once and hides the countdown
+	if action := d.Tick(time.Second); action != "" {
+		t.Errorf("tick after expiry = %q, want no action", action)
+	}
+	if d.CountdownActive() || strings.Contains(m.Render(80, 24, nil), "auto-cancel") {
+		t.Error("countdown should be gone after expiry")
+	}
+
+	// No timeout, no countdown
+	plain := NewConfirmDialog("Delete?", "Sure?")
+	if plain.CountdownActive() || plain.Tick(time.Hour) != "" {
+		t.Error("dialog without Timeout should never auto-cancel")
+	}
+}
+
+func TestConfirmDialog_KeysOverrideCountdown(t *testing.T) {
+	d := NewConfirmDialog("Stash changes?", "Stash 3 files?")
+	d.Timeout = 2 * time.Second
+	m := d.ToModal()
+	m.Render(80, 24, nil)
+
+	d.Tick(time.Second)
+	if action, _, _ := d.HandleKey(tea.KeyMsg{Type: tea.KeyTab}); action != "" {
+		t.Errorf("tab = %q, want focus change only", action)
+	}
+	if d.CountdownActive() {
+		t.Error("a key press should stop the countdown")
+	}
+	if action := d.Tick(5 * time.Second); action != "" {
+		t.Errorf("tick after key press = %q, want no auto-cancel", action)
+	}
+
+	// Keys act immediately while the countdown runs
+	d = NewConfirmDialog("Stash changes?", "Stash 3 files?")
+	d.Timeout = 10 * time.Second
+	d.ToModal().Render(80, 24, nil)
+	if action, _, _ := d.HandleKey(tea.KeyMsg{Type: tea.KeyEnter}); action != "confirm" {
+		t.Errorf("enter during countdown = %q, want confirm", action)
+	}
+}
@@ -2,6 +2,7 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
@@ -73,23 +74,44 @@ func compositeRow(bgLine, modalLine string, modalStartX, modalWidth, totalWidth
 	return result.String()
 }
 
+// modalOrigin returns the top-left corner that centers a modal of the given
+// size in a width x height screen. A modal larger than the screen is pinned
+// to the top or left edge rather than given a negative offset.
+func modalOrigin(modalWidth, modalHeight, width, height int) (x, y int) {
+	return max(0, (width-modalWidth)/2), max(0, (height-modalHeight)/2)
+}
+
+// clipModal trims modal lines to fit a width x height screen. When rows are
+// cut, the last visible row becomes a hint saying how many are hidden.
+func clipModal(lines []string, width, height int) []string {
+	if width > 0 {
+		for i, line := range lines {
+			if ansi.StringWidth(line) > width {
+				lines[i] = ansi.Truncate(line, width, "")
+			}
+		}
+	}
+	if height <= 0 || len(lines) <= height {
+		return lines
+	}
+	hidden := len(lines) - height + 1
+	hint := fmt.Sprintf("↓ %d more lines", hidden)
+	modalWidth := maxLineWidth(lines)
+	lines = lines[:height-1]
+	return append(lines, styles.Muted.Render(lipgloss.PlaceHorizontal(modalWidth, lipgloss.Center, ansi.Truncate(hint, modalWidth, ""))))
+}
+
 // OverlayModal composites a modal on top of a dimmed background.
-// The modal is centered, with dimmed background visible on all sides.
+// The modal is centered, with dimmed background visible on all sides. A
+// modal larger than the screen is clipped to it, with a hint for hidden rows.
 func OverlayModal(background, modal string, width, height int) string {
 	bgLines := strings.Split(background, "\n")
-	modalLines := strings.Split(modal, "\n")
+	modalLines := clipModal(strings.Split(modal, "\n"), width, height)
 
 	// Calculate modal dimensions and position
 	modalWidth := maxLineWidth(modalLines)
 	modalHeight := len(modalLines)
-	startX := (width - modalWidth) / 2
-	startY := (height - modalHeight) / 2
-	if startX < 0 {
-		startX = 0
-	}
-	if startY < 0 {
-		startY = 0
-	}
+	startX, startY := modalOrigin(modalWidth, modalHeight, width, height)
 
 	// Ensure we have enough background lines
 	for len(bgLines) < height {
@@ -116,3 +138,24 @@ func OverlayModal(background, modal string, width, height int) string {
 
 	return strings.Join(result, "\n")
 }
+
+// OverlayAt draws overlay on background with its top-left corner at (x, y),
+// leaving the background undimmed. Lines falling outside the background
+// are dropped.
+func OverlayAt(background, overlay string, x, y int) string {
+	bgLines := strings.Split(background, "\n")
+	for i, line := range strings.Split(overlay, "\n") {
+		row := y + i
+		if row < 0 || row >= len(bgLines) {
+			continue
+		}
+		bg := bgLines[row]
+		left := ansi.Truncate(bg, x, "")
+		if w := ansi.StringWidth(left); w < x {
+			left += strings.Repeat(" ", x-w)
+		}
+		right := ansi.TruncateLeft(bg, x+ansi.StringWidth(line), "")
+		bgLines[row] = left + ResetSequence + line + ResetSequence + right
+	}
+	return strings.Join(bgLines, "\n")
+}
@@ -1,8 +1,11 @@
 package ui
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/x/ansi"
 )
 
 func TestMaxLineWidth(t *testing.T) {
@@ -155,6 +158,51 @@ func TestOverlayModal(t *testing.T) {
 	}
 }
 
+func TestModalOrigin(t *testing.T) {
+	tests := []struct {
+		name                 string
+		modalW, modalH, w, h int
+		wantX, wantY         int
+	}{
+		{"centered", 4, 2, 10, 6, 3, 2},
+		{"exact fit", 10, 6, 10, 6, 0, 0},
+		{"wider than screen", 30, 2, 10, 6, 0, 2},
+		{"taller than screen", 4, 20, 10, 6, 3, 0},
+		{"larger both ways", 30, 20, 10, 6, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y := modalOrigin(tt.modalW, tt.modalH, tt.w, tt.h)
+			if x != tt.wantX || y != tt.wantY {
+				t.Errorf("modalOrigin() = (%d, %d), want (%d, %d)", x, y, tt.wantX, tt.wantY)
+			}
+		})
+	}
+}
+
+func TestOverlayModal_ClipsOversizedModal(t *testing.T) {
+	var rows []string
+	for i := 0; i < 12; i++ {
+		rows = append(rows, fmt.Sprintf("row%02d-%s", i, strings.Repeat("x", 30)))
+	}
+	result := OverlayModal("bg", strings.Join(rows, "\n"), 20, 5)
+	lines := strings.Split(result, "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 lines, got %d", len(lines))
+	}
+	for i, line := range lines {
+		if w := ansi.StringWidth(line); w > 20 {
+			t.Errorf("line %d is %d wide, exceeds 20", i, w)
+		}
+	}
+	if !strings.HasPrefix(ansi.Strip(lines[0]), "row00") {
+		t.Errorf("clipped modal should start at the top, got %q", ansi.Strip(lines[0]))
+	}
+	if !strings.Contains(ansi.Strip(lines[4]), "↓ 8 more lines") {
+		t.Errorf("last row should hint at hidden rows, got %q", ansi.Strip(lines[4]))
+	}
+}
+
 func TestDimLine(t *testing.T) {
 	// dimLine should strip ANSI codes
 	input := "\x1b[31mred text\x1b[0m"
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// SpinnerInterval is how often a Spinner should be ticked.
+const SpinnerInterval = 100 * time.Millisecond
+
+// spinnerFrames is a single-cell braille spinner, compact enough to sit
+// inline before a status label.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Spinner is a one-cell progress indicator for async work that also tracks
+// how long the work has been running. Like BrailleSpinner it is passive:
+// the owner schedules ticks (every SpinnerInterval) and calls Tick.
+type Spinner struct {
+	frame   int
+	active  bool
+	started time.Time
+}
+
+// Start activates the spinner from its first frame, timing from now.
+// Starting an active spinner keeps its frame and start time.
+func (s *Spinner) Start(now time.Time) {
+	if s.active {
+		return
+	}
+	s.active = true
+	s.frame = 0
+	s.started = now
+}
+
+// Stop halts the spinner.
+func (s *Spinner) Stop() {
+	s.active = false
+}
+
+// IsActive returns whether the spinner is running.
+func (s Spinner) IsActive() bool {
+	return s.active
+}
+
+// Tick advances to the next frame while the spinner is active.
+func (s *Spinner) Tick() {
+	if s.active {
+		s.frame = (s.frame + 1) % len(spinnerFrames)
+	}
+}
+
+// Frame returns the current frame, or "" when inactive.
+func (s Spinner) Frame() string {
+	if !s.active {
+		return ""
+	}
+	return spinnerFrames[s.frame]
+}
+
+// Elapsed returns how long the spinner has been running at now.
+func (s Spinner) Elapsed(now time.Time) time.Duration {
+	if !s.active || now.Before(s.started) {
+		return 0
+	}
+	return now.Sub(s.started)
+}
+
+// View renders the frame, label and elapsed time, e.g. "⠹ Pushing... 12s".
+// The elapsed time is left out for the first second so quick operations
+// don't flash a counter. It returns "" when inactive.
+func (s Spinner) View(label string, now time.Time) string {
+	if !s.active {
+		return ""
+	}
+	text := s.Frame() + " " + label
+	if elapsed := s.Elapsed(now); elapsed >= time.Second {
+		text += " " + styles.Muted.Render(FormatElapsed(elapsed))
+	}
+	return text
+}
+
+// FormatElapsed formats a duration as whole seconds ("7s"), minutes and
+// seconds ("2m05s") or hours and minutes ("1h03m").
+func FormatElapsed(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	secs := int(d / time.Second)
+	switch {
+	case secs < 60:
+		return fmt.Sprintf("%ds", secs)
+	case secs < 3600:
+		return fmt.Sprintf("%dm%02ds", secs/60, secs%60)
+	default:
+		return fmt.Sprintf("%dh%02dm", secs/3600, secs%3600/60)
+	}
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestSpinner_FrameAdvance(t *testing.T) {
+	var s Spinner
+	s.Tick()
+	if s.Frame() != "" {
+		t.Error("inactive spinner should render no frame")
+	}
+
+	s.Start(time.Now())
+	if s.Frame() != spinnerFrames[0] {
+		t.Errorf("started spinner should show the first frame, got %q", s.Frame())
+	}
+	s.Tick()
+	s.Tick()
+	if s.Frame() != spinnerFrames[2] {
+		t.Errorf("after two ticks got %q, want %q", s.Frame(), spinnerFrames[2])
+	}
+
+	// Frames wrap around
+	for i := 0; i < len(spinnerFrames); i++ {
+		s.Tick()
+	}
+	if s.Frame() != spinnerFrames[2] {
+		t.Errorf("after a full cycle got %q, want %q", s.Frame(), spinnerFrames[2])
+	}
+
+	// Restarting an active spinner keeps its place
+	s.Start(time.Now())
+	if s.Frame() != spinnerFrames[2] {
+		t.Errorf("Start on an active spinner reset the frame to %q", s.Frame())
+	}
+
+	s.Stop()
+	s.Tick()
+	if s.IsActive() || s.Frame() != "" {
+		t.Error("stopped spinner should be inactive")
+	}
+	s.Start(time.Now())
+	if s.Frame() != spinnerFrames[0] {
+		t.Errorf("restarted spinner should begin at the first frame, got %q", s.Frame())
+	}
+}
+
+func TestFormatElapsed(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{-time.Second, "0s"},
+		{0, "0s"},
+		{1500 * time.Millisecond, "1s"},
+		{59 * time.Second, "59s"},
+		{60 * time.Second, "1m00s"},
+		{125 * time.Second, "2m05s"},
+		{time.Hour + 3*time.Minute + 20*time.Second, "1h03m"},
+	}
+	for _, tt := range tests {
+		if got := FormatElapsed(tt.d); got != tt.want {
+			t.Errorf("FormatElapsed(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestSpinner_ViewElapsed(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var s Spinner
+	if s.View("Pushing...", start) != "" {
+		t.Error("inactive spinner should render nothing")
+	}
+
+	s.Start(start)
+	if got := ansi.Strip(s.View("Pushing...", start.Add(500*time.Millisecond))); got != "⠋ Pushing..." {
+		t.Errorf("under a second got %q, want no elapsed time", got)
+	}
+	got := ansi.Strip(s.View("Pushing...", start.Add(72*time.Second)))
+	if got != "⠋ Pushing... 1m12s" {
+		t.Errorf("View() = %q, want %q", got, "⠋ Pushing... 1m12s")
+	}
+	if !strings.HasPrefix(got, s.Frame()) {
+		t.Errorf("view should lead with the frame, got %q", got)
+	}
+}
@@ -1,38 +1,100 @@
 package ui
 
-import "github.com/mattn/go-runewidth"
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+	"github.com/mattn/go-runewidth"
+)
 
 // TruncateString truncates a string to the given visual width.
 // It handles multi-byte characters and full-width characters correctly.
 // If the string is truncated, "..." is appended (and accounted for in width).
+// ANSI escape sequences count as zero width and are never split; a
+// truncated styled string ends with a reset so no color leaks past it.
 // Pre-condition: width should be at least 3.
 func TruncateString(s string, width int) string {
-    if width < 3 {
-        // Fallback for very small width
-        runes := []rune(s)
-        if len(runes) > width {
-            return string(runes[:width])
-        }
-        return s
-    }
+	if strings.Contains(s, "\x1b") {
+		return truncateANSI(s, width)
+	}
 
-    if runewidth.StringWidth(s) <= width {
-        return s
-    }
+	if width < 3 {
+		// Fallback for very small width
+		runes := []rune(s)
+		if len(runes) > width {
+			return string(runes[:width])
+		}
+		return s
+	}
 
-    targetWidth := width - 3
-    
-    currentWidth := 0
-    runes := []rune(s)
-    for i, r := range runes {
-        w := runewidth.RuneWidth(r)
-        if currentWidth + w > targetWidth {
-            return string(runes[:i]) + "..."
-        }
-        currentWidth += w
-    }
-    
-    return s
+	if runewidth.StringWidth(s) <= width {
+		return s
+	}
+
+	targetWidth := width - 3
+
+	currentWidth := 0
+	runes := []rune(s)
+	for i, r := range runes {
+		w := runewidth.RuneWidth(r)
+		if currentWidth+w > targetWidth {
+			return string(runes[:i]) + "..."
+		}
+		currentWidth += w
+	}
+
+	return s
+}
+
+// ansiReset clears all SGR styling.
+const ansiReset = "\x1b[0m"
+
+// truncateANSI is TruncateString for strings containing escape sequences.
+func truncateANSI(s string, width int) string {
+	if width < 0 {
+		width = 0
+	}
+	if ansi.StringWidth(s) <= width {
+		return s
+	}
+	tail := "..."
+	if width < 3 {
+		tail = ""
+	}
+	return ansi.Truncate(s, width, tail) + ansiReset
+}
+
+// TruncateMiddle shortens s to the given visual width by replacing its
+// middle with "…", keeping the start and end (e.g. a path's leading
+// directory and file name). The end gets the extra column when the split is
+// uneven. Wide runes are never split, so the result may be a column short.
+func TruncateMiddle(s string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if runewidth.StringWidth(s) <= width {
+		return s
+	}
+	if width == 1 {
+		return "…"
+	}
+
+	avail := width - 1 // room for the ellipsis
+	tailWidth := (avail + 1) / 2
+	headWidth := avail - tailWidth
+	runes := []rune(s)
+
+	head, w := 0, 0
+	for head < len(runes) && w+runewidth.RuneWidth(runes[head]) <= headWidth {
+		w += runewidth.RuneWidth(runes[head])
+		head++
+	}
+	tail, w := len(runes), 0
+	for tail > head && w+runewidth.RuneWidth(runes[tail-1]) <= tailWidth {
+		w += runewidth.RuneWidth(runes[tail-1])
+		tail--
+	}
+	return string(runes[:head]) + "…" + string(runes[tail:])
 }
 
 // SafeByteSlice extracts a substring using byte positions, ensuring
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// escapePattern matches complete CSI sequences.
+var escapePattern = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+func TestTruncateString_Plain(t *testing.T) {
+	tests := []struct {
+		input string
+		width int
+		want  string
+	}{
+		{"hello", 10, "hello"},
+		{"hello world", 8, "hello..."},
+		{"日本語テキスト", 9, "日本語..."},
+		{"hello", 2, "he"},
+	}
+
+	for _, tt := range tests {
+		if got := TruncateString(tt.input, tt.width); got != tt.want {
+			t.Errorf("TruncateString(%q, %d) = %q, want %q", tt.input, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestTruncateString_ANSI(t *testing.T) {
+	red, bold, reset := "\x1b[31m", "\x1b[1m", "\x1b[0m"
+	colored := red + "error:" + reset + " " + bold + "something went wrong" + reset
+
+	tests := []struct {
+		name      string
+		input     string
+		width     int
+		wantPlain string
+	}{
+		{"fits", colored, 40, "error: something went wrong"},
+		{"cut inside second style", colored, 15, "error: somet..."},
+		{"cut inside first style", colored, 6, "err..."},
+		{"exact width", colored, 27, "error: something went wrong"},
+		{"tiny width", colored, 2, "er"},
+		{"256-color", "\x1b[38;5;196m" + strings.Repeat("x", 20) + reset, 10, "xxxxxxx..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateString(tt.input, tt.width)
+
+			if plain := ansi.Strip(got); plain != tt.wantPlain {
+				t.Errorf("visible text = %q, want %q", plain, tt.wantPlain)
+			}
+			if w := ansi.StringWidth(got); w > tt.width {
+				t.Errorf("visible width = %d, want <= %d", w, tt.width)
+			}
+
+			// Every escape must be complete, and styling must end reset
+			if rest := escapePattern.ReplaceAllString(got, ""); strings.Contains(rest, "\x1b") {
+				t.Errorf("unterminated escape in %q", got)
+			}
+			if got != tt.input && !strings.HasSuffix(got, reset) {
+				t.Errorf("truncated result %q should end with a reset", got)
+			}
+		})
+	}
+}
+
+func TestTruncateMiddle(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  string
+	}{
+		{"short", "main.go", 20, "main.go"},
+		{"exact width", "internal/app/model.go", 21, "internal/app/model.go"},
+		{"one over", "internal/app/model.go", 20, "internal/…p/model.go"},
+		{"path", "internal/plugins/gitstatus/sidebar_view.go", 21, "internal/p…ar_view.go"},
+		{"odd split favors tail", "abcdefghij", 6, "ab…hij"},
+		{"width one", "abcdef", 1, "…"},
+		{"width zero", "abcdef", 0, ""},
+		{"full-width", "日本語のファイル名.txt", 12, "日本…名.txt"},
+		{"full-width uneven", "日本語のテキスト", 8, "日…スト"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateMiddle(tt.input, tt.width)
+			if got != tt.want {
+				t.Errorf("TruncateMiddle(%q, %d) = %q, want %q", tt.input, tt.width, got, tt.want)
+			}
+			if w := ansi.StringWidth(got); w > tt.width {
+				t.Errorf("width = %d, want <= %d", w, tt.width)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/marcus/sidecar/internal/styles"
+)
+
+// ToastSeverity sets a toast's color and icon.
+type ToastSeverity int
+
+const (
+	ToastInfo ToastSeverity = iota
+	ToastSuccess
+	ToastWarning
+	ToastError
+)
+
+// DefaultToastStack is how many toasts show at once when MaxStack is unset.
+const DefaultToastStack = 3
+
+// Toast is a brief, non-modal message.
+type Toast struct {
+	Message  string
+	Severity ToastSeverity
+	Expires  time.Time
+}
+
+// ToastManager stacks toasts, oldest first, and drops them as they expire.
+// Like BrailleSpinner it is passive: call Prune from an existing tick. The
+// zero value is ready to use.
+type ToastManager struct {
+	MaxStack int // Toasts kept at once; the oldest go first (default 3)
+
+	toasts []Toast
+	now    func() time.Time // Clock; nil means time.Now
+}
+
+func (t *ToastManager) clock() time.Time {
+	if t.now != nil {
+		return t.now()
+	}
+	return time.Now()
+}
+
+// Push adds a toast that disappears after duration, dropping the oldest
+// toasts beyond MaxStack.
+func (t *ToastManager) Push(message string, severity ToastSeverity, duration time.Duration) {
+	t.toasts = append(t.toasts, Toast{
+		Message:  message,
+		Severity: severity,
+		Expires:  t.clock().Add(duration),
+	})
+	limit := t.MaxStack
+	if limit <= 0 {
+		limit = DefaultToastStack
+	}
+	if extra := len(t.toasts) - limit; extra > 0 {
+		t.toasts = append(t.toasts[:0], t.toasts[extra:]...)
+	}
+}
+
+// Prune drops expired toasts and reports whether any were removed.
+func (t *ToastManager) Prune() bool {
+	now := t.clock()
+	kept := t.toasts[:0]
+	for _, toast := range t.toasts {
+		if now.Before(toast.Expires) {
+			kept = append(kept, toast)
+		}
+	}
+	removed := len(kept) != len(t.toasts)
+	t.toasts = kept
+	return removed
+}
+
+// Toasts returns the current toasts, oldest first.
+func (t ToastManager) Toasts() []Toast {
+	return t.toasts
+}
+
+// Len returns the number of toasts showing.
+func (t ToastManager) Len() int {
+	return len(t.toasts)
+}
+
+// View renders the stack, one right-aligned line per toast, each at most
+// maxWidth columns wide. It returns "" when there are no toasts.
+func (t ToastManager) View(maxWidth int) string {
+	if len(t.toasts) == 0 {
+		return ""
+	}
+	lines := make([]string, len(t.toasts))
+	for i, toast := range t.toasts {
+		style, icon := toastStyle(toast.Severity)
+		text := icon + " " + toast.Message
+		// Leave room for the style's horizontal padding
+		text = TruncateString(text, maxWidth-style.GetHorizontalPadding())
+		lines[i] = style.Render(text)
+	}
+	return lipgloss.JoinVertical(lipgloss.Right, lines...)
+}
+
+// toastStyle returns the style and icon for a severity.
+func toastStyle(severity ToastSeverity) (lipgloss.Style, string) {
+	switch severity {
+	case ToastSuccess:
+		return styles.ToastSuccess, "✓"
+	case ToastWarning:
+		return styles.ToastWarning, "!"
+	case ToastError:
+		return styles.ToastError, "✗"
+	default:
+		return styles.ToastInfo, "i"
+	}
+}
@@ -0,0 +1,118 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// fakeClock returns a clock for ToastManager.now and a function to advance it.
+func fakeClock() (func() time.Time, func(time.Duration)) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	return func() time.Time { return now }, func(d time.Duration) { now = now.Add(d) }
+}
+
+func toastMessages(t ToastManager) []string {
+	var msgs []string
+	for _, toast := range t.Toasts() {
+		msgs = append(msgs, toast.Message)
+	}
+	return msgs
+}
+
+func TestToastManager_PruneEvictsExpired(t *testing.T) {
+	clock, advance := fakeClock()
+	tm := ToastManager{now: clock}
+	tm.Push("short", ToastInfo, time.Second)
+	tm.Push("long", ToastError, 5*time.Second)
+	tm.Push("medium", ToastSuccess, 3*time.Second)
+
+	if tm.Prune() {
+		t.Error("nothing should expire before any time passes")
+	}
+
+	advance(time.Second)
+	if !tm.Prune() {
+		t.Error("the first toast should expire at its deadline")
+	}
+	if got := strings.Join(toastMessages(tm), ","); got != "long,medium" {
+		t.Errorf("after 1s got %q, want long,medium", got)
+	}
+
+	advance(2 * time.Second)
+	tm.Prune()
+	if got := strings.Join(toastMessages(tm), ","); got != "long" {
+		t.Errorf("after 3s got %q, want long", got)
+	}
+
+	advance(time.Hour)
+	tm.Prune()
+	if tm.Len() != 0 {
+		t.Errorf("expected all toasts expired, got %d", tm.Len())
+	}
+	if tm.View(40) != "" {
+		t.Error("an empty manager should render nothing")
+	}
+}
+
+func TestToastManager_MaxStackTrimsOldest(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxStack int
+		want     string
+	}{
+		{"default", 0, "c,d,e"},
+		{"custom", 2, "d,e"},
+		{"single", 1, "e"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock, _ := fakeClock()
+			tm := ToastManager{MaxStack: tt.maxStack, now: clock}
+			for _, msg := range []string{"a", "b", "c", "d", "e"} {
+				tm.Push(msg, ToastInfo, time.Minute)
+			}
+			if got := strings.Join(toastMessages(tm), ","); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToastManager_View(t *testing.T) {
+	var tm ToastManager
+	tm.Push("saved", ToastSuccess, time.Minute)
+	tm.Push("disk nearly full", ToastWarning, time.Minute)
+	tm.Push("a very long failure message that will not fit", ToastError, time.Minute)
+
+	view := tm.View(24)
+	lines := strings.Split(view, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected one line per toast, got %d: %q", len(lines), view)
+	}
+	for i, icon := range []string{"✓ saved", "! disk nearly full", "✗ a very"} {
+		if !strings.Contains(ansi.Strip(lines[i]), icon) {
+			t.Errorf("line %d = %q, want it to contain %q", i, ansi.Strip(lines[i]), icon)
+		}
+		if w := ansi.StringWidth(lines[i]); w > 24 {
+			t.Errorf("line %d is %d wide, exceeds 24", i, w)
+		}
+	}
+}
+
+func TestOverlayAt(t *testing.T) {
+	bg := "0123456789\nabcdefghij\nABCDEFGHIJ"
+	got := ansi.Strip(OverlayAt(bg, "XX\nYY", 6, 1))
+	want := "0123456789\nabcdefXXij\nABCDEFYYIJ"
+	if got != want {
+		t.Errorf("OverlayAt() = %q, want %q", got, want)
+	}
+
+	// Rows past the background are dropped; short rows are padded
+	got = ansi.Strip(OverlayAt("ab", "XX\nYY", 4, 0))
+	if got != "ab  XX" {
+		t.Errorf("OverlayAt() past edge = %q, want %q", got, "ab  XX")
+	}
+}
@@ -11,23 +11,81 @@ const (
 	cacheFile   = "version_cache.json"
 	tdCacheFile = "td_version_cache.json"
 	cacheTTL    = 3 * time.Hour
+
+	// cacheTTLEnv overrides CacheTTL with a duration such as "30m" or "24h".
+	// "0" disables the cache so every startup checks.
+	cacheTTLEnv = "SIDECAR_UPDATE_CHECK_TTL"
 )
 
+// CacheTTL is how long a version check result stays cached. It is set from
+// the updates.checkInterval config option; 0 disables the cache.
+var CacheTTL = cacheTTL
+
+// cacheDir returns the directory holding the version caches. Tests replace
+// it to keep caches out of the real config directory.
+var cacheDir = defaultCacheDir
+
+// defaultCacheDir returns $XDG_CONFIG_HOME/sidecar, falling back to
+// ~/.config/sidecar.
+func defaultCacheDir() string {
+	if configHome := os.Getenv("XDG_CONFIG_HOME"); configHome != "" {
+		return filepath.Join(configHome, "sidecar")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "sidecar")
+}
+
+// cacheFilePath joins name onto cacheDir, or returns "" when there is no
+// usable directory.
+func cacheFilePath(name string) string {
+	dir := cacheDir()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, name)
+}
+
+// cacheLifetime returns how long a cached check stays valid.
+func cacheLifetime() time.Duration {
+	if v := os.Getenv(cacheTTLEnv); v != "" {
+		if ttl, err := time.ParseDuration(v); err == nil && ttl >= 0 {
+			return ttl
+		}
+	}
+	if CacheTTL < 0 {
+		return cacheTTL
+	}
+	return CacheTTL
+}
+
 // CacheEntry stores cached version check result.
 type CacheEntry struct {
 	LatestVersion  string    `json:"latestVersion"`
 	CurrentVersion string    `json:"currentVersion"`
 	CheckedAt      time.Time `json:"checkedAt"`
 	HasUpdate      bool      `json:"hasUpdate"`
+	ReleaseNotes   string    `json:"releaseNotes,omitempty"`
+	ReleaseURL     string    `json:"releaseURL,omitempty"`
+}
+
+// newCacheEntry builds the cache entry for a successful check.
+func newCacheEntry(result CheckResult) *CacheEntry {
+	return &CacheEntry{
+		LatestVersion:  result.LatestVersion,
+		CurrentVersion: result.CurrentVersion,
+		CheckedAt:      time.Now(),
+		HasUpdate:      result.HasUpdate,
+		ReleaseNotes:   result.ReleaseNotes,
+		ReleaseURL:     result.UpdateURL,
+	}
 }
 
 // cachePath returns the full path to the cache file.
 func cachePath() string {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return ""
-	}
-	return filepath.Join(home, ".config", "sidecar", cacheFile)
+	return cacheFilePath(cacheFile)
 }
 
 // LoadCache reads cached version check result from disk.
@@ -75,7 +133,7 @@ func IsCacheValid(entry *CacheEntry, currentVersion string) bool {
 	if entry.CurrentVersion != currentVersion {
 		return false
 	}
-	if time.Since(entry.CheckedAt) >= cacheTTL {
+	if time.Since(entry.CheckedAt) >= cacheLifetime() {
 		return false
 	}
 	return true
@@ -83,11 +141,7 @@ func IsCacheValid(entry *CacheEntry, currentVersion string) bool {
 
 // tdCachePath returns the full path to the td cache file.
 func tdCachePath() string {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return ""
-	}
-	return filepath.Join(home, ".config", "sidecar", tdCacheFile)
+	return cacheFilePath(tdCacheFile)
 }
 
 // LoadTdCache reads cached td version check result from disk.
@@ -253,3 +253,162 @@ func TestCachePathSeparation(t *testing.T) {
 			filepath.Dir(sidecarPath), filepath.Dir(tdPath))
 	}
 }
+
+func TestCache_ReleaseNotesRoundtrip(t *testing.T) {
+	useTempCacheDir(t)
+
+	notes := "## Features\n\n- Bookmarks in the file browser\n- `c` checks for updates"
+	entry := newCacheEntry(CheckResult{
+		CurrentVersion: "v1.0.0",
+		LatestVersion:  "v1.1.0",
+		UpdateURL:      "https://github.com/marcus/sidecar/releases/tag/v1.1.0",
+		ReleaseNotes:   notes,
+		HasUpdate:      true,
+	})
+	if err := SaveCache(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.ReleaseNotes != notes {
+		t.Errorf("ReleaseNotes = %q, want %q", loaded.ReleaseNotes, notes)
+	}
+	if loaded.ReleaseURL != entry.ReleaseURL {
+		t.Errorf("ReleaseURL = %q, want %q", loaded.ReleaseURL, entry.ReleaseURL)
+	}
+
+	// A cache hit carries the notes through to the update message
+	msg, ok := CheckAsync("v1.0.0")().(UpdateAvailableMsg)
+	if !ok {
+		t.Fatal("expected UpdateAvailableMsg from cache")
+	}
+	if msg.ReleaseNotes != notes || msg.ReleaseURL != entry.ReleaseURL {
+		t.Errorf("cached msg = %+v, want notes and URL", msg)
+	}
+}
+
+func TestCache_WithoutReleaseNotes(t *testing.T) {
+	useTempCacheDir(t)
+
+	// Entries written before notes were cached still load
+	data := `{"latestVersion":"v1.1.0","currentVersion":"v1.0.0","checkedAt":"` +
+		time.Now().Format(time.RFC3339) + `","hasUpdate":true}`
+	if err := os.MkdirAll(filepath.Dir(cachePath()), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cachePath(), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.ReleaseNotes != "" || loaded.ReleaseURL != "" || !loaded.HasUpdate {
+		t.Errorf("unexpected entry: %+v", loaded)
+	}
+}
+
+// useTempCacheDir points the version caches at a fresh temp directory for
+// the duration of the test.
+func useTempCacheDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	orig := cacheDir
+	cacheDir = func() string { return dir }
+	t.Cleanup(func() { cacheDir = orig })
+	return dir
+}
+
+func TestDefaultCacheDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	xdg := filepath.Join(t.TempDir(), "xdg")
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	if got, want := defaultCacheDir(), filepath.Join(xdg, "sidecar"); got != want {
+		t.Errorf("with XDG_CONFIG_HOME: defaultCacheDir() = %q, want %q", got, want)
+	}
+
+	t.Setenv("XDG_CONFIG_HOME", "")
+	if got, want := defaultCacheDir(), filepath.Join(home, ".config", "sidecar"); got != want {
+		t.Errorf("without XDG_CONFIG_HOME: defaultCacheDir() = %q, want %q", got, want)
+	}
+}
+
+func TestCachePaths_UseCacheDir(t *testing.T) {
+	dir := useTempCacheDir(t)
+	if got, want := cachePath(), filepath.Join(dir, cacheFile); got != want {
+		t.Errorf("cachePath() = %q, want %q", got, want)
+	}
+	if got, want := tdCachePath(), filepath.Join(dir, tdCacheFile); got != want {
+		t.Errorf("tdCachePath() = %q, want %q", got, want)
+	}
+
+	cacheDir = func() string { return "" }
+	if cachePath() != "" {
+		t.Error("cachePath() should be empty without a cache directory")
+	}
+	if err := SaveCache(&CacheEntry{LatestVersion: "v1.0.0"}); err != nil {
+		t.Errorf("SaveCache() without a cache directory should be a no-op, got %v", err)
+	}
+}
+
+func TestIsCacheValid_TTLOverride(t *testing.T) {
+	entry := &CacheEntry{CurrentVersion: "v1.0.0", CheckedAt: time.Now().Add(-4 * time.Hour)}
+
+	tests := []struct {
+		ttl  string
+		want bool
+	}{
+		{"", false},      // default TTL has expired
+		{"24h", true},    // longer cadence keeps it
+		{"30m", false},   // shorter cadence drops it
+		{"0", false},     // caching disabled
+		{"bogus", false}, // invalid falls back to the default
+		{"-1h", false},   // negative falls back to the default
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ttl, func(t *testing.T) {
+			t.Setenv(cacheTTLEnv, tt.ttl)
+			if got := IsCacheValid(entry, "v1.0.0"); got != tt.want {
+				t.Errorf("IsCacheValid() with %s=%q = %v, want %v", cacheTTLEnv, tt.ttl, got, tt.want)
+			}
+		})
+	}
+
+	t.Setenv(cacheTTLEnv, "")
+	recent := &CacheEntry{CurrentVersion: "v1.0.0", CheckedAt: time.Now().Add(-time.Hour)}
+	if !IsCacheValid(recent, "v1.0.0") {
+		t.Error("an hour-old entry should be valid with the default TTL")
+	}
+}
+
+func TestIsCacheValid_ConfiguredTTL(t *testing.T) {
+	orig := CacheTTL
+	defer func() { CacheTTL = orig }()
+
+	entry := &CacheEntry{CurrentVersion: "v1.0.0", CheckedAt: time.Now().Add(-4 * time.Hour)}
+
+	t.Setenv(cacheTTLEnv, "")
+	CacheTTL = 24 * time.Hour
+	if !IsCacheValid(entry, "v1.0.0") {
+		t.Error("a 4h-old entry should be valid with a configured 24h TTL")
+	}
+
+	CacheTTL = 0
+	if IsCacheValid(entry, "v1.0.0") {
+		t.Error("a configured TTL of 0 should disable the cache")
+	}
+
+	// The env var still wins over the config value.
+	CacheTTL = 24 * time.Hour
+	t.Setenv(cacheTTLEnv, "1h")
+	if IsCacheValid(entry, "v1.0.0") {
+		t.Errorf("%s should override the configured TTL", cacheTTLEnv)
+	}
+}
@@ -2,6 +2,7 @@ package version
 
 import (
 	"fmt"
+	"net/http"
 	"os/exec"
 	"strings"
 	"time"
@@ -19,6 +20,17 @@ type UpdateAvailableMsg struct {
 	InstallMethod  InstallMethod
 }
 
+// UpToDateMsg is sent when a manual check finds no newer sidecar release.
+type UpToDateMsg struct {
+	CurrentVersion string
+	LatestVersion  string
+}
+
+// UpdateCheckFailedMsg is sent when a manual check cannot reach the releases API.
+type UpdateCheckFailedMsg struct {
+	Err error
+}
+
 // TdVersionMsg is sent with td version info (installed or not).
 type TdVersionMsg struct {
 	Installed      bool
@@ -54,6 +66,8 @@ func CheckAsync(currentVersion string) tea.Cmd {
 					CurrentVersion: currentVersion,
 					LatestVersion:  cached.LatestVersion,
 					UpdateCommand:  updateCommand(cached.LatestVersion, method),
+					ReleaseNotes:   cached.ReleaseNotes,
+					ReleaseURL:     cached.ReleaseURL,
 					InstallMethod:  method,
 				}
 			}
@@ -65,12 +79,7 @@ func CheckAsync(currentVersion string) tea.Cmd {
 
 		// Only cache successful checks (don't cache network errors)
 		if result.Error == nil {
-			_ = SaveCache(&CacheEntry{
-				LatestVersion:  result.LatestVersion,
-				CurrentVersion: currentVersion,
-				CheckedAt:      time.Now(),
-				HasUpdate:      result.HasUpdate,
-			})
+			_ = SaveCache(newCacheEntry(result))
 		}
 
 		if result.HasUpdate {
@@ -88,33 +97,53 @@ func CheckAsync(currentVersion string) tea.Cmd {
 	}
 }
 
-// ForceCheckAsync checks for updates, ignoring the cache.
+// ForceCheck checks for updates, ignoring the cache, and saves the result.
+// Returns UpdateAvailableMsg, UpToDateMsg, or UpdateCheckFailedMsg.
+func ForceCheck(currentVersion string) tea.Msg {
+	return ForceCheckWithClient(nil, "", currentVersion)
+}
+
+// ForceCheckWithClient is ForceCheck against a specific releases API. See
+// CheckWithClient for the defaults.
+func ForceCheckWithClient(client *http.Client, baseURL, currentVersion string) tea.Msg {
+	method := DetectInstallMethod()
+	result := CheckWithClient(client, baseURL, currentVersion)
+	if result.Error != nil {
+		return UpdateCheckFailedMsg{Err: result.Error}
+	}
+	_ = SaveCache(newCacheEntry(result))
+	if result.HasUpdate {
+		return UpdateAvailableMsg{
+			CurrentVersion: currentVersion,
+			LatestVersion:  result.LatestVersion,
+			UpdateCommand:  updateCommand(result.LatestVersion, method),
+			ReleaseNotes:   result.ReleaseNotes,
+			ReleaseURL:     result.UpdateURL,
+			InstallMethod:  method,
+		}
+	}
+	return UpToDateMsg{CurrentVersion: currentVersion, LatestVersion: result.LatestVersion}
+}
+
+// ForceCheckAsync checks for updates in background, ignoring the cache.
+// Only an available update produces a message.
 func ForceCheckAsync(currentVersion string) tea.Cmd {
 	return func() tea.Msg {
-		method := DetectInstallMethod()
-		result := Check(currentVersion)
-		if result.Error == nil {
-			_ = SaveCache(&CacheEntry{
-				LatestVersion:  result.LatestVersion,
-				CurrentVersion: currentVersion,
-				CheckedAt:      time.Now(),
-				HasUpdate:      result.HasUpdate,
-			})
-		}
-		if result.HasUpdate {
-			return UpdateAvailableMsg{
-				CurrentVersion: currentVersion,
-				LatestVersion:  result.LatestVersion,
-				UpdateCommand:  updateCommand(result.LatestVersion, method),
-				ReleaseNotes:   result.ReleaseNotes,
-				ReleaseURL:     result.UpdateURL,
-				InstallMethod:  method,
-			}
+		if msg, ok := ForceCheck(currentVersion).(UpdateAvailableMsg); ok {
+			return msg
 		}
 		return nil
 	}
 }
 
+// CheckNowAsync is ForceCheck as a Bubble Tea command, for checks the user
+// asked for and expects an answer to.
+func CheckNowAsync(currentVersion string) tea.Cmd {
+	return func() tea.Msg {
+		return ForceCheck(currentVersion)
+	}
+}
+
 // tdUpdateCommand generates the update command for td based on install method.
 func tdUpdateCommand(version string, method InstallMethod) string {
 	switch method {
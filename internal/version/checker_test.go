@@ -3,6 +3,7 @@ package version
 import (
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -99,37 +100,80 @@ func TestCheck_APIErrors(t *testing.T) {
 		{
 			name:       "200 success",
 			statusCode: http.StatusOK,
-			body:       `{"tag_name": "v1.0.0", "html_url": "https://github.com/marcus/sidecar/releases/tag/v1.0.0"}`,
+			body:       `{"tag_name": "v1.1.0", "body": "notes", "html_url": "https://github.com/marcus/sidecar/releases/tag/v1.1.0"}`,
 			wantErr:    false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
 			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath = r.URL.Path
 				w.WriteHeader(tt.statusCode)
 				_, _ = w.Write([]byte(tt.body))
 			}))
 			defer server.Close()
 
-			// Note: We can't easily inject the test server URL into Check()
-			// since it uses a hardcoded URL. This test documents expected behavior.
-			// For real integration testing, we'd need dependency injection.
+			result := CheckWithClient(server.Client(), server.URL+"/", "v1.0.0")
+			if gotPath != "/repos/marcus/sidecar/releases/latest" {
+				t.Errorf("requested %q, want the sidecar latest-release path", gotPath)
+			}
+			if (result.Error != nil) != tt.wantErr {
+				t.Fatalf("Error = %v, wantErr %v", result.Error, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !strings.Contains(result.Error.Error(), strconv.Itoa(tt.statusCode)) {
+					t.Errorf("Error = %v, want status %d", result.Error, tt.statusCode)
+				}
+				if result.HasUpdate {
+					t.Error("failed check should not report an update")
+				}
+				return
+			}
+			if !result.HasUpdate || result.LatestVersion != "v1.1.0" || result.ReleaseNotes != "notes" ||
+				result.UpdateURL != "https://github.com/marcus/sidecar/releases/tag/v1.1.0" {
+				t.Errorf("unexpected result: %+v", result)
+			}
 		})
 	}
 }
 
 func TestCheck_InvalidJSON(t *testing.T) {
-	// Test handling of malformed JSON responses
-	// This verifies json.Decoder error handling
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		_, _ = w.Write([]byte(`{invalid json`))
 	}))
 	defer server.Close()
 
-	// Note: Can't inject test server without modifying Check().
-	// This test documents the expected behavior.
+	result := CheckWithClient(server.Client(), server.URL, "v1.0.0")
+	if result.Error == nil {
+		t.Fatal("expected decode error for malformed JSON")
+	}
+	if result.HasUpdate || result.LatestVersion != "" {
+		t.Errorf("malformed response should leave the result empty: %+v", result)
+	}
+}
+
+func TestCheck_BaseURLFromEnv(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		_, _ = w.Write([]byte(`{"tag_name": "v0.1.0"}`))
+	}))
+	defer server.Close()
+	t.Setenv(apiBaseEnv, server.URL+"/api/v3")
+
+	result := CheckTd("v0.1.0")
+	if result.Error != nil {
+		t.Fatalf("CheckTd() error = %v", result.Error)
+	}
+	if gotPath != "/api/v3/repos/marcus/td/releases/latest" {
+		t.Errorf("requested %q, want the mirror's td latest-release path", gotPath)
+	}
+	if result.HasUpdate {
+		t.Error("same version should not report an update")
+	}
 }
 
 func TestCheckAsync_CacheHit(t *testing.T) {
@@ -333,3 +377,104 @@ func TestCheckTd(t *testing.T) {
 		t.Error("devel version should not have update")
 	}
 }
+
+func TestForceCheck_IgnoresValidCache(t *testing.T) {
+	useTempCacheDir(t)
+	if err := SaveCache(&CacheEntry{
+		LatestVersion:  "v1.0.0",
+		CurrentVersion: "v1.0.0",
+		CheckedAt:      time.Now(),
+		HasUpdate:      false,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if cached, err := LoadCache(); err != nil || !IsCacheValid(cached, "v1.0.0") {
+		t.Fatalf("cache should start valid: %+v, %v", cached, err)
+	}
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"tag_name": "v1.2.0", "body": "notes"}`))
+	}))
+	defer server.Close()
+
+	msg, ok := ForceCheckWithClient(server.Client(), server.URL, "v1.0.0").(UpdateAvailableMsg)
+	if !ok {
+		t.Fatalf("expected UpdateAvailableMsg, got %T", msg)
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+	if msg.LatestVersion != "v1.2.0" || msg.ReleaseNotes != "notes" || msg.UpdateCommand == "" {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+
+	cached, err := LoadCache()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.LatestVersion != "v1.2.0" || !cached.HasUpdate {
+		t.Errorf("cache not refreshed: %+v", cached)
+	}
+}
+
+func TestForceCheck_Results(t *testing.T) {
+	useTempCacheDir(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"tag_name": "v1.0.0"}`))
+	}))
+	defer server.Close()
+
+	msg := ForceCheckWithClient(server.Client(), server.URL, "v1.0.0")
+	if got, ok := msg.(UpToDateMsg); !ok || got.LatestVersion != "v1.0.0" {
+		t.Errorf("expected UpToDateMsg for v1.0.0, got %#v", msg)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer failing.Close()
+
+	msg = ForceCheckWithClient(failing.Client(), failing.URL, "v1.0.0")
+	if got, ok := msg.(UpdateCheckFailedMsg); !ok || got.Err == nil {
+		t.Errorf("expected UpdateCheckFailedMsg, got %#v", msg)
+	}
+	if cached, err := LoadCache(); err != nil || cached.LatestVersion != "v1.0.0" {
+		t.Errorf("failed check should keep the last good cache: %+v, %v", cached, err)
+	}
+}
+
+func TestCheck_Prereleases(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		if strings.HasSuffix(r.URL.Path, "/latest") {
+			_, _ = w.Write([]byte(`{"tag_name": "v1.1.0-rc1", "prerelease": true}`))
+			return
+		}
+		_, _ = w.Write([]byte(`[
+			{"tag_name": "v1.3.0", "draft": true},
+			{"tag_name": "v1.2.0-rc.2", "prerelease": true},
+			{"tag_name": "v1.10.0-rc.1", "prerelease": true},
+			{"tag_name": "v1.1.0"}
+		]`))
+	}))
+	defer server.Close()
+
+	// Stable only: a pre-release is never offered, even if the API returns one
+	t.Setenv(prereleaseEnv, "")
+	result := CheckWithClient(server.Client(), server.URL, "v1.0.0")
+	if result.Error != nil || result.HasUpdate {
+		t.Errorf("stable check = %+v, want no update", result)
+	}
+
+	t.Setenv(prereleaseEnv, "true")
+	result = CheckWithClient(server.Client(), server.URL, "v1.0.0")
+	if gotPath != "/repos/marcus/sidecar/releases" {
+		t.Errorf("requested %q, want the release list", gotPath)
+	}
+	if result.Error != nil || !result.HasUpdate || result.LatestVersion != "v1.10.0-rc.1" {
+		t.Errorf("prerelease check = %+v, want update to v1.10.0-rc.1", result)
+	}
+}
@@ -28,19 +28,83 @@ func parseSemver(v string) [3]int {
 	return result
 }
 
-// isNewer returns true if latest version is newer than current version.
-func isNewer(latest, current string) bool {
-	l := parseSemver(latest)
-	c := parseSemver(current)
+// prerelease returns the pre-release part of a version ("rc.1" for
+// "v2.0.0-rc.1+build5"), or "" for a stable release. Build metadata is
+// dropped since it has no bearing on precedence.
+func prerelease(v string) string {
+	if idx := strings.Index(v, "+"); idx != -1 {
+		v = v[:idx]
+	}
+	if idx := strings.Index(v, "-"); idx != -1 {
+		return v[idx+1:]
+	}
+	return ""
+}
+
+// isPrerelease returns true for versions with a pre-release suffix.
+func isPrerelease(v string) bool {
+	return prerelease(v) != ""
+}
 
+// compareVersions orders two versions by semver precedence, returning -1, 0
+// or 1. A pre-release sorts before its release (v2.0.0-rc1 < v2.0.0).
+func compareVersions(a, b string) int {
+	pa, pb := parseSemver(a), parseSemver(b)
 	for i := 0; i < 3; i++ {
-		if l[i] > c[i] {
-			return true
+		if pa[i] != pb[i] {
+			return compareInts(pa[i], pb[i])
 		}
-		if l[i] < c[i] {
-			return false
+	}
+	return comparePrerelease(prerelease(a), prerelease(b))
+}
+
+// comparePrerelease compares pre-release suffixes per semver: a release
+// outranks any pre-release, and dot-separated identifiers are compared in
+// turn, numerically when both are numbers. Numbers sort before words and a
+// shorter list before a longer one it prefixes.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				return compareInts(an, bn)
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
 		}
 	}
+	return compareInts(len(as), len(bs))
+}
 
-	return false // equal
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// isNewer returns true if latest version is newer than current version.
+func isNewer(latest, current string) bool {
+	return compareVersions(latest, current) > 0
 }
@@ -4,16 +4,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 )
 
 const (
-	repoOwner   = "marcus"
-	repoName    = "sidecar"
-	tdRepoOwner = "marcus"
-	tdRepoName  = "td"
-	apiURL      = "https://api.github.com/repos/%s/%s/releases/latest"
+	repoOwner    = "marcus"
+	repoName     = "sidecar"
+	tdRepoOwner  = "marcus"
+	tdRepoName   = "td"
+	apiBaseURL   = "https://api.github.com"
+	releasePath  = "/repos/%s/%s/releases/latest"
+	releasesPath = "/repos/%s/%s/releases"
+
+	// apiBaseEnv overrides apiBaseURL, e.g. to use a mirror of the releases API.
+	apiBaseEnv = "SIDECAR_RELEASES_API"
+	// prereleaseEnv opts in to offering pre-releases as updates when set to
+	// a true value ("1", "true").
+	prereleaseEnv = "SIDECAR_PRERELEASES"
 )
 
 // Release represents a GitHub release response.
@@ -23,6 +33,8 @@ type Release struct {
 	Body        string    `json:"body"`
 	PublishedAt time.Time `json:"published_at"`
 	HTMLURL     string    `json:"html_url"`
+	Draft       bool      `json:"draft"`
+	Prerelease  bool      `json:"prerelease"`
 }
 
 // CheckResult holds the result of a version check.
@@ -37,7 +49,14 @@ type CheckResult struct {
 
 // Check fetches the latest release from GitHub and compares versions.
 func Check(currentVersion string) CheckResult {
-	return CheckRepo(repoOwner, repoName, currentVersion)
+	return CheckWithClient(nil, "", currentVersion)
+}
+
+// CheckWithClient is Check against a specific releases API. A nil client
+// uses a default with a 5s timeout; an empty baseURL uses the GitHub API or
+// $SIDECAR_RELEASES_API.
+func CheckWithClient(client *http.Client, baseURL, currentVersion string) CheckResult {
+	return CheckRepoWithClient(client, baseURL, repoOwner, repoName, currentVersion)
 }
 
 // CheckTd fetches the latest td release from GitHub and compares versions.
@@ -47,14 +66,27 @@ func CheckTd(currentVersion string) CheckResult {
 
 // CheckRepo fetches the latest release for a repo and compares versions.
 func CheckRepo(owner, repo, currentVersion string) CheckResult {
+	return CheckRepoWithClient(nil, "", owner, repo, currentVersion)
+}
+
+// CheckRepoWithClient is CheckRepo against a specific releases API, with the
+// same defaults as CheckWithClient.
+func CheckRepoWithClient(client *http.Client, baseURL, owner, repo, currentVersion string) CheckResult {
 	result := CheckResult{CurrentVersion: currentVersion}
 
 	if isDevelopmentVersion(currentVersion) {
 		return result
 	}
 
-	client := &http.Client{Timeout: 5 * time.Second}
-	url := fmt.Sprintf(apiURL, owner, repo)
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+	includePrereleases := prereleasesEnabled()
+	path := releasePath
+	if includePrereleases {
+		path = releasesPath
+	}
+	url := releaseURL(baseURL, path, owner, repo)
 
 	resp, err := client.Get(url)
 	if err != nil {
@@ -69,7 +101,14 @@ func CheckRepo(owner, repo, currentVersion string) CheckResult {
 	}
 
 	var release Release
-	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+	if includePrereleases {
+		var releases []Release
+		if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+			result.Error = err
+			return result
+		}
+		release = newestRelease(releases)
+	} else if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
 		result.Error = err
 		return result
 	}
@@ -77,11 +116,43 @@ func CheckRepo(owner, repo, currentVersion string) CheckResult {
 	result.LatestVersion = release.TagName
 	result.UpdateURL = release.HTMLURL
 	result.ReleaseNotes = release.Body
-	result.HasUpdate = isNewer(release.TagName, currentVersion)
+	stable := !release.Prerelease && !isPrerelease(release.TagName)
+	result.HasUpdate = (stable || includePrereleases) && isNewer(release.TagName, currentVersion)
 
 	return result
 }
 
+// newestRelease returns the highest-versioned published release.
+func newestRelease(releases []Release) Release {
+	var newest Release
+	for _, r := range releases {
+		if r.Draft || r.TagName == "" {
+			continue
+		}
+		if newest.TagName == "" || isNewer(r.TagName, newest.TagName) {
+			newest = r
+		}
+	}
+	return newest
+}
+
+// prereleasesEnabled reports whether pre-releases count as updates.
+func prereleasesEnabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(prereleaseEnv))
+	return enabled
+}
+
+// releaseURL builds a releases endpoint for a repo from a path format.
+func releaseURL(baseURL, path, owner, repo string) string {
+	if baseURL == "" {
+		baseURL = os.Getenv(apiBaseEnv)
+	}
+	if baseURL == "" {
+		baseURL = apiBaseURL
+	}
+	return strings.TrimRight(baseURL, "/") + fmt.Sprintf(path, owner, repo)
+}
+
 // isDevelopmentVersion returns true for non-release versions.
 func isDevelopmentVersion(v string) bool {
 	if v == "" || v == "unknown" || v == "devel" {
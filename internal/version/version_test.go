@@ -39,6 +39,24 @@ func TestIsNewer(t *testing.T) {
 		{"v0.1.0", "v0.2.0", false},
 		{"v0.0.1", "v0.0.0", true},
 		{"v2.0.0", "v1.9.9", true},
+		// Double-digit components compare numerically
+		{"v1.10.0", "v1.9.0", true},
+		{"v1.9.0", "v1.10.0", false},
+		{"v0.0.10", "v0.0.9", true},
+		// Pre-release precedence
+		{"v2.0.0", "v2.0.0-rc1", true},
+		{"v2.0.0-rc1", "v2.0.0", false},
+		{"v2.0.0-rc.2", "v2.0.0-rc.1", true},
+		{"v2.0.0-rc.10", "v2.0.0-rc.9", true},
+		{"v2.0.0-beta", "v2.0.0-alpha", true},
+		{"v2.0.0-alpha.1", "v2.0.0-alpha", true},
+		{"v2.0.0-alpha", "v2.0.0-1", true},
+		{"v2.0.0-rc1", "v1.9.9", true},
+		// Equal versions, with or without prefix and build metadata
+		{"v1.2.3", "1.2.3", false},
+		{"v1.2.3+build.7", "v1.2.3", false},
+		{"v1.2.3", "v1.2.3+build.7", false},
+		{"v2.0.0-rc1+linux", "v2.0.0-rc1", false},
 	}
 
 	for _, tt := range tests {
@@ -52,6 +70,26 @@ func TestIsNewer(t *testing.T) {
 	}
 }
 
+func TestPrerelease(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"v1.0.0", ""},
+		{"v1.0.0-rc1", "rc1"},
+		{"v1.0.0-rc.1+build-5", "rc.1"},
+		{"v1.0.0+build-5", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := prerelease(tt.input); got != tt.want {
+				t.Errorf("prerelease(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestIsDevelopmentVersion(t *testing.T) {
 	tests := []struct {
 		input    string